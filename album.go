@@ -0,0 +1,292 @@
+// Copyright 2026 kenny-not-dead. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+//
+// Package gopptx provides functionality to create and manipulate PowerPoint
+// (.pptx) files in Go, using the Office Open XML (ECMA-376) format.
+
+package gopptx
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// This section defines the sources of the captions of the photo album.
+const (
+	// PhotoCaptionNone creates the pictures without captions.
+	PhotoCaptionNone PhotoCaptionSource = iota
+	// PhotoCaptionFileName uses the file names of the images without the
+	// extensions as the captions.
+	PhotoCaptionFileName
+	// PhotoCaptionEXIF uses the image descriptions of the EXIF metadata of
+	// the JPEG images as the captions, and the file names for the images
+	// without the descriptions.
+	PhotoCaptionEXIF
+)
+
+// PhotoCaptionSource defined the type of the source of the captions of the
+// photo album.
+type PhotoCaptionSource byte
+
+// PhotoAlbumOptions directly maps the settings of the photo album. The
+// pictures per slide is the number of the pictures in the grid of each slide
+// with default 1. The title is shown on the first slide if it's not empty.
+// The margin is the space around the grid and between the pictures in EMUs
+// with default 228600 (0.25 inch), and the caption font size is in points
+// with default 14.
+type PhotoAlbumOptions struct {
+	Template         *File
+	Title            string
+	PicturesPerSlide int
+	Captions         PhotoCaptionSource
+	CaptionFontSize  int
+	Margin           int
+}
+
+// BuildPhotoAlbum provides a function to create the presentation with the
+// images, one slide for each image or the grid of images, which replicates
+// the photo album feature of PowerPoint. The pictures are scaled to fit the
+// cells of the grid with keeping the aspect ratio. Supported image types for
+// the photo album: GIF, JPEG and PNG. For example, create the album with 4
+// pictures per slide and the captions from the file names:
+//
+//	f, err := gopptx.BuildPhotoAlbum([]string{"a.jpg", "b.jpg", "c.png"}, &gopptx.PhotoAlbumOptions{
+//	    Title:            "Summer 2026",
+//	    PicturesPerSlide: 4,
+//	    Captions:         gopptx.PhotoCaptionFileName,
+//	})
+//	if err != nil {
+//	    fmt.Println(err)
+//	    return
+//	}
+//	if err := f.SaveAs("album.pptx"); err != nil {
+//	    fmt.Println(err)
+//	}
+func BuildPhotoAlbum(images []string, opts *PhotoAlbumOptions) (*File, error) {
+	if opts == nil {
+		opts = &PhotoAlbumOptions{}
+	}
+	perSlide, margin, fontSize := max(opts.PicturesPerSlide, 1), opts.Margin, opts.CaptionFontSize
+	if margin <= 0 {
+		margin = 228600
+	}
+	if fontSize <= 0 {
+		fontSize = 14
+	}
+	f, reuseFirst := opts.Template, false
+	if f == nil {
+		f, reuseFirst = NewFile(), true
+	}
+	if err := f.checkReadOnly(); err != nil {
+		return f, err
+	}
+	if err := checkPhotoAlbumImages(images); err != nil {
+		return f, err
+	}
+	slideWidth, slideHeight, err := f.getSlideSize()
+	if err != nil {
+		return f, err
+	}
+	newSlide := func() (int, error) {
+		if reuseFirst {
+			reuseFirst = false
+			return defaultXMLSlideID, nil
+		}
+		return f.NewSlide()
+	}
+	if opts.Title != "" {
+		slideID, err := newSlide()
+		if err != nil {
+			return f, err
+		}
+		slide, err := f.slideReader(slideID)
+		if err != nil {
+			return f, err
+		}
+		titleShape := slide.getTitleShape()
+		if titleShape == nil {
+			return f, ErrPlaceholderNotExist
+		}
+		setPlaceholderParagraphs(titleShape, []JSONParagraph{{Runs: []JSONRun{{Text: opts.Title}}}})
+	}
+	cells := getGridCells(perSlide, &GridOptions{
+		OffsetX: margin, OffsetY: margin, Width: slideWidth - 2*margin, Height: slideHeight - 2*margin,
+		GutterX: margin, GutterY: margin,
+	})
+	captionHeight := 0
+	if opts.Captions != PhotoCaptionNone {
+		captionHeight = fontSize * EMUPerPoint * 2
+	}
+	for start := 0; start < len(images); start += perSlide {
+		slideID, err := newSlide()
+		if err != nil {
+			return f, err
+		}
+		slide, err := f.slideReader(slideID)
+		if err != nil {
+			return f, err
+		}
+		slide.CommonSlideData.ShapeTree.Shape = nil
+		for i, name := range images[start:min(start+perSlide, len(images))] {
+			file, err := os.ReadFile(filepath.Clean(name))
+			if err != nil {
+				return f, err
+			}
+			cellX, cellY := cells[i].Offset.X, cells[i].Offset.Y
+			cellWidth, cellHeight := cells[i].Extents.CX, cells[i].Extents.CY
+			width, height := fitPicture(file, cellWidth, cellHeight-captionHeight)
+			if _, err = f.AddPictureFromBytes(slideID, filepath.Ext(name), file, &PictureOptions{
+				Name:            filepath.Base(name),
+				AltText:         getPhotoCaption(name, file, PhotoCaptionEXIF),
+				OffsetX:         cellX + (cellWidth-width)/2,
+				OffsetY:         cellY + (cellHeight-captionHeight-height)/2,
+				Width:           width,
+				Height:          height,
+				LockAspectRatio: true,
+			}); err != nil {
+				return f, err
+			}
+			if captionHeight > 0 {
+				slide.CommonSlideData.ShapeTree.Shape = append(slide.CommonSlideData.ShapeTree.Shape,
+					newCaptionShape(slide.nextShapeID(), getPhotoCaption(name, file, opts.Captions), fontSize,
+						Offset{X: cellX, Y: cellY + cellHeight - captionHeight}, Extents{CX: cellWidth, CY: captionHeight}))
+			}
+		}
+	}
+	return f, nil
+}
+
+// checkPhotoAlbumImages provides a function to check the images of the photo
+// album are supported and readable by given image file names before creating
+// the slides, so the template isn't left with the partial album, the media
+// parts and the relationships of the images added before the error.
+func checkPhotoAlbumImages(images []string) error {
+	for _, name := range images {
+		if _, ok := supportedImageTypes[strings.ToLower(filepath.Ext(name))]; !ok {
+			return ErrImgExt
+		}
+		file, err := os.Open(filepath.Clean(name))
+		if err != nil {
+			return err
+		}
+		if err = file.Close(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// fitPicture returns the size of the picture which fits the cell by given
+// image data and the cell size with keeping the aspect ratio of the image.
+// The cell size will be returned if the image size is unknown.
+func fitPicture(file []byte, cellWidth, cellHeight int) (int, int) {
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(file))
+	if err != nil || cfg.Width == 0 || cfg.Height == 0 {
+		return cellWidth, cellHeight
+	}
+	if cellWidth*cfg.Height > cellHeight*cfg.Width {
+		return cellHeight * cfg.Width / cfg.Height, cellHeight
+	}
+	return cellWidth, cellWidth * cfg.Height / cfg.Width
+}
+
+// newCaptionShape provides a function to create the text box of the caption
+// of the picture by given shape ID, text, font size in points, offset and
+// size of the text box.
+func newCaptionShape(shapeID int, text string, fontSize int, offset Offset, extents Extents) decodeShape {
+	align, txBox, sz := "ctr", true, fontSize*100
+	return decodeShape{
+		NonVisualShapeProperties: &decodeNonVisualShapeProperties{
+			CommonNonVisualProperties:      &CommonNonVisualProperties{ID: shapeID, Name: "Caption " + text},
+			CommonNonVisualShapeProperties: &decodeCommonNonVisualShapeProperties{TxBox: &txBox},
+			NonVisualProperties:            &decodeNonVisualProperties{},
+		},
+		ShapeProperties: &DecodeShapeProperties{
+			Xfrm:           &DecodeXfrm{Offset: &offset, Extents: &extents},
+			PresetGeometry: &DecodePresetGeometry{Preset: "rect", AdjustValueList: &AdjustValueList{}},
+			NoFill:         &noFill{},
+		},
+		TextBody: &DecodeTextBody{
+			BodyProperties: &DecodeBodyProperties{},
+			Paragraph: []DecodeParagraph{{
+				ParagraphProperties: &ParagraphProperties{Align: &align},
+				Runs:                []DecodeRuns{{RunProperties: &DecodeRunProperties{Size: &sz}, Text: text}},
+			}},
+		},
+	}
+}
+
+// getPhotoCaption returns the caption of the picture by given image file
+// name, image data and the source of the caption.
+func getPhotoCaption(name string, file []byte, source PhotoCaptionSource) string {
+	switch source {
+	case PhotoCaptionNone:
+		return ""
+	case PhotoCaptionEXIF:
+		if description := getEXIFDescription(file); description != "" {
+			return description
+		}
+	}
+	return strings.TrimSuffix(filepath.Base(name), filepath.Ext(name))
+}
+
+// getEXIFDescription returns the image description of the EXIF metadata of
+// the JPEG image by given image data, an empty string will be returned if the
+// image has no description.
+func getEXIFDescription(file []byte) string {
+	if len(file) < 4 || file[0] != 0xFF || file[1] != 0xD8 {
+		return ""
+	}
+	for pos := 2; pos+4 <= len(file) && file[pos] == 0xFF; {
+		marker, size := file[pos+1], int(binary.BigEndian.Uint16(file[pos+2:]))
+		if marker == 0xDA || pos+2+size > len(file) {
+			break
+		}
+		if segment := file[pos+4 : pos+2+size]; marker == 0xE1 && bytes.HasPrefix(segment, []byte("Exif\x00\x00")) {
+			return getTIFFDescription(segment[6:])
+		}
+		pos += 2 + size
+	}
+	return ""
+}
+
+// getTIFFDescription returns the value of the ImageDescription tag of the
+// first image file directory by given TIFF data of the EXIF metadata.
+func getTIFFDescription(tiff []byte) string {
+	if len(tiff) < 8 {
+		return ""
+	}
+	var order binary.ByteOrder = binary.LittleEndian
+	if string(tiff[:2]) == "MM" {
+		order = binary.BigEndian
+	}
+	ifd := int(order.Uint32(tiff[4:]))
+	if ifd+2 > len(tiff) {
+		return ""
+	}
+	for i, count := 0, int(order.Uint16(tiff[ifd:])); i < count; i++ {
+		entry := ifd + 2 + i*12
+		if entry+12 > len(tiff) {
+			break
+		}
+		// The ImageDescription tag 0x010E with the ASCII type 2.
+		if order.Uint16(tiff[entry:]) != 0x010E || order.Uint16(tiff[entry+2:]) != 2 {
+			continue
+		}
+		size, value := int(order.Uint32(tiff[entry+4:])), tiff[entry+8:entry+12]
+		if size > 4 {
+			offset := int(order.Uint32(tiff[entry+8:]))
+			if offset < 0 || offset+size > len(tiff) {
+				return ""
+			}
+			value = tiff[offset : offset+size]
+		}
+		return strings.TrimSpace(string(bytes.TrimRight(value[:min(size, len(value))], "\x00")))
+	}
+	return ""
+}
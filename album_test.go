@@ -0,0 +1,41 @@
+package gopptx
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestBuildPhotoAlbum(t *testing.T) {
+	dir := t.TempDir()
+	img := filepath.Join(dir, "a.png")
+	if err := os.WriteFile(img, newTestPNG(t, 16, 16), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	for _, c := range []struct {
+		name   string
+		images []string
+		err    error
+		slides int
+	}{
+		{name: "images", images: []string{img, img}, slides: 3},
+		{name: "missing image", images: []string{img, filepath.Join(dir, "missing.png")}, err: os.ErrNotExist, slides: 1},
+		{name: "image type", images: []string{img, filepath.Join(dir, "b.txt")}, err: ErrImgExt, slides: 1},
+	} {
+		template := NewFile()
+		f, err := BuildPhotoAlbum(c.images, &PhotoAlbumOptions{Template: template})
+		if !errors.Is(err, c.err) {
+			t.Fatalf("%s: expected error %v, got %v", c.name, c.err, err)
+		}
+		if slides := len(f.GetSlideList()); slides != c.slides {
+			t.Errorf("%s: expected %d slides, got %d", c.name, c.slides, slides)
+		}
+		if c.err == nil {
+			continue
+		}
+		if media := f.getMediaPartNames(); len(media) != 0 {
+			t.Errorf("%s: expected no media parts on error, got %v", c.name, media)
+		}
+	}
+}
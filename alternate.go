@@ -0,0 +1,240 @@
+// Copyright 2026 kenny-not-dead. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+//
+// Package gopptx provides functionality to create and manipulate PowerPoint
+// (.pptx) files in Go, using the Office Open XML (ECMA-376) format.
+
+package gopptx
+
+import (
+	"bytes"
+	"encoding/xml"
+	"strings"
+)
+
+// AlternateContentPolicy defined the type of the policy of processing the
+// mc:AlternateContent blocks on reading the presentation.
+type AlternateContentPolicy byte
+
+// This section defines the currently supported policies of processing the
+// mc:AlternateContent blocks.
+const (
+	// AlternateContentKeep keeps the blocks as is.
+	AlternateContentKeep AlternateContentPolicy = iota
+	// AlternateContentPreferChoice replaces the blocks by the content of the
+	// first choice which requires the supported namespaces only, or the
+	// content of the fallback if there is no such choice.
+	AlternateContentPreferChoice
+	// AlternateContentPreferFallback replaces the blocks by the content of the
+	// fallback.
+	AlternateContentPreferFallback
+)
+
+// supportedNameSpaces defined the namespaces which the choices of the
+// mc:AlternateContent blocks can require on reading with the
+// AlternateContentPreferChoice policy.
+var supportedNameSpaces = map[string]bool{
+	NameSpaceDrawingML.Value:      true,
+	NameSpaceDrawingMLA14.Value:   true,
+	NameSpaceDrawingMLChart.Value: true,
+	NameSpacePresentationML.Value: true,
+	NameSpacePowerPointR14.Value:  true,
+	NameSpacePowerPointR15.Value:  true,
+	SourceRelationship.Value:      true,
+}
+
+// AlternateContent directly maps the mc:AlternateContent block, which holds
+// the representations of the content for the applications supporting the
+// required namespaces of the choices, and the fallback representation for
+// the other applications.
+type AlternateContent struct {
+	Choices  []AlternateContentChoice
+	Fallback string
+}
+
+// AlternateContentChoice directly maps the mc:Choice element. The requires is
+// the space-separated namespace prefixes required by the content, such as
+// "p14". The namespace is declared on the choice for the prefix if it's not
+// empty, which is required for the prefixes other than "a", "p", "r", "p14"
+// and "p15".
+type AlternateContentChoice struct {
+	Requires  string
+	Namespace string
+	Content   string
+}
+
+// SetSlideAlternateContent provides a function to set the mc:AlternateContent
+// block after the common slide data of the slide by given slide id, which
+// holds the newer features such as the transitions with the fallbacks. The
+// existing block is removed if the alternate content is nil. For example, set
+// the vortex transition with the fade transition as fallback:
+//
+//	err := f.SetSlideAlternateContent(256, &gopptx.AlternateContent{
+//	    Choices: []gopptx.AlternateContentChoice{{
+//	        Requires: "p14",
+//	        Content:  `<p:transition spd="slow" p14:dur="3000"><p14:vortex dir="r"/></p:transition>`,
+//	    }},
+//	    Fallback: `<p:transition spd="slow"><p:fade/></p:transition>`,
+//	})
+func (f *File) SetSlideAlternateContent(slideID int, ac *AlternateContent) error {
+	if err := f.checkReadOnly(); err != nil {
+		return err
+	}
+	slide, err := f.slideReader(slideID)
+	if err != nil {
+		return err
+	}
+	if ac == nil {
+		slide.AlternateContent, slide.DecodeAlternateContent = nil, nil
+		return nil
+	}
+	content, err := ac.innerXML()
+	if err != nil {
+		return err
+	}
+	slide.AlternateContent, slide.DecodeAlternateContent = nil, &innerXML{Content: content}
+	return nil
+}
+
+// innerXML returns the XML content of the mc:AlternateContent block.
+func (ac *AlternateContent) innerXML() (string, error) {
+	if len(ac.Choices) == 0 {
+		return "", ErrAlternateContentChoice
+	}
+	var buf bytes.Buffer
+	for _, choice := range ac.Choices {
+		requires := strings.Fields(choice.Requires)
+		if len(requires) == 0 {
+			return "", ErrAlternateContentChoice
+		}
+		buf.WriteString("<mc:Choice")
+		if choice.Namespace != "" {
+			buf.WriteString(` xmlns:` + requires[0] + `="`)
+			_ = xml.EscapeText(&buf, []byte(choice.Namespace))
+			buf.WriteString(`"`)
+		}
+		buf.WriteString(` Requires="`)
+		_ = xml.EscapeText(&buf, []byte(strings.Join(requires, " ")))
+		buf.WriteString(`">` + choice.Content + "</mc:Choice>")
+	}
+	if ac.Fallback != "" {
+		buf.WriteString("<mc:Fallback>" + ac.Fallback + "</mc:Fallback>")
+	}
+	return buf.String(), nil
+}
+
+// resolveAlternateContent provides a function to process the
+// mc:AlternateContent blocks of the XML parts in the package by the policy of
+// the options.
+func (f *File) resolveAlternateContent() error {
+	if f.options.AlternateContent == AlternateContentKeep {
+		return nil
+	}
+	for _, name := range f.getPartNames() {
+		content, ok := f.Pkg.Load(name)
+		if !ok || !strings.HasSuffix(name, ".xml") || !bytes.Contains(content.([]byte), []byte("AlternateContent")) {
+			continue
+		}
+		root, err := f.xmlNodeReader(content.([]byte))
+		if err != nil {
+			return err
+		}
+		resolveAlternateContent(root, nil, f.options.AlternateContent)
+		f.saveFileList(name, root.bytes())
+		f.diagnose(DiagnosticSkippedElement, name, "replaced the alternate content blocks by the policy")
+	}
+	return nil
+}
+
+// resolveAlternateContent replaces the mc:AlternateContent blocks in the
+// children of the element by given element, the namespaces in scope and the
+// policy. The namespaces declared on the replaced block are declared on the
+// elements of the selected content.
+func resolveAlternateContent(node *xmlNode, scope map[string]string, policy AlternateContentPolicy) {
+	scope = getNameSpaceScope(node, scope)
+	children := make([]*xmlNode, 0, len(node.Children))
+	for _, child := range node.Children {
+		if child.Name == "" || !isAlternateContent(child, scope) {
+			resolveAlternateContent(child, scope, policy)
+			children = append(children, child)
+			continue
+		}
+		acScope := getNameSpaceScope(child, scope)
+		selected := selectAlternateContent(child, acScope, policy)
+		if selected == nil {
+			continue
+		}
+		for _, content := range selected.Children {
+			if content.Name != "" {
+				for _, attr := range append(append([]xml.Attr(nil), child.Attr...), selected.Attr...) {
+					if strings.HasPrefix(attr.Name.Local, "xmlns:") && content.attr(attr.Name.Local) == "" {
+						content.Attr = append(content.Attr, attr)
+					}
+				}
+			}
+			resolveAlternateContent(content, getNameSpaceScope(selected, acScope), policy)
+			children = append(children, content)
+		}
+	}
+	node.Children = children
+}
+
+// isAlternateContent returns whether the element is the mc:AlternateContent
+// element by given element and the namespaces in scope.
+func isAlternateContent(node *xmlNode, scope map[string]string) bool {
+	if localName(node.Name) != "AlternateContent" {
+		return false
+	}
+	prefix, _, ok := strings.Cut(node.Name, ":")
+	if !ok {
+		prefix = ""
+	}
+	return getNameSpaceScope(node, scope)[prefix] == SourceRelationshipCompatibility.Value
+}
+
+// selectAlternateContent returns the mc:Choice or the mc:Fallback element of
+// the mc:AlternateContent element by given element, the namespaces in scope
+// and the policy, nil will be returned if no element is selected.
+func selectAlternateContent(ac *xmlNode, scope map[string]string, policy AlternateContentPolicy) *xmlNode {
+	if policy == AlternateContentPreferChoice {
+		for _, choice := range ac.findAll("Choice") {
+			choiceScope, supported := getNameSpaceScope(choice, scope), true
+			for _, prefix := range strings.Fields(choice.attr("Requires")) {
+				supported = supported && supportedNameSpaces[choiceScope[prefix]]
+			}
+			if supported {
+				return choice
+			}
+		}
+	}
+	return ac.find("Fallback")
+}
+
+// getNameSpaceScope returns the namespaces in scope of the element by given
+// element and the namespaces in scope of the parent element, the keys of the
+// map are the prefixes.
+func getNameSpaceScope(node *xmlNode, scope map[string]string) map[string]string {
+	var declared bool
+	for _, attr := range node.Attr {
+		if attr.Name.Local == "xmlns" || strings.HasPrefix(attr.Name.Local, "xmlns:") {
+			declared = true
+			break
+		}
+	}
+	if !declared {
+		return scope
+	}
+	nested := make(map[string]string, len(scope)+1)
+	for prefix, space := range scope {
+		nested[prefix] = space
+	}
+	for _, attr := range node.Attr {
+		if attr.Name.Local == "xmlns" {
+			nested[""] = attr.Value
+		} else if prefix, ok := strings.CutPrefix(attr.Name.Local, "xmlns:"); ok {
+			nested[prefix] = attr.Value
+		}
+	}
+	return nested
+}
@@ -0,0 +1,96 @@
+package gopptx
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+func TestAlternateContentPolicy(t *testing.T) {
+	for _, c := range []struct {
+		policy     AlternateContentPolicy
+		requires   string
+		expected   string
+		unexpected string
+	}{
+		{policy: AlternateContentKeep, requires: "p14", expected: `<mc:Choice Requires="p14">`},
+		{policy: AlternateContentPreferChoice, requires: "p14", expected: `<p:transition spd="slow" p14:dur="2000"`, unexpected: "AlternateContent"},
+		{policy: AlternateContentPreferChoice, requires: `x" xmlns:x="urn:example`, expected: `<p:transition spd="slow"`, unexpected: "p14:dur"},
+		{policy: AlternateContentPreferFallback, requires: "p14", expected: `<p:transition spd="slow"`, unexpected: "p14:dur"},
+	} {
+		f, err := openTestFile(t, func(name string, content []byte) []byte {
+			if name == "ppt/slides/slide1.xml" {
+				return bytes.Replace(content, []byte(`Requires="p14"`), []byte(`Requires="`+c.requires+`"`), 1)
+			}
+			return content
+		}, Options{AlternateContent: c.policy})
+		if err != nil {
+			t.Fatal(err)
+		}
+		buf, err := f.WriteToBuffer()
+		if err != nil {
+			t.Fatal(err)
+		}
+		saved, err := OpenReader(buf)
+		if err != nil {
+			t.Fatal(err)
+		}
+		content := saved.readXML("ppt/slides/slide1.xml")
+		if !bytes.Contains(content, []byte(c.expected)) || c.unexpected != "" && bytes.Contains(content, []byte(c.unexpected)) {
+			t.Errorf("expected %q without %q in the slide, got %s", c.expected, c.unexpected, content)
+		}
+	}
+}
+
+func TestSetSlideAlternateContent(t *testing.T) {
+	f := NewFile()
+	slideID := f.GetSlideList()[0]
+	for _, c := range []struct {
+		ac       *AlternateContent
+		expected string
+	}{
+		{
+			ac: &AlternateContent{
+				Choices: []AlternateContentChoice{
+					{Requires: "p159", Namespace: "http://schemas.microsoft.com/office/powerpoint/2015/09/main", Content: `<p:transition><p159:morph option="byObject"/></p:transition>`},
+					{Requires: "p14", Content: `<p:transition spd="slow" p14:dur="3000"><p14:vortex dir="r"/></p:transition>`},
+				},
+				Fallback: `<p:transition spd="slow"><p:fade/></p:transition>`,
+			},
+			expected: `<mc:AlternateContent xmlns:mc="` + SourceRelationshipCompatibility.Value + `"><mc:Choice xmlns:p159="http://schemas.microsoft.com/office/powerpoint/2015/09/main" Requires="p159"><p:transition><p159:morph option="byObject"/></p:transition></mc:Choice>` +
+				`<mc:Choice Requires="p14"><p:transition spd="slow" p14:dur="3000"><p14:vortex dir="r"/></p:transition></mc:Choice>` +
+				`<mc:Fallback><p:transition spd="slow"><p:fade/></p:transition></mc:Fallback></mc:AlternateContent>`,
+		},
+		{expected: "</p:cSld></p:sld>"},
+	} {
+		if err := f.SetSlideAlternateContent(slideID, c.ac); err != nil {
+			t.Fatal(err)
+		}
+		buf, err := f.WriteToBuffer()
+		if err != nil {
+			t.Fatal(err)
+		}
+		saved, err := OpenReader(buf)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if content := saved.readXML("ppt/slides/slide1.xml"); !bytes.Contains(content, []byte(c.expected)) {
+			t.Errorf("expected %s in the slide, got %s", c.expected, content)
+		}
+	}
+
+	for _, c := range []struct {
+		ac       *AlternateContent
+		expected error
+	}{
+		{ac: &AlternateContent{Fallback: "<p:transition/>"}, expected: ErrAlternateContentChoice},
+		{ac: &AlternateContent{Choices: []AlternateContentChoice{{Content: "<p:transition/>"}}}, expected: ErrAlternateContentChoice},
+	} {
+		if err := f.SetSlideAlternateContent(slideID, c.ac); !errors.Is(err, c.expected) {
+			t.Errorf("expected %v, got %v", c.expected, err)
+		}
+	}
+	if err := f.SetSlideAlternateContent(300, nil); !errors.Is(err, ErrSlideNotExist{300}) {
+		t.Errorf("expected ErrSlideNotExist, got %v", err)
+	}
+}
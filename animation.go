@@ -0,0 +1,110 @@
+// Copyright 2026 kenny-not-dead. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+//
+// Package gopptx provides functionality to create and manipulate PowerPoint
+// (.pptx) files in Go, using the Office Open XML (ECMA-376) format.
+
+package gopptx
+
+import "strconv"
+
+// Animation directly maps the animation effect of the slide. The shape ID is
+// the ID of the target shape. The class is the preset class of the effect,
+// one of "entr", "exit", "emph", "path", "verb" or "mediacall", and the
+// preset ID is the number of the preset effect in the class, such as 10 for
+// the fade entrance effect. The trigger is the node type of the effect, one
+// of "clickEffect", "withEffect" or "afterEffect". The delay and the
+// duration are specified in milliseconds, the duration is the longest
+// duration of the behaviors of the effect.
+type Animation struct {
+	ShapeID  int
+	Class    string
+	PresetID int
+	Trigger  string
+	Delay    int
+	Duration int
+}
+
+// GetAnimations provides a function to get the animation effects of the
+// slide by given slide id in the order of the timing tree. For example, print
+// the entrance effects:
+//
+//	animations, err := f.GetAnimations(256)
+//	if err != nil {
+//	    fmt.Println(err)
+//	    return
+//	}
+//	for _, animation := range animations {
+//	    if animation.Class == "entr" {
+//	        fmt.Println(animation.ShapeID, animation.PresetID, animation.Duration)
+//	    }
+//	}
+func (f *File) GetAnimations(slideID int) ([]Animation, error) {
+	slide, err := f.slideNodeReader(slideID)
+	if err != nil {
+		return nil, err
+	}
+	var animations []Animation
+	walkXMLNode(slide.find("timing"), func(node *xmlNode) {
+		if localName(node.Name) != "cTn" || node.attr("presetClass") == "" {
+			return
+		}
+		animation := Animation{Class: node.attr("presetClass"), Trigger: node.attr("nodeType")}
+		animation.PresetID, _ = strconv.Atoi(node.attr("presetID"))
+		animation.Delay, _ = strconv.Atoi(node.find("stCondLst", "cond").attr("delay"))
+		walkXMLNode(node, func(child *xmlNode) {
+			switch localName(child.Name) {
+			case "cTn":
+				if dur, err := strconv.Atoi(child.attr("dur")); err == nil && dur > animation.Duration {
+					animation.Duration = dur
+				}
+			case "spTgt":
+				if animation.ShapeID == 0 {
+					animation.ShapeID, _ = strconv.Atoi(child.attr("spid"))
+				}
+			}
+		})
+		animations = append(animations, animation)
+	})
+	return animations, nil
+}
+
+// DeleteSlideAnimations provides a function to delete all animation effects
+// of the slide by given slide id. For example:
+//
+//	err := f.DeleteSlideAnimations(256)
+func (f *File) DeleteSlideAnimations(slideID int) error {
+	if err := f.checkReadOnly(); err != nil {
+		return err
+	}
+	slide, err := f.slideReader(slideID)
+	if err != nil {
+		return err
+	}
+	slide.mu.Lock()
+	defer slide.mu.Unlock()
+	slide.Timing = nil
+	return nil
+}
+
+// RemoveAnimations provides a function to delete the animation effects of
+// the slides by given slide ids, the animation effects of all slides are
+// deleted if no slide id is given, which is useful for producing the print
+// friendly variant of the animated presentation. For example:
+//
+//	err := f.RemoveAnimations()
+func (f *File) RemoveAnimations(slideIDs ...int) error {
+	if err := f.checkReadOnly(); err != nil {
+		return err
+	}
+	if len(slideIDs) == 0 {
+		slideIDs = f.GetSlideList()
+	}
+	for _, slideID := range slideIDs {
+		if err := f.DeleteSlideAnimations(slideID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
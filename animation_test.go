@@ -0,0 +1,144 @@
+package gopptx
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// testTiming defined the timing tree of the slide with the fade entrance
+// effect of the title on click, and the spin emphasis effect of the subtitle
+// with the fade entrance effect after the previous effect.
+const testTiming = `<p:timing><p:tnLst><p:par><p:cTn id="1" dur="indefinite" restart="never" nodeType="tmRoot"><p:childTnLst>` +
+	`<p:seq concurrent="1" nextAc="seek"><p:cTn id="2" dur="indefinite" nodeType="mainSeq"><p:childTnLst>` +
+	`<p:par><p:cTn id="3" fill="hold"><p:stCondLst><p:cond delay="indefinite"/></p:stCondLst><p:childTnLst>` +
+	`<p:par><p:cTn id="4" fill="hold"><p:stCondLst><p:cond delay="0"/></p:stCondLst><p:childTnLst>` +
+	`<p:par><p:cTn id="5" presetID="10" presetClass="entr" presetSubtype="0" fill="hold" nodeType="clickEffect">` +
+	`<p:stCondLst><p:cond delay="0"/></p:stCondLst><p:childTnLst>` +
+	`<p:set><p:cBhvr><p:cTn id="6" dur="1" fill="hold"><p:stCondLst><p:cond delay="0"/></p:stCondLst></p:cTn>` +
+	`<p:tgtEl><p:spTgt spid="7"/></p:tgtEl><p:attrNameLst><p:attrName>style.visibility</p:attrName></p:attrNameLst></p:cBhvr>` +
+	`<p:to><p:strVal val="visible"/></p:to></p:set>` +
+	`<p:animEffect transition="in" filter="fade"><p:cBhvr><p:cTn id="7" dur="500"/><p:tgtEl><p:spTgt spid="7"/></p:tgtEl></p:cBhvr></p:animEffect>` +
+	`</p:childTnLst></p:cTn></p:par>` +
+	`<p:par><p:cTn id="8" presetID="8" presetClass="emph" presetSubtype="0" fill="hold" nodeType="withEffect">` +
+	`<p:stCondLst><p:cond delay="250"/></p:stCondLst><p:childTnLst>` +
+	`<p:animRot by="21600000"><p:cBhvr><p:cTn id="9" dur="2000" fill="hold"/><p:tgtEl><p:spTgt spid="8"/></p:tgtEl>` +
+	`<p:attrNameLst><p:attrName>r</p:attrName></p:attrNameLst></p:cBhvr></p:animRot>` +
+	`</p:childTnLst></p:cTn></p:par>` +
+	`</p:childTnLst></p:cTn></p:par>` +
+	`<p:par><p:cTn id="10" fill="hold"><p:stCondLst><p:cond delay="2250"/></p:stCondLst><p:childTnLst>` +
+	`<p:par><p:cTn id="11" presetID="10" presetClass="exit" presetSubtype="0" fill="hold" nodeType="afterEffect">` +
+	`<p:stCondLst><p:cond delay="1000"/></p:stCondLst><p:childTnLst>` +
+	`<p:animEffect transition="out" filter="fade"><p:cBhvr><p:cTn id="12" dur="750"/><p:tgtEl><p:spTgt spid="8"/></p:tgtEl></p:cBhvr></p:animEffect>` +
+	`</p:childTnLst></p:cTn></p:par>` +
+	`</p:childTnLst></p:cTn></p:par>` +
+	`</p:childTnLst></p:cTn></p:par></p:childTnLst></p:cTn></p:seq>` +
+	`</p:childTnLst></p:cTn></p:par></p:tnLst></p:timing>`
+
+func TestGetAnimations(t *testing.T) {
+	f, err := openTestFile(t, func(name string, content []byte) []byte {
+		if name != "ppt/slides/slide1.xml" {
+			return content
+		}
+		return bytes.Replace(content, []byte("</p:sld>"), []byte(testTiming+"</p:sld>"), 1)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	slideID := f.GetSlideList()[0]
+	animations, err := f.GetAnimations(slideID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []Animation{
+		{ShapeID: 7, Class: "entr", PresetID: 10, Trigger: "clickEffect", Duration: 500},
+		{ShapeID: 8, Class: "emph", PresetID: 8, Trigger: "withEffect", Delay: 250, Duration: 2000},
+		{ShapeID: 8, Class: "exit", PresetID: 10, Trigger: "afterEffect", Delay: 1000, Duration: 750},
+	}
+	if !reflect.DeepEqual(animations, expected) {
+		t.Errorf("expected the animations %+v, got %+v", expected, animations)
+	}
+	if err = f.DeleteSlideAnimations(slideID); err != nil {
+		t.Fatal(err)
+	}
+	buf, err := f.WriteToBuffer()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f, err = OpenReader(buf); err != nil {
+		t.Fatal(err)
+	}
+	if animations, err = f.GetAnimations(slideID); err != nil || animations != nil {
+		t.Errorf("expected no animations, got %v %v", animations, err)
+	}
+	if bytes.Contains(f.readXML("ppt/slides/slide1.xml"), []byte("timing")) {
+		t.Error("expected the timing tree is removed")
+	}
+	if _, err = f.GetAnimations(300); !errors.Is(err, ErrSlideNotExist{300}) {
+		t.Errorf("expected ErrSlideNotExist, got %v", err)
+	}
+	if err = f.DeleteSlideAnimations(300); !errors.Is(err, ErrSlideNotExist{300}) {
+		t.Errorf("expected ErrSlideNotExist, got %v", err)
+	}
+}
+
+func TestRemoveAnimationsAndTransitions(t *testing.T) {
+	f, err := openTestFile(t, func(name string, content []byte) []byte {
+		if name != "ppt/slides/slide1.xml" {
+			return content
+		}
+		return bytes.Replace(content, []byte("</p:sld>"), []byte(testTiming+"</p:sld>"), 1)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	first := f.GetSlideList()[0]
+	second, err := f.NewSlide()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = f.SetSlideTransition(second, &SlideTransition{Type: "fade"}); err != nil {
+		t.Fatal(err)
+	}
+	hasTransition := func(slideID int) bool {
+		slide, err := f.slideReader(slideID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return slide.Transition != nil || slide.DecodeAlternateContent != nil
+	}
+	if err = f.RemoveTransitions(second); err != nil {
+		t.Fatal(err)
+	}
+	if !hasTransition(first) || hasTransition(second) {
+		t.Errorf("expected only the transition of the slide %d is removed", second)
+	}
+	if err = f.RemoveAnimations(second); err != nil {
+		t.Fatal(err)
+	}
+	if animations, err := f.GetAnimations(first); err != nil || len(animations) != 3 {
+		t.Errorf("expected the animations of the slide %d are kept, got %v %v", first, animations, err)
+	}
+	for _, remove := range []func(slideIDs ...int) error{f.RemoveTransitions, f.RemoveAnimations} {
+		if err = remove(); err != nil {
+			t.Fatal(err)
+		}
+		if err = remove(first, 300); !errors.Is(err, ErrSlideNotExist{300}) {
+			t.Errorf("expected ErrSlideNotExist, got %v", err)
+		}
+	}
+	buf, err := f.WriteToBuffer()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f, err = OpenReader(buf); err != nil {
+		t.Fatal(err)
+	}
+	for _, slideID := range []int{first, second} {
+		slideXMLPath, _ := f.getSlideXMLPath(slideID)
+		if content := f.readXML(slideXMLPath); bytes.Contains(content, []byte("transition")) || bytes.Contains(content, []byte("timing")) {
+			t.Errorf("expected the transition and the animations of the slide %d are removed, got %s", slideID, content)
+		}
+	}
+}
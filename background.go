@@ -0,0 +1,149 @@
+// Copyright 2026 kenny-not-dead. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+//
+// Package gopptx provides functionality to create and manipulate PowerPoint
+// (.pptx) files in Go, using the Office Open XML (ECMA-376) format.
+
+package gopptx
+
+import "strconv"
+
+// EffectiveBackground directly maps the resolved background of the slide.
+// The source is the part defining the background, which is "slide",
+// "layout", "master" or empty if no part defines it. The fill type is one of
+// "none", "solid", "gradient", "pattern" and "picture". The color is the hex
+// RGB color of the solid fill, the first gradient stop or the pattern
+// foreground, and the background color is the pattern background. The
+// gradient stops are the positions in thousandths of a percent and the
+// colors of the gradient, and the angle is the angle of the linear gradient
+// in 60,000ths of a degree. The image is the part name of the picture fill.
+type EffectiveBackground struct {
+	Source          string
+	FillType        string
+	Color           string
+	BackgroundColor string
+	Pattern         string
+	GradientStops   []GradientStop
+	Angle           int
+	Image           string
+}
+
+// GradientStop directly maps the stop of the gradient fill, the position is
+// specified in thousandths of a percent and the color is the hex RGB color.
+type GradientStop struct {
+	Position int
+	Color    string
+}
+
+// GetEffectiveSlideBackground provides a function to get the resolved
+// background of the slide by given slide id for the renderers. The
+// background is inherited from the slide to the slide layout and the slide
+// master, and the background references are resolved to the fill styles and
+// the background fill styles of the theme with the referenced color. The
+// theme colors are resolved to the actual values with the color mapping of
+// the slide. For example:
+//
+//	bg, err := f.GetEffectiveSlideBackground(256)
+//	if err != nil {
+//	    fmt.Println(err)
+//	    return
+//	}
+//	fmt.Println(bg.Source, bg.FillType, bg.Color)
+func (f *File) GetEffectiveSlideBackground(slideID int) (EffectiveBackground, error) {
+	bg := EffectiveBackground{FillType: "none"}
+	slide, err := f.slideNodeReader(slideID)
+	if err != nil {
+		return bg, err
+	}
+	slideXMLPath, _ := f.getSlideXMLPath(slideID)
+	layoutXMLPath, _ := f.getRelTargetByType(slideXMLPath, SourceRelationshipSlideLayout)
+	masterXMLPath, _ := f.getRelTargetByType(layoutXMLPath, SourceRelationshipSlideMaster)
+	themeXMLPath, ok := f.getRelTargetByType(masterXMLPath, SourceRelationshipTheme)
+	if !ok {
+		themeXMLPath = defaultXMLPathTheme
+	}
+	var layout, master *xmlNode
+	if layoutXMLPath != "" {
+		if layout, err = f.xmlNodeReader(f.readXML(layoutXMLPath)); err != nil {
+			return bg, err
+		}
+	}
+	if masterXMLPath != "" {
+		if master, err = f.xmlNodeReader(f.readXML(masterXMLPath)); err != nil {
+			return bg, err
+		}
+	}
+	r := &styleResolver{colorMap: getColorMap(master, layout, slide)}
+	if content := f.readXML(themeXMLPath); len(content) > 0 {
+		if r.theme, err = f.xmlNodeReader(content); err != nil {
+			return bg, err
+		}
+	}
+	for _, part := range []struct {
+		source, partName string
+		root             *xmlNode
+	}{
+		{"slide", slideXMLPath, slide}, {"layout", layoutXMLPath, layout}, {"master", masterXMLPath, master},
+	} {
+		node := part.root.find("cSld", "bg")
+		if node == nil {
+			continue
+		}
+		bg.Source = part.source
+		if bgPr := node.find("bgPr"); bgPr != nil {
+			r.background(&bg, bgPr, "")
+			bg.Image, _ = f.getRelTarget(part.partName, bg.Image)
+			return bg, nil
+		}
+		if bgRef := node.find("bgRef"); bgRef != nil {
+			idx, _ := strconv.Atoi(bgRef.attr("idx"))
+			list := "fillStyleLst"
+			if idx > 1000 {
+				list, idx = "bgFillStyleLst", idx-1000
+			}
+			if styles := r.theme.find("themeElements", "fmtScheme", list); styles != nil && idx > 0 {
+				if fills := elementChildren(styles); idx <= len(fills) {
+					r.background(&bg, &xmlNode{Children: fills[idx-1 : idx]}, r.color(bgRef, ""))
+					bg.Image, _ = f.getRelTarget(themeXMLPath, bg.Image)
+				}
+			}
+		}
+		return bg, nil
+	}
+	return bg, nil
+}
+
+// background sets the fill of the background by given fill properties
+// element and the placeholder color of the theme styles, the image of the
+// picture fill is set to the relationship ID of the picture.
+func (r *styleResolver) background(bg *EffectiveBackground, props *xmlNode, phClr string) {
+	fillType, clr, ok := r.fill(props, phClr)
+	if !ok {
+		return
+	}
+	bg.FillType, bg.Color = fillType, clr
+	for _, child := range props.Children {
+		switch localName(child.Name) {
+		case "gradFill":
+			for _, gs := range child.find("gsLst").findAll("gs") {
+				pos, _ := strconv.Atoi(gs.attr("pos"))
+				bg.GradientStops = append(bg.GradientStops, GradientStop{Position: pos, Color: r.color(gs, phClr)})
+			}
+			bg.Angle, _ = strconv.Atoi(child.find("lin").attr("ang"))
+		case "pattFill":
+			bg.Pattern, bg.BackgroundColor = child.attr("prst"), r.color(child.find("bgClr"), phClr)
+		case "blipFill":
+			if blip := child.find("blip"); blip != nil {
+				for _, attr := range blip.Attr {
+					if localName(attr.Name.Local) == "embed" {
+						bg.Image = attr.Value
+					}
+				}
+			}
+		default:
+			continue
+		}
+		return
+	}
+}
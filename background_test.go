@@ -0,0 +1,72 @@
+package gopptx
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"regexp"
+	"testing"
+)
+
+func TestGetEffectiveSlideBackground(t *testing.T) {
+	const (
+		masterXMLPath = "ppt/slideMasters/slideMaster1.xml"
+		layoutXMLPath = "ppt/slideLayouts/slideLayout1.xml"
+	)
+	cSld := regexp.MustCompile(`<p:cSld[^>]*>`)
+	for _, c := range []struct {
+		backgrounds map[string]string
+		expected    EffectiveBackground
+	}{
+		{expected: EffectiveBackground{FillType: "none"}},
+		{
+			backgrounds: map[string]string{masterXMLPath: `<p:bgRef idx="1001"><a:schemeClr val="accent2"/></p:bgRef>`},
+			expected:    EffectiveBackground{Source: "master", FillType: "solid", Color: "0369A3"},
+		},
+		{
+			backgrounds: map[string]string{
+				masterXMLPath: `<p:bgRef idx="1001"><a:schemeClr val="accent2"/></p:bgRef>`,
+				layoutXMLPath: `<p:bgPr><a:gradFill><a:gsLst><a:gs pos="0"><a:schemeClr val="accent1"/></a:gs><a:gs pos="100000"><a:srgbClr val="FF0000"/></a:gs></a:gsLst>` +
+					`<a:lin ang="5400000" scaled="0"/></a:gradFill><a:effectLst/></p:bgPr>`,
+			},
+			expected: EffectiveBackground{Source: "layout", FillType: "gradient", Color: "18A303", Angle: 5400000,
+				GradientStops: []GradientStop{{Position: 0, Color: "18A303"}, {Position: 100000, Color: "FF0000"}}},
+		},
+		{
+			backgrounds: map[string]string{
+				layoutXMLPath: `<p:bgPr><a:solidFill><a:srgbClr val="FF0000"/></a:solidFill><a:effectLst/></p:bgPr>`,
+				defaultXMLPathSlide: `<p:bgPr><a:pattFill prst="dkDnDiag"><a:fgClr><a:srgbClr val="112233"/></a:fgClr><a:bgClr><a:schemeClr val="bg1"/></a:bgClr></a:pattFill>` +
+					`<a:effectLst/></p:bgPr>`,
+			},
+			expected: EffectiveBackground{Source: "slide", FillType: "pattern", Color: "112233", BackgroundColor: "FFFFFF", Pattern: "dkDnDiag"},
+		},
+		{
+			backgrounds: map[string]string{
+				defaultXMLPathSlide: `<p:bgPr><a:blipFill><a:blip r:embed="rId90"/><a:stretch><a:fillRect/></a:stretch></a:blipFill><a:effectLst/></p:bgPr>`,
+			},
+			expected: EffectiveBackground{Source: "slide", FillType: "picture", Image: "ppt/media/image1.png"},
+		},
+	} {
+		f, err := openTestFile(t, func(name string, content []byte) []byte {
+			if bg, ok := c.backgrounds[name]; ok {
+				loc := cSld.FindIndex(content)
+				return append(append(content[:loc[1]:loc[1]], []byte("<p:bg>"+bg+"</p:bg>")...), content[loc[1]:]...)
+			}
+			if name == getPartRelsPath(defaultXMLPathSlide) {
+				return bytes.Replace(content, []byte("</Relationships>"), []byte(
+					`<Relationship Id="rId90" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/image" Target="../media/image1.png"/></Relationships>`), 1)
+			}
+			return content
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		bg, err := f.GetEffectiveSlideBackground(f.GetSlideList()[0])
+		if err != nil || !reflect.DeepEqual(bg, c.expected) {
+			t.Errorf("expected the background %+v, got %+v %v", c.expected, bg, err)
+		}
+	}
+	if _, err := NewFile().GetEffectiveSlideBackground(300); !errors.Is(err, ErrSlideNotExist{300}) {
+		t.Errorf("expected ErrSlideNotExist, got %v", err)
+	}
+}
@@ -0,0 +1,233 @@
+// Copyright 2026 kenny-not-dead. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+//
+// Package gopptx provides functionality to create and manipulate PowerPoint
+// (.pptx) files in Go, using the Office Open XML (ECMA-376) format.
+
+package gopptx
+
+import (
+	"bytes"
+	"encoding/xml"
+	"path"
+	"strconv"
+	"strings"
+)
+
+// This section defines the names of the shapes stamped onto the slide masters
+// by the brand kit, which are used to replace the shapes on applying the
+// brand kit again.
+const (
+	brandLogoName   = "Brand Logo"
+	brandFooterName = "Brand Footer"
+)
+
+// BrandKit directly maps the settings of the brand applied to the
+// presentation. The logo is the image data with the image extension such as
+// ".png", which is placed at the top right corner of the slide masters, and
+// the logo width is specified in EMUs with default the tenth of the slide
+// width. The footer text is placed at the bottom of the slide masters. The
+// color scheme and the fonts are applied to the theme, and the properties are
+// set as the document properties. The empty settings are kept as is.
+type BrandKit struct {
+	Logo          []byte
+	LogoExtension string
+	LogoWidth     int
+	FooterText    string
+	ColorScheme   *ThemeColorScheme
+	Fonts         *ThemeFonts
+	Properties    *DocProperties
+}
+
+// ApplyBrandKit provides a function to apply the brand kit to the
+// presentation in a single operation, which updates the theme, stamps the
+// logo and the footer onto every slide master and sets the document
+// properties. The logo and the footer stamped by the previous call are
+// replaced. For example:
+//
+//	logo, err := os.ReadFile("logo.png")
+//	if err != nil {
+//	    fmt.Println(err)
+//	    return
+//	}
+//	err = f.ApplyBrandKit(gopptx.BrandKit{
+//	    Logo:          logo,
+//	    LogoExtension: ".png",
+//	    FooterText:    "Example Inc. Confidential",
+//	    ColorScheme:   &gopptx.ThemeColorScheme{Accent1: "C00000"},
+//	    Fonts:         &gopptx.ThemeFonts{Major: "Georgia", Minor: "Verdana"},
+//	    Properties:    &gopptx.DocProperties{Company: "Example Inc."},
+//	})
+func (f *File) ApplyBrandKit(kit BrandKit) error {
+	if err := f.checkReadOnly(); err != nil {
+		return err
+	}
+	if kit.ColorScheme != nil {
+		if err := f.SetThemeColorScheme(kit.ColorScheme); err != nil {
+			return err
+		}
+	}
+	if kit.Fonts != nil {
+		if err := f.SetThemeFonts(kit.Fonts); err != nil {
+			return err
+		}
+	}
+	if len(kit.Logo) > 0 || kit.FooterText != "" {
+		if err := f.addMasterBrand(&kit); err != nil {
+			return err
+		}
+	}
+	if kit.Properties != nil {
+		return f.SetDocProps(kit.Properties)
+	}
+	return nil
+}
+
+// addMasterBrand provides a function to stamp the logo and the footer of the
+// brand kit onto every slide master.
+func (f *File) addMasterBrand(kit *BrandKit) error {
+	slideWidth, slideHeight, err := f.getSlideSize()
+	if err != nil {
+		return err
+	}
+	margin := slideHeight / 40
+	var media string
+	logo := &PictureOptions{Width: kit.LogoWidth}
+	if len(kit.Logo) > 0 {
+		contentType, ok := supportedImageTypes[strings.ToLower(kit.LogoExtension)]
+		if !ok {
+			return ErrImgExt
+		}
+		if logo.Width <= 0 {
+			logo.Width = slideWidth / 10
+		}
+		if logo.Width, logo.Height, err = getPictureSize(kit.Logo, logo); err != nil {
+			return err
+		}
+		logo.OffsetX, logo.OffsetY = slideWidth-margin-logo.Width, margin
+		media = "../media/" + path.Base(f.addMedia(kit.Logo, kit.LogoExtension))
+		if err = f.setContentTypeDefault(kit.LogoExtension, contentType); err != nil {
+			return err
+		}
+	}
+	var oldMediaPaths []string
+	for _, masterXMLPath := range f.getSlideMasterPaths() {
+		master, err := f.xmlNodeReader(f.readXML(masterXMLPath))
+		if err != nil {
+			return err
+		}
+		shapeTree := master.find("cSld", "spTree")
+		if shapeTree == nil {
+			return newSlideMasterShapeTreeError(masterXMLPath)
+		}
+		oldMediaPaths = append(oldMediaPaths, f.removeMasterBrand(masterXMLPath, shapeTree, kit)...)
+		shapeID := getMaxShapeID(master)
+		var shapes []interface{}
+		if media != "" {
+			shapeID++
+			rID := f.addRels(getPartRelsPath(masterXMLPath), SourceRelationshipImage, media, "")
+			shapes = append(shapes, newPicture(newBrandLogo(shapeID, rID, logo)))
+		}
+		if kit.FooterText != "" {
+			shapeID++
+			height := 12 * EMUPerPoint * 2
+			footer := newCaptionShape(shapeID, kit.FooterText, 12,
+				Offset{X: slideWidth / 6, Y: slideHeight - margin - height}, Extents{CX: slideWidth * 2 / 3, CY: height})
+			footer.NonVisualShapeProperties.CommonNonVisualProperties.Name = brandFooterName
+			shapes = append(shapes, newShape(footer))
+		}
+		for _, shape := range shapes {
+			var buf bytes.Buffer
+			name := "p:sp"
+			if _, ok := shape.(Picture); ok {
+				name = "p:pic"
+			}
+			if err = xml.NewEncoder(&buf).EncodeElement(shape, xml.StartElement{Name: xml.Name{Local: name}}); err != nil {
+				return err
+			}
+			node, err := f.xmlNodeReader(buf.Bytes())
+			if err != nil {
+				return err
+			}
+			shapeTree.insert(node, strings.TrimSuffix(shapeTree.Name, "spTree")+"extLst")
+		}
+		f.saveFileList(masterXMLPath, master.bytes())
+	}
+	for _, oldMediaPath := range oldMediaPaths {
+		if !f.isPartReferenced(oldMediaPath) {
+			f.Pkg.Delete(oldMediaPath)
+			f.deleteTempFile(oldMediaPath)
+		}
+	}
+	return nil
+}
+
+// removeMasterBrand provides a function to remove the logo and the footer
+// stamped by the previous brand kit from the shape tree of the slide master
+// by given master part path, shape tree and the brand kit. Only the shapes
+// which will be stamped again are removed. It returns the part names of the
+// images of the removed logos.
+func (f *File) removeMasterBrand(masterXMLPath string, shapeTree *xmlNode, kit *BrandKit) []string {
+	var mediaPaths []string
+	children := make([]*xmlNode, 0, len(shapeTree.Children))
+	for _, child := range shapeTree.Children {
+		switch name := localName(child.Name); {
+		case name == "pic" && len(kit.Logo) > 0 && child.find("nvPicPr", "cNvPr").attr("name") == brandLogoName:
+			for _, attr := range child.find("blipFill", "blip").Attr {
+				if localName(attr.Name.Local) == "embed" {
+					if mediaPath, ok := f.getRelTarget(masterXMLPath, attr.Value); ok {
+						mediaPaths = append(mediaPaths, mediaPath)
+					}
+					f.deleteRels(getPartRelsPath(masterXMLPath), attr.Value)
+				}
+			}
+		case name == "sp" && kit.FooterText != "" && child.find("nvSpPr", "cNvPr").attr("name") == brandFooterName:
+		default:
+			children = append(children, child)
+		}
+	}
+	shapeTree.Children = children
+	return mediaPaths
+}
+
+// newBrandLogo provides a function to create the picture of the logo of the
+// brand kit by given shape ID, relationship ID of the image and the position
+// and size of the logo.
+func newBrandLogo(shapeID int, rID string, logo *PictureOptions) decodePicture {
+	noChangeAspect := 1
+	return decodePicture{
+		NonVisualPictureProperties: &decodeNonVisualPictureProperties{
+			CommonNonVisualProperties: &CommonNonVisualProperties{ID: shapeID, Name: brandLogoName},
+			CommonNonVisualPictureProperties: &decodeCommonNonVisualPictureProperties{
+				PictureLocks: &PictureLocks{NoChangeAspect: &noChangeAspect},
+			},
+			NonVisualProperties: &decodeNonVisualProperties{},
+		},
+		BlipFill: &decodeBlipFill{
+			Blip:    &decodeBlip{Embed: rID},
+			Stretch: &decodeStretch{FillRect: &FillRect{}},
+		},
+		ShapeProperties: &DecodeShapeProperties{
+			Xfrm: &DecodeXfrm{
+				Offset:  &Offset{X: logo.OffsetX, Y: logo.OffsetY},
+				Extents: &Extents{CX: logo.Width, CY: logo.Height},
+			},
+			PresetGeometry: &DecodePresetGeometry{Preset: "rect", AdjustValueList: &AdjustValueList{}},
+		},
+	}
+}
+
+// getMaxShapeID returns the maximum shape ID in the element tree by given
+// root element.
+func getMaxShapeID(node *xmlNode) int {
+	var shapeID int
+	for _, child := range node.Children {
+		if localName(child.Name) == "cNvPr" {
+			id, _ := strconv.Atoi(child.attr("id"))
+			shapeID = max(shapeID, id)
+		}
+		shapeID = max(shapeID, getMaxShapeID(child))
+	}
+	return shapeID
+}
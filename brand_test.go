@@ -0,0 +1,77 @@
+package gopptx
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestApplyBrandKit(t *testing.T) {
+	f := NewFile()
+	scheme, err := f.GetThemeColorScheme()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, logo := range [][]byte{newTestPNG(t, 32, 16), newTestPNG(t, 16, 16)} {
+		if err = f.ApplyBrandKit(BrandKit{
+			Logo:          logo,
+			LogoExtension: ".png",
+			FooterText:    "Example Inc. Confidential",
+			ColorScheme:   &ThemeColorScheme{Accent1: "C00000"},
+			Fonts:         &ThemeFonts{Major: "Georgia", Minor: "Verdana"},
+			Properties:    &DocProperties{Title: "Brand", Company: "Example Inc."},
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	buf, err := f.WriteToBuffer()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f, err = OpenReader(buf); err != nil {
+		t.Fatal(err)
+	}
+	expected := scheme
+	expected.Accent1 = "C00000"
+	if scheme, err = f.GetThemeColorScheme(); err != nil || scheme != expected {
+		t.Errorf("expected the color scheme %v, got %v %v", expected, scheme, err)
+	}
+	for name, parts := range map[string][]string{
+		"ppt/theme/theme1.xml": {`<a:majorFont><a:latin typeface="Georgia"`, `<a:minorFont><a:latin typeface="Verdana"`},
+		"ppt/slideMasters/slideMaster1.xml": {
+			`<p:cNvPr id="5" name="Brand Logo"`, `<a:off x="8930800" y="141763"/><a:ext cx="1008062" cy="1008062"/>`,
+			`<p:cNvPr id="6" name="Brand Footer"`, "Example Inc. Confidential",
+		},
+		"docProps/core.xml": {"<dc:title>Brand</dc:title>"},
+		"docProps/app.xml":  {"<Company>Example Inc.</Company>"},
+	} {
+		content := string(f.readXML(name))
+		for _, part := range parts {
+			if !strings.Contains(content, part) {
+				t.Errorf("expected %s in the part %s, got %s", part, name, content)
+			}
+		}
+		if name == "ppt/slideMasters/slideMaster1.xml" && strings.Count(content, "Brand Logo") != 1 {
+			t.Errorf("expected the logo is replaced, got %s", content)
+		}
+	}
+	var media int
+	for _, name := range f.getPartNames() {
+		if strings.HasPrefix(name, "ppt/media/") {
+			media++
+		}
+	}
+	if media != 1 {
+		t.Errorf("expected the image of the replaced logo is removed, got %d media parts", media)
+	}
+	if err = f.Validate(); err != nil {
+		t.Error(err)
+	}
+	if err = f.ApplyBrandKit(BrandKit{Logo: []byte("logo"), LogoExtension: ".txt"}); !errors.Is(err, ErrImgExt) {
+		t.Errorf("expected ErrImgExt, got %v", err)
+	}
+	if !bytes.Contains(f.readXML("ppt/slideMasters/slideMaster1.xml"), []byte("Brand Logo")) {
+		t.Error("the logo is removed on error")
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright 2026 kenny-not-dead. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+//
+// Package gopptx provides functionality to create and manipulate PowerPoint
+// (.pptx) files in Go, using the Office Open XML (ECMA-376) format.
+
+package gopptx
+
+import (
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/net/html/charset"
+)
+
+// This section defines the built-in charset presets of the Charset option,
+// the other labels of the WHATWG Encoding Standard are also accepted.
+const (
+	CharsetWindows1250 = "windows-1250"
+	CharsetWindows1251 = "windows-1251"
+	CharsetWindows1252 = "windows-1252"
+	CharsetGBK         = "gbk"
+	CharsetGB18030     = "gb18030"
+	CharsetBig5        = "big5"
+	CharsetShiftJIS    = "shift_jis"
+	CharsetEUCJP       = "euc-jp"
+	CharsetEUCKR       = "euc-kr"
+	CharsetISO88591    = "iso-8859-1"
+	CharsetISO88592    = "iso-8859-2"
+	CharsetISO88595    = "iso-8859-5"
+	CharsetISO88597    = "iso-8859-7"
+	CharsetISO88599    = "iso-8859-9"
+	CharsetISO885915   = "iso-8859-15"
+)
+
+// xmlEncodingExp matches the encoding declaration of the XML part.
+var xmlEncodingExp = regexp.MustCompile(`^\s*<\?xml[^>]*?\sencoding\s*=\s*["']([^"']+)["']`)
+
+// checkCharset provides a function to check the Charset option.
+func (f *File) checkCharset() error {
+	if f.options.Charset == "" {
+		return nil
+	}
+	if enc, _ := charset.Lookup(f.options.Charset); enc == nil {
+		return ErrCharsetUnsupported
+	}
+	return nil
+}
+
+// decodeCharset provides a function to convert the XML parts in the package
+// which are not valid UTF-8 and don't declare a non UTF-8 encoding from the
+// charset of the Charset option to UTF-8. The parts declaring the encoding
+// are decoded by the CharsetReader on parsing.
+func (f *File) decodeCharset() error {
+	if f.options.Charset == "" {
+		return nil
+	}
+	enc, _ := charset.Lookup(f.options.Charset)
+	for _, name := range f.getPartNames() {
+		content, ok := f.Pkg.Load(name)
+		if !ok || !(strings.HasSuffix(name, ".xml") || strings.HasSuffix(name, ".rels")) || utf8.Valid(content.([]byte)) {
+			continue
+		}
+		if match := xmlEncodingExp.FindSubmatch(content.([]byte)); match != nil && !strings.EqualFold(string(match[1]), "utf-8") {
+			continue
+		}
+		decoded, err := enc.NewDecoder().Bytes(content.([]byte))
+		if err != nil {
+			return err
+		}
+		f.Pkg.Store(name, decoded)
+		f.diagnose(DiagnosticNamespaceFix, name, "converted the part to UTF-8", "charset", f.options.Charset)
+	}
+	return nil
+}
@@ -0,0 +1,43 @@
+package gopptx
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+func TestCharset(t *testing.T) {
+	for _, c := range []struct {
+		charset  string
+		encoding string
+		text     string
+		expected string
+	}{
+		{charset: CharsetWindows1252, text: "Caf\xe9", expected: "Café"},
+		{charset: CharsetGBK, encoding: "windows-1251", text: "\xcf\xf0\xe8\xe2\xe5\xf2", expected: "Привет"},
+		{charset: CharsetShiftJIS, text: "Plain", expected: "Plain"},
+	} {
+		f, err := openTestFile(t, func(name string, content []byte) []byte {
+			if name != "ppt/slides/slide1.xml" {
+				return content
+			}
+			if c.encoding != "" {
+				content = bytes.Replace(content, []byte(`encoding="UTF-8"`), []byte(`encoding="`+c.encoding+`"`), 1)
+			}
+			return bytes.Replace(content, []byte("</a:pPr>"), []byte("</a:pPr><a:r><a:t>"+c.text+"</a:t></a:r>"), 1)
+		}, Options{Charset: c.charset})
+		if err != nil {
+			t.Fatal(err)
+		}
+		slide, err := f.slideReader(f.GetSlideList()[0])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if text := slide.getTitleShape().TextBody.text(); text != c.expected {
+			t.Errorf("expected the title %q, got %q", c.expected, text)
+		}
+	}
+	if _, err := openTestFile(t, func(_ string, content []byte) []byte { return content }, Options{Charset: "unknown"}); !errors.Is(err, ErrCharsetUnsupported) {
+		t.Errorf("expected ErrCharsetUnsupported, got %v", err)
+	}
+}
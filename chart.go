@@ -0,0 +1,1242 @@
+// Copyright 2026 kenny-not-dead. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+//
+// Package gopptx provides functionality to create and manipulate PowerPoint
+// (.pptx) files in Go, using the Office Open XML (ECMA-376) format.
+
+package gopptx
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"math"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+var (
+	// chartGroupElementsAfterDataLabels defined the child elements of the
+	// chart type groups which follow the dLbls element.
+	chartGroupElementsAfterDataLabels = []string{
+		"gapWidth", "overlap", "serLines", "axId", "dropLines", "hiLowLines", "upDownBars", "marker",
+		"smooth", "firstSliceAng", "holeSize", "bubble3D", "bubbleScale", "showNegBubbles",
+		"sizeRepresents", "splitType", "splitPos", "custSplit", "secondPieSize", "gapDepth", "shape", "extLst",
+	}
+	// chartSeriesElementsAfterDataLabels defined the child elements of the
+	// chart series which follow the dLbls element.
+	chartSeriesElementsAfterDataLabels = []string{
+		"trendline", "errBars", "cat", "val", "xVal", "yVal", "smooth", "shape", "bubbleSize", "bubble3D", "extLst",
+	}
+)
+
+// ChartDataLabelsOptions directly maps the format settings of the chart data
+// labels. The position is one of "bestFit", "b", "ctr", "inBase", "inEnd",
+// "l", "outEnd", "r" and "t", which availability depends on the chart type,
+// and the default position of the chart type is used if it is empty. The
+// number format is the format code such as "#,##0.00" or "0%", the number
+// format of the source data is used if it is empty, and the "$" is replaced
+// by the CurrencySymbol option.
+type ChartDataLabelsOptions struct {
+	ShowValue        bool
+	ShowPercent      bool
+	ShowCategoryName bool
+	ShowSeriesName   bool
+	ShowLegendKey    bool
+	Position         string
+	NumFmt           string
+}
+
+// SetChartDataLabels provides a function to set the data labels of the chart
+// by given slide id, zero-based index of the chart on the slide, zero-based
+// index of the series in the chart and format settings. The data labels of
+// every series without its own settings will be set if the series index is
+// negative. For example, show the values of the first series with thousands
+// separators above the data points:
+//
+//	err := f.SetChartDataLabels(256, 0, 0, gopptx.ChartDataLabelsOptions{
+//	    ShowValue: true,
+//	    Position:  "outEnd",
+//	    NumFmt:    "#,##0",
+//	})
+func (f *File) SetChartDataLabels(slideID, chartIdx, seriesIdx int, opts ChartDataLabelsOptions) error {
+	if err := f.checkReadOnly(); err != nil {
+		return err
+	}
+	chartXMLPath, chart, err := f.chartReader(slideID, chartIdx)
+	if err != nil {
+		return err
+	}
+	c := chart.prefix(NameSpaceDrawingMLChart.Value)
+	newDataLabels := func() *xmlNode {
+		dLbls := newXMLNode(c + "dLbls")
+		if opts.NumFmt != "" {
+			dLbls.Children = append(dLbls.Children, newXMLNode(c+"numFmt", "formatCode", f.getFormatCode(opts.NumFmt), "sourceLinked", "0"))
+		}
+		if opts.Position != "" {
+			dLbls.Children = append(dLbls.Children, newXMLNode(c+"dLblPos", "val", opts.Position))
+		}
+		for _, show := range []struct {
+			name string
+			val  bool
+		}{
+			{"showLegendKey", opts.ShowLegendKey},
+			{"showVal", opts.ShowValue},
+			{"showCatName", opts.ShowCategoryName},
+			{"showSerName", opts.ShowSeriesName},
+			{"showPercent", opts.ShowPercent},
+			{"showBubbleSize", false},
+		} {
+			dLbls.Children = append(dLbls.Children, newXMLNode(c+show.name, "val", boolToVal(show.val)))
+		}
+		return dLbls
+	}
+	if seriesIdx < 0 {
+		for _, group := range getChartGroups(chart, c) {
+			group.remove(c + "dLbls")
+			group.insert(newDataLabels(), prefixNames(c, chartGroupElementsAfterDataLabels)...)
+		}
+	} else {
+		series, err := getChartSeries(chart, c, seriesIdx)
+		if err != nil {
+			return err
+		}
+		series.remove(c + "dLbls")
+		series.insert(newDataLabels(), prefixNames(c, chartSeriesElementsAfterDataLabels)...)
+	}
+	f.saveFileList(chartXMLPath, chart.bytes())
+	return nil
+}
+
+// ChartData directly maps the data of the chart, which is parsed from the
+// chart cache. The categories are shared by every series.
+type ChartData struct {
+	Categories []string
+	Series     []ChartSeries
+}
+
+// ChartSeries directly maps the name and the values of the chart series, the
+// missing values are NaN.
+type ChartSeries struct {
+	Name   string
+	Values []float64
+}
+
+// GetChartData provides a function to get the categories and the values of
+// the series of the chart by given slide id and zero-based index of the chart
+// on the slide. The data is parsed from the chart cache, so the embedded
+// workbook is not required. For scatter and bubble charts, the x values are
+// returned as the categories. For example:
+//
+//	data, err := f.GetChartData(256, 0)
+//	if err != nil {
+//	    fmt.Println(err)
+//	    return
+//	}
+//	for _, series := range data.Series {
+//	    for i, value := range series.Values {
+//	        fmt.Println(series.Name, data.Categories[i], value)
+//	    }
+//	}
+func (f *File) GetChartData(slideID, chartIdx int) (*ChartData, error) {
+	_, chart, err := f.chartReader(slideID, chartIdx)
+	if err != nil {
+		return nil, err
+	}
+	c := chart.prefix(NameSpaceDrawingMLChart.Value)
+	data := &ChartData{Categories: []string{}, Series: []ChartSeries{}}
+	for _, group := range getChartGroups(chart, c) {
+		for _, ser := range group.children(c + "ser") {
+			series := ChartSeries{Values: []float64{}}
+			if tx := ser.child(c + "tx"); tx != nil {
+				if v := tx.child(c + "v"); v != nil {
+					series.Name = v.text()
+				} else if names := getChartCache(tx, c); len(names) > 0 {
+					series.Name = names[0]
+				}
+			}
+			cat, val := ser.child(c+"cat"), ser.child(c+"val")
+			if val == nil {
+				cat, val = ser.child(c+"xVal"), ser.child(c+"yVal")
+			}
+			if cat != nil && len(data.Categories) == 0 {
+				data.Categories = getChartCache(cat, c)
+			}
+			if val != nil {
+				for _, text := range getChartCache(val, c) {
+					value, err := strconv.ParseFloat(text, 64)
+					if err != nil {
+						value = math.NaN()
+					}
+					series.Values = append(series.Values, value)
+				}
+			}
+			data.Series = append(data.Series, series)
+		}
+	}
+	return data, nil
+}
+
+// getChartCache returns the cached point values of the data source element
+// such as c:tx, c:cat or c:val by given name prefix of the chart namespace.
+// The missing points are empty strings, and the lowest level is returned for
+// the multi-level categories.
+func getChartCache(source *xmlNode, c string) []string {
+	var cache, points *xmlNode
+	for _, ref := range source.Children {
+		switch ref.Name {
+		case c + "strRef":
+			cache = ref.child(c + "strCache")
+		case c + "numRef":
+			cache = ref.child(c + "numCache")
+		case c + "multiLvlStrRef":
+			cache = ref.child(c + "multiLvlStrCache")
+		case c + "strLit", c + "numLit":
+			cache = ref
+		}
+		if cache != nil {
+			break
+		}
+	}
+	if points = cache; cache != nil && cache.Name == c+"multiLvlStrCache" {
+		points = cache.child(c + "lvl")
+	}
+	if points == nil {
+		return []string{}
+	}
+	var values []string
+	if ptCount := cache.child(c + "ptCount"); ptCount != nil {
+		count, _ := strconv.Atoi(ptCount.attr("val"))
+		values = make([]string, max(count, 0))
+	}
+	for _, pt := range points.children(c + "pt") {
+		idx, err := strconv.Atoi(pt.attr("idx"))
+		if err != nil || idx < 0 {
+			continue
+		}
+		for idx >= len(values) {
+			values = append(values, "")
+		}
+		if v := pt.child(c + "v"); v != nil {
+			values[idx] = v.text()
+		}
+	}
+	if values == nil {
+		values = []string{}
+	}
+	return values
+}
+
+// chartRelationshipID returns the relationship ID of the chart in the graphic
+// frame, an empty string will be returned if the frame doesn't hold a chart.
+func (dgf *decodeGraphicFrame) chartRelationshipID() string {
+	if dgf.Graphic == nil || dgf.Graphic.GraphicData == nil ||
+		dgf.Graphic.GraphicData.URI != NameSpaceDrawingMLChart.Value {
+		return ""
+	}
+	var chart struct {
+		RID string `xml:"id,attr"`
+	}
+	if err := xml.Unmarshal([]byte(dgf.Graphic.GraphicData.Content), &chart); err != nil {
+		return ""
+	}
+	return chart.RID
+}
+
+// chartReader provides a function to get the path and the element tree of
+// the chart part by given slide id and zero-based index of the chart on the
+// slide. The charts are indexed in the order of the graphic frames on the top
+// level of the shape tree, followed by the charts in the group shapes in
+// depth-first order.
+func (f *File) chartReader(slideID, chartIdx int) (string, *xmlNode, error) {
+	slide, err := f.slideReader(slideID)
+	if err != nil {
+		return "", nil, err
+	}
+	slideXMLPath, _ := f.getSlideXMLPath(slideID)
+	tree := slide.CommonSlideData.ShapeTree
+	if rIDs := getChartRelationshipIDs(tree.GraphicFrame, tree.GroupShape); chartIdx >= 0 && chartIdx < len(rIDs) {
+		if chartXMLPath, ok := f.getRelTarget(slideXMLPath, rIDs[chartIdx]); ok {
+			chart, err := f.xmlNodeReader(f.readXML(chartXMLPath))
+			return chartXMLPath, chart, err
+		}
+	}
+	return "", nil, ErrChartNotExist{SlideID: slideID, ChartIdx: chartIdx}
+}
+
+// getChartRelationshipIDs returns the relationship IDs of the charts by given
+// graphic frames and group shapes, the charts in the group shapes follow the
+// charts of the graphic frames in depth-first order.
+func getChartRelationshipIDs(frames []decodeGraphicFrame, groups []decodeGroupShape) []string {
+	var rIDs []string
+	for _, gf := range frames {
+		if rID := gf.chartRelationshipID(); rID != "" {
+			rIDs = append(rIDs, rID)
+		}
+	}
+	for _, gs := range groups {
+		rIDs = append(rIDs, getChartRelationshipIDs(gs.GraphicFrame, gs.GroupShape)...)
+	}
+	return rIDs
+}
+
+// getChartGroups returns the chart type groups in the plot area of the chart,
+// such as the c:barChart and c:lineChart elements, by given chart element
+// tree and the name prefix of the chart namespace.
+func getChartGroups(chart *xmlNode, c string) []*xmlNode {
+	var groups []*xmlNode
+	if chart = chart.child(c + "chart"); chart == nil {
+		return nil
+	}
+	if plotArea := chart.child(c + "plotArea"); plotArea != nil {
+		for _, child := range plotArea.Children {
+			if strings.HasPrefix(child.Name, c) && strings.HasSuffix(child.Name, "Chart") {
+				groups = append(groups, child)
+			}
+		}
+	}
+	return groups
+}
+
+// getChartSeries returns the series of the chart by given chart element tree,
+// the name prefix of the chart namespace and zero-based index of the series
+// in the order of the plot area.
+func getChartSeries(chart *xmlNode, c string, seriesIdx int) (*xmlNode, error) {
+	var series []*xmlNode
+	for _, group := range getChartGroups(chart, c) {
+		series = append(series, group.children(c+"ser")...)
+	}
+	if seriesIdx < 0 || seriesIdx >= len(series) {
+		return nil, ErrChartSeriesNotExist
+	}
+	return series[seriesIdx], nil
+}
+
+// prefixNames returns the names with the given prefix.
+func prefixNames(prefix string, names []string) []string {
+	list := make([]string, len(names))
+	for i, name := range names {
+		list[i] = prefix + name
+	}
+	return list
+}
+
+// boolToVal returns the value of the boolean attribute in the chart part.
+func boolToVal(b bool) string {
+	if b {
+		return "1"
+	}
+	return "0"
+}
+
+// ChartTrendlineOptions directly maps the settings of the chart trendline.
+// The type is one of "exp", "linear", "log", "movingAvg", "poly" and "power"
+// with default "linear". The order is the polynomial order between 2 and 6
+// with default 2, and the period is the moving average period with default 2.
+// The color is the hex RGB line color and the width is the line width in EMUs,
+// the default line format of the chart is used if they are empty.
+type ChartTrendlineOptions struct {
+	Type            string
+	Name            string
+	Order           int
+	Period          int
+	Forward         float64
+	Backward        float64
+	DisplayRSquared bool
+	DisplayEquation bool
+	Color           string
+	Width           int
+}
+
+// ChartErrorBarsOptions directly maps the settings of the chart error bars.
+// The direction is "x" or "y", which is required only for the scatter and
+// bubble charts. The type is one of "both", "minus" and "plus" with default
+// "both", and the value type is one of "fixedVal", "percentage", "stdDev" and
+// "stdErr" with default "fixedVal", the value is the fixed value, percentage
+// or the number of standard deviations of the error bars. The color is the
+// hex RGB line color and the width is the line width in EMUs, the default
+// line format of the chart is used if they are empty.
+type ChartErrorBarsOptions struct {
+	Direction string
+	Type      string
+	ValueType string
+	Value     float64
+	NoEndCap  bool
+	Color     string
+	Width     int
+}
+
+// AddChartTrendline provides a function to add a trendline to the series of
+// the chart by given slide id, zero-based index of the chart on the slide,
+// zero-based index of the series in the chart and the trendline settings. For
+// example, add the 3 periods moving average of the first series:
+//
+//	err := f.AddChartTrendline(256, 0, 0, gopptx.ChartTrendlineOptions{
+//	    Type:   "movingAvg",
+//	    Period: 3,
+//	    Color:  "FF0000",
+//	})
+func (f *File) AddChartTrendline(slideID, chartIdx, seriesIdx int, opts ChartTrendlineOptions) error {
+	if err := f.checkReadOnly(); err != nil {
+		return err
+	}
+	chartXMLPath, chart, err := f.chartReader(slideID, chartIdx)
+	if err != nil {
+		return err
+	}
+	c := chart.prefix(NameSpaceDrawingMLChart.Value)
+	series, err := getChartSeries(chart, c, seriesIdx)
+	if err != nil {
+		return err
+	}
+	trendlineType := opts.Type
+	if trendlineType == "" {
+		trendlineType = "linear"
+	}
+	trendline := newXMLNode(c + "trendline")
+	if opts.Name != "" {
+		name := newXMLNode(c + "name")
+		name.Children = []*xmlNode{{Text: opts.Name}}
+		trendline.Children = append(trendline.Children, name)
+	}
+	if spPr := newChartLineProperties(chart, c, opts.Color, opts.Width); spPr != nil {
+		trendline.Children = append(trendline.Children, spPr)
+	}
+	trendline.Children = append(trendline.Children, newXMLNode(c+"trendlineType", "val", trendlineType))
+	switch trendlineType {
+	case "poly":
+		trendline.Children = append(trendline.Children, newXMLNode(c+"order", "val", strconv.Itoa(min(max(opts.Order, 2), 6))))
+	case "movingAvg":
+		trendline.Children = append(trendline.Children, newXMLNode(c+"period", "val", strconv.Itoa(max(opts.Period, 2))))
+	}
+	if opts.Forward > 0 {
+		trendline.Children = append(trendline.Children, newXMLNode(c+"forward", "val", strconv.FormatFloat(opts.Forward, 'f', -1, 64)))
+	}
+	if opts.Backward > 0 {
+		trendline.Children = append(trendline.Children, newXMLNode(c+"backward", "val", strconv.FormatFloat(opts.Backward, 'f', -1, 64)))
+	}
+	trendline.Children = append(trendline.Children,
+		newXMLNode(c+"dispRSqr", "val", boolToVal(opts.DisplayRSquared)),
+		newXMLNode(c+"dispEq", "val", boolToVal(opts.DisplayEquation)),
+	)
+	series.insert(trendline, prefixNames(c, chartSeriesElementsAfterDataLabels[1:])...)
+	f.saveFileList(chartXMLPath, chart.bytes())
+	return nil
+}
+
+// AddChartErrorBars provides a function to set the error bars of the series
+// of the chart by given slide id, zero-based index of the chart on the slide,
+// zero-based index of the series in the chart and the error bars settings.
+// The existing error bars of the series in the same direction are replaced.
+// For example, add the 5 percent error bars to the first series:
+//
+//	err := f.AddChartErrorBars(256, 0, 0, gopptx.ChartErrorBarsOptions{
+//	    ValueType: "percentage",
+//	    Value:     5,
+//	})
+func (f *File) AddChartErrorBars(slideID, chartIdx, seriesIdx int, opts ChartErrorBarsOptions) error {
+	if err := f.checkReadOnly(); err != nil {
+		return err
+	}
+	chartXMLPath, chart, err := f.chartReader(slideID, chartIdx)
+	if err != nil {
+		return err
+	}
+	c := chart.prefix(NameSpaceDrawingMLChart.Value)
+	series, err := getChartSeries(chart, c, seriesIdx)
+	if err != nil {
+		return err
+	}
+	barType, valType := opts.Type, opts.ValueType
+	if barType == "" {
+		barType = "both"
+	}
+	if valType == "" {
+		valType = "fixedVal"
+	}
+	errBars := newXMLNode(c + "errBars")
+	if opts.Direction != "" {
+		errBars.Children = append(errBars.Children, newXMLNode(c+"errDir", "val", opts.Direction))
+	}
+	errBars.Children = append(errBars.Children,
+		newXMLNode(c+"errBarType", "val", barType),
+		newXMLNode(c+"errValType", "val", valType),
+		newXMLNode(c+"noEndCap", "val", boolToVal(opts.NoEndCap)),
+	)
+	if valType == "fixedVal" || valType == "percentage" || valType == "stdDev" {
+		errBars.Children = append(errBars.Children, newXMLNode(c+"val", "val", strconv.FormatFloat(opts.Value, 'f', -1, 64)))
+	}
+	if spPr := newChartLineProperties(chart, c, opts.Color, opts.Width); spPr != nil {
+		errBars.Children = append(errBars.Children, spPr)
+	}
+	children := series.Children[:0]
+	for _, child := range series.Children {
+		if child.Name == c+"errBars" {
+			if dir := child.child(c + "errDir"); opts.Direction == "" || dir == nil || dir.attr("val") == opts.Direction {
+				continue
+			}
+		}
+		children = append(children, child)
+	}
+	series.Children = children
+	series.insert(errBars, prefixNames(c, chartSeriesElementsAfterDataLabels[2:])...)
+	f.saveFileList(chartXMLPath, chart.bytes())
+	return nil
+}
+
+// newChartLineProperties returns the c:spPr element with the line format by
+// given chart element tree, the name prefix of the chart namespace, hex RGB
+// line color and line width in EMUs. It returns nil if both the color and
+// width are empty.
+func newChartLineProperties(chart *xmlNode, c, color string, width int) *xmlNode {
+	if color == "" && width <= 0 {
+		return nil
+	}
+	a := chart.prefix(NameSpaceDrawingMLMain)
+	if a == "" {
+		a = "a:"
+		chart.setAttr("xmlns:a", NameSpaceDrawingMLMain)
+	}
+	ln := newXMLNode(a + "ln")
+	if width > 0 {
+		ln.setAttr("w", strconv.Itoa(width))
+	}
+	if color != "" {
+		solidFill := newXMLNode(a + "solidFill")
+		solidFill.Children = []*xmlNode{newXMLNode(a+"srgbClr", "val", strings.TrimPrefix(color, "#"))}
+		ln.Children = []*xmlNode{solidFill}
+	}
+	spPr := newXMLNode(c + "spPr")
+	spPr.Children = []*xmlNode{ln}
+	return spPr
+}
+
+// ChartDataOptions directly maps the settings of updating the chart data. The
+// range is the address of the top left cell of the data in the embedded
+// workbook, such as "Sheet1!A1", with default "Sheet1!A1". The workbook is
+// the content of the embedded workbook which replaces the existing one, for
+// example, the buffer written by the WriteToBuffer function of the excelize
+// library, the cells of the data range in the embedded workbook are updated
+// if it is nil.
+type ChartDataOptions struct {
+	Range    string
+	Workbook io.Reader
+}
+
+// SetChartData provides a function to replace the data of the chart by given
+// slide id, zero-based index of the chart on the slide, the rows of the data
+// and the options, and keep the chart cache in sync with the data. The first
+// row holds the series names after the first cell, and each following row
+// holds the category in the first cell and the values of each series. The
+// numbers and numeric strings are accepted as values, the other cells are
+// treated as empty. The existing series are reused in order, the last series
+// is copied for the extra series, and the surplus series are removed. The
+// cells of the embedded workbook are updated with the data, and the
+// ErrChartWorkbookUpdate will be returned without changing the chart if the
+// embedded workbook can't be updated, such as the legacy binary workbook,
+// which can be replaced by the workbook of the options. For example, replace
+// the chart data and the embedded workbook by the sheet of the excelize
+// workbook:
+//
+//	rows, err := wb.GetRows("Sheet1")
+//	if err != nil {
+//	    fmt.Println(err)
+//	    return
+//	}
+//	buf, err := wb.WriteToBuffer()
+//	if err != nil {
+//	    fmt.Println(err)
+//	    return
+//	}
+//	data := make([][]interface{}, len(rows))
+//	for i, row := range rows {
+//	    for _, cell := range row {
+//	        data[i] = append(data[i], cell)
+//	    }
+//	}
+//	err = f.SetChartData(256, 0, data, &gopptx.ChartDataOptions{Workbook: buf})
+func (f *File) SetChartData(slideID, chartIdx int, data [][]interface{}, opts *ChartDataOptions) error {
+	if err := f.checkReadOnly(); err != nil {
+		return err
+	}
+	if opts == nil {
+		opts = &ChartDataOptions{}
+	}
+	if len(data) == 0 || len(data[0]) < 2 {
+		return ErrChartDataEmpty
+	}
+	sheet, col, row, err := parseChartDataRange(opts.Range)
+	if err != nil {
+		return err
+	}
+	chartXMLPath, chart, err := f.chartReader(slideID, chartIdx)
+	if err != nil {
+		return err
+	}
+	c := chart.prefix(NameSpaceDrawingMLChart.Value)
+	series, err := setChartSeriesCount(chart, c, len(data[0])-1)
+	if err != nil {
+		return err
+	}
+	categories := make([]interface{}, len(data)-1)
+	for i := range categories {
+		if len(data[i+1]) > 0 {
+			categories[i] = data[i+1][0]
+		}
+	}
+	lastRow := row + len(categories)
+	catRef := fmt.Sprintf("%s!$%s$%d:$%s$%d", sheet, columnName(col), row+1, columnName(col), lastRow)
+	for i, ser := range series {
+		values := make([]interface{}, len(categories))
+		for j := range values {
+			if i+1 < len(data[j+1]) {
+				values[j] = data[j+1][i+1]
+			}
+		}
+		serCol := columnName(col + i + 1)
+		tx := newXMLNode(c + "tx")
+		tx.Children = []*xmlNode{newChartDataRef(c, fmt.Sprintf("%s!$%s$%d", sheet, serCol, row), []interface{}{data[0][i+1]}, false, "")}
+		ser.remove(c + "tx")
+		ser.insert(tx, prefixNames(c, []string{"spPr", "invertIfNegative", "pictureOptions", "marker", "explosion", "dPt", "dLbls", "trendline", "errBars", "cat", "val", "xVal", "yVal", "smooth", "shape", "bubbleSize", "bubble3D", "extLst"})...)
+		catName, valName := c+"cat", c+"val"
+		if ser.child(c+"xVal") != nil || ser.child(c+"yVal") != nil {
+			catName, valName = c+"xVal", c+"yVal"
+		}
+		formatCode := "General"
+		if old := ser.child(valName); old != nil {
+			for _, ref := range old.Children {
+				if cache := ref.child(c + "numCache"); cache != nil && cache.child(c+"formatCode") != nil {
+					formatCode = cache.child(c + "formatCode").text()
+				}
+			}
+		}
+		cat, val := newXMLNode(catName), newXMLNode(valName)
+		cat.Children = []*xmlNode{newChartDataRef(c, catRef, categories, isChartNumbers(categories), "General")}
+		val.Children = []*xmlNode{newChartDataRef(c, fmt.Sprintf("%s!$%s$%d:$%s$%d", sheet, serCol, row+1, serCol, lastRow), values, true, formatCode)}
+		ser.remove(catName, valName)
+		ser.insert(cat, prefixNames(c, []string{"val", "yVal", "smooth", "shape", "bubbleSize", "bubble3D", "extLst"})...)
+		ser.insert(val, prefixNames(c, []string{"smooth", "shape", "bubbleSize", "bubble3D", "extLst"})...)
+	}
+	var target string
+	if externalData := chart.child(c + "externalData"); externalData != nil {
+		target, _ = f.getRelTarget(chartXMLPath, externalData.attr(strings.TrimSuffix(chart.prefix(SourceRelationship.Value), ":")+":id"))
+	}
+	var workbook []byte
+	if opts.Workbook != nil {
+		if target == "" {
+			return ErrChartWorkbookNotExist
+		}
+		if workbook, err = io.ReadAll(opts.Workbook); err != nil {
+			return err
+		}
+	} else if target != "" {
+		if workbook, err = f.setChartWorkbookData(f.readPart(target), sheet, col, row, data); err != nil {
+			return err
+		}
+	}
+	if target != "" {
+		f.Pkg.Store(target, workbook)
+		f.deleteTempFile(target)
+	}
+	f.saveFileList(chartXMLPath, chart.bytes())
+	return nil
+}
+
+// setChartWorkbookData provides a function to write the chart data into the
+// cells of the embedded workbook by given content of the workbook, the
+// quoted sheet name, one-based column and row number of the top left cell and
+// the rows of the data, and returns the content of the updated workbook. The
+// other parts of the workbook are kept, except the calculation chain which
+// may refer to the replaced formulas, and the workbook is recalculated on
+// opening it.
+func (f *File) setChartWorkbookData(content []byte, sheet string, col, row int, data [][]interface{}) ([]byte, error) {
+	zr, err := zip.NewReader(bytes.NewReader(content), int64(len(content)))
+	if err != nil {
+		return nil, ErrChartWorkbookUpdate
+	}
+	parts := map[string][]byte{}
+	for _, file := range zr.File {
+		if parts[file.Name], err = readFile(file); err != nil {
+			return nil, err
+		}
+	}
+	rels, err := f.xmlNodeReader(parts["_rels/.rels"])
+	if err != nil {
+		return nil, ErrChartWorkbookUpdate
+	}
+	var workbookPath string
+	for _, rel := range rels.findAll("Relationship") {
+		if rel.attr("Type") == SourceRelationshipOfficeDocument {
+			workbookPath = resolveRelTarget("", rel.attr("Target"))
+		}
+	}
+	workbook, err := f.xmlNodeReader(parts[workbookPath])
+	if err != nil {
+		return nil, ErrChartWorkbookUpdate
+	}
+	workbookRelsPath := getPartRelsPath(workbookPath)
+	if rels, err = f.xmlNodeReader(parts[workbookRelsPath]); err != nil {
+		return nil, ErrChartWorkbookUpdate
+	}
+	sheetName := strings.ReplaceAll(strings.TrimSuffix(strings.TrimPrefix(sheet, "'"), "'"), "''", "'")
+	r := workbook.prefix(SourceRelationship.Value)
+	var sheetPath string
+	for _, node := range workbook.find("sheets").findAll("sheet") {
+		if !strings.EqualFold(node.attr("name"), sheetName) {
+			continue
+		}
+		for _, rel := range rels.findAll("Relationship") {
+			if rel.attr("Id") == node.attr(r+"id") {
+				sheetPath = resolveRelTarget(workbookPath, rel.attr("Target"))
+			}
+		}
+	}
+	worksheet, err := f.xmlNodeReader(parts[sheetPath])
+	if err != nil || worksheet.find("sheetData") == nil {
+		return nil, ErrChartWorkbookUpdate
+	}
+	pfx := worksheet.prefix(NameSpaceSpreadSheetMLMain)
+	for i, cells := range data {
+		for j, value := range cells {
+			setWorksheetCell(worksheet.find("sheetData"), pfx, col+j, row+i, value)
+		}
+	}
+	parts[sheetPath] = append([]byte(xml.Header), worksheet.bytes()...)
+	// Remove the calculation chain and recalculate the workbook on opening,
+	// so the formulas referring to the data are refreshed.
+	for _, rel := range rels.findAll("Relationship") {
+		if rel.attr("Type") == SourceRelationshipCalcChain {
+			calcChainPath := resolveRelTarget(workbookPath, rel.attr("Target"))
+			delete(parts, calcChainPath)
+			rels.Children = slices.DeleteFunc(rels.Children, func(n *xmlNode) bool { return n == rel })
+			parts[workbookRelsPath] = append([]byte(xml.Header), rels.bytes()...)
+			if contentTypes, err := f.xmlNodeReader(parts[defaultXMLPathContentTypes]); err == nil {
+				contentTypes.Children = slices.DeleteFunc(contentTypes.Children, func(n *xmlNode) bool {
+					return localName(n.Name) == "Override" && n.attr("PartName") == "/"+calcChainPath
+				})
+				parts[defaultXMLPathContentTypes] = append([]byte(xml.Header), contentTypes.bytes()...)
+			}
+		}
+	}
+	if calcPr := workbook.find("calcPr"); calcPr != nil {
+		calcPr.setAttr("fullCalcOnLoad", "1")
+		parts[workbookPath] = append([]byte(xml.Header), workbook.bytes()...)
+	}
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for _, file := range zr.File {
+		partContent, ok := parts[file.Name]
+		if !ok {
+			continue
+		}
+		w, err := zw.Create(file.Name)
+		if err != nil {
+			return nil, err
+		}
+		if _, err = w.Write(partContent); err != nil {
+			return nil, err
+		}
+	}
+	if err = zw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// setWorksheetCell provides a function to set the value of the cell by given
+// sheetData element of the worksheet, the name prefix of the spreadsheet
+// namespace, one-based column and row number and the value. The numbers and
+// numeric strings are written as numbers, the other values as the inline
+// strings, and the cell is cleared for the nil value. The style of the cell
+// is kept, and the rows and cells are created in order if they don't exist.
+func setWorksheetCell(sheetData *xmlNode, pfx string, col, row int, value interface{}) {
+	var rowNode *xmlNode
+	for i, node := range sheetData.Children {
+		if node.Name != pfx+"row" {
+			continue
+		}
+		if num, _ := strconv.Atoi(node.attr("r")); num == row {
+			rowNode = node
+			break
+		} else if num > row {
+			rowNode = newXMLNode(pfx+"row", "r", strconv.Itoa(row))
+			sheetData.Children = slices.Insert(sheetData.Children, i, rowNode)
+			break
+		}
+	}
+	if rowNode == nil {
+		rowNode = newXMLNode(pfx+"row", "r", strconv.Itoa(row))
+		sheetData.Children = append(sheetData.Children, rowNode)
+	}
+	ref := columnName(col) + strconv.Itoa(row)
+	var cell *xmlNode
+	for i, node := range rowNode.Children {
+		if node.Name != pfx+"c" {
+			continue
+		}
+		if node.attr("r") == ref {
+			cell = node
+			break
+		} else if cellColumn(node.attr("r")) > col {
+			cell = newXMLNode(pfx+"c", "r", ref)
+			rowNode.Children = slices.Insert(rowNode.Children, i, cell)
+			break
+		}
+	}
+	if cell == nil {
+		cell = newXMLNode(pfx+"c", "r", ref)
+		rowNode.Children = append(rowNode.Children, cell)
+	}
+	// The spans of the row are optional hints, remove them as the cells of
+	// the row may be changed.
+	rowNode.removeAttr("spans")
+	cell.removeAttr("t")
+	cell.Children = nil
+	if value == nil {
+		return
+	}
+	if number, ok := chartNumber(value); ok {
+		v := newXMLNode(pfx + "v")
+		v.Children = []*xmlNode{{Text: number}}
+		cell.Children = []*xmlNode{v}
+		return
+	}
+	cell.setAttr("t", "inlineStr")
+	t, is := newXMLNode(pfx+"t"), newXMLNode(pfx+"is")
+	t.Children = []*xmlNode{{Text: fmt.Sprint(value)}}
+	is.Children = []*xmlNode{t}
+	cell.Children = []*xmlNode{is}
+}
+
+// setChartSeriesCount provides a function to set the number of the series of
+// the chart by given chart element tree, the name prefix of the chart
+// namespace and the number of series. The last series is copied for the extra
+// series, and the surplus series are removed. It returns the series.
+func setChartSeriesCount(chart *xmlNode, c string, count int) ([]*xmlNode, error) {
+	var series, parents []*xmlNode
+	for _, group := range getChartGroups(chart, c) {
+		for _, ser := range group.children(c + "ser") {
+			series, parents = append(series, ser), append(parents, group)
+		}
+	}
+	if len(series) == 0 {
+		return nil, ErrChartSeriesNotExist
+	}
+	for i := count; i < len(series); i++ {
+		parent := parents[i]
+		parent.Children = slices.DeleteFunc(parent.Children, func(child *xmlNode) bool { return child == series[i] })
+	}
+	series = series[:min(count, len(series))]
+	maxIdx := -1
+	for _, ser := range series {
+		if idx := ser.child(c + "idx"); idx != nil {
+			n, _ := strconv.Atoi(idx.attr("val"))
+			maxIdx = max(maxIdx, n)
+		}
+	}
+	for last, group := series[len(series)-1], parents[len(series)-1]; len(series) < count; {
+		ser := last.clone()
+		maxIdx++
+		for _, name := range []string{"idx", "order"} {
+			if node := ser.child(c + name); node != nil {
+				node.setAttr("val", strconv.Itoa(maxIdx))
+			}
+		}
+		ser.remove(c+"dPt", c+"trendline", c+"errBars")
+		pos := slices.Index(group.Children, series[len(series)-1]) + 1
+		group.Children = slices.Insert(group.Children, pos, ser)
+		series = append(series, ser)
+	}
+	return series, nil
+}
+
+// newChartDataRef returns the c:numRef or c:strRef element with the cache of
+// the values by given name prefix of the chart namespace, formula reference,
+// values, whether the values are numbers and the number format code.
+func newChartDataRef(c, ref string, values []interface{}, numeric bool, formatCode string) *xmlNode {
+	refName, cacheName := "strRef", "strCache"
+	if numeric {
+		refName, cacheName = "numRef", "numCache"
+	}
+	formula, cache := newXMLNode(c+"f"), newXMLNode(c+cacheName)
+	formula.Children = []*xmlNode{{Text: ref}}
+	if numeric {
+		code := newXMLNode(c + "formatCode")
+		code.Children = []*xmlNode{{Text: formatCode}}
+		cache.Children = append(cache.Children, code)
+	}
+	cache.Children = append(cache.Children, newXMLNode(c+"ptCount", "val", strconv.Itoa(len(values))))
+	for i, value := range values {
+		text, ok := fmt.Sprint(value), value != nil
+		if numeric {
+			text, ok = chartNumber(value)
+		}
+		if !ok {
+			continue
+		}
+		v := newXMLNode(c + "v")
+		v.Children = []*xmlNode{{Text: text}}
+		pt := newXMLNode(c+"pt", "idx", strconv.Itoa(i))
+		pt.Children = []*xmlNode{v}
+		cache.Children = append(cache.Children, pt)
+	}
+	node := newXMLNode(c + refName)
+	node.Children = []*xmlNode{formula, cache}
+	return node
+}
+
+// chartNumber returns the text of the number by given value, which is a
+// number or numeric string, false will be returned for other values.
+func chartNumber(value interface{}) (string, bool) {
+	switch v := value.(type) {
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		return fmt.Sprint(v), true
+	case float32:
+		return strconv.FormatFloat(float64(v), 'f', -1, 32), true
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), true
+	case string:
+		n, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+		if err != nil {
+			return "", false
+		}
+		return strconv.FormatFloat(n, 'f', -1, 64), true
+	}
+	return "", false
+}
+
+// isChartNumbers returns true if every value is a number or numeric string.
+func isChartNumbers(values []interface{}) bool {
+	for _, value := range values {
+		if _, ok := chartNumber(value); !ok {
+			return false
+		}
+	}
+	return len(values) > 0
+}
+
+// parseChartDataRange provides a function to parse the address of the top
+// left cell of the chart data, such as "Sheet1!A1", and returns the quoted
+// sheet name, one-based column and row number.
+func parseChartDataRange(address string) (string, int, int, error) {
+	if address == "" {
+		address = "Sheet1!A1"
+	}
+	sep := strings.LastIndex(address, "!")
+	if sep <= 0 {
+		return "", 0, 0, ErrChartDataRange
+	}
+	sheet, cell := address[:sep], strings.ToUpper(strings.ReplaceAll(address[sep+1:], "$", ""))
+	if cell = strings.SplitN(cell, ":", 2)[0]; cell == "" {
+		return "", 0, 0, ErrChartDataRange
+	}
+	if !strings.HasPrefix(sheet, "'") && strings.ContainsAny(sheet, " -+(),;&'") {
+		sheet = "'" + strings.ReplaceAll(sheet, "'", "''") + "'"
+	}
+	col := cellColumn(cell)
+	row, err := strconv.Atoi(strings.TrimLeft(cell, "ABCDEFGHIJKLMNOPQRSTUVWXYZ"))
+	if col == 0 || err != nil || row < 1 || col > 16384 || row > 1048576 {
+		return "", 0, 0, ErrChartDataRange
+	}
+	return sheet, col, row, nil
+}
+
+// cellColumn returns the one-based column number by given reference of the
+// cell in upper case, such as 2 for "B3", 0 will be returned if the reference
+// has no column name.
+func cellColumn(cell string) int {
+	var col int
+	for i := 0; i < len(cell) && cell[i] >= 'A' && cell[i] <= 'Z'; i++ {
+		col = col*26 + int(cell[i]-'A') + 1
+	}
+	return col
+}
+
+// columnName returns the column name by given one-based column number, such
+// as "A" for 1 and "AA" for 27.
+func columnName(col int) string {
+	var name []byte
+	for ; col > 0; col = (col - 1) / 26 {
+		name = append([]byte{byte('A' + (col-1)%26)}, name...)
+	}
+	return string(name)
+}
+
+// chartSeriesElementsAfterShapeProperties defined the child elements of the
+// chart series which follow the spPr element.
+var chartSeriesElementsAfterShapeProperties = []string{
+	"invertIfNegative", "pictureOptions", "dPt", "dLbls", "trendline", "errBars", "cat", "val", "marker",
+	"explosion", "xVal", "yVal", "smooth", "shape", "bubbleSize", "bubble3D", "extLst",
+}
+
+// ApplyChartTemplate provides a function to apply the chart template (.crtx)
+// to the chart by given slide id, zero-based index of the chart on the slide
+// and the content of the chart template. The chart style and the chart colors
+// parts of the template replace the parts of the chart, and the formatting of
+// the chart area, the plot area and the series of the template is copied to
+// the chart, the series formatting is repeated if the chart has more series
+// than the template. The data of the chart is kept. For example:
+//
+//	template, err := os.ReadFile("Corporate.crtx")
+//	if err != nil {
+//	    fmt.Println(err)
+//	    return
+//	}
+//	err = f.ApplyChartTemplate(256, 0, template)
+func (f *File) ApplyChartTemplate(slideID, chartIdx int, template []byte) error {
+	if err := f.checkReadOnly(); err != nil {
+		return err
+	}
+	chartXMLPath, chart, err := f.chartReader(slideID, chartIdx)
+	if err != nil {
+		return err
+	}
+	zr, err := zip.NewReader(bytes.NewReader(template), int64(len(template)))
+	if err != nil {
+		return err
+	}
+	parts := make(map[string][]byte, len(zr.File))
+	for _, file := range zr.File {
+		name, ok := getCanonicalPartName(file.Name)
+		if !ok {
+			continue
+		}
+		rc, err := file.Open()
+		if err != nil {
+			return err
+		}
+		content, err := io.ReadAll(io.LimitReader(rc, f.options.UnzipXMLSizeLimit))
+		_ = rc.Close()
+		if err != nil {
+			return err
+		}
+		parts[name] = content
+	}
+	getTarget := func(partName, relType string) string {
+		var rels relationships
+		relsPath := defaultXMLPathRels
+		if partName != "" {
+			relsPath = getPartRelsPath(partName)
+		}
+		if err := xml.Unmarshal(parts[relsPath], &rels); err != nil {
+			return ""
+		}
+		for _, rel := range rels.Relationships {
+			if rel.Type == relType && rel.TargetMode != "External" {
+				return resolveRelTarget(partName, rel.Target)
+			}
+		}
+		return ""
+	}
+	templateXMLPath := getTarget("", SourceRelationshipOfficeDocument)
+	if _, ok := parts[templateXMLPath]; !ok {
+		return ErrChartTemplate
+	}
+	for _, style := range []struct {
+		relType, contentType, name string
+	}{
+		{SourceRelationshipChartStyle, ContentTypeChartStyle, "style"},
+		{SourceRelationshipChartColorStyle, ContentTypeChartColorStyle, "colors"},
+	} {
+		content, ok := parts[getTarget(templateXMLPath, style.relType)]
+		if !ok {
+			continue
+		}
+		partName, ok := f.getRelTargetByType(chartXMLPath, style.relType)
+		if !ok {
+			for i := 1; ; i++ {
+				if partName = "ppt/charts/" + style.name + strconv.Itoa(i) + ".xml"; !f.isPartLoaded(partName) {
+					break
+				}
+			}
+			if err = f.setContentTypes("/"+partName, style.contentType); err != nil {
+				return err
+			}
+			f.addRels(getPartRelsPath(chartXMLPath), style.relType, path.Base(partName), "")
+		}
+		f.Pkg.Store(partName, content)
+	}
+	if err = f.applyChartTemplateFormatting(chart, parts[templateXMLPath]); err != nil {
+		return err
+	}
+	f.saveFileList(chartXMLPath, chart.bytes())
+	return nil
+}
+
+// applyChartTemplateFormatting provides a function to copy the shape
+// properties and the text properties of the chart area, the shape properties
+// of the plot area and the series by given element tree of the chart and the
+// chart part of the chart template.
+func (f *File) applyChartTemplateFormatting(chart *xmlNode, content []byte) error {
+	template, err := f.xmlNodeReader(content)
+	if err != nil {
+		return err
+	}
+	c, tc := chart.prefix(NameSpaceDrawingMLChart.Value), template.prefix(NameSpaceDrawingMLChart.Value)
+	a, ta := chart.prefix(NameSpaceDrawingMLMain), template.prefix(NameSpaceDrawingMLMain)
+	if a == "" {
+		a = "a:"
+		chart.Attr = append(chart.Attr, xml.Attr{Name: xml.Name{Local: "xmlns:a"}, Value: NameSpaceDrawingMLMain})
+	}
+	copyNode := func(node *xmlNode) *xmlNode {
+		node = node.clone()
+		setNodePrefix(node, map[string]string{tc: c, ta: a})
+		return node
+	}
+	for _, name := range []string{"spPr", "txPr"} {
+		if node := template.child(tc + name); node != nil {
+			chart.remove(c + name)
+			chart.insert(copyNode(node), prefixNames(c, []string{"txPr", "externalData", "printSettings", "userShapes", "extLst"})...)
+		}
+	}
+	if node := template.find("chart", "plotArea").child(tc + "spPr"); node != nil {
+		if plotArea := chart.find("chart", "plotArea"); plotArea != nil {
+			plotArea.remove(c + "spPr")
+			plotArea.insert(copyNode(node), c+"extLst")
+		}
+	}
+	var templateSeries []*xmlNode
+	for _, group := range getChartGroups(template, tc) {
+		for _, series := range group.children(tc + "ser") {
+			templateSeries = append(templateSeries, series.child(tc+"spPr"))
+		}
+	}
+	if len(templateSeries) == 0 {
+		return nil
+	}
+	var idx int
+	for _, group := range getChartGroups(chart, c) {
+		for _, series := range group.children(c + "ser") {
+			if node := templateSeries[idx%len(templateSeries)]; node != nil {
+				series.remove(c + "spPr")
+				series.insert(copyNode(node), prefixNames(c, chartSeriesElementsAfterShapeProperties)...)
+			}
+			idx++
+		}
+	}
+	return nil
+}
+
+// isPartLoaded returns whether the part exists in the package by given part
+// name.
+func (f *File) isPartLoaded(partName string) bool {
+	if _, ok := f.Pkg.Load(partName); ok {
+		return true
+	}
+	_, ok := f.tempFiles.Load(partName)
+	return ok
+}
+
+// setNodePrefix provides a function to replace the namespace prefixes of the
+// element names in the element tree by given element tree and the mapping of
+// the prefixes.
+func setNodePrefix(node *xmlNode, prefixes map[string]string) {
+	if node.Name == "" {
+		return
+	}
+	if i := strings.Index(node.Name, ":") + 1; i > 0 {
+		if prefix, ok := prefixes[node.Name[:i]]; ok {
+			node.Name = prefix + node.Name[i:]
+		}
+	}
+	for _, child := range node.Children {
+		setNodePrefix(child, prefixes)
+	}
+}
+
+// addChart provides a function to add the chart without the embedded
+// workbook onto the slide by given slide id, chart type, title, offset and
+// size in EMUs, and returns the zero-based index of the chart on the slide.
+// The chart type is one of "column", "bar", "line" and "pie", and the chart
+// has one series without data, which is set by the SetChartData function.
+func (f *File) addChart(slideID int, chartType, title string, offset Offset, extents Extents) (int, error) {
+	chartSpace, err := newChartSpace(chartType, title)
+	if err != nil {
+		return -1, err
+	}
+	slide, err := f.slideReader(slideID)
+	if err != nil {
+		return -1, err
+	}
+	chartIdx := len(getChartRelationshipIDs(slide.CommonSlideData.ShapeTree.GraphicFrame, nil))
+	slideXMLPath, _ := f.getSlideXMLPath(slideID)
+	chartXMLPath := f.getUnusedPartPath("ppt/charts/chart%d.xml")
+	f.saveFileList(chartXMLPath, chartSpace)
+	if err = f.setContentTypes("/"+chartXMLPath, ContentTypeDrawingMLChart); err != nil {
+		return -1, err
+	}
+	rID := f.addRels(getPartRelsPath(slideXMLPath), SourceRelationshipChart, "../charts/"+path.Base(chartXMLPath), "")
+	shapeID := slide.nextShapeID()
+	slide.CommonSlideData.ShapeTree.GraphicFrame = append(slide.CommonSlideData.ShapeTree.GraphicFrame, decodeGraphicFrame{
+		NonVisualGraphicFrameProperties: &decodeNonVisualGraphicFrameProperties{
+			CommonNonVisualProperties:             &CommonNonVisualProperties{ID: shapeID, Name: fmt.Sprintf("Chart %d", shapeID-1)},
+			CommonNonVisualGraphicFrameProperties: &innerXML{},
+			NonVisualProperties:                   &decodeNonVisualProperties{},
+		},
+		Xfrm: &DecodeXfrm{Offset: &offset, Extents: &extents},
+		Graphic: &decodeGraphic{GraphicData: &GraphicData{
+			URI: NameSpaceDrawingMLChart.Value,
+			Content: fmt.Sprintf(`<c:chart xmlns:c="%s" xmlns:r="%s" r:id="%s"/>`,
+				NameSpaceDrawingMLChart.Value, SourceRelationship.Value, rID),
+		}},
+	})
+	return chartIdx, nil
+}
+
+// newChartSpace returns the content of the chart part by given chart type and
+// title, the chart has one series without data and the legend at the bottom.
+func newChartSpace(chartType, title string) ([]byte, error) {
+	var group, axes string
+	axisIDs := `<c:axId val="1"/><c:axId val="2"/>`
+	switch chartType {
+	case "", "column", "bar":
+		barDir := "col"
+		if chartType == "bar" {
+			barDir = "bar"
+		}
+		group = `<c:barChart><c:barDir val="` + barDir + `"/><c:grouping val="clustered"/><c:varyColors val="0"/>` +
+			`<c:ser><c:idx val="0"/><c:order val="0"/><c:invertIfNegative val="0"/></c:ser><c:gapWidth val="150"/>` + axisIDs + `</c:barChart>`
+	case "line":
+		group = `<c:lineChart><c:grouping val="standard"/><c:varyColors val="0"/>` +
+			`<c:ser><c:idx val="0"/><c:order val="0"/><c:smooth val="0"/></c:ser><c:marker val="1"/>` + axisIDs + `</c:lineChart>`
+	case "pie":
+		group = `<c:pieChart><c:varyColors val="1"/><c:ser><c:idx val="0"/><c:order val="0"/></c:ser>` +
+			`<c:firstSliceAng val="0"/></c:pieChart>`
+	default:
+		return nil, newChartTypeError(chartType)
+	}
+	if chartType != "pie" {
+		catPos, valPos := "b", "l"
+		if chartType == "bar" {
+			catPos, valPos = "l", "b"
+		}
+		axes = `<c:catAx><c:axId val="1"/><c:scaling><c:orientation val="minMax"/></c:scaling><c:delete val="0"/>` +
+			`<c:axPos val="` + catPos + `"/><c:majorTickMark val="none"/><c:minorTickMark val="none"/><c:tickLblPos val="nextTo"/>` +
+			`<c:crossAx val="2"/><c:crosses val="autoZero"/><c:auto val="1"/><c:lblAlgn val="ctr"/><c:lblOffset val="100"/></c:catAx>` +
+			`<c:valAx><c:axId val="2"/><c:scaling><c:orientation val="minMax"/></c:scaling><c:delete val="0"/>` +
+			`<c:axPos val="` + valPos + `"/><c:majorGridlines/><c:numFmt formatCode="General" sourceLinked="1"/>` +
+			`<c:majorTickMark val="none"/><c:minorTickMark val="none"/><c:tickLblPos val="nextTo"/>` +
+			`<c:crossAx val="1"/><c:crosses val="autoZero"/><c:crossBetween val="between"/></c:valAx>`
+	}
+	var buf bytes.Buffer
+	buf.WriteString(xml.Header)
+	fmt.Fprintf(&buf, `<c:chartSpace xmlns:c="%s" xmlns:a="%s" xmlns:r="%s"><c:roundedCorners val="0"/><c:chart>`,
+		NameSpaceDrawingMLChart.Value, NameSpaceDrawingML.Value, SourceRelationship.Value)
+	if title != "" {
+		buf.WriteString(`<c:title><c:tx><c:rich><a:bodyPr/><a:p><a:r><a:t>`)
+		if err := xml.EscapeText(&buf, []byte(title)); err != nil {
+			return nil, err
+		}
+		buf.WriteString(`</a:t></a:r></a:p></c:rich></c:tx><c:overlay val="0"/></c:title><c:autoTitleDeleted val="0"/>`)
+	} else {
+		buf.WriteString(`<c:autoTitleDeleted val="1"/>`)
+	}
+	buf.WriteString(`<c:plotArea><c:layout/>` + group + axes + `</c:plotArea>`)
+	buf.WriteString(`<c:legend><c:legendPos val="b"/><c:overlay val="0"/></c:legend><c:plotVisOnly val="1"/></c:chart></c:chartSpace>`)
+	return buf.Bytes(), nil
+}
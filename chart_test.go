@@ -0,0 +1,372 @@
+package gopptx
+
+import (
+	"archive/zip"
+	"bytes"
+	"errors"
+	"math"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// newTestWorkbook returns the content of the workbook with the sheet named
+// "Sheet1", the sheet has the cells of the chart data with the style and
+// formula, and the workbook has the calculation chain.
+func newTestWorkbook(t *testing.T) []byte {
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for _, part := range []struct{ name, content string }{
+		{"[Content_Types].xml", `<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">` +
+			`<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>` +
+			`<Override PartName="/xl/worksheets/sheet1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>` +
+			`<Override PartName="/xl/calcChain.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.calcChain+xml"/></Types>`},
+		{"_rels/.rels", `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+			`<Relationship Id="rId1" Type="` + SourceRelationshipOfficeDocument + `" Target="xl/workbook.xml"/></Relationships>`},
+		{"xl/workbook.xml", `<workbook xmlns="` + NameSpaceSpreadSheetMLMain + `" xmlns:r="` + SourceRelationship.Value + `">` +
+			`<sheets><sheet name="Sheet1" sheetId="1" r:id="rId1"/></sheets><calcPr calcId="191029"/></workbook>`},
+		{"xl/_rels/workbook.xml.rels", `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+			`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/>` +
+			`<Relationship Id="rId2" Type="` + SourceRelationshipCalcChain + `" Target="calcChain.xml"/></Relationships>`},
+		{"xl/worksheets/sheet1.xml", `<worksheet xmlns="` + NameSpaceSpreadSheetMLMain + `"><sheetData>` +
+			`<row r="2" spans="1:3"><c r="A2" t="s"><v>0</v></c><c r="C2" s="1"><f>B2*2</f><v>2</v></c></row>` +
+			`<row r="5"><c r="A5"><v>9</v></c></row></sheetData></worksheet>`},
+		{"xl/calcChain.xml", `<calcChain xmlns="` + NameSpaceSpreadSheetMLMain + `"><c r="C2" i="1"/></calcChain>`},
+	} {
+		w, err := zw.Create(part.name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err = w.Write([]byte(part.content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestSetChartData(t *testing.T) {
+	const workbookPath = "ppt/embeddings/Microsoft_Excel_Worksheet.xlsx"
+	data := [][]interface{}{{nil, "Sales", "Cost"}, {"Q1", 1, "2.5"}, {"Q2", 3, nil}}
+	for _, c := range []struct {
+		name     string
+		workbook []byte
+		err      error
+		cells    []string
+	}{
+		{name: "no workbook"},
+		{
+			name: "workbook", workbook: newTestWorkbook(t),
+			cells: []string{
+				`<row r="1"><c r="A1"/><c r="B1" t="inlineStr"><is><t>Sales</t></is></c><c r="C1" t="inlineStr"><is><t>Cost</t></is></c></row>`,
+				`<row r="2"><c r="A2" t="inlineStr"><is><t>Q1</t></is></c><c r="B2"><v>1</v></c><c r="C2" s="1"><v>2.5</v></c></row>`,
+				`<row r="3"><c r="A3" t="inlineStr"><is><t>Q2</t></is></c><c r="B3"><v>3</v></c><c r="C3"/></row>`,
+				`<row r="5"><c r="A5"><v>9</v></c></row>`,
+			},
+		},
+		{name: "binary workbook", workbook: []byte{0xd0, 0xcf, 0x11, 0xe0}, err: ErrChartWorkbookUpdate},
+	} {
+		f := NewFile()
+		slideID := f.GetSlideList()[0]
+		chartIdx, err := f.addChart(slideID, "column", "", Offset{}, Extents{CX: 914400, CY: 914400})
+		if err != nil {
+			t.Fatal(err)
+		}
+		chartXMLPath, _, err := f.chartReader(slideID, chartIdx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if c.workbook != nil {
+			f.Pkg.Store(workbookPath, c.workbook)
+			rID := f.addRels(getPartRelsPath(chartXMLPath), SourceRelationshipPackage, "../embeddings/Microsoft_Excel_Worksheet.xlsx", "")
+			f.saveFileList(chartXMLPath, bytes.Replace(f.readXML(chartXMLPath), []byte("</c:chartSpace>"),
+				[]byte(`<c:externalData r:id="`+rID+`"/></c:chartSpace>`), 1))
+		}
+		chart := string(f.readXML(chartXMLPath))
+		if err = f.SetChartData(slideID, chartIdx, data, nil); !errors.Is(err, c.err) {
+			t.Fatalf("%s: expected error %v, got %v", c.name, c.err, err)
+		}
+		if c.err != nil {
+			if string(f.readXML(chartXMLPath)) != chart {
+				t.Errorf("%s: the chart is changed on error", c.name)
+			}
+			continue
+		}
+		if !strings.Contains(string(f.readXML(chartXMLPath)), "<c:v>2.5</c:v>") {
+			t.Errorf("%s: the chart data isn't set", c.name)
+		}
+		if c.workbook == nil {
+			continue
+		}
+		zr, err := zip.NewReader(bytes.NewReader(f.readPart(workbookPath)), int64(len(f.readPart(workbookPath))))
+		if err != nil {
+			t.Fatal(err)
+		}
+		parts := map[string]string{}
+		for _, file := range zr.File {
+			content, err := readFile(file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			parts[file.Name] = string(content)
+		}
+		for _, cell := range c.cells {
+			if !strings.Contains(parts["xl/worksheets/sheet1.xml"], cell) {
+				t.Errorf("%s: expected the cells %s, got %s", c.name, cell, parts["xl/worksheets/sheet1.xml"])
+			}
+		}
+		if _, ok := parts["xl/calcChain.xml"]; ok || strings.Contains(parts["xl/_rels/workbook.xml.rels"], "calcChain") ||
+			strings.Contains(parts["[Content_Types].xml"], "calcChain") {
+			t.Errorf("%s: the calculation chain isn't removed", c.name)
+		}
+		if !strings.Contains(parts["xl/workbook.xml"], `fullCalcOnLoad="1"`) {
+			t.Errorf("%s: the workbook isn't recalculated on loading", c.name)
+		}
+	}
+}
+
+func TestSetChartDataLabels(t *testing.T) {
+	f := NewFile()
+	slideID := f.GetSlideList()[0]
+	for i := 0; i < 2; i++ {
+		if _, err := f.addChart(slideID, "column", "", Offset{}, Extents{CX: 914400, CY: 914400}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// Group the first chart, the charts in the group shapes follow the charts
+	// on the top level of the shape tree
+	slide, err := f.slideReader(slideID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tree := &slide.CommonSlideData.ShapeTree
+	tree.GroupShape = append(tree.GroupShape, decodeGroupShape{GraphicFrame: tree.GraphicFrame[:1]})
+	tree.GraphicFrame = tree.GraphicFrame[1:]
+	slideXMLPath, _ := f.getSlideXMLPath(slideID)
+	groupedChartXMLPath, _ := f.getRelTarget(slideXMLPath, tree.GroupShape[0].GraphicFrame[0].chartRelationshipID())
+
+	for _, c := range []struct {
+		chartIdx, seriesIdx int
+		opts                ChartDataLabelsOptions
+		err                 error
+		expected            []string
+	}{
+		{
+			chartIdx: 1, opts: ChartDataLabelsOptions{ShowValue: true, Position: "outEnd", NumFmt: "#,##0"},
+			expected: []string{`<c:invertIfNegative val="0"/><c:dLbls><c:numFmt formatCode="#,##0" sourceLinked="0"/><c:dLblPos val="outEnd"/><c:showLegendKey val="0"/><c:showVal val="1"/>`},
+		},
+		{
+			chartIdx: 1, seriesIdx: -1, opts: ChartDataLabelsOptions{ShowPercent: true},
+			expected: []string{`</c:ser><c:dLbls><c:showLegendKey val="0"/><c:showVal val="0"/><c:showCatName val="0"/><c:showSerName val="0"/><c:showPercent val="1"/><c:showBubbleSize val="0"/></c:dLbls><c:gapWidth`},
+		},
+		{chartIdx: 1, seriesIdx: 1, err: ErrChartSeriesNotExist},
+		{chartIdx: 2, err: ErrChartNotExist{SlideID: slideID, ChartIdx: 2}},
+	} {
+		if err = f.SetChartDataLabels(slideID, c.chartIdx, c.seriesIdx, c.opts); err != c.err {
+			t.Errorf("expected error %v, got %v", c.err, err)
+		}
+		for _, expected := range c.expected {
+			if chart := string(f.readXML(groupedChartXMLPath)); !strings.Contains(chart, expected) {
+				t.Errorf("expected %s in the chart, got %s", expected, chart)
+			}
+		}
+	}
+}
+
+func TestAddChartTrendlineErrorBars(t *testing.T) {
+	f := NewFile()
+	slideID := f.GetSlideList()[0]
+	chartIdx, err := f.addChart(slideID, "line", "", Offset{}, Extents{CX: 914400, CY: 914400})
+	if err != nil {
+		t.Fatal(err)
+	}
+	chartXMLPath, _, err := f.chartReader(slideID, chartIdx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, c := range []struct {
+		name     string
+		add      func() error
+		err      error
+		expected string
+	}{
+		{
+			name: "trendline",
+			add: func() error {
+				return f.AddChartTrendline(slideID, chartIdx, 0, ChartTrendlineOptions{Type: "movingAvg", Period: 3, Color: "FF0000", Width: 12700})
+			},
+			expected: `<c:trendline><c:spPr><a:ln w="12700"><a:solidFill><a:srgbClr val="FF0000"/></a:solidFill></a:ln></c:spPr><c:trendlineType val="movingAvg"/><c:period val="3"/><c:dispRSqr val="0"/><c:dispEq val="0"/></c:trendline><c:smooth val="0"/>`,
+		},
+		{
+			name: "polynomial trendline",
+			add: func() error {
+				return f.AddChartTrendline(slideID, chartIdx, 0, ChartTrendlineOptions{Type: "poly", Order: 9, Forward: 1.5, DisplayRSquared: true})
+			},
+			expected: `</c:trendline><c:trendline><c:trendlineType val="poly"/><c:order val="6"/><c:forward val="1.5"/><c:dispRSqr val="1"/><c:dispEq val="0"/></c:trendline><c:smooth val="0"/>`,
+		},
+		{
+			name:     "error bars",
+			add:      func() error { return f.AddChartErrorBars(slideID, chartIdx, 0, ChartErrorBarsOptions{Value: 1}) },
+			expected: `</c:trendline><c:errBars><c:errBarType val="both"/><c:errValType val="fixedVal"/><c:noEndCap val="0"/><c:val val="1"/></c:errBars><c:smooth val="0"/>`,
+		},
+		{
+			name: "replaced error bars",
+			add: func() error {
+				return f.AddChartErrorBars(slideID, chartIdx, 0, ChartErrorBarsOptions{Type: "plus", ValueType: "stdErr", NoEndCap: true})
+			},
+			expected: `</c:trendline><c:errBars><c:errBarType val="plus"/><c:errValType val="stdErr"/><c:noEndCap val="1"/></c:errBars><c:smooth val="0"/>`,
+		},
+		{
+			name: "series", add: func() error { return f.AddChartErrorBars(slideID, chartIdx, 1, ChartErrorBarsOptions{}) },
+			err: ErrChartSeriesNotExist,
+		},
+	} {
+		chart := string(f.readXML(chartXMLPath))
+		if err = c.add(); err != c.err {
+			t.Fatalf("%s: expected error %v, got %v", c.name, c.err, err)
+		}
+		if c.err != nil {
+			if string(f.readXML(chartXMLPath)) != chart {
+				t.Errorf("%s: the chart is changed on error", c.name)
+			}
+			continue
+		}
+		if chart = string(f.readXML(chartXMLPath)); !strings.Contains(chart, c.expected) {
+			t.Errorf("%s: expected %s in the chart, got %s", c.name, c.expected, chart)
+		}
+	}
+}
+
+func TestGetChartData(t *testing.T) {
+	f := NewFile()
+	slideID := f.GetSlideList()[0]
+	for _, c := range []struct {
+		chartType string
+		data      [][]interface{}
+		expected  ChartData
+	}{
+		{chartType: "column", expected: ChartData{Categories: []string{}, Series: []ChartSeries{{Values: []float64{}}}}},
+		{
+			chartType: "line", data: [][]interface{}{{nil, "Sales", "Cost"}, {"Q1", 1, "2.5"}, {"Q2", 3, nil}},
+			expected: ChartData{Categories: []string{"Q1", "Q2"}, Series: []ChartSeries{
+				{Name: "Sales", Values: []float64{1, 3}}, {Name: "Cost", Values: []float64{2.5, math.NaN()}},
+			}},
+		},
+	} {
+		chartIdx, err := f.addChart(slideID, c.chartType, "", Offset{}, Extents{CX: 914400, CY: 914400})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if c.data != nil {
+			if err = f.SetChartData(slideID, chartIdx, c.data, nil); err != nil {
+				t.Fatal(err)
+			}
+		}
+		data, err := f.GetChartData(slideID, chartIdx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !slices.Equal(data.Categories, c.expected.Categories) || !slices.EqualFunc(data.Series, c.expected.Series, func(a, b ChartSeries) bool {
+			return a.Name == b.Name && slices.EqualFunc(a.Values, b.Values, func(a, b float64) bool {
+				return a == b || math.IsNaN(a) && math.IsNaN(b)
+			})
+		}) {
+			t.Errorf("%s: expected the chart data %v, got %v", c.chartType, c.expected, *data)
+		}
+	}
+	if _, err := f.GetChartData(slideID, 2); err != (ErrChartNotExist{SlideID: slideID, ChartIdx: 2}) {
+		t.Errorf("expected error %v, got %v", ErrChartNotExist{SlideID: slideID, ChartIdx: 2}, err)
+	}
+}
+
+// newTestChartTemplate provides a function to create the chart template
+// (.crtx) package by given part names and contents.
+func newTestChartTemplate(t *testing.T, parts map[string]string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for name, content := range parts {
+		w, err := zw.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err = w.Write([]byte(content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestApplyChartTemplate(t *testing.T) {
+	template := newTestChartTemplate(t, map[string]string{
+		"_rels/.rels": `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+			`<Relationship Id="rId1" Type="` + SourceRelationshipOfficeDocument + `" Target="chart/chart.xml"/></Relationships>`,
+		"chart/_rels/chart.xml.rels": `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+			`<Relationship Id="rId1" Type="` + SourceRelationshipChartStyle + `" Target="style1.xml"/>` +
+			`<Relationship Id="rId2" Type="` + SourceRelationshipChartColorStyle + `" Target="colors1.xml"/></Relationships>`,
+		"chart/chart.xml": `<ch:chartSpace xmlns:ch="` + NameSpaceDrawingMLChart.Value + `" xmlns:d="` + NameSpaceDrawingMLMain + `"><ch:chart><ch:plotArea>` +
+			`<ch:barChart><ch:ser><ch:idx val="0"/><ch:spPr><d:solidFill><d:srgbClr val="445566"/></d:solidFill></ch:spPr></ch:ser></ch:barChart>` +
+			`<ch:spPr><d:noFill/></ch:spPr></ch:plotArea></ch:chart><ch:spPr><d:solidFill><d:srgbClr val="112233"/></d:solidFill></ch:spPr></ch:chartSpace>`,
+		"chart/style1.xml":  `<cs:chartStyle xmlns:cs="http://schemas.microsoft.com/office/drawing/2012/chartStyle" id="201"/>`,
+		"chart/colors1.xml": `<cs:colorStyle xmlns:cs="http://schemas.microsoft.com/office/drawing/2012/chartStyle" meth="cycle" id="10"/>`,
+	})
+	f := NewFile()
+	slideID := f.GetSlideList()[0]
+	chartIdx, err := f.addChart(slideID, "column", "", Offset{}, Extents{CX: 914400, CY: 914400})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = f.SetChartData(slideID, chartIdx, [][]interface{}{{nil, "Sales", "Cost"}, {"Q1", 1, 2}}, nil); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 2; i++ {
+		if err = f.ApplyChartTemplate(slideID, chartIdx, template); err != nil {
+			t.Fatal(err)
+		}
+	}
+	chartXMLPath, _, err := f.chartReader(slideID, chartIdx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	chart := string(f.readXML(chartXMLPath))
+	for expected, count := range map[string]int{
+		`<c:spPr><a:solidFill><a:srgbClr val="445566"/></a:solidFill></c:spPr>`: 2,
+		`<c:spPr><a:noFill/></c:spPr></c:plotArea>`:                             1,
+		`<c:spPr><a:solidFill><a:srgbClr val="112233"/></a:solidFill></c:spPr>`: 1,
+		"<c:v>Sales</c:v>": 1,
+	} {
+		if strings.Count(chart, expected) != count {
+			t.Errorf("expected %d %s in the chart, got %s", count, expected, chart)
+		}
+	}
+	for relType, content := range map[string]string{
+		SourceRelationshipChartStyle:      `id="201"`,
+		SourceRelationshipChartColorStyle: `id="10"`,
+	} {
+		if target, ok := f.getRelTargetByType(chartXMLPath, relType); !ok || !strings.Contains(string(f.readXML(target)), content) {
+			t.Errorf("expected the part with %s related to the chart, got %s", content, target)
+		}
+	}
+	if err = f.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	for _, c := range []struct {
+		chartIdx int
+		template []byte
+		expected error
+	}{
+		{chartIdx: chartIdx, template: newTestChartTemplate(t, map[string]string{"chart/chart.xml": "<ch:chartSpace/>"}), expected: ErrChartTemplate},
+		{chartIdx: chartIdx, template: []byte("template"), expected: zip.ErrFormat},
+		{chartIdx: 5, template: template, expected: ErrChartNotExist{SlideID: slideID, ChartIdx: 5}},
+	} {
+		if err = f.ApplyChartTemplate(slideID, c.chartIdx, c.template); !errors.Is(err, c.expected) {
+			t.Errorf("expected %v, got %v", c.expected, err)
+		}
+	}
+}
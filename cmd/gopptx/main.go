@@ -0,0 +1,306 @@
+// Copyright 2026 kenny-not-dead. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+
+// Command gopptx provides quick operations on PowerPoint (.pptx) files for
+// scripts and CI, built on the gopptx library.
+//
+// Usage:
+//
+//	gopptx inspect <file.pptx>
+//	gopptx extract-text <file.pptx>
+//	gopptx extract-media <file.pptx> <output-dir>
+//	gopptx merge <output.pptx> <file.pptx>...
+//	gopptx split <file.pptx> <output-dir>
+//	gopptx set-props <file.pptx> <key=value>...
+//	gopptx validate <file.pptx>
+//
+// The keys of the set-props command are title, subject, creator, keywords,
+// description, category, last-modified-by and company.
+package main
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/kenny-not-dead/gopptx"
+)
+
+// command defines a subcommand of the tool, the variadic subcommand takes at
+// least the number of the arguments.
+type command struct {
+	args     string
+	usage    string
+	nArgs    int
+	variadic bool
+	run      func(args []string) error
+}
+
+var commands = map[string]command{
+	"inspect":       {"<file.pptx>", "list the slides and their shapes", 1, false, inspect},
+	"extract-text":  {"<file.pptx>", "print the text of each slide", 1, false, extractText},
+	"extract-media": {"<file.pptx> <output-dir>", "write the media files into the directory", 2, false, extractMedia},
+	"merge":         {"<output.pptx> <file.pptx>...", "write the slides of the presentations into one", 2, true, merge},
+	"split":         {"<file.pptx> <output-dir>", "write each slide as a separate presentation", 2, false, split},
+	"set-props":     {"<file.pptx> <key=value>...", "set the document properties of the presentation", 2, true, setProps},
+	"validate":      {"<file.pptx>", "check the package structure of the presentation", 1, false, validate},
+}
+
+// docPropsKeys defines the keys of the set-props subcommand and the document
+// properties they set.
+var docPropsKeys = map[string]func(props *gopptx.DocProperties, value string){
+	"title":            func(props *gopptx.DocProperties, value string) { props.Title = value },
+	"subject":          func(props *gopptx.DocProperties, value string) { props.Subject = value },
+	"creator":          func(props *gopptx.DocProperties, value string) { props.Creator = value },
+	"keywords":         func(props *gopptx.DocProperties, value string) { props.Keywords = value },
+	"description":      func(props *gopptx.DocProperties, value string) { props.Description = value },
+	"category":         func(props *gopptx.DocProperties, value string) { props.Category = value },
+	"last-modified-by": func(props *gopptx.DocProperties, value string) { props.LastModifiedBy = value },
+	"company":          func(props *gopptx.DocProperties, value string) { props.Company = value },
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(2)
+	}
+	cmd, ok := commands[os.Args[1]]
+	if nArgs := len(os.Args) - 2; !ok || nArgs < cmd.nArgs || (!cmd.variadic && nArgs != cmd.nArgs) {
+		usage()
+		os.Exit(2)
+	}
+	if err := cmd.run(os.Args[2:]); err != nil {
+		fmt.Fprintln(os.Stderr, "gopptx:", err)
+		os.Exit(1)
+	}
+}
+
+// usage prints the usage of all subcommands.
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: gopptx <command> [arguments]\n\ncommands:")
+	for _, name := range []string{"inspect", "extract-text", "extract-media", "merge", "split", "set-props", "validate"} {
+		cmd := commands[name]
+		fmt.Fprintf(os.Stderr, "  %-14s %-29s %s\n", name, cmd.args, cmd.usage)
+	}
+}
+
+// readSlides opens the presentation and decodes each slide in the JSON schema
+// of the library.
+func readSlides(name string) ([]gopptx.JSONSlide, error) {
+	f, err := gopptx.OpenFile(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	var slides []gopptx.JSONSlide
+	for _, slideID := range f.GetSlideList() {
+		data, err := f.MarshalSlideJSON(slideID)
+		if err != nil {
+			return nil, err
+		}
+		var slide gopptx.JSONSlide
+		if err = json.Unmarshal(data, &slide); err != nil {
+			return nil, err
+		}
+		slides = append(slides, slide)
+	}
+	return slides, nil
+}
+
+// shapeText returns the text of the shape, paragraphs separated by newline.
+func shapeText(shape gopptx.JSONShape) string {
+	if shape.Text == nil {
+		return ""
+	}
+	var paragraphs []string
+	for _, p := range shape.Text.Paragraphs {
+		var text strings.Builder
+		for _, r := range p.Runs {
+			text.WriteString(r.Text)
+		}
+		paragraphs = append(paragraphs, text.String())
+	}
+	return strings.Join(paragraphs, "\n")
+}
+
+func inspect(args []string) error {
+	slides, err := readSlides(args[0])
+	if err != nil {
+		return err
+	}
+	for idx, slide := range slides {
+		fmt.Printf("slide %d (id %d): %d shapes\n", idx+1, slide.ID, len(slide.Shapes))
+		for _, shape := range slide.Shapes {
+			kind := shape.Type
+			if shape.Placeholder != "" {
+				kind += "/" + shape.Placeholder
+			}
+			fmt.Printf("  #%-4d %-20s %-16q x=%d y=%d w=%d h=%d\n",
+				shape.ID, kind, shape.Name, shape.X, shape.Y, shape.Width, shape.Height)
+		}
+	}
+	return nil
+}
+
+func extractText(args []string) error {
+	slides, err := readSlides(args[0])
+	if err != nil {
+		return err
+	}
+	for idx, slide := range slides {
+		if idx > 0 {
+			fmt.Println()
+		}
+		fmt.Printf("--- slide %d ---\n", idx+1)
+		for _, shape := range slide.Shapes {
+			if text := strings.TrimSpace(shapeText(shape)); text != "" {
+				fmt.Println(text)
+			}
+		}
+	}
+	return nil
+}
+
+func extractMedia(args []string) error {
+	zr, err := zip.OpenReader(args[0])
+	if err != nil {
+		return err
+	}
+	defer zr.Close()
+	if err = os.MkdirAll(args[1], 0o755); err != nil {
+		return err
+	}
+	for _, file := range zr.File {
+		if !strings.HasPrefix(file.Name, "ppt/media/") || file.FileInfo().IsDir() {
+			continue
+		}
+		if err = extractFile(file, filepath.Join(args[1], filepath.Base(file.Name))); err != nil {
+			return err
+		}
+		fmt.Println(filepath.Base(file.Name))
+	}
+	return nil
+}
+
+// extractFile writes the content of the file in the archive to the path.
+func extractFile(file *zip.File, name string) error {
+	rc, err := file.Open()
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+	out, err := os.Create(name)
+	if err != nil {
+		return err
+	}
+	if _, err = io.Copy(out, rc); err != nil {
+		_ = out.Close()
+		return err
+	}
+	return out.Close()
+}
+
+func merge(args []string) error {
+	f, err := gopptx.OpenFile(args[1])
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	for _, name := range args[2:] {
+		src, err := gopptx.OpenFile(name)
+		if err != nil {
+			return err
+		}
+		for _, slideID := range src.GetSlideList() {
+			if _, err = f.ImportSlide(src, slideID, nil); err != nil {
+				_ = src.Close()
+				return fmt.Errorf("%s: %w", name, err)
+			}
+		}
+		if err = src.Close(); err != nil {
+			return err
+		}
+	}
+	if err = f.SaveAs(args[0]); err != nil {
+		return err
+	}
+	fmt.Printf("%s: %d slides\n", args[0], len(f.GetSlideList()))
+	return nil
+}
+
+func split(args []string) error {
+	f, err := gopptx.OpenFile(args[0])
+	if err != nil {
+		return err
+	}
+	slideIDs := f.GetSlideList()
+	if err = f.Close(); err != nil {
+		return err
+	}
+	if err = os.MkdirAll(args[1], 0o755); err != nil {
+		return err
+	}
+	base := strings.TrimSuffix(filepath.Base(args[0]), filepath.Ext(args[0]))
+	for idx, keep := range slideIDs {
+		if f, err = gopptx.OpenFile(args[0]); err != nil {
+			return err
+		}
+		for _, slideID := range slideIDs {
+			if slideID != keep {
+				if err = f.DeleteSlide(slideID); err != nil {
+					return err
+				}
+			}
+		}
+		name := filepath.Join(args[1], fmt.Sprintf("%s-%d.pptx", base, idx+1))
+		if err = f.SaveAs(name); err != nil {
+			return err
+		}
+		if err = f.Close(); err != nil {
+			return err
+		}
+		fmt.Println(name)
+	}
+	return nil
+}
+
+func setProps(args []string) error {
+	var props gopptx.DocProperties
+	for _, arg := range args[1:] {
+		key, value, ok := strings.Cut(arg, "=")
+		set, known := docPropsKeys[key]
+		if !ok || !known {
+			return fmt.Errorf("invalid property %q, expected key=value with the key one of title, subject, "+
+				"creator, keywords, description, category, last-modified-by and company", arg)
+		}
+		set(&props, value)
+	}
+	f, err := gopptx.OpenFile(args[0])
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if err = f.SetDocProps(&props); err != nil {
+		return err
+	}
+	return f.Save()
+}
+
+func validate(args []string) error {
+	f, err := gopptx.OpenFile(args[0])
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if err = f.Validate(); err != nil {
+		return errors.New("invalid presentation:\n" + err.Error())
+	}
+	fmt.Println("ok")
+	return nil
+}
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"archive/zip"
+	"io/fs"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/kenny-not-dead/gopptx"
+)
+
+func TestSplitMerge(t *testing.T) {
+	dir := t.TempDir()
+	name := filepath.Join(dir, "deck.pptx")
+	f := gopptx.NewFile()
+	if _, err := f.NewSlide(); err != nil {
+		t.Fatal(err)
+	}
+	if err := f.SaveAs(name); err != nil {
+		t.Fatal(err)
+	}
+	if err := split([]string{name, filepath.Join(dir, "split")}); err != nil {
+		t.Fatal(err)
+	}
+	parts := []string{filepath.Join(dir, "split", "deck-1.pptx"), filepath.Join(dir, "split", "deck-2.pptx")}
+	merged := filepath.Join(dir, "merged.pptx")
+	for _, c := range []struct {
+		name   string
+		run    func() error
+		slides int
+	}{
+		{name: parts[0], slides: 1},
+		{name: parts[1], slides: 1},
+		{name: merged, run: func() error { return merge(append([]string{merged}, parts...)) }, slides: 2},
+		{name: merged, run: func() error { return setProps([]string{merged, "title=Merged", "company=GoPPTX"}) }, slides: 2},
+	} {
+		if c.run != nil {
+			if err := c.run(); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if err := validate([]string{c.name}); err != nil {
+			t.Errorf("%s: %v", c.name, err)
+		}
+		f, err := gopptx.OpenFile(c.name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if slides := len(f.GetSlideList()); slides != c.slides {
+			t.Errorf("%s: expected %d slides, got %d", c.name, c.slides, slides)
+		}
+		_ = f.Close()
+	}
+	zr, err := zip.OpenReader(merged)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer zr.Close()
+	for part, expected := range map[string]string{
+		"docProps/core.xml": "<dc:title>Merged</dc:title>", "docProps/app.xml": "<Company>GoPPTX</Company>",
+	} {
+		content, err := fs.ReadFile(zr, part)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !strings.Contains(string(content), expected) {
+			t.Errorf("expected %s in %s, got %s", expected, part, content)
+		}
+	}
+	if err = setProps([]string{merged, "author"}); err == nil {
+		t.Error("expected error on the invalid property")
+	}
+}
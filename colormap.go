@@ -0,0 +1,241 @@
+// Copyright 2026 kenny-not-dead. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+//
+// Package gopptx provides functionality to create and manipulate PowerPoint
+// (.pptx) files in Go, using the Office Open XML (ECMA-376) format.
+
+package gopptx
+
+// ColorMap directly maps the color mapping of the slide, which maps the
+// background and text colors, the accents and the hyperlinks to the theme
+// colors. The value should be one of the theme colors "dk1", "lt1", "dk2",
+// "lt2", "accent1" to "accent6", "hlink" and "folHlink". The empty field
+// keeps the mapping of the layout or the slide master.
+type ColorMap struct {
+	Background1       string
+	Text1             string
+	Background2       string
+	Text2             string
+	Accent1           string
+	Accent2           string
+	Accent3           string
+	Accent4           string
+	Accent5           string
+	Accent6           string
+	Hyperlink         string
+	FollowedHyperlink string
+}
+
+var (
+	// themeColorNames defined the names of the theme colors in the order of
+	// the color scheme.
+	themeColorNames = []string{
+		"dk1", "lt1", "dk2", "lt2", "accent1", "accent2", "accent3",
+		"accent4", "accent5", "accent6", "hlink", "folHlink",
+	}
+	// colorMapNames defined the attribute names of the color mapping in the
+	// order of the schema.
+	colorMapNames = []string{
+		"bg1", "tx1", "bg2", "tx2", "accent1", "accent2", "accent3",
+		"accent4", "accent5", "accent6", "hlink", "folHlink",
+	}
+)
+
+// fields returns the pointers to the fields of the color mapping by the
+// attribute names of the clrMap element.
+func (m *ColorMap) fields() map[string]*string {
+	return map[string]*string{
+		"bg1": &m.Background1, "tx1": &m.Text1, "bg2": &m.Background2, "tx2": &m.Text2,
+		"accent1": &m.Accent1, "accent2": &m.Accent2, "accent3": &m.Accent3,
+		"accent4": &m.Accent4, "accent5": &m.Accent5, "accent6": &m.Accent6,
+		"hlink": &m.Hyperlink, "folHlink": &m.FollowedHyperlink,
+	}
+}
+
+// SetSlideColorMap provides a function to override the color mapping of the
+// slide by given slide id and color mapping, the fields not set keep the
+// mapping of the layout or the slide master. The slide follows the mapping of
+// the slide master again if the color mapping is nil. For example, swap the
+// dark and light colors for a dark slide:
+//
+//	err := f.SetSlideColorMap(256, &gopptx.ColorMap{
+//	    Background1: "dk1",
+//	    Text1:       "lt1",
+//	    Background2: "dk2",
+//	    Text2:       "lt2",
+//	})
+func (f *File) SetSlideColorMap(slideID int, colorMap *ColorMap) error {
+	if err := f.checkReadOnly(); err != nil {
+		return err
+	}
+	slide, err := f.slideReader(slideID)
+	if err != nil {
+		return err
+	}
+	if colorMap == nil {
+		slide.ColorMapOverride = &decodeColorMapOverride{MasterColorMapping: &struct{}{}}
+		return nil
+	}
+	slideXMLPath, _ := f.getSlideXMLPath(slideID)
+	layoutXMLPath, _ := f.getRelTargetByType(slideXMLPath, SourceRelationshipSlideLayout)
+	mapping, err := f.getSlideColorMap(layoutXMLPath, nil)
+	if err != nil {
+		return err
+	}
+	values, err := getColorMapValues(mapping, colorMap)
+	if err != nil {
+		return err
+	}
+	slide.ColorMapOverride = &decodeColorMapOverride{OverrideColorMapping: &colorMapping{
+		Background1: values["bg1"], Text1: values["tx1"], Background2: values["bg2"], Text2: values["tx2"],
+		Accent1: values["accent1"], Accent2: values["accent2"], Accent3: values["accent3"],
+		Accent4: values["accent4"], Accent5: values["accent5"], Accent6: values["accent6"],
+		Hyperlink: values["hlink"], FollowedHyperlink: values["folHlink"],
+	}}
+	return nil
+}
+
+// GetSlideColorMap provides a function to get the color mapping in effect on
+// the slide by given slide id, which is the overriding mapping of the slide,
+// the layout or the mapping of the slide master. For example:
+//
+//	colorMap, err := f.GetSlideColorMap(256)
+//	if err != nil {
+//	    fmt.Println(err)
+//	    return
+//	}
+//	fmt.Println(colorMap.Background1, colorMap.Text1)
+func (f *File) GetSlideColorMap(slideID int) (ColorMap, error) {
+	var colorMap ColorMap
+	slide, err := f.slideNodeReader(slideID)
+	if err != nil {
+		return colorMap, err
+	}
+	slideXMLPath, _ := f.getSlideXMLPath(slideID)
+	layoutXMLPath, _ := f.getRelTargetByType(slideXMLPath, SourceRelationshipSlideLayout)
+	mapping, err := f.getSlideColorMap(layoutXMLPath, slide)
+	if err != nil {
+		return colorMap, err
+	}
+	for name, field := range colorMap.fields() {
+		*field = mapping[name]
+	}
+	return colorMap, nil
+}
+
+// SetSlideLayoutColorMap provides a function to override the color mapping of
+// the slide layout by given layout name or layout type, case-insensitive, and
+// the color mapping, the fields not set keep the mapping of the slide master.
+// The slides using the layout follow the overriding mapping unless they
+// override the mapping themselves. The layout follows the mapping of the slide
+// master again if the color mapping is nil. For example:
+//
+//	err := f.SetSlideLayoutColorMap("Section Header", &gopptx.ColorMap{
+//	    Background1: "dk2",
+//	    Text1:       "lt1",
+//	})
+func (f *File) SetSlideLayoutColorMap(layoutName string, colorMap *ColorMap) error {
+	if err := f.checkReadOnly(); err != nil {
+		return err
+	}
+	layoutXMLPath, err := f.getSlideLayoutPath(layoutName)
+	if err != nil {
+		return err
+	}
+	root, err := f.xmlNodeReader(f.readXML(layoutXMLPath))
+	if err != nil {
+		return err
+	}
+	p, a := root.prefix(NameSpacePresentationMLMain), root.prefix(NameSpaceDrawingML.Value)
+	if a == "" {
+		root.setAttr("xmlns:a", NameSpaceDrawingML.Value)
+		a = "a:"
+	}
+	clrMapOvr := newXMLNode(p + "clrMapOvr")
+	if colorMap == nil {
+		clrMapOvr.Children = append(clrMapOvr.Children, newXMLNode(a+"masterClrMapping"))
+	} else {
+		masterXMLPath, _ := f.getRelTargetByType(layoutXMLPath, SourceRelationshipSlideMaster)
+		master, err := f.xmlNodeReader(f.readXML(masterXMLPath))
+		if err != nil {
+			return err
+		}
+		values, err := getColorMapValues(getColorMap(master), colorMap)
+		if err != nil {
+			return err
+		}
+		mapping := newXMLNode(a + "overrideClrMapping")
+		for _, name := range colorMapNames {
+			mapping.setAttr(name, values[name])
+		}
+		clrMapOvr.Children = append(clrMapOvr.Children, mapping)
+	}
+	root.remove(p + "clrMapOvr")
+	root.insert(clrMapOvr, p+"transition", p+"timing", p+"hf", p+"extLst")
+	f.saveFileList(layoutXMLPath, root.bytes())
+	return nil
+}
+
+// getSlideColorMap provides a function to get the color mapping in effect on
+// the slide by given layout part name of the slide and the element tree of
+// the slide, the mapping in effect on the layout is returned if the element
+// tree is nil.
+func (f *File) getSlideColorMap(layoutXMLPath string, slide *xmlNode) (map[string]string, error) {
+	if layoutXMLPath == "" {
+		return getColorMap(slide), nil
+	}
+	var master *xmlNode
+	layout, err := f.xmlNodeReader(f.readXML(layoutXMLPath))
+	if err != nil {
+		return nil, err
+	}
+	if masterXMLPath, ok := f.getRelTargetByType(layoutXMLPath, SourceRelationshipSlideMaster); ok {
+		if master, err = f.xmlNodeReader(f.readXML(masterXMLPath)); err != nil {
+			return nil, err
+		}
+	}
+	return getColorMap(master, layout, slide), nil
+}
+
+// getColorMap returns the color mapping by given element trees of the slide
+// master, the layout and the slide, the overriding mapping of the latter
+// element replaces the former one. The default mapping of the dark text on
+// the light background is used if there is no mapping.
+func getColorMap(nodes ...*xmlNode) map[string]string {
+	colorMap := map[string]string{"bg1": "lt1", "tx1": "dk1", "bg2": "lt2", "tx2": "dk2"}
+	for _, name := range colorMapNames[4:] {
+		colorMap[name] = name
+	}
+	for _, node := range nodes {
+		clrMap := node.find("clrMap")
+		if clrMap == nil {
+			clrMap = node.find("clrMapOvr", "overrideClrMapping")
+		}
+		if clrMap == nil {
+			continue
+		}
+		for _, attr := range clrMap.Attr {
+			colorMap[attr.Name.Local] = attr.Value
+		}
+	}
+	return colorMap
+}
+
+// getColorMapValues returns the overriding color mapping by given base
+// mapping and the color mapping, the fields not set are taken from the base
+// mapping.
+func getColorMapValues(base map[string]string, colorMap *ColorMap) (map[string]string, error) {
+	values := map[string]string{}
+	for name, field := range colorMap.fields() {
+		values[name] = base[name]
+		if *field == "" {
+			continue
+		}
+		if inStrSlice(themeColorNames, *field, true) == -1 {
+			return nil, ErrColorMapInvalid
+		}
+		values[name] = *field
+	}
+	return values, nil
+}
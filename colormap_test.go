@@ -0,0 +1,67 @@
+package gopptx
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestSetSlideColorMap(t *testing.T) {
+	f := NewFile()
+	slideID := f.GetSlideList()[0]
+	master := ColorMap{
+		Background1: "lt1", Text1: "dk1", Background2: "lt2", Text2: "dk2",
+		Accent1: "accent1", Accent2: "accent2", Accent3: "accent3", Accent4: "accent4",
+		Accent5: "accent5", Accent6: "accent6", Hyperlink: "hlink", FollowedHyperlink: "folHlink",
+	}
+	layout, slide := master, master
+	layout.Background1, layout.Text1 = "dk2", "lt1"
+	slide.Background1, slide.Text1, slide.Accent1 = "dk1", "lt1", "accent2"
+	for _, c := range []struct {
+		set      func() error
+		expected ColorMap
+	}{
+		{set: func() error { return nil }, expected: master},
+		{set: func() error {
+			return f.SetSlideLayoutColorMap("Default", &ColorMap{Background1: "dk2", Text1: "lt1"})
+		}, expected: layout},
+		{set: func() error {
+			return f.SetSlideColorMap(slideID, &ColorMap{Background1: "dk1", Accent1: "accent2"})
+		}, expected: slide},
+		{set: func() error { return f.SetSlideColorMap(slideID, nil) }, expected: layout},
+		{set: func() error { return f.SetSlideLayoutColorMap("title", nil) }, expected: master},
+	} {
+		if err := c.set(); err != nil {
+			t.Fatal(err)
+		}
+		buf, err := f.WriteToBuffer()
+		if err != nil {
+			t.Fatal(err)
+		}
+		saved, err := OpenReader(buf)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, file := range []*File{f, saved} {
+			if colorMap, err := file.GetSlideColorMap(slideID); err != nil || colorMap != c.expected {
+				t.Errorf("expected the color map %v, got %v %v", c.expected, colorMap, err)
+			}
+		}
+	}
+
+	for _, c := range []struct {
+		err      error
+		expected error
+	}{
+		{f.SetSlideColorMap(slideID, &ColorMap{Text1: "FF0000"}), ErrColorMapInvalid},
+		{f.SetSlideLayoutColorMap("Default", &ColorMap{Hyperlink: "tx1"}), ErrColorMapInvalid},
+		{f.SetSlideLayoutColorMap("Missing", nil), ErrLayoutNotExist{"Missing"}},
+		{f.SetSlideColorMap(300, nil), ErrSlideNotExist{300}},
+	} {
+		if !errors.Is(c.err, c.expected) {
+			t.Errorf("expected %v, got %v", c.expected, c.err)
+		}
+	}
+	if _, err := f.GetSlideColorMap(300); !errors.Is(err, ErrSlideNotExist{300}) {
+		t.Errorf("expected ErrSlideNotExist, got %v", err)
+	}
+}
@@ -0,0 +1,304 @@
+// Copyright 2026 kenny-not-dead. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+//
+// Package gopptx provides functionality to create and manipulate PowerPoint
+// (.pptx) files in Go, using the Office Open XML (ECMA-376) format.
+
+package gopptx
+
+import (
+	"encoding/json"
+	"io"
+)
+
+// DeckDefinition directly maps the JSON schema of a declarative deck
+// definition, used by BuildFromDefinition.
+type DeckDefinition struct {
+	Slides []SlideDefinition `json:"slides"`
+}
+
+// SlideDefinition directly maps the JSON schema of a slide in the deck
+// definition. The layout is matched by the name or the type of the slide
+// layouts in the presentation, such as "Title and Content" or "obj". The
+// shapes are appended in the schema of MarshalSlideJSON.
+type SlideDefinition struct {
+	Layout       string                  `json:"layout,omitempty"`
+	Title        string                  `json:"title,omitempty"`
+	Placeholders []PlaceholderDefinition `json:"placeholders,omitempty"`
+	Images       []ImageDefinition       `json:"images,omitempty"`
+	Tables       []TableDefinition       `json:"tables,omitempty"`
+	Charts       []ChartDefinition       `json:"charts,omitempty"`
+	Shapes       []JSONShape             `json:"shapes,omitempty"`
+}
+
+// PlaceholderDefinition directly maps the JSON schema of the placeholder
+// content in the deck definition. The placeholder is matched by the index if
+// it is specified, otherwise by the type, and the type "body" matches the body
+// placeholders in order. Each of the text will be added as a paragraph, the
+// paragraphs can be used for the styled text instead.
+type PlaceholderDefinition struct {
+	Type       string          `json:"type,omitempty"`
+	Index      *int            `json:"index,omitempty"`
+	Text       []string        `json:"text,omitempty"`
+	Paragraphs []JSONParagraph `json:"paragraphs,omitempty"`
+}
+
+// ImageDefinition directly maps the JSON schema of the picture in the deck
+// definition, the position and size are specified in EMUs.
+type ImageDefinition struct {
+	Path    string `json:"path"`
+	AltText string `json:"altText,omitempty"`
+	X       int    `json:"x"`
+	Y       int    `json:"y"`
+	Width   int    `json:"width,omitempty"`
+	Height  int    `json:"height,omitempty"`
+}
+
+// TableDefinition directly maps the JSON schema of the table in the deck
+// definition. The header is the first row of the table, and the table has no
+// header row if it is empty. The alignment of each column is "left", "center"
+// or "right". The position, width and row height are specified in EMUs, and
+// the font size in points, see TableOptions for the defaults.
+type TableDefinition struct {
+	Header     []string   `json:"header,omitempty"`
+	Rows       [][]string `json:"rows"`
+	Align      []string   `json:"align,omitempty"`
+	X          int        `json:"x,omitempty"`
+	Y          int        `json:"y,omitempty"`
+	Width      int        `json:"width,omitempty"`
+	RowHeight  int        `json:"rowHeight,omitempty"`
+	FontSize   int        `json:"fontSize,omitempty"`
+	HeaderFill string     `json:"headerFill,omitempty"`
+}
+
+// ChartDefinition directly maps the JSON schema of the chart in the deck
+// definition. The type is one of "column", "bar", "line" and "pie" with
+// default "column". The categories are shared by every series, and each
+// series has the name and the values of the categories. The position and
+// size are specified in EMUs, the chart is placed below the title area and
+// fills the rest of the slide with margins by default.
+type ChartDefinition struct {
+	Type       string        `json:"type,omitempty"`
+	Title      string        `json:"title,omitempty"`
+	Categories []string      `json:"categories"`
+	Series     []ChartSeries `json:"series"`
+	X          int           `json:"x,omitempty"`
+	Y          int           `json:"y,omitempty"`
+	Width      int           `json:"width,omitempty"`
+	Height     int           `json:"height,omitempty"`
+}
+
+// BuildFromDefinition provides a function to produce slides from the JSON
+// deck definition read from the given io.Reader, so decks can be defined
+// without writing Go. The slides are appended to the given template
+// presentation, a new presentation will be created if the template is nil,
+// and its default slide holds the first defined slide. The charts are added
+// without the embedded workbooks, so the data is kept in the chart caches.
+// For example:
+//
+//	f, err := gopptx.BuildFromDefinition(strings.NewReader(`{"slides":[{
+//	    "title":"Quarterly Review",
+//	    "placeholders":[{"type":"subTitle","text":["Q3 2026"]}]
+//	}, {
+//	    "layout":"Title Only",
+//	    "title":"Revenue",
+//	    "charts":[{"type":"column","categories":["Q1","Q2","Q3"],
+//	        "series":[{"name":"2026","values":[12.5,14.1,15.8]}]}]
+//	}]}`), nil)
+//	if err != nil {
+//	    fmt.Println(err)
+//	    return
+//	}
+//	if err := f.SaveAs("review.pptx"); err != nil {
+//	    fmt.Println(err)
+//	}
+func BuildFromDefinition(r io.Reader, template *File) (*File, error) {
+	var deck DeckDefinition
+	err := json.NewDecoder(r).Decode(&deck)
+	if err != nil {
+		return nil, err
+	}
+	f, reuseFirst := template, false
+	if f == nil {
+		f, reuseFirst = NewFile(), true
+	}
+	for idx, s := range deck.Slides {
+		slideID := defaultXMLSlideID
+		if idx > 0 || !reuseFirst {
+			if slideID, err = f.NewSlide(); err != nil {
+				return f, err
+			}
+		}
+		if err = f.setDefinitionSlide(slideID, s); err != nil {
+			return f, newDefinitionSlideError(idx+1, err)
+		}
+	}
+	return f, nil
+}
+
+// setDefinitionSlide provides a function to set the layout and content of the
+// slide by given slide id and slide definition.
+func (f *File) setDefinitionSlide(slideID int, s SlideDefinition) error {
+	if s.Layout != "" {
+		layoutXMLPath, err := f.getSlideLayoutPath(s.Layout)
+		if err != nil {
+			return err
+		}
+		if err = f.setSlideLayout(slideID, layoutXMLPath); err != nil {
+			return err
+		}
+	}
+	slide, err := f.slideReader(slideID)
+	if err != nil {
+		return err
+	}
+	placeholders := s.Placeholders
+	if s.Title != "" {
+		placeholders = append([]PlaceholderDefinition{{Type: "title", Text: []string{s.Title}}}, placeholders...)
+	}
+	used := map[*decodeShape]bool{}
+	for _, p := range placeholders {
+		shape := slide.getPlaceholder(p.Type, p.Index, used)
+		if shape == nil {
+			return newPlaceholderTypeError(p.Type, p.Index)
+		}
+		used[shape] = true
+		paragraphs := p.Paragraphs
+		for _, text := range p.Text {
+			paragraphs = append(paragraphs, JSONParagraph{Runs: []JSONRun{{Text: text}}})
+		}
+		setPlaceholderParagraphs(shape, paragraphs)
+	}
+	slideXMLPath, _ := f.getSlideXMLPath(slideID)
+	for _, shape := range s.Shapes {
+		if err = f.addJSONShape(slide, slideXMLPath, shape); err != nil {
+			return err
+		}
+	}
+	for _, img := range s.Images {
+		if _, err = f.AddPicture(slideID, img.Path, &PictureOptions{
+			AltText: img.AltText,
+			OffsetX: img.X,
+			OffsetY: img.Y,
+			Width:   img.Width,
+			Height:  img.Height,
+		}); err != nil {
+			return err
+		}
+	}
+	for _, table := range s.Tables {
+		if _, err = f.addTable(slideID, tableData{header: table.Header, rows: table.Rows, align: table.Align}, &TableOptions{
+			OffsetX:    table.X,
+			OffsetY:    table.Y,
+			Width:      table.Width,
+			RowHeight:  table.RowHeight,
+			FontSize:   table.FontSize,
+			HeaderFill: table.HeaderFill,
+		}); err != nil {
+			return err
+		}
+	}
+	for _, chart := range s.Charts {
+		if err = f.addDefinitionChart(slideID, chart); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// addDefinitionChart provides a function to add the chart onto the slide by
+// given slide id and chart definition.
+func (f *File) addDefinitionChart(slideID int, chart ChartDefinition) error {
+	if len(chart.Categories) == 0 || len(chart.Series) == 0 {
+		return ErrChartDataEmpty
+	}
+	slideWidth, slideHeight, err := f.getSlideSize()
+	if err != nil {
+		return err
+	}
+	offset, extents := Offset{X: chart.X, Y: chart.Y}, Extents{CX: chart.Width, CY: chart.Height}
+	if offset.X == 0 && offset.Y == 0 {
+		offset = Offset{X: defaultTableMargin, Y: defaultTableMargin * 7 / 2}
+	}
+	if extents.CX <= 0 {
+		extents.CX = slideWidth - offset.X - defaultTableMargin
+	}
+	if extents.CY <= 0 {
+		extents.CY = slideHeight - offset.Y - defaultTableMargin
+	}
+	chartIdx, err := f.addChart(slideID, chart.Type, chart.Title, offset, extents)
+	if err != nil {
+		return err
+	}
+	data := [][]interface{}{{""}}
+	for _, series := range chart.Series {
+		data[0] = append(data[0], series.Name)
+	}
+	for i, category := range chart.Categories {
+		row := []interface{}{category}
+		for _, series := range chart.Series {
+			var value interface{}
+			if i < len(series.Values) {
+				value = series.Values[i]
+			}
+			row = append(row, value)
+		}
+		data = append(data, row)
+	}
+	return f.SetChartData(slideID, chartIdx, data, nil)
+}
+
+// getPlaceholder provides a function to get the first placeholder of the slide
+// which is not in the used shapes by given placeholder type or index. The
+// title type matches both the title and center title placeholders, and the
+// body type matches any body placeholder.
+func (ds *decodeSlide) getPlaceholder(phType string, index *int, used map[*decodeShape]bool) *decodeShape {
+	for i := range ds.CommonSlideData.ShapeTree.Shape {
+		shape := &ds.CommonSlideData.ShapeTree.Shape[i]
+		if used[shape] || shape.placeholderType() == "" {
+			continue
+		}
+		if index != nil {
+			if ph := shape.NonVisualShapeProperties.NonVisualProperties.Ph; ph.Idx != nil && *ph.Idx == *index {
+				return shape
+			}
+			continue
+		}
+		if shape.placeholderType() == phType || phType == "title" && shape.isTitle() ||
+			phType == "body" && shape.isBody() {
+			return shape
+		}
+	}
+	return nil
+}
+
+// setPlaceholderParagraphs provides a function to replace the paragraphs of
+// the placeholder by given JSON paragraphs. The runs without formatting
+// inherit the properties of the first paragraph of the placeholder.
+func setPlaceholderParagraphs(shape *decodeShape, paragraphs []JSONParagraph) {
+	var (
+		pPr *ParagraphProperties
+		rPr *DecodeRunProperties
+	)
+	if shape.TextBody == nil {
+		shape.TextBody = &DecodeTextBody{BodyProperties: &DecodeBodyProperties{}}
+	}
+	if len(shape.TextBody.Paragraph) > 0 {
+		pPr, rPr = shape.TextBody.Paragraph[0].ParagraphProperties, shape.TextBody.Paragraph[0].EndParagraphRunProperties
+	}
+	shape.TextBody.Paragraph = newJSONTextBody(&JSONText{Paragraphs: paragraphs}).Paragraph
+	for i, p := range paragraphs {
+		dp := &shape.TextBody.Paragraph[i]
+		if dp.ParagraphProperties == nil {
+			dp.ParagraphProperties = pPr
+		}
+		dp.EndParagraphRunProperties = rPr
+		for j, r := range p.Runs {
+			if rPr != nil && r == (JSONRun{Text: r.Text}) {
+				props := *rPr
+				dp.Runs[j].RunProperties = &props
+			}
+		}
+	}
+}
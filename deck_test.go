@@ -0,0 +1,61 @@
+package gopptx
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestBuildFromDefinition(t *testing.T) {
+	for _, c := range []struct {
+		name, definition string
+		err              error
+		markdown         string
+		graphicFrames    []int
+	}{
+		{
+			name: "slides",
+			definition: `{"slides":[{"title":"Quarterly Review","placeholders":[{"type":"subTitle","text":["Q3 2026"]}]},
+				{"layout":"Default","title":"Agenda","placeholders":[{"type":"body","text":["Intro","Outlook"]}],
+				"shapes":[{"type":"shape","x":1,"y":2,"width":3,"height":4,"text":{"paragraphs":[{"runs":[{"text":"Note"}]}]}}]},
+				{"layout":"title","title":"Revenue","tables":[{"header":["Quarter","Sales"],"rows":[["Q1","12.5"]]}],
+				"charts":[{"categories":["Q1","Q2"],"series":[{"name":"2026","values":[12.5,14.1]}]}]}]}`,
+			markdown:      "# Quarterly Review\n\n- Q3 2026\n\n# Agenda\n\n- Intro\n- Outlook\n\n# Revenue\n",
+			graphicFrames: []int{0, 0, 2},
+		},
+		{name: "layout", definition: `{"slides":[{"layout":"Missing"}]}`, err: ErrLayoutNotExist{"Missing"}},
+		{name: "placeholder", definition: `{"slides":[{"placeholders":[{"type":"pic"}]}]}`, err: ErrPlaceholderNotExist},
+		{name: "chart", definition: `{"slides":[{"charts":[{"categories":["Q1"]}]}]}`, err: ErrChartDataEmpty},
+	} {
+		f, err := BuildFromDefinition(strings.NewReader(c.definition), nil)
+		if !errors.Is(err, c.err) {
+			t.Fatalf("%s: expected error %v, got %v", c.name, c.err, err)
+		}
+		if err != nil {
+			if !strings.HasPrefix(err.Error(), "slide 1 of the deck definition: ") {
+				t.Errorf("%s: expected the position of the slide in the error, got %v", c.name, err)
+			}
+			continue
+		}
+		var buf bytes.Buffer
+		if err = f.ExportMarkdown(&buf); err != nil {
+			t.Fatal(err)
+		}
+		if buf.String() != c.markdown {
+			t.Errorf("%s: expected the outline %q, got %q", c.name, c.markdown, buf.String())
+		}
+		for idx, slideID := range f.GetSlideList() {
+			slide, err := f.slideReader(slideID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if count := len(slide.CommonSlideData.ShapeTree.GraphicFrame); count != c.graphicFrames[idx] {
+				t.Errorf("%s: expected %d graphic frames on the slide %d, got %d", c.name, c.graphicFrames[idx], idx+1, count)
+			}
+		}
+	}
+	if _, err := BuildFromDefinition(strings.NewReader(`{"slides":`), nil); err == nil {
+		t.Error("expected error on the malformed definition")
+	}
+}
@@ -0,0 +1,191 @@
+// Copyright 2026 kenny-not-dead. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+//
+// Package gopptx provides functionality to create and manipulate PowerPoint
+// (.pptx) files in Go, using the Office Open XML (ECMA-376) format.
+
+package gopptx
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log/slog"
+	"path"
+	"strings"
+)
+
+// DiagnosticKind defined the type of the kind of the non-fatal issue found
+// on reading the presentation.
+type DiagnosticKind byte
+
+// This section defines the currently supported kinds of the diagnostics.
+const (
+	// DiagnosticSkippedEntry reports the entry of the archive which is
+	// skipped, such as the entry outside of the package.
+	DiagnosticSkippedEntry DiagnosticKind = iota
+	// DiagnosticRenamedEntry reports the entry of the archive which name is
+	// converted to the canonical part name.
+	DiagnosticRenamedEntry
+	// DiagnosticUnknownPart reports the part which has no content type or
+	// isn't referenced by any relationship.
+	DiagnosticUnknownPart
+	// DiagnosticSkippedElement reports the element which isn't kept on
+	// saving the changed part, or the mc:AlternateContent blocks replaced
+	// by the policy of the options.
+	DiagnosticSkippedElement
+	// DiagnosticIgnoredAttribute reports the attribute which is ignored on
+	// reading the part.
+	DiagnosticIgnoredAttribute
+	// DiagnosticNamespaceFix reports the part which namespaces or charset
+	// are converted on reading, such as the strict namespaces.
+	DiagnosticNamespaceFix
+)
+
+// Diagnostic directly maps the non-fatal issue found on reading the
+// presentation, which may not round-trip faithfully. The part is the name of
+// the part or the entry of the archive, and the message describes the issue
+// with the details.
+type Diagnostic struct {
+	Kind    DiagnosticKind
+	Part    string
+	Message string
+}
+
+// DiagnosticsCollector is the interface receiving the non-fatal issues found
+// on reading the presentation, which is set by the DiagnosticsCollector
+// option. For example, collect the issues of the ingested presentation:
+//
+//	var diagnostics []gopptx.Diagnostic
+//	f, err := gopptx.OpenFile("Presentation.pptx", gopptx.WithDiagnosticsCollector(
+//	    gopptx.DiagnosticsFunc(func(d gopptx.Diagnostic) {
+//	        diagnostics = append(diagnostics, d)
+//	    }),
+//	))
+type DiagnosticsCollector interface {
+	Collect(d Diagnostic)
+}
+
+// DiagnosticsFunc is the adapter to use the ordinary function as the
+// DiagnosticsCollector.
+type DiagnosticsFunc func(d Diagnostic)
+
+// Collect implements the DiagnosticsCollector interface by calling the
+// function.
+func (fn DiagnosticsFunc) Collect(d Diagnostic) {
+	fn(d)
+}
+
+// spTreeElements defined the elements of the shape tree which are kept on
+// saving the changed slide.
+var spTreeElements = map[string]bool{
+	"nvGrpSpPr": true, "grpSpPr": true, "sp": true, "pic": true, "graphicFrame": true,
+	"grpSp": true, "contentPart": true, "AlternateContent": true, "extLst": true,
+}
+
+// loggerDiagnosticsCollector is the DiagnosticsCollector reporting the
+// non-fatal issues to the logger, which is used if the DiagnosticsCollector
+// option isn't set.
+type loggerDiagnosticsCollector struct {
+	logger *slog.Logger
+}
+
+// Collect implements the DiagnosticsCollector interface by logging the
+// issue, the renamed entries and the converted namespaces are logged as
+// information, and the other issues as warnings.
+func (c loggerDiagnosticsCollector) Collect(d Diagnostic) {
+	level := slog.LevelWarn
+	if d.Kind == DiagnosticRenamedEntry || d.Kind == DiagnosticNamespaceFix {
+		level = slog.LevelInfo
+	}
+	c.logger.Log(context.Background(), level, d.Message, "part", d.Part)
+}
+
+// diagnosticsCollector returns the collector receiving the non-fatal issues
+// of the presentation, the issues are reported to the logger if the
+// DiagnosticsCollector option isn't set.
+func (f *File) diagnosticsCollector() DiagnosticsCollector {
+	if f.options != nil && f.options.DiagnosticsCollector != nil {
+		return f.options.DiagnosticsCollector
+	}
+	return loggerDiagnosticsCollector{logger: f.logger()}
+}
+
+// diagnose provides a function to report the non-fatal issue to the
+// diagnostics collector by given kind, part name, message and the key-value
+// pairs of the details.
+func (f *File) diagnose(kind DiagnosticKind, partName, message string, args ...interface{}) {
+	var details []string
+	for i := 0; i+1 < len(args); i += 2 {
+		details = append(details, fmt.Sprintf("%v=%v", args[i], args[i+1]))
+	}
+	d := Diagnostic{Kind: kind, Part: partName, Message: message}
+	if len(details) > 0 {
+		d.Message += " (" + strings.Join(details, ", ") + ")"
+	}
+	f.diagnosticsCollector().Collect(d)
+}
+
+// diagnoseParts provides a function to report the parts which have no
+// content type or aren't referenced by any relationship, the parts in the
+// strict namespaces, and the elements of the shape trees of the slides which
+// aren't kept on saving the changed slides. It's skipped if neither the
+// diagnostics collector nor the logger is set.
+func (f *File) diagnoseParts() error {
+	if f.options.DiagnosticsCollector == nil && f.options.Logger == nil {
+		return nil
+	}
+	parts := f.getPartNames()
+	referenced := map[string]bool{}
+	for _, name := range parts {
+		if !strings.HasSuffix(name, ".rels") {
+			continue
+		}
+		source := strings.TrimSuffix(strings.Replace(name, "_rels/", "", 1), ".rels")
+		for _, rel := range getRelationships(f.getRels(name)) {
+			if rel.TargetMode != "External" {
+				referenced[resolveRelTarget(source, rel.Target)] = true
+			}
+		}
+	}
+	for _, name := range parts {
+		if name == defaultXMLPathContentTypes || strings.HasSuffix(name, ".rels") ||
+			path.Ext(name) == "" && len(f.readXML(name)) == 0 {
+			continue
+		}
+		contentType, _, err := f.getPartContentType(name)
+		if err != nil {
+			return err
+		}
+		if contentType == "" {
+			f.diagnose(DiagnosticUnknownPart, name, "found the part without content type")
+		} else if !referenced[name] {
+			f.diagnose(DiagnosticUnknownPart, name, "found the part not referenced by any relationship", "contentType", contentType)
+		}
+		content, ok := f.Pkg.Load(name)
+		if !ok {
+			continue
+		}
+		if bytes.Contains(content.([]byte), []byte("http://purl.oclc.org/ooxml/")) {
+			f.diagnose(DiagnosticNamespaceFix, name, "converted the strict namespaces to the transitional namespaces")
+		}
+		if !strings.HasPrefix(name, "ppt/slides/slide") || !strings.HasSuffix(name, ".xml") {
+			continue
+		}
+		root, err := f.xmlNodeReader(content.([]byte))
+		if err != nil {
+			return err
+		}
+		spTree := root.find("cSld", "spTree")
+		if spTree == nil {
+			continue
+		}
+		for _, child := range spTree.Children {
+			if child.Name != "" && !spTreeElements[localName(child.Name)] {
+				f.diagnose(DiagnosticSkippedElement, name, "found the element not kept on saving the changed slide", "element", child.Name)
+			}
+		}
+	}
+	return nil
+}
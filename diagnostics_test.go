@@ -0,0 +1,51 @@
+package gopptx
+
+import (
+	"bytes"
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+func TestDiagnoseUnexpectedNamespace(t *testing.T) {
+	for _, c := range []struct {
+		name      string
+		collector bool
+		logged    int
+		collected int
+	}{
+		{name: "logger", logged: 1},
+		{name: "collector and logger", collector: true, collected: 1},
+	} {
+		var (
+			buf       bytes.Buffer
+			collected int
+		)
+		opts := []Option{WithLogger(slog.New(slog.NewTextHandler(&buf, nil)))}
+		if c.collector {
+			opts = append(opts, WithDiagnosticsCollector(DiagnosticsFunc(func(d Diagnostic) {
+				if d.Kind == DiagnosticIgnoredAttribute && strings.Contains(d.Message, "urn:unexpected") {
+					collected++
+				}
+			})))
+		}
+		f, err := openTestFile(t, func(name string, content []byte) []byte {
+			if name == defaultXMLPathPresentation {
+				return bytes.Replace(content, []byte(`<p:sldId `), []byte(`<p:sldId xmlns:x="urn:unexpected" x:id="1" `), 1)
+			}
+			return content
+		}, opts...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err = f.presentationReader(); err != nil {
+			t.Fatal(err)
+		}
+		if logged := strings.Count(buf.String(), "urn:unexpected"); logged != c.logged {
+			t.Errorf("%s: expected %d logged warnings, got %d", c.name, c.logged, logged)
+		}
+		if collected != c.collected {
+			t.Errorf("%s: expected %d collected diagnostics, got %d", c.name, c.collected, collected)
+		}
+	}
+}
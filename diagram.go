@@ -0,0 +1,184 @@
+// Copyright 2026 kenny-not-dead. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+//
+// Package gopptx provides functionality to create and manipulate PowerPoint
+// (.pptx) files in Go, using the Office Open XML (ECMA-376) format.
+
+package gopptx
+
+import "strconv"
+
+// This section defines the types of the business diagrams.
+const (
+	// DiagramProcess places the chevrons from left to right.
+	DiagramProcess DiagramType = iota
+	// DiagramFunnel stacks the trapezoids narrowing from top to bottom.
+	DiagramFunnel
+	// DiagramPyramid stacks the triangle and the trapezoids widening from
+	// top to bottom.
+	DiagramPyramid
+)
+
+// DiagramType defined the type of the business diagram.
+type DiagramType byte
+
+// DiagramOptions directly maps the settings of the business diagram. The
+// offset and size of the bounding box of the diagram are specified in EMUs,
+// the bounding box is the middle half of the slide inside the 0.5 inch
+// margins if the width or height is zero. The font size of the labels is in
+// points with default 14.
+type DiagramOptions struct {
+	OffsetX  int
+	OffsetY  int
+	Width    int
+	Height   int
+	FontSize int
+}
+
+// AddDiagram provides a function to add the business diagram as the group
+// shape of the native shapes by given slide id, diagram type, labels of the
+// steps and the diagram settings, it returns the shape id of the group. The
+// number of the steps is the number of the labels, and the steps are filled
+// by the accent colors of the theme in turn with the labels in the light
+// color of the theme, so the diagram follows the theme of the presentation.
+// For example, add the funnel of the sales stages:
+//
+//	shapeID, err := f.AddDiagram(256, gopptx.DiagramFunnel,
+//	    []string{"Leads", "Qualified", "Proposal", "Closed"}, nil)
+func (f *File) AddDiagram(slideID int, diagramType DiagramType, labels []string, opts *DiagramOptions) (int, error) {
+	if err := f.checkReadOnly(); err != nil {
+		return 0, err
+	}
+	slide, err := f.slideReader(slideID)
+	if err != nil {
+		return 0, err
+	}
+	if len(labels) == 0 {
+		return 0, ErrDiagramLabelsEmpty
+	}
+	if opts == nil {
+		opts = &DiagramOptions{}
+	}
+	box, fontSize := *opts, opts.FontSize
+	if fontSize <= 0 {
+		fontSize = 14
+	}
+	if box.Width <= 0 || box.Height <= 0 {
+		slideWidth, slideHeight, err := f.getSlideSize()
+		if err != nil {
+			return 0, err
+		}
+		box.OffsetX, box.OffsetY, box.Width, box.Height = 457200, slideHeight/4, slideWidth-2*457200, slideHeight/2
+	}
+	shapeID := slide.nextShapeID()
+	group := decodeGroupShape{
+		NonVisualGroupShapeProperties: &decodeNonVisualGroupShapeProperties{
+			CommonNonVisualProperties:           &CommonNonVisualProperties{ID: shapeID, Name: "Diagram"},
+			CommonNonVisualGroupShapeProperties: &CommonNonVisualGroupShapeProperties{},
+			NonVisualProperties:                 &decodeNonVisualProperties{},
+		},
+		GroupShapeProperties: &decodeGroupShapeProperties{Xfrm: &DecodeXfrm{
+			Offset:       &Offset{X: box.OffsetX, Y: box.OffsetY},
+			Extents:      &Extents{CX: box.Width, CY: box.Height},
+			ChildOffset:  &Offset{X: box.OffsetX, Y: box.OffsetY},
+			ChildExtents: &Extents{CX: box.Width, CY: box.Height},
+		}},
+	}
+	count, gap := len(labels), 3*EMUPerPoint
+	for i, label := range labels {
+		var (
+			step   diagramStep
+			accent = "accent" + strconv.Itoa(i%6+1)
+		)
+		switch diagramType {
+		case DiagramProcess:
+			// Overlap the chevrons by the depth of the points, which is the
+			// half of the height by default.
+			depth := box.Height / 2
+			width := (box.Width + (count-1)*(depth-gap)) / count
+			step = diagramStep{preset: "chevron", offset: Offset{X: box.OffsetX + i*(width-depth+gap), Y: box.OffsetY}}
+			step.extents = Extents{CX: width, CY: box.Height}
+			step.label = Offset{X: step.offset.X + depth/2, Y: step.offset.Y}
+			step.labelExtents = Extents{CX: width - depth, CY: box.Height}
+			if i == 0 {
+				step.preset = "homePlate"
+				step.label.X, step.labelExtents.CX = step.offset.X, width-depth/2
+			}
+		case DiagramFunnel, DiagramPyramid:
+			height := (box.Height - (count-1)*gap) / count
+			// The widths of the levels change linearly, the funnel narrows to
+			// the third of the width and the pyramid widens from the apex.
+			widthAt := func(level int) int { return box.Width * level / count }
+			if diagramType == DiagramFunnel {
+				widthAt = func(level int) int { return box.Width - box.Width*2/3*level/count }
+			}
+			top, bottom := widthAt(i), widthAt(i+1)
+			width := max(top, bottom)
+			step = diagramStep{
+				preset:  "trapezoid",
+				offset:  Offset{X: box.OffsetX + (box.Width-width)/2, Y: box.OffsetY + i*(height+gap)},
+				extents: Extents{CX: width, CY: height},
+				flipV:   diagramType == DiagramFunnel,
+			}
+			step.adjust = (width - min(top, bottom)) / 2 * 100000 / max(min(width, height), 1)
+			if min(top, bottom) == 0 {
+				step.preset, step.adjust = "triangle", 0
+			}
+			step.label, step.labelExtents = step.offset, step.extents
+		}
+		shapeID++
+		group.Shape = append(group.Shape, step.newShape(shapeID, "Diagram Step "+label, accent))
+		shapeID++
+		caption := newCaptionShape(shapeID, label, fontSize, step.label, step.labelExtents)
+		caption.NonVisualShapeProperties.CommonNonVisualProperties.Name = "Diagram Label " + label
+		anchor := "ctr"
+		caption.TextBody.BodyProperties.Anchor = &anchor
+		caption.TextBody.Paragraph[0].Runs[0].RunProperties.SolidFill = &DecodeSolidFill{SchemeColor: &DecodeSchemeColor{Val: "lt1"}}
+		group.Shape = append(group.Shape, caption)
+	}
+	slide.CommonSlideData.ShapeTree.GroupShape = append(slide.CommonSlideData.ShapeTree.GroupShape, group)
+	return group.NonVisualGroupShapeProperties.CommonNonVisualProperties.ID, nil
+}
+
+// diagramStep defines the geometry of the step of the business diagram, the
+// adjust value is the first adjust value of the preset geometry and is
+// omitted if it is zero. The label is the offset and size of the text box of
+// the step.
+type diagramStep struct {
+	preset       string
+	adjust       int
+	flipV        bool
+	offset       Offset
+	extents      Extents
+	label        Offset
+	labelExtents Extents
+}
+
+// newShape provides a function to create the shape of the step filled by the
+// theme color by given shape id, shape name and theme color name.
+func (ds *diagramStep) newShape(shapeID int, name, clr string) decodeShape {
+	offset, extents := ds.offset, ds.extents
+	shape := decodeShape{
+		NonVisualShapeProperties: &decodeNonVisualShapeProperties{
+			CommonNonVisualProperties:      &CommonNonVisualProperties{ID: shapeID, Name: name},
+			CommonNonVisualShapeProperties: &decodeCommonNonVisualShapeProperties{},
+			NonVisualProperties:            &decodeNonVisualProperties{},
+		},
+		ShapeProperties: &DecodeShapeProperties{
+			Xfrm:           &DecodeXfrm{Offset: &offset, Extents: &extents},
+			PresetGeometry: &DecodePresetGeometry{Preset: ds.preset, AdjustValueList: &AdjustValueList{}},
+			SolidFill:      &DecodeSolidFill{SchemeColor: &DecodeSchemeColor{Val: clr}},
+			Ln:             &decodeLine{NoFill: &noFill{}},
+		},
+	}
+	if ds.flipV {
+		shape.ShapeProperties.Xfrm.FlipV = &ds.flipV
+	}
+	if ds.adjust > 0 {
+		shape.ShapeProperties.PresetGeometry.AdjustValueList.AdjustValue = []AdjustValue{
+			{Name: "adj", Formula: "val " + strconv.Itoa(ds.adjust)},
+		}
+	}
+	return shape
+}
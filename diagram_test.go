@@ -0,0 +1,97 @@
+package gopptx
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestAddDiagram(t *testing.T) {
+	type diagramShape struct {
+		ID      int
+		Name    string
+		Preset  string
+		Adjust  string
+		FlipV   bool
+		OffsetX int
+		Width   int
+		Fill    string
+	}
+	for _, c := range []struct {
+		diagramType DiagramType
+		expected    []diagramShape
+	}{
+		{
+			diagramType: DiagramProcess,
+			expected: []diagramShape{
+				{ID: 10, Name: "Diagram Step Plan", Preset: "homePlate", OffsetX: 0, Width: 1274600, Fill: "accent1"},
+				{ID: 12, Name: "Diagram Step Build", Preset: "chevron", OffsetX: 862700, Width: 1274600, Fill: "accent2"},
+				{ID: 14, Name: "Diagram Step Ship", Preset: "chevron", OffsetX: 1725400, Width: 1274600, Fill: "accent3"},
+			},
+		},
+		{
+			diagramType: DiagramFunnel,
+			expected: []diagramShape{
+				{ID: 10, Name: "Diagram Step Plan", Preset: "trapezoid", Adjust: "val 121388", FlipV: true, OffsetX: 0, Width: 3000000, Fill: "accent1"},
+				{ID: 12, Name: "Diagram Step Build", Preset: "trapezoid", Adjust: "val 121388", FlipV: true, OffsetX: 333333, Width: 2333334, Fill: "accent2"},
+				{ID: 14, Name: "Diagram Step Ship", Preset: "trapezoid", Adjust: "val 121388", FlipV: true, OffsetX: 666666, Width: 1666667, Fill: "accent3"},
+			},
+		},
+		{
+			diagramType: DiagramPyramid,
+			expected: []diagramShape{
+				{ID: 10, Name: "Diagram Step Plan", Preset: "triangle", OffsetX: 1000000, Width: 1000000, Fill: "accent1"},
+				{ID: 12, Name: "Diagram Step Build", Preset: "trapezoid", Adjust: "val 182083", OffsetX: 500000, Width: 2000000, Fill: "accent2"},
+				{ID: 14, Name: "Diagram Step Ship", Preset: "trapezoid", Adjust: "val 182083", OffsetX: 0, Width: 3000000, Fill: "accent3"},
+			},
+		},
+	} {
+		f := NewFile()
+		slideID := f.GetSlideList()[0]
+		shapeID, err := f.AddDiagram(slideID, c.diagramType, []string{"Plan", "Build", "Ship"}, &DiagramOptions{Width: 3000000, Height: 900000})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if shapeID != 9 {
+			t.Errorf("expected the group shape id 9, got %d", shapeID)
+		}
+		slide, err := f.slideReader(slideID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var steps []diagramShape
+		for i, shape := range slide.CommonSlideData.ShapeTree.GroupShape[0].Shape {
+			if i%2 == 1 {
+				if label := shape.TextBody.text(); "Diagram Step "+label != steps[len(steps)-1].Name {
+					t.Errorf("unexpected label %s of the step %s", label, steps[len(steps)-1].Name)
+				}
+				continue
+			}
+			spPr := shape.ShapeProperties
+			step := diagramShape{
+				ID:      shape.NonVisualShapeProperties.CommonNonVisualProperties.ID,
+				Name:    shape.NonVisualShapeProperties.CommonNonVisualProperties.Name,
+				Preset:  spPr.PresetGeometry.Preset,
+				FlipV:   spPr.Xfrm.FlipV != nil && *spPr.Xfrm.FlipV,
+				OffsetX: spPr.Xfrm.Offset.X,
+				Width:   spPr.Xfrm.Extents.CX,
+				Fill:    spPr.SolidFill.SchemeColor.Val,
+			}
+			if adjust := spPr.PresetGeometry.AdjustValueList.AdjustValue; len(adjust) > 0 {
+				step.Adjust = adjust[0].Formula
+			}
+			steps = append(steps, step)
+		}
+		if !reflect.DeepEqual(steps, c.expected) {
+			t.Errorf("expected the diagram steps %v, got %v", c.expected, steps)
+		}
+	}
+
+	f := NewFile()
+	if _, err := f.AddDiagram(f.GetSlideList()[0], DiagramFunnel, nil, nil); !errors.Is(err, ErrDiagramLabelsEmpty) {
+		t.Errorf("expected ErrDiagramLabelsEmpty, got %v", err)
+	}
+	if _, err := f.AddDiagram(300, DiagramFunnel, []string{"Leads"}, nil); !errors.Is(err, ErrSlideNotExist{300}) {
+		t.Errorf("expected ErrSlideNotExist, got %v", err)
+	}
+}
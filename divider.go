@@ -0,0 +1,178 @@
+// Copyright 2026 kenny-not-dead. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+//
+// Package gopptx provides functionality to create and manipulate PowerPoint
+// (.pptx) files in Go, using the Office Open XML (ECMA-376) format.
+
+package gopptx
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+const (
+	// dividerSlideName is the name of the common slide data of the section
+	// divider slides, which is used to find the slides for refreshing.
+	dividerSlideName = "Section Divider"
+	// dividerNumberShapeName is the name of the text box of the section
+	// number on the divider slides without the body placeholder.
+	dividerNumberShapeName = "Section Number"
+)
+
+// SectionDividerOptions directly maps the settings of the section divider
+// slides. The color is the hex RGB accent color of the section name and the
+// accent bar with default "4472C4". The number format is the format string of
+// the one-based section number, such as "Part %d", with default "Section %d",
+// the number will be hidden if HideNumber is true.
+type SectionDividerOptions struct {
+	Color        string
+	NumberFormat string
+	HideNumber   bool
+}
+
+// AddSectionDividers provides a function to insert a divider slide at the
+// start of each section of the presentation, which shows the section number
+// and name with an accent bar on the left side. The sections without slides
+// are skipped. If the first slide of a section is already a divider slide
+// created by this function, the slide will be refreshed in place, so call it
+// again after the sections changed. It returns the slide IDs of the divider
+// slides in the order of the sections. For example:
+//
+//	slideIDs, err := f.AddSectionDividers(&gopptx.SectionDividerOptions{Color: "C00000"})
+func (f *File) AddSectionDividers(opts *SectionDividerOptions) ([]int, error) {
+	if err := f.checkReadOnly(); err != nil {
+		return nil, err
+	}
+	if opts == nil {
+		opts = &SectionDividerOptions{}
+	}
+	clr, format := strings.TrimPrefix(opts.Color, "#"), opts.NumberFormat
+	if clr == "" {
+		clr = "4472C4"
+	}
+	if format == "" {
+		format = "Section %d"
+	}
+	sections, err := f.GetSections()
+	if err != nil {
+		return nil, err
+	}
+	var slideIDs []int
+	for idx, s := range sections {
+		if len(s.SlideIDs) == 0 {
+			continue
+		}
+		slide, err := f.slideReader(s.SlideIDs[0])
+		if err != nil {
+			return nil, err
+		}
+		slideID := s.SlideIDs[0]
+		if slide.CommonSlideData.Name != dividerSlideName {
+			if slideID, err = f.insertSectionSlide(idx, s.SlideIDs[0]); err != nil {
+				return nil, err
+			}
+			if slide, err = f.slideReader(slideID); err != nil {
+				return nil, err
+			}
+		}
+		var number string
+		if !opts.HideNumber {
+			number = fmt.Sprintf(format, idx+1)
+		}
+		if err = f.setSectionDivider(slide, s.Name, number, clr); err != nil {
+			return nil, err
+		}
+		slideIDs = append(slideIDs, slideID)
+	}
+	return slideIDs, nil
+}
+
+// insertSectionSlide provides a function to create a slide before the slide
+// by given slide id, and put it at the start of the section by given section
+// index. It returns the slide id of the created slide.
+func (f *File) insertSectionSlide(sectionIdx, beforeSlideID int) (int, error) {
+	index, err := f.GetSlideIndex(beforeSlideID)
+	if err != nil {
+		return 0, err
+	}
+	slideID, err := f.NewSlide()
+	if err != nil {
+		return 0, err
+	}
+	if err = f.moveSlide(slideID, index); err != nil {
+		return 0, err
+	}
+	return slideID, f.updateSectionSlides(func(idx int, slideIDs []int) []int {
+		slideIDs = slices.DeleteFunc(slideIDs, func(id int) bool { return id == slideID })
+		if idx == sectionIdx {
+			return slices.Insert(slideIDs, 0, slideID)
+		}
+		return slideIDs
+	})
+}
+
+// setSectionDivider provides a function to set the content of the divider
+// slide by given section name, number text and accent color.
+func (f *File) setSectionDivider(slide *decodeSlide, name, number, clr string) error {
+	slideWidth, slideHeight, err := f.getSlideSize()
+	if err != nil {
+		return err
+	}
+	slide.CommonSlideData.Name = dividerSlideName
+	titleShape := slide.getTitleShape()
+	if titleShape == nil {
+		return ErrPlaceholderNotExist
+	}
+	setPlaceholderParagraphs(titleShape, []JSONParagraph{{Runs: []JSONRun{{Text: name, Color: clr}}}})
+	// Show the number in the text box below the title if the layout of the
+	// slide has no body placeholder, such as the title only layout.
+	numberShape := slide.getPlaceholder("body", nil, nil)
+	if numberShape == nil {
+		numberShape = slide.getShapeByName(dividerNumberShapeName)
+	}
+	if numberShape == nil {
+		slide.CommonSlideData.ShapeTree.Shape = append(slide.CommonSlideData.ShapeTree.Shape, newTextBox(slide.nextShapeID(), TextBoxOptions{
+			Name: dividerNumberShapeName, Text: number, OffsetX: slideWidth / 12, OffsetY: slideHeight * 3 / 5,
+			Width: slideWidth * 5 / 6, Height: slideHeight / 8, FontSize: 20,
+		}))
+	} else {
+		setPlaceholderParagraphs(numberShape, []JSONParagraph{{Runs: []JSONRun{{Text: number}}}})
+	}
+	if accentBar := slide.getShapeByName("Accent Bar"); accentBar != nil && accentBar.ShapeProperties != nil {
+		accentBar.ShapeProperties.SolidFill = &DecodeSolidFill{SolidRGBColor: &SolidRGBColor{Val: clr}}
+		return nil
+	}
+	slide.CommonSlideData.ShapeTree.Shape = append(slide.CommonSlideData.ShapeTree.Shape, decodeShape{
+		NonVisualShapeProperties: &decodeNonVisualShapeProperties{
+			CommonNonVisualProperties:      &CommonNonVisualProperties{ID: slide.nextShapeID(), Name: "Accent Bar"},
+			CommonNonVisualShapeProperties: &decodeCommonNonVisualShapeProperties{},
+			NonVisualProperties:            &decodeNonVisualProperties{},
+		},
+		ShapeProperties: &DecodeShapeProperties{
+			Xfrm: &DecodeXfrm{
+				Offset:  &Offset{},
+				Extents: &Extents{CX: slideWidth / 40, CY: slideHeight},
+			},
+			PresetGeometry: &DecodePresetGeometry{Preset: "rect", AdjustValueList: &AdjustValueList{}},
+			SolidFill:      &DecodeSolidFill{SolidRGBColor: &SolidRGBColor{Val: clr}},
+			Ln:             &decodeLine{NoFill: &noFill{}},
+		},
+	})
+	return nil
+}
+
+// getShapeByName returns the shape of the slide by given shape name, nil will
+// be returned if the slide has no such shape.
+func (ds *decodeSlide) getShapeByName(name string) *decodeShape {
+	for i := range ds.CommonSlideData.ShapeTree.Shape {
+		shape := &ds.CommonSlideData.ShapeTree.Shape[i]
+		if shape.NonVisualShapeProperties != nil && shape.NonVisualShapeProperties.CommonNonVisualProperties != nil &&
+			shape.NonVisualShapeProperties.CommonNonVisualProperties.Name == name {
+			return shape
+		}
+	}
+	return nil
+}
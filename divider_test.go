@@ -0,0 +1,48 @@
+package gopptx
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestAddSectionDividers(t *testing.T) {
+	f := NewFile()
+	if err := f.SetSections([]Section{{Name: "Intro", SlideIDs: f.GetSlideList()}}); err != nil {
+		t.Fatal(err)
+	}
+	for _, c := range []struct {
+		name       string
+		removeBody bool
+	}{
+		{name: "body placeholder", removeBody: true},
+		{name: "no body placeholder"},
+		{name: "refresh without body placeholder"},
+	} {
+		slideIDs, err := f.AddSectionDividers(&SectionDividerOptions{NumberFormat: "Part %d"})
+		if err != nil {
+			t.Fatalf("%s: %v", c.name, err)
+		}
+		if len(slideIDs) != 1 || len(f.GetSlideList()) != 2 {
+			t.Fatalf("%s: expected 1 divider slide of 2 slides, got %v %v", c.name, slideIDs, f.GetSlideList())
+		}
+		slide, err := f.slideReader(slideIDs[0])
+		if err != nil {
+			t.Fatal(err)
+		}
+		var numbers int
+		for _, shape := range slide.getShapes() {
+			if shape.TextBody != nil && len(shape.TextBody.Paragraph) > 0 && len(shape.TextBody.Paragraph[0].Runs) > 0 &&
+				shape.TextBody.Paragraph[0].Runs[0].Text == "Part 1" {
+				numbers++
+			}
+		}
+		if numbers != 1 {
+			t.Errorf("%s: expected 1 section number, got %d", c.name, numbers)
+		}
+		if c.removeBody {
+			slide.CommonSlideData.ShapeTree.Shape = slices.DeleteFunc(slide.CommonSlideData.ShapeTree.Shape, func(shape decodeShape) bool {
+				return shape.isBody()
+			})
+		}
+	}
+}
@@ -0,0 +1,269 @@
+// Copyright 2026 kenny-not-dead. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+//
+// Package gopptx provides functionality to create and manipulate PowerPoint
+// (.pptx) files in Go, using the Office Open XML (ECMA-376) format.
+
+package gopptx
+
+import (
+	"bytes"
+	"encoding/xml"
+	"io"
+	"strings"
+)
+
+// DocProperties directly maps the core properties and the company of the
+// extended properties of the presentation. The empty properties are kept as
+// is on setting the properties.
+type DocProperties struct {
+	Title          string
+	Subject        string
+	Creator        string
+	Keywords       string
+	Description    string
+	Category       string
+	LastModifiedBy string
+	Company        string
+}
+
+// SetDocProps provides a function to set the document properties of the
+// presentation. For example:
+//
+//	err := f.SetDocProps(&gopptx.DocProperties{
+//	    Title:   "Quarterly Report",
+//	    Creator: "Finance Team",
+//	    Company: "Example Inc.",
+//	})
+func (f *File) SetDocProps(props *DocProperties) error {
+	if err := f.checkReadOnly(); err != nil {
+		return err
+	}
+	core, err := f.docPropsNodeReader(defaultXMLPathDocPropsCore, ContentTypeCoreProperties,
+		SourceRelationshipCoreProperties, "cp:coreProperties")
+	if err != nil {
+		return err
+	}
+	for _, ns := range [][]string{
+		{"xmlns:cp", "http://schemas.openxmlformats.org/package/2006/metadata/core-properties"},
+		{"xmlns:dc", "http://purl.org/dc/elements/1.1/"},
+	} {
+		if core.attr(ns[0]) == "" {
+			core.setAttr(ns[0], ns[1])
+		}
+	}
+	for name, value := range map[string]string{
+		"dc:title": props.Title, "dc:subject": props.Subject, "dc:creator": props.Creator,
+		"cp:keywords": props.Keywords, "dc:description": props.Description,
+		"cp:category": props.Category, "cp:lastModifiedBy": props.LastModifiedBy,
+	} {
+		setDocProp(core, name, value)
+	}
+	app, err := f.docPropsNodeReader(defaultXMLPathDocPropsApp, ContentTypeExtendedProperties,
+		SourceRelationshipExtendedProperties, "Properties", "xmlns", NameSpaceExtendedProperties)
+	if err != nil {
+		return err
+	}
+	setDocProp(app, "Company", props.Company)
+	f.saveFileList(defaultXMLPathDocPropsCore, core.bytes())
+	f.saveFileList(defaultXMLPathDocPropsApp, app.bytes())
+	return nil
+}
+
+// docPropsNodeReader provides a function to get the element tree of the
+// document properties part by given part name, the content type and the
+// relationship type of the part, the name and the attributes of the root
+// element. The part is created with the root element if it doesn't exist.
+func (f *File) docPropsNodeReader(partName, contentType, relType, rootName string, attrs ...string) (*xmlNode, error) {
+	if content := f.readXML(partName); len(content) != 0 {
+		return f.xmlNodeReader(content)
+	}
+	if err := f.setContentTypes("/"+partName, contentType); err != nil {
+		return nil, err
+	}
+	f.addRels("_rels/.rels", relType, partName, "")
+	return newXMLNode(rootName, attrs...), nil
+}
+
+// setDocProp sets the text of the property element of the document
+// properties by given root element, the element name and the value, the
+// element is created if it doesn't exist. The empty value is ignored.
+func setDocProp(root *xmlNode, name, value string) {
+	if value == "" {
+		return
+	}
+	prop := root.find(localName(name))
+	if prop == nil {
+		prop = newXMLNode(name)
+		root.Children = append(root.Children, prop)
+	}
+	prop.Children = []*xmlNode{{Text: value}}
+}
+
+// newAppProperties returns the extended properties to be serialized by given
+// parsed extended properties, the counts and the vectors of the parts are
+// computed by the appPropsWriter.
+func newAppProperties(app *decodeAppProperties) *appProperties {
+	return &appProperties{
+		XMLNS:                NameSpaceExtendedProperties,
+		XMLNSVT:              NameSpaceDocumentPropertiesVariantTypes.Value,
+		Template:             app.Template,
+		TotalTime:            app.TotalTime,
+		Words:                app.Words,
+		Application:          app.Application,
+		PresentationFormat:   app.PresentationFormat,
+		Paragraphs:           app.Paragraphs,
+		MMClips:              app.MMClips,
+		ScaleCrop:            app.ScaleCrop,
+		Manager:              app.Manager,
+		Company:              app.Company,
+		LinksUpToDate:        app.LinksUpToDate,
+		SharedDoc:            app.SharedDoc,
+		HyperlinkBase:        app.HyperlinkBase,
+		HLinks:               app.HLinks,
+		HyperlinksChanged:    app.HyperlinksChanged,
+		DigSig:               app.DigSig,
+		AppVersion:           app.AppVersion,
+		DocSecurity:          app.DocSecurity,
+		Characters:           app.Characters,
+		CharactersWithSpaces: app.CharactersWithSpaces,
+		Lines:                app.Lines,
+		Pages:                app.Pages,
+	}
+}
+
+// appPropsReader provides a function to get the parsed extended properties
+// of the presentation, the part is created with the relationship and the
+// content type if it doesn't exist.
+func (f *File) appPropsReader() (*decodeAppProperties, error) {
+	app := &decodeAppProperties{Application: "GoPPTX"}
+	content := f.readXML(defaultXMLPathDocPropsApp)
+	if len(content) == 0 {
+		if err := f.setContentTypes("/"+defaultXMLPathDocPropsApp, ContentTypeExtendedProperties); err != nil {
+			return nil, err
+		}
+		f.addRels(defaultXMLPathRels, SourceRelationshipExtendedProperties, defaultXMLPathDocPropsApp, "")
+		return app, nil
+	}
+	app.Application = ""
+	if err := f.xmlNewDecoder(bytes.NewReader(namespaceStrictToTransitional(content))).
+		Decode(app); err != nil && err != io.EOF {
+		return nil, err
+	}
+	return app, nil
+}
+
+// appPropsWriter provides a function to save the extended properties of the
+// presentation, the counts of the slides, the notes and the hidden slides,
+// and the fonts, the themes and the slide titles in the heading pairs and the
+// titles of parts are updated to match the presentation.
+func (f *File) appPropsWriter() error {
+	decoded, err := f.appPropsReader()
+	if err != nil {
+		return err
+	}
+	app := newAppProperties(decoded)
+	var fonts, themes, titles []string
+	addFont := func(typeface string) {
+		if typeface != "" && !strings.HasPrefix(typeface, "+") && inStrSlice(fonts, typeface, true) == -1 {
+			fonts = append(fonts, typeface)
+		}
+	}
+	for _, masterXMLPath := range f.getSlideMasterPaths() {
+		theme, err := f.getMasterThemeNode(masterXMLPath)
+		if err != nil {
+			return err
+		}
+		if theme == nil {
+			continue
+		}
+		fontScheme := theme.find("themeElements", "fontScheme")
+		addFont(fontScheme.find("majorFont", "latin").attr("typeface"))
+		addFont(fontScheme.find("minorFont", "latin").attr("typeface"))
+		themes = append(themes, theme.attr("name"))
+	}
+	presentation, err := f.presentationReader()
+	if err != nil {
+		return err
+	}
+	if presentation.Slides != nil {
+		for _, s := range presentation.Slides.Slide {
+			slide, err := f.slideNodeReader(s.SlideID)
+			if err != nil {
+				return err
+			}
+			app.Slides++
+			if show := slide.attr("show"); show == "0" || show == "false" {
+				app.HiddenSlides++
+			}
+			if slideXMLPath, ok := f.getSlideXMLPath(s.SlideID); ok {
+				if _, ok = f.getRelTargetByType(slideXMLPath, SourceRelationshipNotesSlide); ok {
+					app.Notes++
+				}
+			}
+			walkXMLNode(slide, func(node *xmlNode) {
+				if localName(node.Name) == "latin" {
+					addFont(node.attr("typeface"))
+				}
+			})
+			titles = append(titles, getSlideNodeTitle(slide))
+		}
+	}
+	var pairs []appVariant
+	for _, heading := range []struct {
+		name  string
+		parts []string
+	}{{"Fonts Used", fonts}, {"Theme", themes}, {"Slide Titles", titles}} {
+		if count := len(heading.parts); count > 0 {
+			pairs = append(pairs, appVariant{Lpstr: heading.name}, appVariant{I4: &count})
+		}
+	}
+	if len(pairs) > 0 {
+		parts := append(append(append([]string{}, fonts...), themes...), titles...)
+		app.HeadingPairs = &appVector{Size: len(pairs), BaseType: "variant", Variant: pairs}
+		app.TitlesOfParts = &appVector{Size: len(parts), BaseType: "lpstr", Lpstr: parts}
+	}
+	output, err := xml.Marshal(app)
+	if err != nil {
+		return err
+	}
+	f.saveFileList(defaultXMLPathDocPropsApp, output)
+	return nil
+}
+
+// walkXMLNode calls the function with each descendant element of the element
+// tree in the document order by given element tree and the function.
+func walkXMLNode(node *xmlNode, fn func(node *xmlNode)) {
+	if node == nil {
+		return
+	}
+	for _, child := range node.Children {
+		if child.Name != "" {
+			fn(child)
+			walkXMLNode(child, fn)
+		}
+	}
+}
+
+// getSlideNodeTitle returns the text of the title placeholder by given
+// element tree of the slide, the paragraphs are separated by the spaces.
+// PowerPoint uses "PowerPoint Presentation" as the title of the slide without
+// the title text.
+func getSlideNodeTitle(slide *xmlNode) string {
+	for _, sp := range slide.find("cSld", "spTree").findAll("sp") {
+		if phType := sp.find("nvSpPr", "nvPr", "ph").attr("type"); phType != "title" && phType != "ctrTitle" {
+			continue
+		}
+		var paragraphs []string
+		for _, p := range sp.find("txBody").findAll("p") {
+			if text := strings.TrimSpace(p.text()); text != "" {
+				paragraphs = append(paragraphs, text)
+			}
+		}
+		if len(paragraphs) > 0 {
+			return strings.Join(paragraphs, " ")
+		}
+	}
+	return "PowerPoint Presentation"
+}
@@ -0,0 +1,54 @@
+package gopptx
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestAppPropsWriter(t *testing.T) {
+	f := NewFile()
+	if err := f.SetDocProps(&DocProperties{Company: "Example Inc."}); err != nil {
+		t.Fatal(err)
+	}
+	slide, err := f.slideReader(f.GetSlideList()[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	slide.getTitleShape().TextBody = &DecodeTextBody{Paragraph: []DecodeParagraph{
+		{Runs: []DecodeRuns{{Text: "Quarterly"}}}, {Runs: []DecodeRuns{{Text: " report "}}},
+	}}
+	slideID, err := f.NewSlide()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if slide, err = f.slideReader(slideID); err != nil {
+		t.Fatal(err)
+	}
+	show := false
+	slide.Show = &show
+	slideXMLPath, _ := f.getSlideXMLPath(slideID)
+	f.addRels(getPartRelsPath(slideXMLPath), SourceRelationshipNotesSlide, "../notesSlides/notesSlide1.xml", "")
+	for i := 0; i < 2; i++ {
+		buf, err := f.WriteToBuffer()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if f, err = OpenReader(buf); err != nil {
+			t.Fatal(err)
+		}
+		app := string(f.readXML(defaultXMLPathDocPropsApp))
+		for _, expected := range []string{
+			"<Company>Example Inc.</Company>",
+			"<Application>GoPPTX</Application><Slides>2</Slides><Notes>1</Notes><HiddenSlides>1</HiddenSlides>",
+			`<HeadingPairs><vt:vector size="6" baseType="variant"><vt:variant><vt:lpstr>Fonts Used</vt:lpstr></vt:variant><vt:variant><vt:i4>1</vt:i4></vt:variant>` +
+				`<vt:variant><vt:lpstr>Theme</vt:lpstr></vt:variant><vt:variant><vt:i4>1</vt:i4></vt:variant>` +
+				`<vt:variant><vt:lpstr>Slide Titles</vt:lpstr></vt:variant><vt:variant><vt:i4>2</vt:i4></vt:variant></vt:vector></HeadingPairs>`,
+			`<TitlesOfParts><vt:vector size="4" baseType="lpstr"><vt:lpstr>Arial</vt:lpstr><vt:lpstr>Office</vt:lpstr>` +
+				`<vt:lpstr>Quarterly report</vt:lpstr><vt:lpstr>PowerPoint Presentation</vt:lpstr></vt:vector></TitlesOfParts>`,
+		} {
+			if !strings.Contains(app, expected) {
+				t.Errorf("expected %s in the extended properties, got %s", expected, app)
+			}
+		}
+	}
+}
@@ -0,0 +1,118 @@
+// Copyright 2026 kenny-not-dead. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+//
+// Package gopptx provides functionality to create and manipulate PowerPoint
+// (.pptx) files in Go, using the Office Open XML (ECMA-376) format.
+
+package gopptx
+
+import (
+	"path"
+	"slices"
+	"strings"
+)
+
+// This section defines the kinds of the embedded objects returned by the
+// GetEmbeddedObjects function.
+const (
+	EmbeddedObjectOLE   = "ole"
+	EmbeddedObjectMedia = "media"
+	EmbeddedObjectFont  = "font"
+)
+
+// embeddedObjectDirs defined the directories of the embedded objects in the
+// package by the kinds of the embedded objects.
+var embeddedObjectDirs = map[string]string{
+	"ppt/embeddings": EmbeddedObjectOLE,
+	"ppt/media":      EmbeddedObjectMedia,
+	"ppt/fonts":      EmbeddedObjectFont,
+}
+
+// EmbeddedObject directly maps the embedded object of the presentation. The
+// kind is one of the EmbeddedObject constants, the path is the part name in
+// the package, the content type is the declared content type of the part,
+// the size is the uncompressed size in bytes, and the slide IDs are the
+// slides referencing the object directly or through the parts of the slide,
+// such as the charts referencing the embedded workbooks, in the order of the
+// slides.
+type EmbeddedObject struct {
+	Kind        string
+	Path        string
+	ContentType string
+	Size        int64
+	SlideIDs    []int
+}
+
+// GetEmbeddedObjects provides a function to get every embedded object of the
+// presentation, including the OLE objects, the media and the embedded
+// fonts, in the order of the part names, so the security scanning can flag
+// the executable or oversized content. For example:
+//
+//	objects, err := f.GetEmbeddedObjects()
+//	if err != nil {
+//	    fmt.Println(err)
+//	    return
+//	}
+//	for _, obj := range objects {
+//	    if obj.Kind == gopptx.EmbeddedObjectOLE || obj.Size > 50<<20 {
+//	        fmt.Println(obj.Path, obj.ContentType, obj.Size, obj.SlideIDs)
+//	    }
+//	}
+func (f *File) GetEmbeddedObjects() ([]EmbeddedObject, error) {
+	var objects []EmbeddedObject
+	refs := f.getEmbeddedObjectSlideIDs()
+	for _, part := range f.getPartSizes() {
+		kind, ok := embeddedObjectDirs[path.Dir(part.Name)]
+		if !ok {
+			continue
+		}
+		contentType, _, err := f.getPartContentType(part.Name)
+		if err != nil {
+			return objects, err
+		}
+		objects = append(objects, EmbeddedObject{
+			Kind: kind, Path: part.Name, ContentType: contentType, Size: part.Size, SlideIDs: refs[part.Name],
+		})
+	}
+	return objects, nil
+}
+
+// getEmbeddedObjectSlideIDs provides a function to get the slide IDs
+// referencing each embedded object, the key of the map is the part name of
+// the object. The relationships of the slides are followed through the
+// parts of the slides, except the slides, the layouts, the masters and the
+// notes slides.
+func (f *File) getEmbeddedObjectSlideIDs() map[string][]int {
+	refs := map[string][]int{}
+	for _, slideID := range f.GetSlideList() {
+		slideXMLPath, _ := f.getSlideXMLPath(slideID)
+		visited := map[string]bool{slideXMLPath: true}
+		queue := []string{slideXMLPath}
+		for len(queue) > 0 {
+			source := queue[0]
+			queue = queue[1:]
+			for _, rel := range getRelationships(f.getRels(getPartRelsPath(source))) {
+				if rel.TargetMode == "External" {
+					continue
+				}
+				target := resolveRelTarget(source, rel.Target)
+				if visited[target] {
+					continue
+				}
+				visited[target] = true
+				if _, ok := embeddedObjectDirs[path.Dir(target)]; ok {
+					if !slices.Contains(refs[target], slideID) {
+						refs[target] = append(refs[target], slideID)
+					}
+					continue
+				}
+				if !strings.HasSuffix(rel.Type, "/slide") && !strings.HasSuffix(rel.Type, "/slideLayout") &&
+					!strings.HasSuffix(rel.Type, "/slideMaster") && !strings.HasSuffix(rel.Type, "/notesSlide") {
+					queue = append(queue, target)
+				}
+			}
+		}
+	}
+	return refs
+}
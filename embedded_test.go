@@ -0,0 +1,57 @@
+package gopptx
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestGetEmbeddedObjects(t *testing.T) {
+	f := NewFile(Options{DeduplicateMedia: true})
+	slideID := f.GetSlideList()[0]
+	nextSlideID, err := f.NewSlide()
+	if err != nil {
+		t.Fatal(err)
+	}
+	logo := newTestPNG(t, 8, 8)
+	for _, id := range []int{slideID, nextSlideID} {
+		if _, err = f.AddPictureFromBytes(id, ".png", logo, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	chartIdx, err := f.addChart(nextSlideID, "column", "", Offset{}, Extents{CX: 914400, CY: 914400})
+	if err != nil {
+		t.Fatal(err)
+	}
+	chartXMLPath, _, err := f.chartReader(nextSlideID, chartIdx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	workbook := newTestWorkbook(t)
+	f.Pkg.Store("ppt/embeddings/Microsoft_Excel_Worksheet1.xlsx", workbook)
+	f.addRels(getPartRelsPath(chartXMLPath), SourceRelationshipPackage, "../embeddings/Microsoft_Excel_Worksheet1.xlsx", "")
+	f.Pkg.Store("ppt/fonts/font1.fntdata", []byte("font"))
+	for extension, contentType := range map[string]string{".xlsx": ContentTypeSheetML, ".fntdata": "application/x-fontdata"} {
+		if err = f.setContentTypeDefault(extension, contentType); err != nil {
+			t.Fatal(err)
+		}
+	}
+	buf, err := f.WriteToBuffer()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f, err = OpenReader(buf); err != nil {
+		t.Fatal(err)
+	}
+	objects, err := f.GetEmbeddedObjects()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []EmbeddedObject{
+		{Kind: EmbeddedObjectOLE, Path: "ppt/embeddings/Microsoft_Excel_Worksheet1.xlsx", ContentType: ContentTypeSheetML, Size: int64(len(workbook)), SlideIDs: []int{nextSlideID}},
+		{Kind: EmbeddedObjectFont, Path: "ppt/fonts/font1.fntdata", ContentType: "application/x-fontdata", Size: 4},
+		{Kind: EmbeddedObjectMedia, Path: "ppt/media/image1.png", ContentType: "image/png", Size: int64(len(logo)), SlideIDs: []int{slideID, nextSlideID}},
+	}
+	if !reflect.DeepEqual(objects, expected) {
+		t.Errorf("expected the embedded objects %v, got %v", expected, objects)
+	}
+}
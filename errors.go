@@ -16,6 +16,15 @@ var (
 	// ErrPasswordLengthInvalid defined the error message on invalid password
 	// length.
 	ErrPasswordLengthInvalid = errors.New("password length invalid")
+	// ErrUnprotectPassword defined the error message on receive the password
+	// which doesn't match the password to modify of the presentation.
+	ErrUnprotectPassword = errors.New("the password doesn't match the password to modify")
+	// ErrUnsupportedHashAlgorithm defined the error message on unsupported
+	// hash algorithm of the password.
+	ErrUnsupportedHashAlgorithm = errors.New("unsupported hash algorithm")
+	// ErrReadOnly defined the error message on modifying or saving the
+	// presentation opened with the ReadOnly option.
+	ErrReadOnly = errors.New("the presentation is opened in read-only mode")
 	// ErrOptionsUnzipSizeLimit defined the error message for receiving
 	// invalid UnzipSizeLimit and UnzipXMLSizeLimit.
 	ErrOptionsUnzipSizeLimit = errors.New("the value of UnzipSizeLimit should be greater than or equal to UnzipXMLSizeLimit")
@@ -29,6 +38,129 @@ var (
 	// ErrPresentationFileFormat defined the error message on receive an
 	// unsupported presentation file format.
 	ErrPresentationFileFormat = errors.New("unsupported presentation file format")
+	// ErrImgExt defined the error message on receive an unsupported image
+	// extension.
+	ErrImgExt = errors.New("unsupported image extension")
+	// ErrImgSize defined the error message on receive an image which size
+	// can't be detected without the width and height options.
+	ErrImgSize = errors.New("the width and height of the picture are required for this image type")
+	// ErrPlaceholderImageMode defined the error message on receive the
+	// invalid mode of the picture filling the placeholder.
+	ErrPlaceholderImageMode = errors.New("the mode of the placeholder image must be cover or contain")
+	// ErrPlaceholderNotExist defined the error message on the slide has no
+	// required title or body placeholder.
+	ErrPlaceholderNotExist = errors.New("the slide has no required placeholder")
+	// ErrSectionSlides defined the error message on receive the sections
+	// which don't contain every slide once in the order of the slides.
+	ErrSectionSlides = errors.New("the sections should contain every slide once in the order of the slides")
+	// ErrChartSeriesNotExist defined the error message on receive the non
+	// existing chart series.
+	ErrChartSeriesNotExist = errors.New("chart series does not exist")
+	// ErrChartDataEmpty defined the error message on receive the chart data
+	// without series.
+	ErrChartDataEmpty = errors.New("the chart data should have at least one series")
+	// ErrChartDataRange defined the error message on receive an invalid cell
+	// address of the chart data.
+	ErrChartDataRange = errors.New("invalid chart data range address")
+	// ErrChartWorkbookNotExist defined the error message on the chart has no
+	// embedded workbook.
+	ErrChartWorkbookNotExist = errors.New("the chart has no embedded workbook")
+	// ErrChartWorkbookUpdate defined the error message on updating the
+	// embedded workbook of the chart which isn't a spreadsheet package or
+	// has no worksheet of the chart data range.
+	ErrChartWorkbookUpdate = errors.New("the embedded workbook of the chart can not be updated")
+	// ErrTableStructs defined the error message on receive the table data
+	// which is not a slice of structs with exported fields.
+	ErrTableStructs = errors.New("the table data should be a slice of structs with exported fields")
+	// ErrTableEmpty defined the error message on receive the table data
+	// without any cells.
+	ErrTableEmpty = errors.New("the table data should have at least one cell")
+	// ErrTextRunNotExist defined the error message on receive the paragraph
+	// or text run index which is out of range.
+	ErrTextRunNotExist = errors.New("the text run does not exist")
+	// ErrColorMapInvalid defined the error message on receive the color
+	// mapping to a color which is not a theme color.
+	ErrColorMapInvalid = errors.New("the color mapping should map to the theme colors")
+	// ErrColorInvalid defined the error message on receive the color which
+	// is not a hex RGB color.
+	ErrColorInvalid = errors.New("the color should be a hex RGB color such as 4472C4")
+	// ErrThemeNotExist defined the error message on editing the theme of the
+	// presentation without the theme.
+	ErrThemeNotExist = errors.New("the presentation has no theme")
+	// ErrShapeStyleInvalid defined the error message on receive the shape
+	// style with the negative style matrix index or the font reference which
+	// is not the major or minor font.
+	ErrShapeStyleInvalid = errors.New("the shape style should reference the style matrix and the fonts of the theme")
+	// ErrAlternateContentChoice defined the error message on receive the
+	// alternate content without choices or a choice without the required
+	// namespaces.
+	ErrAlternateContentChoice = errors.New("the alternate content should have choices with the required namespaces")
+	// ErrCharsetUnsupported defined the error message on receive an
+	// unsupported charset of the Charset option.
+	ErrCharsetUnsupported = errors.New("unsupported charset")
+	// ErrMemoryMapUnsupported defined the error message on mapping the file
+	// into memory on the platforms or the files which don't support it.
+	ErrMemoryMapUnsupported = errors.New("memory mapped file unsupported")
+	// ErrMemoryMapOverwrite defined the error message on writing the
+	// presentation into the file which is mapped into memory for reading it,
+	// which would truncate the mapped content before it is read.
+	ErrMemoryMapOverwrite = errors.New("can not overwrite the memory mapped file in place, save it by the Save or SaveAs function")
+	// ErrXMLEntityDeclaration defined the error message on receive the XML
+	// part declaring the entities while the entity references are limited.
+	ErrXMLEntityDeclaration = errors.New("the XML entity declarations are not allowed")
+	// ErrChartTemplate defined the error message on receive the chart
+	// template without the chart part.
+	ErrChartTemplate = errors.New("the chart template has no chart part")
+	// ErrTimelineEventsEmpty defined the error message on adding the timeline
+	// without events.
+	ErrTimelineEventsEmpty = errors.New("the timeline should have at least one event")
+	// ErrDiagramLabelsEmpty defined the error message on adding the business
+	// diagram without labels.
+	ErrDiagramLabelsEmpty = errors.New("the diagram should have at least one label")
+	// ErrFieldType defined the error message on receive the field type which
+	// is not the slide number or the date and time field.
+	ErrFieldType = errors.New("the field type should be slidenum or datetime1 to datetime13")
+	// ErrSlideIndex defined the error message on receive the slide index
+	// which is out of range.
+	ErrSlideIndex = errors.New("the slide index is out of range")
+	// ErrPartHandler defined the error message on registering the part
+	// handler without the handler or without the content type and the part
+	// name pattern.
+	ErrPartHandler = errors.New("the part handler should have the handler and the content type or part name pattern")
+	// ErrTextLevelStyle defined the error message on receive the outline
+	// level of the text style which is out of range.
+	ErrTextLevelStyle = errors.New("the outline level of the text style should be between 0 and 8")
+	// ErrTransitionType defined the error message on receive the unsupported
+	// type of the slide transition.
+	ErrTransitionType = errors.New("unsupported slide transition type")
+	// ErrTransitionSpeed defined the error message on receive the speed of
+	// the slide transition which isn't one of "slow", "med" or "fast".
+	ErrTransitionSpeed = errors.New("the speed of the slide transition should be slow, med or fast")
+	// ErrOutputFormat defined the error message on receive the unsupported
+	// format of the output of saving the presentation.
+	ErrOutputFormat = errors.New("the format of the output should be presentation, thumbnails, html, markdown or notes")
+	// ErrNotesFormat defined the error message on receive the unsupported
+	// format of the exported speaker notes.
+	ErrNotesFormat = errors.New("the format of the notes should be text, markdown or json")
+	// ErrTranslationFormat defined the error message on receive the
+	// unsupported format of the translation bundle.
+	ErrTranslationFormat = errors.New("the format of the translation bundle should be xliff or json")
+	// ErrLanguageTag defined the error message on receive the invalid BCP 47
+	// language tag.
+	ErrLanguageTag = errors.New("invalid language tag")
+	// ErrSlideXMLRoot defined the error message on receive the XML content of
+	// the slide which root element isn't the p:sld element with the common
+	// slide data.
+	ErrSlideXMLRoot = errors.New("the root element of the slide should be p:sld with the p:cSld element")
+	// ErrExternalLinkEmbed defined the error message on embedding the external
+	// link which isn't the linked workbook of the chart.
+	ErrExternalLinkEmbed = errors.New("only the linked workbooks of the charts can be embedded")
+	// ErrShapeTransform defined the error message on flattening the shape
+	// without the offset and the size.
+	ErrShapeTransform = errors.New("the shape should have the offset and the size")
+	// ErrSafeAreaSize defined the error message on the bleed and the safe
+	// margin exceeding the half of the slide size.
+	ErrSafeAreaSize = errors.New("the bleed and the safe margin should be less than the half of the slide size")
 )
 
 // ErrSlideNotExist defined an error of slide that does not exist.
@@ -46,9 +178,73 @@ func newUnzipSizeLimitError(unzipSizeLimit int64) error {
 	return fmt.Errorf("unzip size exceeds the %d bytes limit", unzipSizeLimit)
 }
 
-// unexpectedNamespace returns an error when an unexpected XML namespace is encountered.
-func unexpectedNamespace(space string) error {
-	return fmt.Errorf("Unexpected namespace: %s", space)
+// newInvalidPartNameError returns an error when the name of the entry in the
+// archive isn't a canonical part name.
+func newInvalidPartNameError(name string) error {
+	return fmt.Errorf("invalid part name %q", name)
+}
+
+// newPartCountLimitError returns an error when the number of the parts in the
+// package exceeds the specified limit.
+func newPartCountLimitError(maxParts int) error {
+	return fmt.Errorf("the number of parts exceeds the %d limit", maxParts)
+}
+
+// newXMLLimitError returns an error when the XML part exceeds the specified
+// limit of the nesting depth, the attribute count or the entity references.
+func newXMLLimitError(partName, item string, limit int) error {
+	return fmt.Errorf("the %s of %s exceeds the %d limit", item, partName, limit)
+}
+
+// newSlideMasterShapeTreeError returns an error when the slide master has no
+// shape tree by given part name of the slide master.
+func newSlideMasterShapeTreeError(masterXMLPath string) error {
+	return fmt.Errorf("unsupported slide master %s without the shape tree", masterXMLPath)
+}
+
+// newChartTypeError returns an error when the chart type isn't supported on
+// adding the chart.
+func newChartTypeError(chartType string) error {
+	return fmt.Errorf("unsupported chart type %q, the chart type should be column, bar, line or pie", chartType)
+}
+
+// newDefinitionSlideError returns an error when the slide of the deck
+// definition can't be built by given one-based position of the slide in the
+// definition.
+func newDefinitionSlideError(position int, err error) error {
+	return fmt.Errorf("slide %d of the deck definition: %w", position, err)
+}
+
+// newPlaceholderTypeError returns an error when the slide has no placeholder
+// of the deck definition by given placeholder type and index.
+func newPlaceholderTypeError(phType string, index *int) error {
+	if index != nil {
+		return fmt.Errorf("%w: index %d", ErrPlaceholderNotExist, *index)
+	}
+	return fmt.Errorf("%w: %s", ErrPlaceholderNotExist, phType)
+}
+
+// ErrExternalLinkNotExist defined an error of the external link that does not
+// exist.
+type ErrExternalLinkNotExist struct {
+	Part           string
+	RelationshipID string
+}
+
+// Error returns the error message on receiving the non existing external link.
+func (err ErrExternalLinkNotExist) Error() string {
+	return fmt.Sprintf("external link %s of %s does not exist", err.RelationshipID, err.Part)
+}
+
+// ErrNotesSlideNotExist defined an error of the notes slide of the slide
+// that does not exist.
+type ErrNotesSlideNotExist struct {
+	SlideID int
+}
+
+// Error returns the error message on receiving the non existing notes slide.
+func (err ErrNotesSlideNotExist) Error() string {
+	return fmt.Sprintf("slide %d has no notes slide", err.SlideID)
 }
 
 // ErrShapeNotExist defined an error of shape that does not exist.
@@ -60,3 +256,92 @@ type ErrShapeNotExist struct {
 func (err ErrShapeNotExist) Error() string {
 	return fmt.Sprintf("shape %d does not exist", err.ShapeID)
 }
+
+// ErrShapeNameNotExist defined an error of shape that does not exist by given
+// shape name.
+type ErrShapeNameNotExist struct {
+	SlideID int
+	Name    string
+}
+
+// Error returns the error message on receiving the non existing shape name.
+func (err ErrShapeNameNotExist) Error() string {
+	return fmt.Sprintf("shape %s does not exist on slide %d", err.Name, err.SlideID)
+}
+
+// ErrPictureNotExist defined an error of picture that does not exist.
+type ErrPictureNotExist struct {
+	SlideID int
+	Name    string
+}
+
+// Error returns the error message on receiving the non existing picture.
+func (err ErrPictureNotExist) Error() string {
+	return fmt.Sprintf("picture %s does not exist on slide %d", err.Name, err.SlideID)
+}
+
+// ErrSectionNotExist defined an error of section that does not exist.
+type ErrSectionNotExist struct {
+	Name string
+}
+
+// Error returns the error message on receiving the non existing section.
+func (err ErrSectionNotExist) Error() string {
+	return fmt.Sprintf("section %s does not exist", err.Name)
+}
+
+// ErrTranslationUnitNotExist defined an error of the translation unit which
+// doesn't match a text run of the slides.
+type ErrTranslationUnitNotExist struct {
+	ID string
+}
+
+// Error returns the error message on receiving the non existing translation
+// unit.
+func (err ErrTranslationUnitNotExist) Error() string {
+	return fmt.Sprintf("text run %s of the translation unit does not exist", err.ID)
+}
+
+// ErrXMLNameSpaceNotDeclared defined an error of the namespace prefix which
+// isn't declared in the XML content.
+type ErrXMLNameSpaceNotDeclared struct {
+	Prefix string
+}
+
+// Error returns the error message on receiving the undeclared namespace
+// prefix.
+func (err ErrXMLNameSpaceNotDeclared) Error() string {
+	return fmt.Sprintf("the namespace prefix %s is not declared", err.Prefix)
+}
+
+// ErrLayoutNotExist defined an error of slide layout that does not exist.
+type ErrLayoutNotExist struct {
+	Name string
+}
+
+// Error returns the error message on receiving the non existing slide layout.
+func (err ErrLayoutNotExist) Error() string {
+	return fmt.Sprintf("slide layout %s does not exist", err.Name)
+}
+
+// ErrSlideMasterNotExist defined an error of slide master that does not
+// exist.
+type ErrSlideMasterNotExist struct {
+	Index int
+}
+
+// Error returns the error message on receiving the non existing slide master.
+func (err ErrSlideMasterNotExist) Error() string {
+	return fmt.Sprintf("slide master %d does not exist", err.Index)
+}
+
+// ErrChartNotExist defined an error of chart that does not exist.
+type ErrChartNotExist struct {
+	SlideID  int
+	ChartIdx int
+}
+
+// Error returns the error message on receiving the non existing chart.
+func (err ErrChartNotExist) Error() string {
+	return fmt.Sprintf("chart %d does not exist on slide %d", err.ChartIdx, err.SlideID)
+}
@@ -0,0 +1,302 @@
+// Copyright 2026 kenny-not-dead. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+//
+// Package gopptx provides functionality to create and manipulate PowerPoint
+// (.pptx) files in Go, using the Office Open XML (ECMA-376) format.
+
+package gopptx
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"html"
+	"io"
+	"path"
+	"strings"
+)
+
+// ExportHTML provides a function to write the presentation as a single HTML
+// document to the given io.Writer, useful for web previews of generated
+// decks. Each slide is rendered as a page of absolutely positioned blocks
+// with basic text styling, pictures are embedded as data URIs. For example:
+//
+//	var buf bytes.Buffer
+//	if err := f.ExportHTML(&buf); err != nil {
+//	    fmt.Println(err)
+//	}
+func (f *File) ExportHTML(w io.Writer) error {
+	width, height, err := f.getSlideSize()
+	if err != nil {
+		return err
+	}
+	var buf bytes.Buffer
+	buf.WriteString("<!DOCTYPE html>\n<html>\n<head>\n<meta charset=\"utf-8\">\n<style>\n")
+	buf.WriteString("body{margin:0;background:#e0e0e0}\n")
+	fmt.Fprintf(&buf, ".slide{position:relative;overflow:hidden;width:%.2fpx;height:%.2fpx;margin:16px auto;background:#fff}\n",
+		emuToPixel(width), emuToPixel(height))
+	buf.WriteString(".shape{position:absolute;display:flex;flex-direction:column;box-sizing:border-box}\n")
+	buf.WriteString(".shape p{margin:0}\n.picture{position:absolute}\n</style>\n</head>\n<body>\n")
+	for idx, slideID := range f.GetSlideList() {
+		slide, err := f.slideReader(slideID)
+		if err != nil {
+			return err
+		}
+		slideXMLPath, _ := f.getSlideXMLPath(slideID)
+		fmt.Fprintf(&buf, "<section class=\"slide\" id=\"slide%d\">\n", idx+1)
+		for _, shape := range slide.getShapes() {
+			writeHTMLShape(&buf, shape)
+		}
+		for _, pic := range slide.CommonSlideData.ShapeTree.Picture {
+			f.writeHTMLPicture(&buf, slideXMLPath, pic)
+		}
+		buf.WriteString("</section>\n")
+	}
+	buf.WriteString("</body>\n</html>\n")
+	_, err = buf.WriteTo(w)
+	return err
+}
+
+// ExportMarkdown provides a function to write the outline of the presentation
+// as Markdown to the given io.Writer, for feeding decks into documentation
+// pipelines. Slide titles are written as headings, the text of the body
+// placeholders as bullet lists, and speaker notes as blockquotes. For example:
+//
+//	var buf bytes.Buffer
+//	if err := f.ExportMarkdown(&buf); err != nil {
+//	    fmt.Println(err)
+//	}
+func (f *File) ExportMarkdown(w io.Writer) error {
+	var buf bytes.Buffer
+	for idx, slideID := range f.GetSlideList() {
+		slide, err := f.slideReader(slideID)
+		if err != nil {
+			return err
+		}
+		if idx > 0 {
+			buf.WriteString("\n")
+		}
+		var title string
+		if shape := slide.getTitleShape(); shape != nil {
+			title = strings.TrimSpace(strings.ReplaceAll(shape.TextBody.text(), "\n", " "))
+		}
+		if title == "" {
+			title = fmt.Sprintf("Slide %d", idx+1)
+		}
+		fmt.Fprintf(&buf, "# %s\n", title)
+		var bullets []string
+		for _, shape := range slide.getShapes() {
+			if !shape.isBody() || shape.TextBody == nil {
+				continue
+			}
+			for _, p := range shape.TextBody.Paragraph {
+				if text := strings.TrimSpace(p.text()); text != "" {
+					bullets = append(bullets, strings.Repeat("  ", p.level())+"- "+text)
+				}
+			}
+		}
+		if len(bullets) > 0 {
+			buf.WriteString("\n" + strings.Join(bullets, "\n") + "\n")
+		}
+		paragraphs, err := f.getNotesParagraphs(slideID)
+		if err != nil {
+			return err
+		}
+		var notes []string
+		for _, p := range paragraphs {
+			notes = append(notes, strings.TrimRight("> "+p.text(), " "))
+		}
+		if strings.TrimSpace(strings.Join(notes, "")) != "" {
+			buf.WriteString("\n" + strings.Join(notes, "\n") + "\n")
+		}
+	}
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+// slideNotes directly maps the speaker notes of the slide exported by the
+// ExportNotes function, the number is the one-based slide number.
+type slideNotes struct {
+	Number  int    `json:"number"`
+	SlideID int    `json:"slideId"`
+	Title   string `json:"title"`
+	Notes   string `json:"notes"`
+}
+
+// ExportNotes provides a function to write the slide numbers, titles and the
+// speaker notes of the slides to the given io.Writer by given format, for
+// generating the speaker handouts and feeding the transcripts to the
+// localization. The format is one of "text", "markdown" or "json", and the
+// paragraphs of the notes are separated by the line feeds. For example:
+//
+//	var buf bytes.Buffer
+//	if err := f.ExportNotes(&buf, "json"); err != nil {
+//	    fmt.Println(err)
+//	}
+func (f *File) ExportNotes(w io.Writer, format string) error {
+	if format != "text" && format != "markdown" && format != "json" {
+		return ErrNotesFormat
+	}
+	list := []slideNotes{}
+	for idx, slideID := range f.GetSlideList() {
+		slide, err := f.slideReader(slideID)
+		if err != nil {
+			return err
+		}
+		item := slideNotes{Number: idx + 1, SlideID: slideID}
+		if shape := slide.getTitleShape(); shape != nil {
+			item.Title = strings.TrimSpace(strings.ReplaceAll(shape.TextBody.text(), "\n", " "))
+		}
+		paragraphs, err := f.getNotesParagraphs(slideID)
+		if err != nil {
+			return err
+		}
+		notes := make([]string, len(paragraphs))
+		for i, p := range paragraphs {
+			notes[i] = p.text()
+		}
+		item.Notes = strings.TrimSpace(strings.Join(notes, "\n"))
+		list = append(list, item)
+	}
+	var buf bytes.Buffer
+	switch format {
+	case "json":
+		enc := json.NewEncoder(&buf)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(list); err != nil {
+			return err
+		}
+	default:
+		for idx, item := range list {
+			heading, notes := fmt.Sprintf("Slide %d", item.Number), item.Notes
+			if format == "markdown" {
+				heading, notes = "## "+heading, "\n"+notes
+			}
+			if idx > 0 {
+				buf.WriteString("\n")
+			}
+			if item.Title != "" {
+				heading += ": " + item.Title
+			}
+			buf.WriteString(heading + "\n")
+			if item.Notes != "" {
+				buf.WriteString(notes + "\n")
+			}
+		}
+	}
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+// writeHTMLShape provides a function to write the positioned block of the
+// given shape with its text.
+func writeHTMLShape(buf *bytes.Buffer, shape decodeShape) {
+	if shape.ShapeProperties == nil || shape.ShapeProperties.Xfrm == nil {
+		return
+	}
+	style := htmlPositionStyle(shape.ShapeProperties.Xfrm)
+	if shape.TextBody != nil && shape.TextBody.BodyProperties != nil && shape.TextBody.BodyProperties.Anchor != nil {
+		style += "justify-content:" + map[string]string{
+			"t": "flex-start", "ctr": "center", "b": "flex-end",
+		}[*shape.TextBody.BodyProperties.Anchor] + ";"
+	}
+	fmt.Fprintf(buf, "<div class=\"shape\" style=\"%s\">", style)
+	if shape.TextBody != nil {
+		for _, p := range shape.TextBody.Paragraph {
+			buf.WriteString("<p")
+			if p.ParagraphProperties != nil && p.ParagraphProperties.Align != nil {
+				if align, ok := map[string]string{
+					"l": "left", "ctr": "center", "r": "right", "just": "justify",
+				}[*p.ParagraphProperties.Align]; ok {
+					fmt.Fprintf(buf, " style=\"text-align:%s\"", align)
+				}
+			}
+			buf.WriteString(">")
+			for _, r := range p.Runs {
+				fmt.Fprintf(buf, "<span style=\"%s\">%s</span>", html.EscapeString(htmlRunStyle(r.RunProperties)), html.EscapeString(r.Text))
+			}
+			if len(p.Runs) == 0 {
+				buf.WriteString("&nbsp;")
+			}
+			buf.WriteString("</p>")
+		}
+	}
+	buf.WriteString("</div>\n")
+}
+
+// writeHTMLPicture provides a function to write the positioned image of the
+// given picture, the image data are embedded as data URI.
+func (f *File) writeHTMLPicture(buf *bytes.Buffer, slideXMLPath string, pic decodePicture) {
+	if pic.ShapeProperties == nil || pic.ShapeProperties.Xfrm == nil ||
+		pic.BlipFill == nil || pic.BlipFill.Blip == nil {
+		return
+	}
+	src, ok := f.getRelTarget(slideXMLPath, pic.BlipFill.Blip.Embed)
+	if !ok {
+		return
+	}
+	contentType, ok := supportedImageTypes[strings.ToLower(path.Ext(src))]
+	if !ok {
+		return
+	}
+	var alt string
+	if pic.NonVisualPictureProperties != nil && pic.NonVisualPictureProperties.CommonNonVisualProperties != nil {
+		alt = pic.NonVisualPictureProperties.CommonNonVisualProperties.Descr
+		if alt == "" {
+			alt = pic.NonVisualPictureProperties.CommonNonVisualProperties.Name
+		}
+	}
+	fmt.Fprintf(buf, "<img class=\"picture\" style=\"%s\" alt=\"%s\" src=\"data:%s;base64,%s\">\n",
+		htmlPositionStyle(pic.ShapeProperties.Xfrm), html.EscapeString(alt), contentType,
+		base64.StdEncoding.EncodeToString(f.readBytes(src)))
+}
+
+// htmlPositionStyle returns the CSS absolute position declarations by given
+// shape transform.
+func htmlPositionStyle(xfrm *DecodeXfrm) string {
+	var style string
+	if xfrm.Offset != nil {
+		style += fmt.Sprintf("left:%.2fpx;top:%.2fpx;", emuToPixel(xfrm.Offset.X), emuToPixel(xfrm.Offset.Y))
+	}
+	if xfrm.Extents != nil {
+		style += fmt.Sprintf("width:%.2fpx;height:%.2fpx;", emuToPixel(xfrm.Extents.CX), emuToPixel(xfrm.Extents.CY))
+	}
+	return style
+}
+
+// cssStringReplacer escapes the text in the quoted CSS strings.
+var cssStringReplacer = strings.NewReplacer(`\`, `\\`, `'`, `\'`, `"`, `\"`, "\n", `\a `)
+
+// htmlRunStyle returns the CSS declarations by given text run properties.
+func htmlRunStyle(rPr *DecodeRunProperties) string {
+	if rPr == nil {
+		return ""
+	}
+	var style string
+	if rPr.Bold != nil && *rPr.Bold == 1 {
+		style += "font-weight:bold;"
+	}
+	if rPr.Size != nil {
+		style += fmt.Sprintf("font-size:%gpt;", float64(*rPr.Size)/100)
+	}
+	if rPr.Strike != "" && rPr.Strike != "noStrike" {
+		style += "text-decoration:line-through;"
+	}
+	// Skip the malformed colors and escape the quotes of the typeface, so
+	// the values of the untrusted presentations can't inject the CSS
+	// declarations or break out of the style attribute.
+	if rPr.SolidFill != nil && rPr.SolidFill.SolidRGBColor != nil && hexColorExp.MatchString(rPr.SolidFill.SolidRGBColor.Val) {
+		style += "color:#" + rPr.SolidFill.SolidRGBColor.Val + ";"
+	}
+	if rPr.Latin != nil && rPr.Latin.Typeface != "" {
+		style += "font-family:'" + cssStringReplacer.Replace(rPr.Latin.Typeface) + "';"
+	}
+	return style
+}
+
+// emuToPixel converts the English Metric Units to pixels at 96 DPI.
+func emuToPixel(emu int) float64 {
+	return float64(emu) / EMUPerPixel
+}
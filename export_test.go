@@ -0,0 +1,141 @@
+package gopptx
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// addTestShape provides a function to add the shape with the paragraphs of
+// given text to the slide, the empty text adds the paragraph without runs. The
+// shape is the placeholder by given type unless the type is empty.
+func addTestShape(t *testing.T, f *File, slideID int, phType string, paragraphs ...string) *decodeShape {
+	t.Helper()
+	var textBody DecodeTextBody
+	for _, text := range paragraphs {
+		var p DecodeParagraph
+		if text != "" {
+			p.Runs = []DecodeRuns{{Text: text}}
+		}
+		textBody.Paragraph = append(textBody.Paragraph, p)
+	}
+	if _, err := f.CreateShape(slideID, DecodeShapeProperties{
+		Xfrm: &DecodeXfrm{Offset: &Offset{X: 914400, Y: 457200}, Extents: &Extents{CX: 1828800, CY: 914400}},
+	}, textBody); err != nil {
+		t.Fatal(err)
+	}
+	slide, err := f.slideReader(slideID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	shape := &slide.CommonSlideData.ShapeTree.Shape[len(slide.CommonSlideData.ShapeTree.Shape)-1]
+	if phType != "" {
+		shape.NonVisualShapeProperties.NonVisualProperties = &decodeNonVisualProperties{Ph: &Ph{Type: &phType}}
+	}
+	return shape
+}
+
+func TestExportHTML(t *testing.T) {
+	f := NewFile()
+	slideID := f.GetSlideList()[0]
+	shape := addTestShape(t, f, slideID, "", "A & <b>", "")
+	bold, size, align, anchor := 1, 2400, "ctr", "b"
+	shape.TextBody.BodyProperties = &DecodeBodyProperties{Anchor: &anchor}
+	shape.TextBody.Paragraph[0].ParagraphProperties = &ParagraphProperties{Align: &align}
+	shape.TextBody.Paragraph[0].Runs[0].RunProperties = &DecodeRunProperties{
+		Bold: &bold, Size: &size, SolidFill: &DecodeSolidFill{SolidRGBColor: &SolidRGBColor{Val: "FF0000"}},
+		Latin: &Latin{Typeface: `Arial"><script>`},
+	}
+	var buf bytes.Buffer
+	if err := f.ExportHTML(&buf); err != nil {
+		t.Fatal(err)
+	}
+	for _, expected := range []string{
+		".slide{position:relative;overflow:hidden;width:1058.33px;height:595.33px;",
+		`<section class="slide" id="slide1">`,
+		`<div class="shape" style="left:96.00px;top:48.00px;width:192.00px;height:96.00px;justify-content:flex-end;">`,
+		`<p style="text-align:center"><span style="font-weight:bold;font-size:24pt;color:#FF0000;font-family:&#39;Arial\&#34;&gt;&lt;script&gt;&#39;;">A &amp; &lt;b&gt;</span></p>`,
+		"<p>&nbsp;</p></div>",
+	} {
+		if !strings.Contains(buf.String(), expected) {
+			t.Errorf("expected %s in the HTML, got %s", expected, buf.String())
+		}
+	}
+}
+
+func TestExportMarkdown(t *testing.T) {
+	f := NewFile()
+	slideID, err := f.NewSlide()
+	if err != nil {
+		t.Fatal(err)
+	}
+	slide, err := f.slideReader(slideID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	slide.getTitleShape().TextBody.Paragraph = []DecodeParagraph{
+		{Runs: []DecodeRuns{{Text: "Agenda"}}}, {Runs: []DecodeRuns{{Text: "2026"}}},
+	}
+	level := 1
+	addTestShape(t, f, slideID, "body", "Intro", " ", "Details").TextBody.Paragraph[2].ParagraphProperties = &ParagraphProperties{Level: &level}
+	addTestShape(t, f, slideID, "", "Free text")
+	addTestShape(t, f, slideID, "ftr", "Footer")
+	var buf bytes.Buffer
+	if err = f.ExportMarkdown(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if expected := "# Slide 1\n\n# Agenda 2026\n\n- Intro\n  - Details\n"; buf.String() != expected {
+		t.Errorf("expected the Markdown %q, got %q", expected, buf.String())
+	}
+}
+
+func TestExportNotes(t *testing.T) {
+	f := NewFile()
+	first := f.GetSlideList()[0]
+	slide, err := f.slideReader(first)
+	if err != nil {
+		t.Fatal(err)
+	}
+	slide.getTitleShape().TextBody = &DecodeTextBody{Paragraph: []DecodeParagraph{
+		{Runs: []DecodeRuns{{Text: "Quarterly"}}}, {Runs: []DecodeRuns{{Text: "report "}}},
+	}}
+	if err = f.addNotesSlide(first, []string{"Welcome everyone", "", "Agenda & goals", ""}); err != nil {
+		t.Fatal(err)
+	}
+	second, err := f.NewSlide()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for format, expected := range map[string]string{
+		"text":     "Slide 1: Quarterly report\nWelcome everyone\n\nAgenda & goals\n\nSlide 2\n",
+		"markdown": "## Slide 1: Quarterly report\n\nWelcome everyone\n\nAgenda & goals\n\n## Slide 2\n",
+	} {
+		var buf bytes.Buffer
+		if err = f.ExportNotes(&buf, format); err != nil {
+			t.Fatal(err)
+		}
+		if buf.String() != expected {
+			t.Errorf("expected the %s notes %q, got %q", format, expected, buf.String())
+		}
+	}
+	var buf bytes.Buffer
+	if err = f.ExportNotes(&buf, "json"); err != nil {
+		t.Fatal(err)
+	}
+	var notes []map[string]interface{}
+	if err = json.Unmarshal(buf.Bytes(), &notes); err != nil {
+		t.Fatal(err)
+	}
+	expected := []map[string]interface{}{
+		{"number": 1.0, "slideId": float64(first), "title": "Quarterly report", "notes": "Welcome everyone\n\nAgenda & goals"},
+		{"number": 2.0, "slideId": float64(second), "title": "", "notes": ""},
+	}
+	if !reflect.DeepEqual(notes, expected) {
+		t.Errorf("expected the notes %v, got %v", expected, notes)
+	}
+	if err = f.ExportNotes(&buf, "html"); err != ErrNotesFormat {
+		t.Errorf("expected ErrNotesFormat, got %v", err)
+	}
+}
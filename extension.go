@@ -0,0 +1,179 @@
+// Copyright 2026 kenny-not-dead. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+//
+// Package gopptx provides functionality to create and manipulate PowerPoint
+// (.pptx) files in Go, using the Office Open XML (ECMA-376) format.
+
+package gopptx
+
+// Extension directly maps the ext element of the extension list, the URI
+// identifies the extension and the content is the XML content of the ext
+// element, which is kept as is. The namespaces used by the content should be
+// declared in the content.
+type Extension struct {
+	URI     string
+	Content string
+}
+
+// GetPresentationExtensions provides a function to get the extensions of the
+// presentation. For example:
+//
+//	exts, err := f.GetPresentationExtensions()
+//	if err != nil {
+//	    fmt.Println(err)
+//	    return
+//	}
+//	for _, ext := range exts {
+//	    fmt.Println(ext.URI)
+//	}
+func (f *File) GetPresentationExtensions() ([]Extension, error) {
+	presentation, err := f.presentationReader()
+	if err != nil {
+		return nil, err
+	}
+	return getExtensions(presentation.ExtensionList), nil
+}
+
+// AddPresentationExtension provides a function to add the extension to the
+// presentation, the existing extension with the same URI is replaced. For
+// example:
+//
+//	err := f.AddPresentationExtension(gopptx.Extension{
+//	    URI:     "{B8E7A2B1-5C3D-4F6E-9A0B-1C2D3E4F5A6B}",
+//	    Content: `<x:data xmlns:x="urn:example:data" value="1"/>`,
+//	})
+func (f *File) AddPresentationExtension(ext Extension) error {
+	if err := f.checkReadOnly(); err != nil {
+		return err
+	}
+	presentation, err := f.presentationReader()
+	if err != nil {
+		return err
+	}
+	presentation.ExtensionList = addExtension(presentation.ExtensionList, ext)
+	return nil
+}
+
+// GetSlideExtensions provides a function to get the extensions of the slide by
+// given slide id. For example:
+//
+//	exts, err := f.GetSlideExtensions(256)
+func (f *File) GetSlideExtensions(slideID int) ([]Extension, error) {
+	slide, err := f.slideReader(slideID)
+	if err != nil {
+		return nil, err
+	}
+	return getExtensions(slide.ExtensionList), nil
+}
+
+// AddSlideExtension provides a function to add the extension to the slide by
+// given slide id, the existing extension with the same URI is replaced. For
+// example:
+//
+//	err := f.AddSlideExtension(256, gopptx.Extension{
+//	    URI:     "{B8E7A2B1-5C3D-4F6E-9A0B-1C2D3E4F5A6B}",
+//	    Content: `<x:data xmlns:x="urn:example:data" value="1"/>`,
+//	})
+func (f *File) AddSlideExtension(slideID int, ext Extension) error {
+	if err := f.checkReadOnly(); err != nil {
+		return err
+	}
+	slide, err := f.slideReader(slideID)
+	if err != nil {
+		return err
+	}
+	slide.ExtensionList = addExtension(slide.ExtensionList, ext)
+	return nil
+}
+
+// GetShapeExtensions provides a function to get the extensions of the shape,
+// the picture or the graphic frame by given slide id and shape id. For
+// example:
+//
+//	exts, err := f.GetShapeExtensions(256, 2)
+func (f *File) GetShapeExtensions(slideID, shapeID int) ([]Extension, error) {
+	list, err := f.getShapeExtensionList(slideID, shapeID)
+	if err != nil {
+		return nil, err
+	}
+	return getExtensions(*list), nil
+}
+
+// AddShapeExtension provides a function to add the extension to the shape, the
+// picture or the graphic frame by given slide id and shape id, the existing
+// extension with the same URI is replaced. For example:
+//
+//	err := f.AddShapeExtension(256, 2, gopptx.Extension{
+//	    URI:     "{B8E7A2B1-5C3D-4F6E-9A0B-1C2D3E4F5A6B}",
+//	    Content: `<x:data xmlns:x="urn:example:data" value="1"/>`,
+//	})
+func (f *File) AddShapeExtension(slideID, shapeID int, ext Extension) error {
+	if err := f.checkReadOnly(); err != nil {
+		return err
+	}
+	list, err := f.getShapeExtensionList(slideID, shapeID)
+	if err != nil {
+		return err
+	}
+	*list = addExtension(*list, ext)
+	return nil
+}
+
+// getShapeExtensionList provides a function to get the pointer to the
+// extension list of the shape, the picture or the graphic frame by given
+// slide id and shape id.
+func (f *File) getShapeExtensionList(slideID, shapeID int) (**decodeExtensionList, error) {
+	slide, err := f.slideReader(slideID)
+	if err != nil {
+		return nil, err
+	}
+	shapeTree := &slide.CommonSlideData.ShapeTree
+	for i, shape := range shapeTree.Shape {
+		if shape.NonVisualShapeProperties != nil && shape.NonVisualShapeProperties.CommonNonVisualProperties != nil &&
+			shape.NonVisualShapeProperties.CommonNonVisualProperties.ID == shapeID {
+			return &shapeTree.Shape[i].ExtensionList, nil
+		}
+	}
+	for i, pic := range shapeTree.Picture {
+		if pic.NonVisualPictureProperties != nil && pic.NonVisualPictureProperties.CommonNonVisualProperties != nil &&
+			pic.NonVisualPictureProperties.CommonNonVisualProperties.ID == shapeID {
+			return &shapeTree.Picture[i].ExtensionList, nil
+		}
+	}
+	for i, gf := range shapeTree.GraphicFrame {
+		if gf.NonVisualGraphicFrameProperties != nil && gf.NonVisualGraphicFrameProperties.CommonNonVisualProperties != nil &&
+			gf.NonVisualGraphicFrameProperties.CommonNonVisualProperties.ID == shapeID {
+			return &shapeTree.GraphicFrame[i].ExtensionList, nil
+		}
+	}
+	return nil, ErrShapeNotExist{shapeID}
+}
+
+// getExtensions returns the extensions by given extension list.
+func getExtensions(list *decodeExtensionList) []Extension {
+	if list == nil {
+		return nil
+	}
+	exts := make([]Extension, 0, len(list.Ext))
+	for _, ext := range list.Ext {
+		exts = append(exts, Extension(ext))
+	}
+	return exts
+}
+
+// addExtension returns the extension list with the extension added, the
+// existing extension with the same URI is replaced in place.
+func addExtension(list *decodeExtensionList, ext Extension) *decodeExtensionList {
+	if list == nil {
+		list = &decodeExtensionList{}
+	}
+	for i := range list.Ext {
+		if list.Ext[i].URI == ext.URI {
+			list.Ext[i].Content = ext.Content
+			return list
+		}
+	}
+	list.Ext = append(list.Ext, decodeExtension(ext))
+	return list
+}
@@ -0,0 +1,71 @@
+package gopptx
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestExtensions(t *testing.T) {
+	creationID := Extension{
+		URI:     "{BB962C8B-B14F-4D97-AF65-F5344CB8AC3E}",
+		Content: `<p14:creationId xmlns:p14="http://schemas.microsoft.com/office/powerpoint/2010/main" val="1"/>`,
+	}
+	extLst := []byte(`<p:extLst><p:ext uri="` + creationID.URI + `">` + creationID.Content + `</p:ext></p:extLst>`)
+	f, err := openTestFile(t, func(name string, content []byte) []byte {
+		switch name {
+		case "ppt/presentation.xml":
+			return bytes.Replace(content, []byte("</p:presentation>"), append(extLst, []byte("</p:presentation>")...), 1)
+		case "ppt/slides/slide1.xml":
+			content = bytes.Replace(content, []byte("</p:txBody></p:sp>"), append([]byte("</p:txBody>"), append(extLst, []byte("</p:sp>")...)...), 1)
+			return bytes.Replace(content, []byte("</p:sld>"), append(extLst, []byte("</p:sld>")...), 1)
+		}
+		return content
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	slideID := f.GetSlideList()[0]
+	data := Extension{URI: "{B8E7A2B1-5C3D-4F6E-9A0B-1C2D3E4F5A6B}", Content: `<x:data xmlns:x="urn:example:data" value="1"/>`}
+	replaced := Extension{URI: creationID.URI, Content: `<p14:creationId xmlns:p14="http://schemas.microsoft.com/office/powerpoint/2010/main" val="2"/>`}
+	if err = f.AddPresentationExtension(data); err != nil {
+		t.Fatal(err)
+	}
+	if err = f.AddSlideExtension(slideID, replaced); err != nil {
+		t.Fatal(err)
+	}
+	if err = f.AddShapeExtension(slideID, 8, data); err != nil {
+		t.Fatal(err)
+	}
+	buf, err := f.WriteToBuffer()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f, err = OpenReader(buf); err != nil {
+		t.Fatal(err)
+	}
+	for _, c := range []struct {
+		get      func() ([]Extension, error)
+		expected []Extension
+	}{
+		{get: f.GetPresentationExtensions, expected: []Extension{creationID, data}},
+		{get: func() ([]Extension, error) { return f.GetSlideExtensions(slideID) }, expected: []Extension{replaced}},
+		{get: func() ([]Extension, error) { return f.GetShapeExtensions(slideID, 7) }, expected: []Extension{creationID}},
+		{get: func() ([]Extension, error) { return f.GetShapeExtensions(slideID, 8) }, expected: []Extension{data}},
+	} {
+		exts, err := c.get()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(exts, c.expected) {
+			t.Errorf("expected the extensions %v, got %v", c.expected, exts)
+		}
+	}
+	if _, err = f.GetShapeExtensions(slideID, 99); !errors.Is(err, ErrShapeNotExist{99}) {
+		t.Errorf("expected ErrShapeNotExist, got %v", err)
+	}
+	if err = f.AddSlideExtension(300, data); !errors.Is(err, ErrSlideNotExist{300}) {
+		t.Errorf("expected ErrSlideNotExist, got %v", err)
+	}
+}
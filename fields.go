@@ -0,0 +1,305 @@
+// Copyright 2026 kenny-not-dead. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+//
+// Package gopptx provides functionality to create and manipulate PowerPoint
+// (.pptx) files in Go, using the Office Open XML (ECMA-376) format.
+
+package gopptx
+
+import (
+	"encoding/xml"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// This section defines the default patterns of the date and time fields, the
+// patterns use the tokens "yyyy", "yy", "mmmm", "mmm", "mm", "m", "dddd",
+// "ddd", "dd", "d", "hh", "h", "ss", "s" and "AM/PM", the "m" and "mm"
+// tokens following the hours are the minutes.
+const (
+	defaultShortDatePattern = "m/d/yyyy"
+	defaultLongDatePattern  = "dddd, mmmm d, yyyy"
+	defaultLongTimePattern  = "h:mm:ss AM/PM"
+)
+
+// dateTimeFieldPatterns defined the patterns of the date and time fields by
+// the field types, the empty patterns are the short date, long date and long
+// time patterns of the options.
+var dateTimeFieldPatterns = map[string]string{
+	"datetime":   "",
+	"datetime1":  "",
+	"datetime2":  "",
+	"datetime3":  "d mmmm yyyy",
+	"datetime4":  "mmmm d, yyyy",
+	"datetime5":  "d-mmm-yy",
+	"datetime6":  "mmmm yy",
+	"datetime7":  "mmm-yy",
+	"datetime8":  "h:mm AM/PM",
+	"datetime9":  "",
+	"datetime10": "H:mm",
+	"datetime11": "H:mm:ss",
+	"datetime12": "h:mm AM/PM",
+	"datetime13": "",
+}
+
+// UnmarshalXML implements the xml.Unmarshaler interface for the paragraph to
+// keep the order of the text runs and the fields.
+func (dp *DecodeParagraph) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "pPr":
+				dp.ParagraphProperties = &ParagraphProperties{}
+				err = d.DecodeElement(dp.ParagraphProperties, &t)
+			case "r", "fld":
+				var run DecodeRuns
+				if err = d.DecodeElement(&run, &t); t.Name.Local == "r" {
+					run.FieldID, run.FieldType = "", ""
+				}
+				dp.Runs = append(dp.Runs, run)
+			case "endParaRPr":
+				dp.EndParagraphRunProperties = &DecodeRunProperties{}
+				err = d.DecodeElement(dp.EndParagraphRunProperties, &t)
+			default:
+				err = d.Skip()
+			}
+			if err != nil {
+				return err
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// MarshalXML implements the xml.Marshaler interface for the text run, which
+// is serialized as the fld element if the field ID isn't empty.
+func (r Runs) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if r.FieldID != "" {
+		start.Name.Local = "a:fld"
+	}
+	type runs Runs
+	return e.EncodeElement(runs(r), start)
+}
+
+// AddTextField provides a function to append the field to the paragraph of
+// the shape by given slide id, shape id, zero-based index of the paragraph
+// and the field type, such as "slidenum" for the slide number and "datetime1"
+// to "datetime13" for the current date and time in the formats of
+// PowerPoint. The date and time fields "datetime", "datetime1", "datetime2",
+// "datetime8", "datetime9" and "datetime13" use the ShortDatePattern,
+// LongDatePattern and LongTimePattern options if they are set. For example,
+// show the slide number in the first paragraph of the shape:
+//
+//	err := f.AddTextField(256, 3, 0, "slidenum")
+func (f *File) AddTextField(slideID, shapeID, paragraphIdx int, fieldType string) error {
+	if err := f.checkReadOnly(); err != nil {
+		return err
+	}
+	slide, err := f.slideReader(slideID)
+	if err != nil {
+		return err
+	}
+	for i, shape := range slide.CommonSlideData.ShapeTree.Shape {
+		if shape.NonVisualShapeProperties == nil || shape.NonVisualShapeProperties.CommonNonVisualProperties == nil ||
+			shape.NonVisualShapeProperties.CommonNonVisualProperties.ID != shapeID {
+			continue
+		}
+		var paragraphs int
+		if shape.TextBody != nil {
+			paragraphs = len(shape.TextBody.Paragraph)
+		}
+		if paragraphIdx < 0 || paragraphIdx > paragraphs {
+			return ErrTextRunNotExist
+		}
+		text, ok := f.getFieldText(slideID, fieldType, time.Now())
+		if !ok {
+			return ErrFieldType
+		}
+		if shape.TextBody == nil {
+			slide.CommonSlideData.ShapeTree.Shape[i].TextBody = &DecodeTextBody{BodyProperties: &DecodeBodyProperties{}}
+		}
+		textBody := slide.CommonSlideData.ShapeTree.Shape[i].TextBody
+		if paragraphIdx == len(textBody.Paragraph) {
+			textBody.Paragraph = append(textBody.Paragraph, DecodeParagraph{})
+		}
+		paragraph := &textBody.Paragraph[paragraphIdx]
+		paragraph.Runs = append(paragraph.Runs, DecodeRuns{
+			FieldID:       newGUID(),
+			FieldType:     fieldType,
+			RunProperties: &DecodeRunProperties{Lang: "en-US"},
+			Text:          text,
+		})
+		return nil
+	}
+	return ErrShapeNotExist{shapeID}
+}
+
+// UpdateFields provides a function to update the text of the slide number
+// and the date and time fields in the shapes of all slides by the current
+// slide numbers and the current time, the other fields are kept as is.
+func (f *File) UpdateFields() error {
+	if err := f.checkReadOnly(); err != nil {
+		return err
+	}
+	now := time.Now()
+	for _, slideID := range f.GetSlideList() {
+		slide, err := f.slideReader(slideID)
+		if err != nil {
+			return err
+		}
+		for _, shape := range slide.CommonSlideData.ShapeTree.Shape {
+			if shape.TextBody == nil {
+				continue
+			}
+			for i := range shape.TextBody.Paragraph {
+				runs := shape.TextBody.Paragraph[i].Runs
+				for j := range runs {
+					if runs[j].FieldID == "" {
+						continue
+					}
+					if text, ok := f.getFieldText(slideID, runs[j].FieldType, now); ok {
+						runs[j].Text = text
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// getFieldText returns the text of the field by given slide id, field type
+// and the time of the date and time fields. It returns false if the field
+// type is unsupported.
+func (f *File) getFieldText(slideID int, fieldType string, now time.Time) (string, bool) {
+	if fieldType == "slidenum" {
+		first := 1
+		if presentation, err := f.presentationReader(); err == nil && presentation.FirstSlideNum != nil {
+			first = *presentation.FirstSlideNum
+		}
+		for i, id := range f.GetSlideList() {
+			if id == slideID {
+				return strconv.Itoa(i + first), true
+			}
+		}
+		return "", false
+	}
+	pattern, ok := dateTimeFieldPatterns[fieldType]
+	if !ok {
+		return "", false
+	}
+	shortDate, longDate, longTime := defaultShortDatePattern, defaultLongDatePattern, defaultLongTimePattern
+	if f.options.ShortDatePattern != "" {
+		shortDate = f.options.ShortDatePattern
+	}
+	if f.options.LongDatePattern != "" {
+		longDate = f.options.LongDatePattern
+	}
+	if f.options.LongTimePattern != "" {
+		longTime = f.options.LongTimePattern
+	}
+	switch fieldType {
+	case "datetime", "datetime1":
+		pattern = shortDate
+	case "datetime2":
+		pattern = longDate
+	case "datetime8":
+		pattern = shortDate + " " + pattern
+	case "datetime9":
+		pattern = shortDate + " " + longTime
+	case "datetime13":
+		pattern = longTime
+	}
+	return formatDateTime(now, pattern), true
+}
+
+// formatDateTime returns the text of the time by given date and time pattern,
+// the characters which are not the tokens are kept as is. The hours are in
+// the 12-hour clock if the pattern contains "AM/PM".
+func formatDateTime(t time.Time, pattern string) string {
+	var (
+		buf      strings.Builder
+		hour12   = strings.Contains(strings.ToUpper(pattern), "AM/PM")
+		minute   bool
+		count    func(i int) int
+		lower    = strings.ToLower(pattern)
+		padValue = func(n, width int) string {
+			s := strconv.Itoa(n)
+			for len(s) < width {
+				s = "0" + s
+			}
+			return s
+		}
+	)
+	count = func(i int) int {
+		n := 1
+		for i+n < len(lower) && lower[i+n] == lower[i] {
+			n++
+		}
+		return n
+	}
+	for i := 0; i < len(pattern); {
+		if strings.HasPrefix(strings.ToUpper(pattern[i:]), "AM/PM") {
+			if t.Hour() < 12 {
+				buf.WriteString("AM")
+			} else {
+				buf.WriteString("PM")
+			}
+			i += 5
+			continue
+		}
+		n := count(i)
+		switch lower[i] {
+		case 'y':
+			if n <= 2 {
+				buf.WriteString(padValue(t.Year()%100, 2))
+			} else {
+				buf.WriteString(strconv.Itoa(t.Year()))
+			}
+		case 'm':
+			switch {
+			case minute && n <= 2:
+				buf.WriteString(padValue(t.Minute(), n))
+			case n == 1 || n == 2:
+				buf.WriteString(padValue(int(t.Month()), n))
+			case n == 3:
+				buf.WriteString(t.Month().String()[:3])
+			default:
+				buf.WriteString(t.Month().String())
+			}
+		case 'd':
+			switch n {
+			case 1, 2:
+				buf.WriteString(padValue(t.Day(), n))
+			case 3:
+				buf.WriteString(t.Weekday().String()[:3])
+			default:
+				buf.WriteString(t.Weekday().String())
+			}
+		case 'h':
+			hour := t.Hour()
+			if hour12 {
+				if hour = hour % 12; hour == 0 {
+					hour = 12
+				}
+			}
+			buf.WriteString(padValue(hour, min(n, 2)))
+		case 's':
+			buf.WriteString(padValue(t.Second(), min(n, 2)))
+		default:
+			buf.WriteString(pattern[i : i+n])
+		}
+		if lower[i] != ' ' && lower[i] != ':' {
+			minute = lower[i] == 'h'
+		}
+		i += n
+	}
+	return buf.String()
+}
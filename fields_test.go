@@ -0,0 +1,102 @@
+package gopptx
+
+import (
+	"errors"
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestAddTextField(t *testing.T) {
+	for _, c := range []struct {
+		name         string
+		text         string
+		paragraphIdx int
+		fieldType    string
+		err          error
+		paragraphs   int
+	}{
+		{name: "slide number", text: "text", paragraphIdx: 0, fieldType: "slidenum", paragraphs: 1},
+		{name: "new paragraph", text: "text", paragraphIdx: 1, fieldType: "datetime1", paragraphs: 2},
+		{name: "empty text body", paragraphIdx: 0, fieldType: "slidenum", paragraphs: 1},
+		{name: "field type", text: "text", paragraphIdx: 1, fieldType: "unknown", err: ErrFieldType, paragraphs: 1},
+		{name: "field type on empty text body", paragraphIdx: 0, fieldType: "unknown", err: ErrFieldType},
+		{name: "paragraph index", text: "text", paragraphIdx: 2, fieldType: "slidenum", err: ErrTextRunNotExist, paragraphs: 1},
+		{name: "negative paragraph index", paragraphIdx: -1, fieldType: "slidenum", err: ErrTextRunNotExist},
+	} {
+		f := NewFile()
+		slideID := f.GetSlideList()[0]
+		shapeID, err := f.AddTextBox(slideID, TextBoxOptions{Text: c.text})
+		if err != nil {
+			t.Fatal(err)
+		}
+		slide, err := f.slideReader(slideID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		shape := &slide.CommonSlideData.ShapeTree.Shape[len(slide.CommonSlideData.ShapeTree.Shape)-1]
+		if c.text == "" {
+			shape.TextBody = nil
+		}
+		if err = f.AddTextField(slideID, shapeID, c.paragraphIdx, c.fieldType); !errors.Is(err, c.err) {
+			t.Errorf("%s: expected error %v, got %v", c.name, c.err, err)
+		}
+		var paragraphs int
+		if shape.TextBody != nil {
+			paragraphs = len(shape.TextBody.Paragraph)
+		}
+		if paragraphs != c.paragraphs {
+			t.Errorf("%s: expected %d paragraphs, got %d", c.name, c.paragraphs, paragraphs)
+		}
+	}
+}
+
+func TestUpdateFields(t *testing.T) {
+	f := NewFile()
+	f.options.ShortDatePattern = "yyyy"
+	slideID, err := f.NewSlide()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = f.CreateShape(slideID, DecodeShapeProperties{}, DecodeTextBody{Paragraph: []DecodeParagraph{{Runs: []DecodeRuns{
+		{FieldID: "{B6F15528-21DE-4FAA-801E-634DDDAF4B2B}", FieldType: "slidenum", Text: "9"},
+		{FieldID: "{B6F15528-21DE-4FAA-801E-634DDDAF4B2C}", FieldType: "datetime1", Text: "1/1/2000"},
+		{FieldID: "{B6F15528-21DE-4FAA-801E-634DDDAF4B2D}", FieldType: "unknown", Text: "kept"},
+		{Text: "9"},
+	}}}}); err != nil {
+		t.Fatal(err)
+	}
+	if err = f.UpdateFields(); err != nil {
+		t.Fatal(err)
+	}
+	slide, err := f.slideReader(slideID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	shapes := slide.CommonSlideData.ShapeTree.Shape
+	var texts []string
+	for _, run := range shapes[len(shapes)-1].TextBody.Paragraph[0].Runs {
+		texts = append(texts, run.Text)
+	}
+	if expected := []string{"2", strconv.Itoa(time.Now().Year()), "kept", "9"}; !reflect.DeepEqual(texts, expected) {
+		t.Errorf("expected the field texts %v, got %v", expected, texts)
+	}
+}
+
+func TestFormatDateTime(t *testing.T) {
+	now := time.Date(2026, 3, 5, 14, 7, 9, 0, time.UTC)
+	for pattern, expected := range map[string]string{
+		defaultShortDatePattern: "3/5/2026",
+		defaultLongDatePattern:  "Thursday, March 5, 2026",
+		defaultLongTimePattern:  "2:07:09 PM",
+		"yy-mm-dd hh:mm":        "26-03-05 14:07",
+		"ddd, dd mmm yyyy":      "Thu, 05 Mar 2026",
+		"h:mm AM/PM, m/d":       "2:07 PM, 3/5",
+		"dd.mm.yyyy hh:mm:ss":   "05.03.2026 14:07:09",
+	} {
+		if text := formatDateTime(now, pattern); text != expected {
+			t.Errorf("expected %s of the pattern %s, got %s", expected, pattern, text)
+		}
+	}
+}
@@ -8,6 +8,7 @@
 package gopptx
 
 import (
+	"archive/zip"
 	"bytes"
 	_ "embed"
 	"encoding/xml"
@@ -16,6 +17,7 @@ import (
 	"math"
 	"os"
 	"path/filepath"
+	"slices"
 	"sort"
 	"strings"
 	"sync"
@@ -25,10 +27,11 @@ import (
 // For example:
 //
 //	f := NewFile()
-func NewFile(opts ...Options) *File {
+func NewFile(opts ...Option) *File {
 	f := newFile()
 	f.Pkg.Store(defaultXMLPathRels, []byte(xml.Header+templateRels))
-	f.Pkg.Store(defaultXMLPathDocPropsApp, []byte(xml.Header+templateDocpropsApp))
+	app, _ := xml.Marshal(newAppProperties(&decodeAppProperties{Application: "GoPPTX"}))
+	f.Pkg.Store(defaultXMLPathDocPropsApp, append([]byte(xml.Header), app...))
 	f.Pkg.Store(defaultXMLPathDocPropsCore, []byte(xml.Header+templateDocpropsCore))
 	f.Pkg.Store(defaultXMLPathPresentationRels, []byte(xml.Header+templatePresentationRels))
 	f.Pkg.Store(defaultXMLPathTheme, []byte(xml.Header+templateTheme))
@@ -51,23 +54,27 @@ func NewFile(opts ...Options) *File {
 	slide, _ := f.slideReader(defaultXMLSlideID)
 	f.Slide.Store(defaultXMLPathSlide, slide)
 	f.Theme, _ = f.themeReader()
-	f.options = f.getOptions(opts...)
+	f.applyOptions(opts...)
 	return f
 }
 
 // Save provides a function to override the presentation with origin path.
-func (f *File) Save(opts ...Options) error {
+func (f *File) Save(opts ...Option) error {
+	if err := f.checkReadOnly(); err != nil {
+		return err
+	}
 	if f.Path == "" {
 		return ErrSave
 	}
-	for i := range opts {
-		f.options = &opts[i]
-	}
-	return f.SaveAs(f.Path, *f.options)
+	f.applyOptions(opts...)
+	return f.SaveAs(f.Path)
 }
 
 // SaveAs provides a function to create or update to a presentation at the provided path.
-func (f *File) SaveAs(name string, opts ...Options) error {
+func (f *File) SaveAs(name string, opts ...Option) error {
+	if err := f.checkReadOnly(); err != nil {
+		return err
+	}
 	if len(name) > MaxFilePathLength {
 		return ErrMaxFilePathLength
 	}
@@ -75,6 +82,9 @@ func (f *File) SaveAs(name string, opts ...Options) error {
 	if _, ok := supportedContentTypes[strings.ToLower(filepath.Ext(f.Path))]; !ok {
 		return ErrPresentationFileFormat
 	}
+	if fi, err := os.Stat(filepath.Clean(name)); err == nil && f.isMappedFile(fi) {
+		return f.replaceMappedFile(filepath.Clean(name), fi.Mode().Perm(), opts...)
+	}
 	file, err := os.OpenFile(filepath.Clean(name), os.O_WRONLY|os.O_TRUNC|os.O_CREATE, os.ModePerm)
 	if err != nil {
 		return err
@@ -83,6 +93,37 @@ func (f *File) SaveAs(name string, opts ...Options) error {
 	return f.Write(file, opts...)
 }
 
+// isMappedFile returns true if the given file is the file mapped into memory
+// by the MemoryMap option, which the large parts are still read from.
+func (f *File) isMappedFile(fi os.FileInfo) bool {
+	return f.mapped != nil && f.mappedFile != nil && os.SameFile(fi, f.mappedFile)
+}
+
+// replaceMappedFile provides a function to save the presentation into the
+// file mapped into memory by given path and the permission of the file. The
+// presentation is written into a new file in the same directory which is
+// renamed over the mapped file, so the mapping keeps the original content
+// until the file is closed.
+func (f *File) replaceMappedFile(name string, perm os.FileMode, opts ...Option) error {
+	file, err := os.CreateTemp(filepath.Dir(name), "."+filepath.Base(name)+"-")
+	if err != nil {
+		return err
+	}
+	if err = f.Write(file, opts...); err == nil {
+		err = file.Chmod(perm)
+	}
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(file.Name(), name)
+	}
+	if err != nil {
+		_ = os.Remove(file.Name())
+	}
+	return err
+}
+
 // Close closes and cleanup the open temporary file for the presentation.
 func (f *File) Close() error {
 	var firstErr error
@@ -92,27 +133,46 @@ func (f *File) Close() error {
 	}
 	f.streams = nil
 	f.tempFiles.Range(func(k, v interface{}) bool {
-		if err := os.Remove(v.(string)); err != nil && firstErr == nil {
-			firstErr = err
+		if path, ok := v.(string); ok {
+			if err := f.tempFileProvider().Remove(path); err != nil && firstErr == nil {
+				firstErr = err
+			}
 		}
 		return true
 	})
 	f.tempFiles.Clear()
+	if f.mapped != nil {
+		if err := munmapFile(f.mapped); err != nil && firstErr == nil {
+			firstErr = err
+		}
+		f.mapped, f.mappedFile = nil, nil
+	}
 
 	return firstErr
 }
 
 // Write provides a function to write to an io.Writer.
-func (f *File) Write(w io.Writer, opts ...Options) error {
+func (f *File) Write(w io.Writer, opts ...Option) error {
+	if err := f.checkReadOnly(); err != nil {
+		return err
+	}
 	_, err := f.WriteTo(w, opts...)
 	return err
 }
 
-// WriteTo implements io.WriterTo to write the file.
-func (f *File) WriteTo(w io.Writer, opts ...Options) (int64, error) {
-	for i := range opts {
-		f.options = &opts[i]
+// WriteTo implements io.WriterTo to write the file. It returns
+// ErrMemoryMapOverwrite if the writer is the file mapped into memory by the
+// MemoryMap option.
+func (f *File) WriteTo(w io.Writer, opts ...Option) (int64, error) {
+	if err := f.checkReadOnly(); err != nil {
+		return 0, err
+	}
+	if file, ok := w.(*os.File); ok {
+		if fi, err := file.Stat(); err == nil && f.isMappedFile(fi) {
+			return 0, ErrMemoryMapOverwrite
+		}
 	}
+	f.applyOptions(opts...)
 	if len(f.Path) != 0 {
 		contentType, ok := supportedContentTypes[strings.ToLower(filepath.Ext(f.Path))]
 		if !ok {
@@ -132,6 +192,9 @@ func (f *File) WriteTo(w io.Writer, opts ...Options) (int64, error) {
 // WriteToBuffer provides a function to get bytes.Buffer from the saved file,
 // and it allocates space in memory. Be careful when the file size is large.
 func (f *File) WriteToBuffer() (*bytes.Buffer, error) {
+	if err := f.checkReadOnly(); err != nil {
+		return nil, err
+	}
 	buf := new(bytes.Buffer)
 	zw := f.ZipWriter(buf)
 
@@ -148,22 +211,53 @@ func (f *File) WriteToBuffer() (*bytes.Buffer, error) {
 
 // writeToZip provides a function to write to ZipWriter.
 func (f *File) writeToZip(zw ZipWriter) error {
+	if f.options.ZipComment != "" {
+		if w, ok := zw.(interface{ SetComment(comment string) error }); ok {
+			if err := w.SetComment(f.options.ZipComment); err != nil {
+				return err
+			}
+		}
+	}
+	if err := f.appPropsWriter(); err != nil {
+		return err
+	}
+	f.themeWriter()
+	if err := f.masterThemeWriter(); err != nil {
+		return err
+	}
 	f.contentTypesWriter()
 	f.presentationWriter()
-	// TODO: MasterWritter
 	// TODO: MasterLayoutWritter
 	f.slideWriter()
 	f.relsWriter()
-	f.themeWriter()
+	if err := f.customPartsWriter(); err != nil {
+		return err
+	}
+	// The media parts are deduplicated and compressed in the saved package
+	// only, so the presentation is kept unchanged by saving.
+	savedParts, removed, err := f.getSavedMedia()
+	if err != nil {
+		return err
+	}
 
 	for path, stream := range f.streams {
-		fi, err := zw.Create(path)
+		from, err := stream.rawData.Reader()
 		if err != nil {
+			_ = stream.rawData.Close()
 			return err
 		}
-		var from io.Reader
-		if from, err = stream.rawData.Reader(); err != nil {
-			_ = stream.rawData.Close()
+		if len(f.partWriteHooks) > 0 {
+			content, err := io.ReadAll(from)
+			if err != nil {
+				return err
+			}
+			if content, err = f.runPartWriteHooks(path, content); err != nil {
+				return err
+			}
+			from = bytes.NewReader(content)
+		}
+		fi, err := f.createZipEntry(zw, path)
+		if err != nil {
 			return err
 		}
 		written, err := io.Copy(fi, from)
@@ -176,24 +270,42 @@ func (f *File) writeToZip(zw ZipWriter) error {
 	}
 	var (
 		n                int
-		err              error
 		files, tempFiles []string
 	)
 	f.Pkg.Range(func(path, content interface{}) bool {
 		if _, ok := f.streams[path.(string)]; ok {
 			return true
 		}
+		if _, ok := removed[path.(string)]; ok {
+			return true
+		}
 		files = append(files, path.(string))
 		return true
 	})
+	for path := range savedParts {
+		if !slices.Contains(files, path) {
+			files = append(files, path)
+		}
+	}
 	sort.Sort(sort.Reverse(sort.StringSlice(files)))
 	for _, path := range files {
-		var fi io.Writer
-		if fi, err = zw.Create(path); err != nil {
+		var (
+			fi      io.Writer
+			content []byte
+		)
+		if value, _ := f.Pkg.Load(path); value != nil {
+			content = value.([]byte)
+		}
+		if value, ok := savedParts[path]; ok {
+			content = value
+		}
+		if content, err = f.runPartWriteHooks(path, content); err != nil {
+			break
+		}
+		if fi, err = f.createZipEntry(zw, path); err != nil {
 			break
 		}
-		content, _ := f.Pkg.Load(path)
-		if n, err = fi.Write(content.([]byte)); int64(n) > math.MaxUint32 {
+		if n, err = fi.Write(content); int64(n) > math.MaxUint32 {
 			f.zip64Entries = append(f.zip64Entries, path)
 		}
 	}
@@ -201,22 +313,100 @@ func (f *File) writeToZip(zw ZipWriter) error {
 		if _, ok := f.Pkg.Load(path); ok {
 			return true
 		}
+		if _, ok := savedParts[path.(string)]; ok {
+			return true
+		}
+		if _, ok := removed[path.(string)]; ok {
+			return true
+		}
 		tempFiles = append(tempFiles, path.(string))
 		return true
 	})
 	sort.Sort(sort.Reverse(sort.StringSlice(tempFiles)))
 	for _, path := range tempFiles {
-		var fi io.Writer
-		if fi, err = zw.Create(path); err != nil {
+		if err = f.writeTempToZip(zw, path); err != nil {
 			break
 		}
-		if n, err = fi.Write(f.readBytes(path)); int64(n) > math.MaxUint32 {
-			f.zip64Entries = append(f.zip64Entries, path)
+	}
+	return err
+}
+
+// writeTempToZip provides a function to write the part kept in the temporary
+// file or the mapped package into the ZipWriter by given part name. The part
+// is copied from its source directly, so only the part processed by the part
+// write hooks is read into the memory.
+func (f *File) writeTempToZip(zw ZipWriter, path string) error {
+	from, err := f.readTemp(path)
+	if err != nil {
+		return err
+	}
+	defer from.Close()
+	var r io.Reader = from
+	if len(f.partWriteHooks) > 0 {
+		content, err := io.ReadAll(from)
+		if err != nil {
+			return err
+		}
+		if content, err = f.runPartWriteHooks(path, content); err != nil {
+			return err
 		}
+		r = bytes.NewReader(content)
+	}
+	fi, err := f.createZipEntry(zw, path)
+	if err != nil {
+		return err
+	}
+	written, err := io.Copy(fi, r)
+	if written > math.MaxUint32 {
+		f.zip64Entries = append(f.zip64Entries, path)
 	}
 	return err
 }
 
+// AddPartWriteHook provides a function to register the callback which is
+// invoked with the part name and the content of each part just before the
+// part is written into the archive on saving, and the returned content is
+// written instead, which allows the custom post-processing of the parts. The
+// callbacks are invoked in the order of the registration, and the saving is
+// aborted if any callback returns an error. For example, remove the
+// indentation of the XML parts:
+//
+//	f.AddPartWriteHook(func(partName string, content []byte) ([]byte, error) {
+//	    if !strings.HasSuffix(partName, ".xml") {
+//	        return content, nil
+//	    }
+//	    return regexp.MustCompile(`>\s+<`).ReplaceAll(content, []byte("><")), nil
+//	})
+func (f *File) AddPartWriteHook(hook func(partName string, content []byte) ([]byte, error)) {
+	if hook != nil {
+		f.partWriteHooks = append(f.partWriteHooks, hook)
+	}
+}
+
+// runPartWriteHooks provides a function to run the registered part write
+// hooks by given part name and the content of the part in order.
+func (f *File) runPartWriteHooks(partName string, content []byte) ([]byte, error) {
+	var err error
+	for _, hook := range f.partWriteHooks {
+		if content, err = hook(partName, content); err != nil {
+			return content, err
+		}
+	}
+	return content, err
+}
+
+// createZipEntry provides a function to add the entry to the archive by
+// given ZipWriter and the entry name. The modification time of the entry is
+// set by the ZipModTime option if the ZipWriter supports it.
+func (f *File) createZipEntry(zw ZipWriter, name string) (io.Writer, error) {
+	if w, ok := zw.(interface {
+		CreateHeader(fh *zip.FileHeader) (io.Writer, error)
+	}); ok && !f.options.ZipModTime.IsZero() {
+		return w.CreateHeader(&zip.FileHeader{Name: name, Method: zip.Deflate, Modified: f.options.ZipModTime})
+	}
+	return zw.Create(name)
+}
+
 // setContentTypePartProjectExtensions provides a function to set the content
 // type for relationship parts and the main document part.
 func (f *File) setContentTypePartProjectExtensions(contentType string) error {
@@ -268,27 +458,62 @@ func (f *File) presentationWriter() {
 			slides[i] = newSlideID(s)
 		}
 
+		masters := make([]slideID, len(f.Presentation.MasterSlide.MasterSlide))
+		for i, s := range f.Presentation.MasterSlide.MasterSlide {
+			masters[i] = newSlideID(s)
+		}
+
 		output, _ := xml.Marshal(&presentation{
-			XMLName:  f.Presentation.XMLName,
-			XMLNSA:   NameSpaceDrawingML.Value,
-			XMLNSP:   NameSpacePresentationML.Value,
-			XMLNSR:   SourceRelationship.Value,
-			XMLNSP14: NameSpacePowerPointR14.Value,
-			XMLNSP15: NameSpacePowerPointR15.Value,
-			XMLNSMC:  SourceRelationshipCompatibility.Value,
+			XMLName:               f.Presentation.XMLName,
+			XMLNSA:                NameSpaceDrawingML.Value,
+			XMLNSP:                NameSpacePresentationML.Value,
+			XMLNSR:                SourceRelationship.Value,
+			XMLNSP14:              NameSpacePowerPointR14.Value,
+			XMLNSP15:              NameSpacePowerPointR15.Value,
+			XMLNSMC:               SourceRelationshipCompatibility.Value,
+			FirstSlideNum:         f.Presentation.FirstSlideNum,
+			ShowSpecialPlsOnTitle: f.Presentation.ShowSpecialPlsOnTitle,
 			MasterSlide: masterSlideList{
-				MasterSlide: slideID(f.Presentation.MasterSlide.MasterSlide),
+				MasterSlide: masters,
 			},
+			NotesMaster: newNotesMasterList(f.Presentation.NotesMaster),
 			Slides: &slideList{
 				Slide: slides,
 			},
-			SlideSize: f.Presentation.SlideSize,
-			NotesSize: f.Presentation.NotesSize,
+			SlideSize:      f.Presentation.SlideSize,
+			NotesSize:      f.Presentation.NotesSize,
+			ModifyVerifier: f.Presentation.ModifyVerifier,
+			ExtensionList:  newExtensionList(f.Presentation.ExtensionList),
 		})
 		f.saveFileList(f.getPresentationPath(), replaceRelationshipsBytes(f.replaceNameSpaceBytes(f.getPresentationPath(), output)))
 	}
 }
 
+// newNotesMasterList converts the decoded notes master list for
+// serialization.
+func newNotesMasterList(dnml *decodeNotesMasterList) *notesMasterList {
+	if dnml == nil || len(dnml.NotesMaster) == 0 {
+		return nil
+	}
+	nml := &notesMasterList{}
+	for _, nm := range dnml.NotesMaster {
+		nml.NotesMaster = append(nml.NotesMaster, notesMasterID{RelationshipID: nm.RelationshipID})
+	}
+	return nml
+}
+
+// newExtensionList converts the decoded extension list for serialization.
+func newExtensionList(del *decodeExtensionList) *extensionList {
+	if del == nil || len(del.Ext) == 0 {
+		return nil
+	}
+	el := &extensionList{}
+	for _, ext := range del.Ext {
+		el.Ext = append(el.Ext, extension(ext))
+	}
+	return el
+}
+
 // replaceRelationshipsBytes; Some tools that read presentation files have very
 // strict requirements about the structure of the input XML. This function is
 // a horrible hack to fix that after the XML marshalling is completed.
@@ -417,177 +642,591 @@ func (f *File) relsWriter() {
 	})
 }
 
-// slideWriter provides a function to save ppt/slides/slide%d.xml after
-// serialize structure.
-func (f *File) slideWriter() {
-	newNonVisualGroupShapeProperties := func(
-		nvgsp *decodeNonVisualGroupShapeProperties,
-	) *NonVisualGroupShapeProperties {
-		return &NonVisualGroupShapeProperties{
-			CommonNonVisualProperties:           nvgsp.CommonNonVisualProperties,
-			CommonNonVisualGroupShapeProperties: nvgsp.CommonNonVisualGroupShapeProperties,
-			NonVisualProperties:                 (*NonVisualProperties)(nvgsp.NonVisualProperties),
-		}
-	}
-
-	newGroupShapeProperties := func(c *decodeGroupShapeProperties) *GroupShapeProperties {
-		return &GroupShapeProperties{
-			Xfrm: &Xfrm{
-				Offset:       c.Xfrm.Offset,
-				Extents:      c.Xfrm.Extents,
-				ChildOffset:  c.Xfrm.ChildOffset,
-				ChildExtents: c.Xfrm.ChildExtents,
-			},
-		}
+// newNonVisualGroupShapeProperties converts the decoded non-visual group shape
+// properties for serialization.
+func newNonVisualGroupShapeProperties(
+	nvgsp *decodeNonVisualGroupShapeProperties,
+) *NonVisualGroupShapeProperties {
+	return &NonVisualGroupShapeProperties{
+		CommonNonVisualProperties:           nvgsp.CommonNonVisualProperties,
+		CommonNonVisualGroupShapeProperties: nvgsp.CommonNonVisualGroupShapeProperties,
+		NonVisualProperties:                 newNonVisualProperties(nvgsp.NonVisualProperties),
+	}
+}
+
+// newNonVisualProperties converts the decoded non-visual properties for
+// serialization.
+func newNonVisualProperties(dnvp *decodeNonVisualProperties) *NonVisualProperties {
+	if dnvp == nil {
+		return nil
 	}
+	return &NonVisualProperties{
+		UserDrawn:     dnvp.UserDrawn,
+		Ph:            dnvp.Ph,
+		ExtensionList: newExtensionList(dnvp.ExtensionList),
+	}
+}
 
-	newXfrm := func(dx *DecodeXfrm) *Xfrm {
-		if dx == nil {
-			return nil
-		}
+// newGroupShapeProperties converts the decoded group shape properties for
+// serialization.
+func newGroupShapeProperties(c *decodeGroupShapeProperties) *GroupShapeProperties {
+	if c == nil {
+		return &GroupShapeProperties{}
+	}
+	return &GroupShapeProperties{Xfrm: newXfrm(c.Xfrm)}
+}
 
-		return &Xfrm{
-			Offset:       dx.Offset,
-			Extents:      dx.Extents,
-			ChildOffset:  dx.ChildOffset,
-			ChildExtents: dx.ChildExtents,
-		}
+// newXfrm converts the decoded shape transform for serialization.
+func newXfrm(dx *DecodeXfrm) *Xfrm {
+	if dx == nil {
+		return nil
 	}
 
-	newPresetGeometry := func(dpg *DecodePresetGeometry) *PresetGeometry {
-		if dpg == nil {
-			return nil
+	return &Xfrm{
+		Rot:          dx.Rot,
+		FlipH:        dx.FlipH,
+		FlipV:        dx.FlipV,
+		Offset:       dx.Offset,
+		Extents:      dx.Extents,
+		ChildOffset:  dx.ChildOffset,
+		ChildExtents: dx.ChildExtents,
+	}
+}
+
+// newPresetGeometry converts the decoded preset geometry for serialization.
+func newPresetGeometry(dpg *DecodePresetGeometry) *PresetGeometry {
+	if dpg == nil {
+		return nil
+	}
+
+	pg := &PresetGeometry{Preset: dpg.Preset}
+	if dpg.AdjustValueList != nil {
+		pg.AdjustValueList = &adjustValueList{AdjustValue: dpg.AdjustValueList.AdjustValue}
+	}
+
+	return pg
+}
+
+// newLine converts the decoded outline for serialization.
+func newLine(dl *decodeLine) *Line {
+	if dl == nil {
+		return nil
+	}
+	return &Line{
+		Width:  dl.Width,
+		NoFill: dl.NoFill,
+	}
+}
+
+// newRunProperties converts the decoded text run properties for
+// serialization.
+func newRunProperties(drp *DecodeRunProperties) *RunProperties {
+	if drp == nil {
+		return nil
+	}
+
+	rp := &RunProperties{
+		Bold:      drp.Bold,
+		Lang:      drp.Lang,
+		Size:      drp.Size,
+		Space:     drp.Space,
+		Strike:    drp.Strike,
+		SolidFill: newSolidFill(drp.SolidFill),
+		Latin:     drp.Latin,
+	}
+	if drp.HyperlinkClick != nil {
+		rp.HyperlinkClick = &hyperlink{
+			RID:     drp.HyperlinkClick.RID,
+			Action:  drp.HyperlinkClick.Action,
+			Tooltip: drp.HyperlinkClick.Tooltip,
 		}
+	}
+
+	return rp
+}
+
+// newSolidFill converts the decoded solid fill for serialization.
+func newSolidFill(dsf *DecodeSolidFill) *SolidFill {
+	if dsf == nil {
+		return nil
+	}
+
+	sf := &SolidFill{SchemeColor: newSchemeColor(dsf.SchemeColor)}
+	if clr := dsf.SolidRGBColor; clr != nil {
+		sf.SolidRGBColor = &solidRGBColor{Val: clr.Val, Alpha: clr.Alpha}
+	}
+
+	return sf
+}
+
+// newSchemeColor converts the decoded scheme color for serialization.
+func newSchemeColor(dsc *DecodeSchemeColor) *schemeColor {
+	if dsc == nil {
+		return nil
+	}
+
+	return &schemeColor{
+		Val:    dsc.Val,
+		Tint:   dsc.Tint,
+		Shade:  dsc.Shade,
+		Alpha:  dsc.Alpha,
+		LumMod: dsc.LumMod,
+		LumOff: dsc.LumOff,
+	}
+}
+
+// newStyleMatrixReference converts the decoded line, fill or effect reference
+// of the shape style for serialization.
+func newStyleMatrixReference(dsmr *DecodeStyleMatrixReference) *styleMatrixReference {
+	if dsmr == nil {
+		return nil
+	}
 
-		return &PresetGeometry{
-			Preset:          dpg.Preset,
-			AdjustValueList: dpg.AdjustValueList,
+	ref := &styleMatrixReference{Index: dsmr.Index, SchemeColor: newSchemeColor(dsmr.SchemeColor)}
+	if clr := dsmr.SolidRGBColor; clr != nil {
+		ref.SolidRGBColor = &solidRGBColor{Val: clr.Val, Alpha: clr.Alpha}
+	}
+
+	return ref
+}
+
+// newShapeStyle converts the decoded shape style for serialization.
+func newShapeStyle(dss *DecodeShapeStyle) *shapeStyle {
+	if dss == nil {
+		return nil
+	}
+
+	style := &shapeStyle{
+		LineReference:   newStyleMatrixReference(dss.LineReference),
+		FillReference:   newStyleMatrixReference(dss.FillReference),
+		EffectReference: newStyleMatrixReference(dss.EffectReference),
+	}
+	if dfr := dss.FontReference; dfr != nil {
+		style.FontReference = &fontReference{Index: dfr.Index, SchemeColor: newSchemeColor(dfr.SchemeColor)}
+		if clr := dfr.SolidRGBColor; clr != nil {
+			style.FontReference.SolidRGBColor = &solidRGBColor{Val: clr.Val, Alpha: clr.Alpha}
 		}
 	}
 
-	newLine := func(dl *decodeLine) *Line {
-		if dl == nil {
-			return nil
+	return style
+}
+
+// newRuns converts the decoded text runs for serialization.
+func newRuns(r []DecodeRuns) []Runs {
+	runs := make([]Runs, len(r))
+	for i, run := range r {
+		runs[i] = Runs{
+			FieldID:       run.FieldID,
+			FieldType:     run.FieldType,
+			RunProperties: newRunProperties(run.RunProperties),
+			Text:          run.Text,
 		}
-		return &Line{
-			Width:  dl.Width,
-			NoFill: dl.NoFill,
+	}
+
+	return runs
+}
+
+// newParagraphProperties converts the decoded paragraph properties for
+// serialization.
+func newParagraphProperties(pPr *ParagraphProperties) *paragraphProperties {
+	if pPr == nil {
+		return nil
+	}
+
+	var ls *lineSpacing
+	if pPr.LineSpacing != nil {
+		ls = &lineSpacing{SpacingPercent: pPr.LineSpacing.SpacingPercent}
+	}
+
+	return &paragraphProperties{
+		Level:       pPr.Level,
+		Indent:      pPr.Indent,
+		Align:       pPr.Align,
+		LineSpacing: ls,
+		BuNone:      pPr.BuNone,
+	}
+}
+
+// newTextBody converts the decoded text body for serialization.
+func newTextBody(dt *DecodeTextBody) *TextBody {
+	if dt == nil {
+		return nil
+	}
+
+	paragraphs := make([]Paragraph, len(dt.Paragraph))
+	for i, p := range dt.Paragraph {
+		paragraphs[i] = Paragraph{
+			ParagraphProperties:       newParagraphProperties(p.ParagraphProperties),
+			Runs:                      newRuns(p.Runs),
+			EndParagraphRunProperties: newRunProperties(p.EndParagraphRunProperties),
 		}
 	}
 
-	newRuns := func(r []DecodeRuns) []Runs {
-		runs := make([]Runs, len(r))
-		for i, run := range r {
-			runs[i] = Runs{
-				RunProperties: &RunProperties{
-					Bold:   run.RunProperties.Bold,
-					Lang:   run.RunProperties.Lang,
-					Size:   run.RunProperties.Size,
-					Space:  run.RunProperties.Space,
-					Strike: run.RunProperties.Strike,
-					SolidFill: &SolidFill{
-						SolidRGBColor: run.RunProperties.SolidFill.SolidRGBColor,
-					},
-					Latin: run.RunProperties.Latin,
-				},
-				Text: run.Text,
-			}
+	bodyProperties := &BodyProperties{}
+	if dt.BodyProperties != nil {
+		bodyProperties = &BodyProperties{
+			LIns:        dt.BodyProperties.LIns,
+			RIns:        dt.BodyProperties.RIns,
+			TIns:        dt.BodyProperties.TIns,
+			BIns:        dt.BodyProperties.BIns,
+			Anchor:      dt.BodyProperties.Anchor,
+			Wrap:        dt.BodyProperties.Wrap,
+			NoAutofit:   dt.BodyProperties.NoAutofit,
+			NormAutofit: dt.BodyProperties.NormAutofit,
+			SpAutoFit:   dt.BodyProperties.SpAutoFit,
 		}
+	}
+
+	return &TextBody{
+		BodyProperties: bodyProperties,
+		Paragraph:      paragraphs,
+	}
+}
+
+// newShapeProperties converts the decoded shape properties for serialization.
+func newShapeProperties(dsp *DecodeShapeProperties) *ShapeProperties {
+	if dsp == nil {
+		return nil
+	}
+
+	return &ShapeProperties{
+		Xfrm:           newXfrm(dsp.Xfrm),
+		PresetGeometry: newPresetGeometry(dsp.PresetGeometry),
+		SolidFill:      newSolidFill(dsp.SolidFill),
+		NoFill:         dsp.NoFill,
+		Ln:             newLine(dsp.Ln),
+		ExtensionList:  dsp.ExtensionList,
+	}
+}
 
-		return runs
+// newNonVisualShapeProperties converts the decoded non-visual shape
+// properties for serialization.
+func newNonVisualShapeProperties(dnsp *decodeNonVisualShapeProperties) *NonVisualShapeProperties {
+	if dnsp == nil {
+		return nil
 	}
 
-	newTextBody := func(dt *DecodeTextBody) *TextBody {
-		if dt == nil {
-			return nil
+	var commonNonVisualShapeProperties *CommonNonVisualShapeProperties
+	if dnsp.CommonNonVisualShapeProperties != nil {
+		commonNonVisualShapeProperties = &CommonNonVisualShapeProperties{
+			ShapeLocks: dnsp.CommonNonVisualShapeProperties.ShapeLocks,
+			TxBox:      dnsp.CommonNonVisualShapeProperties.TxBox,
 		}
+	}
 
-		paragraphs := make([]Paragraph, len(dt.Paragraph))
-		for i, p := range dt.Paragraph {
-			var endParagraphRunProperties *RunProperties
+	return &NonVisualShapeProperties{
+		CommonNonVisualProperties:      dnsp.CommonNonVisualProperties,
+		CommonNonVisualShapeProperties: commonNonVisualShapeProperties,
+		NonVisualProperties:            newNonVisualProperties(dnsp.NonVisualProperties),
+	}
+}
 
-			if p.EndParagraphRunProperties != nil {
-				endParagraphRunProperties = &RunProperties{
-					Bold:   p.EndParagraphRunProperties.Bold,
-					Lang:   p.EndParagraphRunProperties.Lang,
-					Size:   p.EndParagraphRunProperties.Size,
-					Space:  p.EndParagraphRunProperties.Space,
-					Strike: p.EndParagraphRunProperties.Strike,
-					SolidFill: &SolidFill{
-						SolidRGBColor: p.EndParagraphRunProperties.SolidFill.SolidRGBColor,
-					},
-					Latin: p.EndParagraphRunProperties.Latin,
-				}
-			}
+// newShape converts the decoded shape for serialization.
+func newShape(ds decodeShape) Shape {
+	return Shape{
+		NonVisualShapeProperties: newNonVisualShapeProperties(ds.NonVisualShapeProperties),
+		ShapeProperties:          newShapeProperties(ds.ShapeProperties),
+		Style:                    newShapeStyle(ds.Style),
+		TextBody:                 newTextBody(ds.TextBody),
+		ExtensionList:            newExtensionList(ds.ExtensionList),
+		order:                    ds.order,
+	}
+}
 
-			paragraphs[i] = Paragraph{
-				ParagraphProperties:       p.ParagraphProperties,
-				Runs:                      newRuns(p.Runs),
-				EndParagraphRunProperties: endParagraphRunProperties,
+// newPicture converts the decoded picture for serialization.
+func newPicture(dp decodePicture) Picture {
+	pic := Picture{
+		ShapeProperties: newShapeProperties(dp.ShapeProperties),
+		ExtensionList:   newExtensionList(dp.ExtensionList),
+		order:           dp.order,
+	}
+	if dnvpp := dp.NonVisualPictureProperties; dnvpp != nil {
+		pic.NonVisualPictureProperties = &NonVisualPictureProperties{
+			CommonNonVisualProperties:        dnvpp.CommonNonVisualProperties,
+			CommonNonVisualPictureProperties: &CommonNonVisualPictureProperties{},
+			NonVisualProperties:              &NonVisualProperties{},
+		}
+		if dnvpp.CommonNonVisualPictureProperties != nil {
+			pic.NonVisualPictureProperties.CommonNonVisualPictureProperties.PictureLocks = dnvpp.CommonNonVisualPictureProperties.PictureLocks
+		}
+		if dnvpp.NonVisualProperties != nil {
+			pic.NonVisualPictureProperties.NonVisualProperties = newNonVisualProperties(dnvpp.NonVisualProperties)
+		}
+	}
+	if dbf := dp.BlipFill; dbf != nil {
+		pic.BlipFill = &BlipFill{SrcRect: dbf.SrcRect}
+		if dbf.Blip != nil {
+			pic.BlipFill.Blip = &Blip{
+				Embed:         dbf.Blip.Embed,
+				Link:          dbf.Blip.Link,
+				AlphaModFix:   dbf.Blip.AlphaModFix,
+				Duotone:       dbf.Blip.Duotone,
+				Grayscale:     dbf.Blip.Grayscale,
+				Luminance:     dbf.Blip.Luminance,
+				ExtensionList: dbf.Blip.ExtensionList,
 			}
 		}
-
-		return &TextBody{
-			BodyProperties: &BodyProperties{
-				LIns:      dt.BodyProperties.LIns,
-				RIns:      dt.BodyProperties.RIns,
-				TIns:      dt.BodyProperties.TIns,
-				BIns:      dt.BodyProperties.BIns,
-				Anchor:    dt.BodyProperties.Anchor,
-				NoAutofit: dt.BodyProperties.NoAutofit,
-			},
-			Paragraph: paragraphs,
+		if dbf.Stretch != nil {
+			pic.BlipFill.Stretch = &Stretch{FillRect: dbf.Stretch.FillRect}
 		}
 	}
+	return pic
+}
 
-	newShapeProperties := func(dsp *DecodeShapeProperties) *ShapeProperties {
-		if dsp == nil {
-			return nil
+// newGraphicFrame converts the decoded graphic frame for serialization.
+func newGraphicFrame(dgf decodeGraphicFrame) GraphicFrame {
+	gf := GraphicFrame{Xfrm: newXfrm(dgf.Xfrm), ExtensionList: newExtensionList(dgf.ExtensionList), order: dgf.order}
+	if dnvgfp := dgf.NonVisualGraphicFrameProperties; dnvgfp != nil {
+		gf.NonVisualGraphicFrameProperties = &NonVisualGraphicFrameProperties{
+			CommonNonVisualProperties:             dnvgfp.CommonNonVisualProperties,
+			CommonNonVisualGraphicFrameProperties: dnvgfp.CommonNonVisualGraphicFrameProperties,
+			NonVisualProperties:                   &NonVisualProperties{},
 		}
-
-		return &ShapeProperties{
-			Xfrm:           newXfrm(dsp.Xfrm),
-			PresetGeometry: newPresetGeometry(dsp.PresetGeometry),
-			NoFill:         dsp.NoFill,
-			Ln:             newLine(dsp.Ln),
+		if gf.NonVisualGraphicFrameProperties.CommonNonVisualGraphicFrameProperties == nil {
+			gf.NonVisualGraphicFrameProperties.CommonNonVisualGraphicFrameProperties = &innerXML{}
 		}
+		if dnvgfp.NonVisualProperties != nil {
+			gf.NonVisualGraphicFrameProperties.NonVisualProperties = newNonVisualProperties(dnvgfp.NonVisualProperties)
+		}
+	}
+	if dgf.Graphic != nil {
+		gf.Graphic = &Graphic{GraphicData: dgf.Graphic.GraphicData}
 	}
+	return gf
+}
 
-	newNonVisualShapeProperties := func(dnsp *decodeNonVisualShapeProperties) *NonVisualShapeProperties {
-		if dnsp == nil {
-			return nil
-		}
+// newGroupShape converts the decoded group shape and the shapes in the group
+// for serialization.
+func newGroupShape(dgs decodeGroupShape) GroupShape {
+	gs := GroupShape{GroupShapeProperties: newGroupShapeProperties(dgs.GroupShapeProperties), order: dgs.order}
+	if dgs.NonVisualGroupShapeProperties != nil {
+		gs.NonVisualGroupShapeProperties = newNonVisualGroupShapeProperties(dgs.NonVisualGroupShapeProperties)
+	}
+	for _, s := range dgs.Shape {
+		gs.Shape = append(gs.Shape, newShape(s))
+	}
+	for _, p := range dgs.Picture {
+		gs.Picture = append(gs.Picture, newPicture(p))
+	}
+	for _, gf := range dgs.GraphicFrame {
+		gs.GraphicFrame = append(gs.GraphicFrame, newGraphicFrame(gf))
+	}
+	for _, g := range dgs.GroupShape {
+		gs.GroupShape = append(gs.GroupShape, newGroupShape(g))
+	}
+	gs.ContentPart, gs.AlternateContent = newContentParts(dgs.ContentPart), newAlternateContents(dgs.AlternateContent)
+	gs.ExtensionList = newExtensionList(dgs.ExtensionList)
+	return gs
+}
 
-		var commonNonVisualShapeProperties *CommonNonVisualShapeProperties
-		if dnsp.CommonNonVisualShapeProperties != nil {
-			commonNonVisualShapeProperties = &CommonNonVisualShapeProperties{
-				ShapeLocks: dnsp.CommonNonVisualShapeProperties.ShapeLocks,
-				TxBox:      dnsp.CommonNonVisualShapeProperties.TxBox,
-			}
+// newContentParts converts the decoded content parts of the shape tree or the
+// group shape for serialization.
+func newContentParts(dcps []decodeContentPart) []contentPart {
+	var contentParts []contentPart
+	for _, cp := range dcps {
+		contentParts = append(contentParts, contentPart{BwMode: cp.BwMode, RID: cp.RID, Content: cp.Content, order: cp.order})
+	}
+	return contentParts
+}
+
+// newAlternateContents converts the decoded alternate content blocks of the
+// shape tree or the group shape for serialization.
+func newAlternateContents(dacs []decodeAlternateContent) []alternateContent {
+	var acs []alternateContent
+	for _, ac := range dacs {
+		acs = append(acs, alternateContent{Content: ac.Content, order: ac.order})
+	}
+	return acs
+}
+
+// shapeTreeElement defines the element of the shape tree or the group shape
+// for serialization, with the document order of the element.
+type shapeTreeElement struct {
+	order int
+	name  string
+	value interface{}
+}
+
+// MarshalXML implements the xml.Marshaler interface for the shape tree to
+// write the shapes in the document order, which is the z-order of the shapes
+// on the slide.
+func (t ShapeTree) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	children := newShapeTreeElements(t.Shape, t.Picture, t.GraphicFrame, t.GroupShape)
+	for _, cp := range t.ContentPart {
+		children = append(children, shapeTreeElement{order: cp.order, name: "p:contentPart", value: cp})
+	}
+	for _, ac := range t.AlternateContent {
+		children = append(children, shapeTreeElement{order: ac.order, name: "mc:AlternateContent", value: ac})
+	}
+	return encodeShapeTreeElements(e, start, t.NonVisualGroupShapeProperties, t.GroupShapeProperties, children, t.ExtensionList)
+}
+
+// MarshalXML implements the xml.Marshaler interface for the group shape to
+// write the shapes in the group in the document order, the group shape has
+// the same children as the shape tree.
+func (g GroupShape) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return ShapeTree{
+		NonVisualGroupShapeProperties: g.NonVisualGroupShapeProperties,
+		GroupShapeProperties:          g.GroupShapeProperties,
+		Shape:                         g.Shape,
+		Picture:                       g.Picture,
+		GraphicFrame:                  g.GraphicFrame,
+		GroupShape:                    g.GroupShape,
+		ContentPart:                   g.ContentPart,
+		AlternateContent:              g.AlternateContent,
+		ExtensionList:                 g.ExtensionList,
+	}.MarshalXML(e, start)
+}
+
+// newShapeTreeElements returns the elements of the shapes, pictures, graphic
+// frames and group shapes for serialization.
+func newShapeTreeElements(shapes []Shape, pics []Picture, frames []GraphicFrame, groups []GroupShape) []shapeTreeElement {
+	var elements []shapeTreeElement
+	for _, sp := range shapes {
+		elements = append(elements, shapeTreeElement{order: sp.order, name: "p:sp", value: sp})
+	}
+	for _, pic := range pics {
+		elements = append(elements, shapeTreeElement{order: pic.order, name: "p:pic", value: pic})
+	}
+	for _, gf := range frames {
+		elements = append(elements, shapeTreeElement{order: gf.order, name: "p:graphicFrame", value: gf})
+	}
+	for _, grpSp := range groups {
+		elements = append(elements, shapeTreeElement{order: grpSp.order, name: "p:grpSp", value: grpSp})
+	}
+	return elements
+}
+
+// encodeShapeTreeElements writes the element of the shape tree or the group
+// shape by given start element, group shape properties, child elements and
+// extension list. The child elements are sorted by the document order, and
+// the elements without the document order, which are added after the shape
+// tree is read, are placed at the end in the order of the types.
+func encodeShapeTreeElements(e *xml.Encoder, start xml.StartElement, nvGrpSpPr *NonVisualGroupShapeProperties,
+	grpSpPr *GroupShapeProperties, children []shapeTreeElement, extLst *extensionList,
+) error {
+	slices.SortStableFunc(children, func(a, b shapeTreeElement) int { return compareShapeOrder(a.order, b.order) })
+	elements := append([]shapeTreeElement{
+		{name: "p:nvGrpSpPr", value: nvGrpSpPr},
+		{name: "p:grpSpPr", value: grpSpPr},
+	}, children...)
+	elements = append(elements, shapeTreeElement{name: "p:extLst", value: extLst})
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, element := range elements {
+		if err := e.EncodeElement(element.value, xml.StartElement{Name: xml.Name{Local: element.name}}); err != nil {
+			return err
 		}
+	}
+	return e.EncodeToken(start.End())
+}
 
-		var nonVisualProperties *NonVisualProperties
-		if dnsp.NonVisualProperties != nil {
-			nonVisualProperties = &NonVisualProperties{
-				Ph: dnsp.NonVisualProperties.Ph,
-			}
+// compareShapeOrder returns the comparison of the document orders of the
+// shapes, the shapes without the document order are placed at the end.
+func compareShapeOrder(a, b int) int {
+	switch {
+	case a == b:
+		return 0
+	case a == 0:
+		return 1
+	case b == 0:
+		return -1
+	}
+	return a - b
+}
+
+// marshalSlide returns the serialized slide XML by given decoded slide.
+func marshalSlide(ds *decodeSlide) []byte {
+	shapes := make([]Shape, len(ds.CommonSlideData.ShapeTree.Shape))
+	for i, s := range ds.CommonSlideData.ShapeTree.Shape {
+		shapes[i] = newShape(s)
+	}
+
+	pictures := make([]Picture, len(ds.CommonSlideData.ShapeTree.Picture))
+	for i, p := range ds.CommonSlideData.ShapeTree.Picture {
+		pictures[i] = newPicture(p)
+	}
+
+	graphicFrames := make([]GraphicFrame, len(ds.CommonSlideData.ShapeTree.GraphicFrame))
+	for i, gf := range ds.CommonSlideData.ShapeTree.GraphicFrame {
+		graphicFrames[i] = newGraphicFrame(gf)
+	}
+
+	groupShapes := make([]GroupShape, len(ds.CommonSlideData.ShapeTree.GroupShape))
+	for i, gs := range ds.CommonSlideData.ShapeTree.GroupShape {
+		groupShapes[i] = newGroupShape(gs)
+	}
+
+	var ac *alternateContent
+	if ds.DecodeAlternateContent != nil {
+		ac = &alternateContent{
+			Content: ds.DecodeAlternateContent.Content,
+			XMLNSMC: SourceRelationshipCompatibility.Value,
 		}
+	}
 
-		return &NonVisualShapeProperties{
-			CommonNonVisualProperties: dnsp.CommonNonVisualProperties,
-			CommonNonVisualShapeProperties: commonNonVisualShapeProperties,
-			NonVisualProperties: nonVisualProperties,
+	var colorMap *colorMapOverride
+	if ds.ColorMapOverride != nil {
+		colorMap = &colorMapOverride{
+			MasterColorMapping:   ds.ColorMapOverride.MasterColorMapping,
+			OverrideColorMapping: ds.ColorMapOverride.OverrideColorMapping,
 		}
 	}
 
-	newShape := func(ds decodeShape) Shape {
-		return Shape{
-			NonVisualShapeProperties: newNonVisualShapeProperties(ds.NonVisualShapeProperties),
-			ShapeProperties:          newShapeProperties(ds.ShapeProperties),
-			TextBody:                 newTextBody(ds.TextBody),
+	var transition *slideTransition
+	if ds.Transition != nil {
+		transition = &slideTransition{
+			Speed:          ds.Transition.Speed,
+			AdvanceOnClick: ds.Transition.AdvanceOnClick,
+			AdvanceAfter:   ds.Transition.AdvanceAfter,
+			Content:        ds.Transition.Content,
 		}
 	}
 
+	var timing *slideTiming
+	if ds.Timing != nil {
+		timing = &slideTiming{Content: ds.Timing.Content}
+	}
+
+	output, _ := xml.Marshal(&Slide{
+		XMLName:          ds.XMLName,
+		XMLNSA:           NameSpaceDrawingML.Value,
+		XMLNSP:           NameSpacePresentationML.Value,
+		XMLNSR:           SourceRelationship.Value,
+		XMLNSP14:         NameSpacePowerPointR14.Value,
+		XMLNSP15:         NameSpacePowerPointR15.Value,
+		XMLNSMC:          SourceRelationshipCompatibility.Value,
+		ShowMasterShapes: ds.ShowMasterShapes,
+		ShowMasterPhAnim: ds.ShowMasterPhAnim,
+		Show:             ds.Show,
+		CommonSlideData: SlideData{
+			Name:          ds.CommonSlideData.Name,
+			ExtensionList: newExtensionList(ds.CommonSlideData.ExtensionList),
+			ShapeTree: ShapeTree{
+				NonVisualGroupShapeProperties: newNonVisualGroupShapeProperties(ds.CommonSlideData.ShapeTree.NonVisualGroupShapeProperties),
+				GroupShapeProperties:          newGroupShapeProperties(ds.CommonSlideData.ShapeTree.GroupShapeProperties),
+				Shape:                         shapes,
+				Picture:                       pictures,
+				GraphicFrame:                  graphicFrames,
+				GroupShape:                    groupShapes,
+				ContentPart:                   newContentParts(ds.CommonSlideData.ShapeTree.ContentPart),
+				AlternateContent:              newAlternateContents(ds.CommonSlideData.ShapeTree.AlternateContent),
+				ExtensionList:                 newExtensionList(ds.CommonSlideData.ShapeTree.ExtensionList),
+			},
+		},
+		ColorMapOverride: colorMap,
+		AlternateContent: ac,
+		Transition:       transition,
+		Timing:           timing,
+		ExtensionList:    newExtensionList(ds.ExtensionList),
+	})
+	return output
+}
+
+// slideWriter provides a function to save ppt/slides/slide%d.xml after
+// serialize structure.
+func (f *File) slideWriter() {
 	var (
 		arr    []byte
 		buffer = bytes.NewBuffer(arr)
@@ -595,34 +1234,7 @@ func (f *File) slideWriter() {
 	f.Slide.Range(func(p, slide interface{}) bool {
 		if slide != nil {
 
-			ds := slide.(*decodeSlide)
-
-			shapes := make([]Shape, len(ds.CommonSlideData.ShapeTree.Shape))
-			for i, s := range ds.CommonSlideData.ShapeTree.Shape {
-				shapes[i] = newShape(s)
-			}
-
-			output, _ := xml.Marshal(&Slide{
-				XMLName:  ds.XMLName,
-				XMLNSA:   NameSpaceDrawingML.Value,
-				XMLNSP:   NameSpacePresentationML.Value,
-				XMLNSR:   SourceRelationship.Value,
-				XMLNSP14: NameSpacePowerPointR14.Value,
-				XMLNSP15: NameSpacePowerPointR15.Value,
-				XMLNSMC:  SourceRelationshipCompatibility.Value,
-				CommonSlideData: SlideData{
-					ShapeTree: ShapeTree{
-						NonVisualGroupShapeProperties: newNonVisualGroupShapeProperties(ds.CommonSlideData.ShapeTree.NonVisualGroupShapeProperties),
-						GroupShapeProperties:          newGroupShapeProperties(ds.CommonSlideData.ShapeTree.GroupShapeProperties),
-						Shape:                         shapes,
-					},
-				},
-				AlternateContent: &alternateContent{
-					Content: ds.DecodeAlternateContent.Content,
-					XMLNSMC: SourceRelationshipCompatibility.Value,
-				},
-			})
-
+			output := marshalSlide(slide.(*decodeSlide))
 			f.saveFileList(p.(string), f.replaceNameSpaceBytes(p.(string), output))
 			_, ok := f.checked.Load(p.(string))
 			if ok {
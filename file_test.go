@@ -0,0 +1,139 @@
+package gopptx
+
+import (
+	"archive/zip"
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestGroupShapeRoundTrip(t *testing.T) {
+	const group = `<p:grpSp><p:nvGrpSpPr><p:cNvPr id="10" name="Group 9"/><p:cNvGrpSpPr/><p:nvPr/></p:nvGrpSpPr>` +
+		`<p:grpSpPr><a:xfrm><a:off x="0" y="0"/><a:ext cx="100" cy="100"/><a:chOff x="0" y="0"/><a:chExt cx="100" cy="100"/></a:xfrm></p:grpSpPr>` +
+		`<p:sp><p:nvSpPr><p:cNvPr id="11" name="Shape 10"/><p:cNvSpPr/><p:nvPr/></p:nvSpPr><p:spPr/></p:sp>` +
+		`<p:contentPart p14:bwMode="auto" r:id="rId9"><p14:nvContentPartPr><p14:cNvPr id="12" name="Ink 11"/><p14:cNvContentPartPr/><p14:nvPr/></p14:nvContentPartPr></p:contentPart>` +
+		`<mc:AlternateContent><mc:Choice Requires="p14"><p:sp><p:nvSpPr><p:cNvPr id="13" name="Shape 12"/><p:cNvSpPr/><p:nvPr/></p:nvSpPr><p:spPr/></p:sp></mc:Choice></mc:AlternateContent>` +
+		`<p:extLst><p:ext uri="{GROUP-EXT}"><p14:creationId val="1"/></p:ext></p:extLst></p:grpSp>`
+	f, err := openTestFile(t, func(name string, content []byte) []byte {
+		if name == defaultXMLPathSlide {
+			return bytes.Replace(content, []byte("</p:spTree>"), []byte(group+"</p:spTree>"), 1)
+		}
+		return content
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	slideID := f.GetSlideList()[0]
+	if _, err = f.AddTextBox(slideID, TextBoxOptions{Text: "text"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = f.WriteToBuffer(); err != nil {
+		t.Fatal(err)
+	}
+	content := string(f.readXML(defaultXMLPathSlide))
+	start, end := strings.Index(content, "<p:grpSp>"), strings.Index(content, "</p:grpSp>")
+	if start == -1 || end == -1 {
+		t.Fatalf("the group shape is lost: %s", content)
+	}
+	saved := content[start:end]
+	for _, element := range []string{`<p:contentPart p14:bwMode="auto" r:id="rId9">`, "<mc:AlternateContent>", `<p:ext uri="{GROUP-EXT}">`} {
+		if !strings.Contains(saved, element) {
+			t.Errorf("the element %s of the group shape is lost: %s", element, saved)
+		}
+	}
+	if sp, cp, ac, ext := strings.Index(saved, "<p:sp>"), strings.Index(saved, "<p:contentPart"),
+		strings.Index(saved, "<mc:AlternateContent>"), strings.Index(saved, "<p:extLst>"); !(sp < cp && cp < ac && ac < ext) {
+		t.Errorf("the document order of the group shape isn't kept: %s", saved)
+	}
+}
+
+func TestZipEntryMetadata(t *testing.T) {
+	modTime := time.Date(2026, 1, 2, 3, 4, 6, 0, time.UTC)
+	for _, c := range []struct {
+		opts    Options
+		comment string
+		modTime time.Time
+	}{
+		{},
+		{opts: Options{ZipModTime: modTime, ZipComment: "Quarterly report"}, comment: "Quarterly report", modTime: modTime},
+	} {
+		buf, err := NewFile(c.opts).WriteToBuffer()
+		if err != nil {
+			t.Fatal(err)
+		}
+		zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if zr.Comment != c.comment {
+			t.Errorf("expected the comment %q, got %q", c.comment, zr.Comment)
+		}
+		for _, file := range zr.File {
+			if !c.modTime.IsZero() && !file.Modified.Equal(c.modTime) {
+				t.Errorf("expected the modification time %v of the entry %s, got %v", c.modTime, file.Name, file.Modified)
+			}
+		}
+	}
+}
+
+func TestAddPartWriteHook(t *testing.T) {
+	f := NewFile()
+	var partNames []string
+	f.AddPartWriteHook(nil)
+	f.AddPartWriteHook(func(partName string, content []byte) ([]byte, error) {
+		partNames = append(partNames, partName)
+		if !strings.HasSuffix(partName, ".xml") {
+			return content, nil
+		}
+		return append(content, "<!-- first -->"...), nil
+	})
+	f.AddPartWriteHook(func(partName string, content []byte) ([]byte, error) {
+		if partName != "ppt/presentation.xml" {
+			return content, nil
+		}
+		return append(content, "<!-- second -->"...), nil
+	})
+	buf, err := f.WriteToBuffer()
+	if err != nil {
+		t.Fatal(err)
+	}
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(partNames) != len(zr.File) {
+		t.Errorf("expected the hook is invoked for %d parts, got %v", len(zr.File), partNames)
+	}
+	for _, file := range zr.File {
+		rc, err := file.Open()
+		if err != nil {
+			t.Fatal(err)
+		}
+		content, err := io.ReadAll(rc)
+		if err = errors.Join(err, rc.Close()); err != nil {
+			t.Fatal(err)
+		}
+		suffix := "<!-- first -->"
+		if file.Name == "ppt/presentation.xml" {
+			suffix += "<!-- second -->"
+		}
+		if strings.HasSuffix(file.Name, ".xml") && !strings.HasSuffix(string(content), suffix) {
+			t.Errorf("expected the part %s ends with %s, got %s", file.Name, suffix, content)
+		}
+	}
+
+	errHook := errors.New("hook")
+	f = NewFile()
+	f.AddPartWriteHook(func(partName string, content []byte) ([]byte, error) {
+		if partName == "ppt/presentation.xml" {
+			return nil, errHook
+		}
+		return content, nil
+	})
+	if _, err = f.WriteToBuffer(); err != errHook {
+		t.Errorf("expected the error of the hook, got %v", err)
+	}
+}
@@ -0,0 +1,184 @@
+// Copyright 2026 kenny-not-dead. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+//
+// Package gopptx provides functionality to create and manipulate PowerPoint
+// (.pptx) files in Go, using the Office Open XML (ECMA-376) format.
+
+package gopptx
+
+import (
+	"bytes"
+	"image"
+	"image/png"
+	"math"
+	"path"
+)
+
+// flattenShapeScale is the number of the pixels of the flattened shape per
+// pixel at 96 DPI.
+const flattenShapeScale = 2
+
+// shapeTransform defines the transform from the coordinates of the shapes in
+// a group to the coordinates of the rendered image, both in EMUs.
+type shapeTransform struct {
+	offsetX, offsetY, scaleX, scaleY float64
+}
+
+// FlattenShape provides a function to replace the shape or the group shape
+// on the slide by given slide id and shape name with the picture rendered by
+// the renderer of the RenderThumbnails function, which locks down the
+// complex diagrams before the external distribution. The picture keeps the
+// id, name, alternative text, position, size and rotation of the shape. Only
+// the shapes and groups at the top level of the shape tree are flattened,
+// and the shape should specify its offset and size. For example:
+//
+//	if err := f.FlattenShape(256, "Org Chart"); err != nil {
+//	    fmt.Println(err)
+//	}
+func (f *File) FlattenShape(slideID int, shapeName string) error {
+	if err := f.checkReadOnly(); err != nil {
+		return err
+	}
+	slide, err := f.slideReader(slideID)
+	if err != nil {
+		return err
+	}
+	slideXMLPath, _ := f.getSlideXMLPath(slideID)
+	var (
+		cNvPr            *CommonNonVisualProperties
+		xfrm             *DecodeXfrm
+		shapeIdx, grpIdx = -1, -1
+		tree             = &slide.CommonSlideData.ShapeTree
+	)
+	for i, sp := range tree.Shape {
+		if nvSpPr := sp.NonVisualShapeProperties; nvSpPr != nil && nvSpPr.CommonNonVisualProperties != nil &&
+			nvSpPr.CommonNonVisualProperties.Name == shapeName {
+			cNvPr, shapeIdx = nvSpPr.CommonNonVisualProperties, i
+			if sp.ShapeProperties != nil {
+				xfrm = sp.ShapeProperties.Xfrm
+			}
+			break
+		}
+	}
+	for i, grpSp := range tree.GroupShape {
+		if nvGrpSpPr := grpSp.NonVisualGroupShapeProperties; cNvPr == nil && nvGrpSpPr != nil &&
+			nvGrpSpPr.CommonNonVisualProperties != nil && nvGrpSpPr.CommonNonVisualProperties.Name == shapeName {
+			cNvPr, grpIdx = nvGrpSpPr.CommonNonVisualProperties, i
+			if grpSp.GroupShapeProperties != nil {
+				xfrm = grpSp.GroupShapeProperties.Xfrm
+			}
+			break
+		}
+	}
+	if cNvPr == nil {
+		return ErrShapeNameNotExist{SlideID: slideID, Name: shapeName}
+	}
+	if xfrm == nil || xfrm.Offset == nil || xfrm.Extents == nil || xfrm.Extents.CX <= 0 || xfrm.Extents.CY <= 0 {
+		return ErrShapeTransform
+	}
+	r := &slideRenderer{scale: float64(flattenShapeScale) / EMUPerPixel}
+	r.img = image.NewRGBA(image.Rect(0, 0, max(1, r.px(xfrm.Extents.CX)), max(1, r.px(xfrm.Extents.CY))))
+	t := shapeTransform{offsetX: -float64(xfrm.Offset.X), offsetY: -float64(xfrm.Offset.Y), scaleX: 1, scaleY: 1}
+	if shapeIdx != -1 {
+		f.drawShapes(r, slideXMLPath, t, tree.Shape[shapeIdx:shapeIdx+1], nil, nil)
+	} else {
+		group := tree.GroupShape[grpIdx]
+		f.drawShapes(r, slideXMLPath, t.group(xfrm), group.Shape, group.Picture, group.GroupShape)
+	}
+	var buf bytes.Buffer
+	if err = png.Encode(&buf, r.img); err != nil {
+		return err
+	}
+	mediaPath := f.addMedia(buf.Bytes(), ".png")
+	if err = f.setContentTypeDefault(".png", supportedImageTypes[".png"]); err != nil {
+		return err
+	}
+	rID := f.addRels(getPartRelsPath(slideXMLPath), SourceRelationshipImage, "../media/"+path.Base(mediaPath), "")
+	shapeID, descr, frame, order := cNvPr.ID, cNvPr.Descr, *xfrm, 0
+	if shapeIdx != -1 {
+		order = tree.Shape[shapeIdx].order
+		tree.Shape = append(tree.Shape[:shapeIdx], tree.Shape[shapeIdx+1:]...)
+	} else {
+		order = tree.GroupShape[grpIdx].order
+		tree.GroupShape = append(tree.GroupShape[:grpIdx], tree.GroupShape[grpIdx+1:]...)
+	}
+	slide.addPicture(&decodeBlip{Embed: rID}, frame.Extents.CX, frame.Extents.CY, &PictureOptions{
+		Name: shapeName, AltText: descr, OffsetX: frame.Offset.X, OffsetY: frame.Offset.Y,
+	})
+	pic := &tree.Picture[len(tree.Picture)-1]
+	pic.NonVisualPictureProperties.CommonNonVisualProperties.ID, pic.order = shapeID, order
+	pic.ShapeProperties.Xfrm.Rot, pic.ShapeProperties.Xfrm.FlipH, pic.ShapeProperties.Xfrm.FlipV = frame.Rot, frame.FlipH, frame.FlipV
+	return nil
+}
+
+// drawShapes provides a function to draw the pictures, the shapes and the
+// group shapes by given renderer, part name of the slide and the transform
+// of the coordinates of the shapes.
+func (f *File) drawShapes(r *slideRenderer, slideXMLPath string, t shapeTransform, shapes []decodeShape, pics []decodePicture, groups []decodeGroupShape) {
+	for _, pic := range pics {
+		if pic.ShapeProperties != nil {
+			props := *pic.ShapeProperties
+			props.Xfrm, pic.ShapeProperties = t.apply(props.Xfrm), &props
+		}
+		f.drawPicture(r, slideXMLPath, pic)
+	}
+	for _, shape := range shapes {
+		if shape.ShapeProperties != nil {
+			props := *shape.ShapeProperties
+			props.Xfrm, shape.ShapeProperties = t.apply(props.Xfrm), &props
+		}
+		r.drawShape(shape)
+	}
+	for _, group := range groups {
+		if group.GroupShapeProperties == nil || group.GroupShapeProperties.Xfrm == nil {
+			continue
+		}
+		f.drawShapes(r, slideXMLPath, t.group(group.GroupShapeProperties.Xfrm), group.Shape, group.Picture, group.GroupShape)
+	}
+}
+
+// apply returns the transform of the shape in the coordinates of the
+// rendered image by given transform of the shape, it returns nil if the
+// transform has no offset or size.
+func (t shapeTransform) apply(xfrm *DecodeXfrm) *DecodeXfrm {
+	if xfrm == nil || xfrm.Offset == nil || xfrm.Extents == nil {
+		return nil
+	}
+	out := *xfrm
+	out.Offset = &Offset{
+		X: int(math.Round(t.offsetX + float64(xfrm.Offset.X)*t.scaleX)),
+		Y: int(math.Round(t.offsetY + float64(xfrm.Offset.Y)*t.scaleY)),
+	}
+	out.Extents = &Extents{
+		CX: int(math.Round(float64(xfrm.Extents.CX) * t.scaleX)),
+		CY: int(math.Round(float64(xfrm.Extents.CY) * t.scaleY)),
+	}
+	return &out
+}
+
+// group returns the transform of the shapes in the group by given transform
+// of the group, the child offset and size of the group are mapped to the
+// offset and size of the group.
+func (t shapeTransform) group(xfrm *DecodeXfrm) shapeTransform {
+	if xfrm.Offset == nil || xfrm.Extents == nil {
+		return t
+	}
+	chOff, chExt := xfrm.Offset, xfrm.Extents
+	if xfrm.ChildOffset != nil {
+		chOff = xfrm.ChildOffset
+	}
+	if xfrm.ChildExtents != nil && xfrm.ChildExtents.CX > 0 && xfrm.ChildExtents.CY > 0 {
+		chExt = xfrm.ChildExtents
+	}
+	scaleX, scaleY := 1.0, 1.0
+	if chExt.CX > 0 && chExt.CY > 0 {
+		scaleX, scaleY = float64(xfrm.Extents.CX)/float64(chExt.CX), float64(xfrm.Extents.CY)/float64(chExt.CY)
+	}
+	return shapeTransform{
+		offsetX: t.offsetX + (float64(xfrm.Offset.X)-float64(chOff.X)*scaleX)*t.scaleX,
+		offsetY: t.offsetY + (float64(xfrm.Offset.Y)-float64(chOff.Y)*scaleY)*t.scaleY,
+		scaleX:  scaleX * t.scaleX,
+		scaleY:  scaleY * t.scaleY,
+	}
+}
@@ -0,0 +1,99 @@
+package gopptx
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/color"
+	"testing"
+)
+
+func TestFlattenShape(t *testing.T) {
+	f := NewFile()
+	slideID := f.GetSlideList()[0]
+	groupID, err := f.AddDiagram(slideID, DiagramProcess, []string{"Plan", "Build"}, &DiagramOptions{OffsetX: 457200, OffsetY: 457200, Width: 3000000, Height: 900000})
+	if err != nil {
+		t.Fatal(err)
+	}
+	shape := addTestShape(t, f, slideID, "", "")
+	shape.NonVisualShapeProperties.CommonNonVisualProperties.Name, shape.NonVisualShapeProperties.CommonNonVisualProperties.Descr = "Badge", "Status"
+	rot := 5400000
+	shape.ShapeProperties.Xfrm.Rot = &rot
+	shape.ShapeProperties.PresetGeometry = &DecodePresetGeometry{Preset: "rect"}
+	shape.ShapeProperties.SolidFill = &DecodeSolidFill{SolidRGBColor: &SolidRGBColor{Val: "FF0000"}}
+	shapeID := shape.NonVisualShapeProperties.CommonNonVisualProperties.ID
+	addTestShape(t, f, slideID, "", "Top")
+	for _, name := range []string{"", "Diagram", "Badge"} {
+		if name != "" {
+			if err = f.FlattenShape(slideID, name); err != nil {
+				t.Fatal(err)
+			}
+		}
+		buf, err := f.WriteToBuffer()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if f, err = OpenReader(buf); err != nil {
+			t.Fatal(err)
+		}
+	}
+	slide, err := f.slideReader(slideID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tree := slide.CommonSlideData.ShapeTree
+	if len(tree.GroupShape) != 0 || len(tree.Picture) != 2 || len(tree.Shape) != 3 {
+		t.Fatalf("expected the group and the shape are replaced by the pictures, got %d groups %d pictures %d shapes",
+			len(tree.GroupShape), len(tree.Picture), len(tree.Shape))
+	}
+	slideXMLPath, _ := f.getSlideXMLPath(slideID)
+	pics := map[string]decodePicture{}
+	for _, pic := range tree.Picture {
+		pics[pic.NonVisualPictureProperties.CommonNonVisualProperties.Name] = pic
+	}
+	for _, c := range []struct {
+		id, x, y, width, height int
+		name, descr             string
+		rot                     *int
+		fill                    color.Color
+	}{
+		{id: groupID, x: 457200, y: 457200, width: 630, height: 189, name: "Diagram"},
+		{id: shapeID, x: 914400, y: 457200, width: 384, height: 192, name: "Badge", descr: "Status", rot: &rot, fill: color.RGBA{R: 255, A: 255}},
+	} {
+		pic := pics[c.name]
+		cNvPr, xfrm := pic.NonVisualPictureProperties.CommonNonVisualProperties, pic.ShapeProperties.Xfrm
+		if cNvPr.ID != c.id || cNvPr.Name != c.name || cNvPr.Descr != c.descr || xfrm.Offset.X != c.x || xfrm.Offset.Y != c.y ||
+			(xfrm.Rot == nil) != (c.rot == nil) || c.rot != nil && *xfrm.Rot != *c.rot {
+			t.Errorf("expected the picture %s keeps the id, alternative text and transform, got %+v %+v", c.name, cNvPr, xfrm)
+		}
+		target, _ := f.getRelTarget(slideXMLPath, pic.BlipFill.Blip.Embed)
+		img, _, err := image.Decode(bytes.NewReader(f.readBytes(target)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if size := img.Bounds().Size(); size.X != c.width || size.Y != c.height {
+			t.Errorf("expected the rendered image %dx%d of the picture %s, got %v", c.width, c.height, c.name, size)
+		}
+		if c.fill != nil && color.RGBAModel.Convert(img.At(c.width/2, c.height/2)) != c.fill {
+			t.Errorf("expected the fill %v of the picture %s, got %v", c.fill, c.name, img.At(c.width/2, c.height/2))
+		}
+	}
+	if top := tree.Shape[2].order; !(pics["Badge"].order < top && top < pics["Diagram"].order) {
+		t.Errorf("expected the pictures keep the z-order of the flattened shapes, got %d %d %d", pics["Badge"].order, top, pics["Diagram"].order)
+	}
+
+	slide.CommonSlideData.ShapeTree.Shape[1].ShapeProperties.Xfrm = nil
+	for _, c := range []struct {
+		slideID  int
+		name     string
+		expected error
+	}{
+		{slideID: slideID, name: "PlaceHolder 2", expected: ErrShapeTransform},
+		{slideID: slideID, name: "Missing", expected: ErrShapeNameNotExist{SlideID: slideID, Name: "Missing"}},
+		{slideID: 300, name: "Diagram", expected: ErrSlideNotExist{300}},
+	} {
+		if err = f.FlattenShape(c.slideID, c.name); !errors.Is(err, c.expected) {
+			t.Errorf("expected %v, got %v", c.expected, err)
+		}
+	}
+}
@@ -13,35 +13,40 @@ import (
 	"encoding/xml"
 	"io"
 	"io/fs"
+	"log/slog"
 	"os"
 	"path/filepath"
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"golang.org/x/net/html/charset"
 )
 
 // File define a populated slides file struct.
 type File struct {
-	mu            sync.Mutex
-	checked       sync.Map
-	zip64Entries  []string
-	options       *Options
-	tempFiles     sync.Map
-	slideMap      map[int]string
-	streams       map[string]*StreamWriter
-	xmlAttr       sync.Map
-	CharsetReader func(charset string, input io.Reader) (rdr io.Reader, err error)
-	ContentTypes  *contentTypes
-	Path          string
-	Pkg           sync.Map
-	Presentation  *decodePresentation
-	Relationships sync.Map
-	Slide         sync.Map
-	SlideCount    int
-	Theme         *decodeTheme
-	ZipWriter     func(io.Writer) ZipWriter
+	mu             sync.Mutex
+	checked        sync.Map
+	zip64Entries   []string
+	options        *Options
+	tempFiles      sync.Map
+	mapped         []byte
+	mappedFile     os.FileInfo
+	partWriteHooks []func(partName string, content []byte) ([]byte, error)
+	slideMap       map[int]string
+	streams        map[string]*StreamWriter
+	xmlAttr        sync.Map
+	CharsetReader  func(charset string, input io.Reader) (rdr io.Reader, err error)
+	ContentTypes   *contentTypes
+	Path           string
+	Pkg            sync.Map
+	Presentation   *decodePresentation
+	Relationships  sync.Map
+	Slide          sync.Map
+	SlideCount     int
+	Theme          *decodeTheme
+	ZipWriter      func(io.Writer) ZipWriter
 }
 
 // ZipWriter defines an interface for writing files to a ZIP archive. It
@@ -53,29 +58,132 @@ type ZipWriter interface {
 	Close() error
 }
 
+// Options define the options for opening and saving the presentation. The
+// Options implements the Option interface, only the non-zero fields are
+// applied, so passing the multiple Options values merges them in order, and
+// the zero fields of a later value don't reset the fields set before.
+//
+// ShortDatePattern, LongDatePattern and LongTimePattern specify the patterns
+// of the date and time fields in the text, such as "m/d/yyyy", "dddd, mmmm
+// d, yyyy" and "h:mm:ss AM/PM" which are the defaults. DecimalSeparator,
+// ThousandsSeparator and CurrencySymbol specify the symbols of the numbers
+// formatted by the number format codes, such as "," "." and "€", which are
+// "." "," and "$" by default.
+//
+// MaxImageDPI specifies the maximum resolution of the images on saving, the
+// larger images are downscaled with the ImageQuality, and DeduplicateMedia
+// specifies whether to share the identical media parts on saving. They only
+// change the saved package, the presentation is kept unchanged.
+//
+// AlternateContent specifies the policy of processing the
+// mc:AlternateContent blocks on reading, and Charset specifies the charset
+// of the XML parts which are not UTF-8 and don't declare the encoding.
+//
+// MaxParts, MaxXMLDepth, MaxXMLAttributes and MaxXMLEntities limit the
+// number of the parts in the package, the nesting depth of the elements, the
+// attribute count of an element and the number of the entity references of
+// an XML part on reading, which protect the services opening the untrusted
+// presentations. The value 0 means no limit.
+//
+// The entries of the package which refer outside of the package, such as
+// "../evil.xml", are skipped on reading, and the other entry names are
+// converted to the canonical part names. StrictPartNames specifies whether
+// to reject the package with the non-canonical entry names instead.
+//
+// TempFileProvider specifies the provider creating, opening and removing the
+// scratch files in the TmpDir, such as the extracted large slides, so the
+// services running in the read-only or diskless containers can keep them in
+// the memory or the object storage. The files are kept in the file system
+// if it is nil.
+//
+// MemoryMap specifies whether the OpenFile function maps the file into memory
+// instead of reading it, and the large slides are read from the mapped file
+// on demand instead of extracting them to the temporary files. The file is
+// read as usual on the platforms which don't support it. Saving the
+// presentation to the mapped file replaces the file by renaming a new file
+// over it, so the mapped content is never truncated while it is read.
+//
+// ReadOnly specifies whether to open the presentation in read-only mode for
+// the text extraction services, which skips building the caches used only
+// on saving, and the functions modifying or saving the presentation return
+// ErrReadOnly. The reading functions leave the package of the presentation
+// opened in read-only mode unchanged, so it can be shared across the
+// goroutines reading the presentation without locks.
+//
+// ZipModTime and ZipComment specify the modification time of the entries and
+// the comment of the archive on saving, which are set if the ZipWriter
+// supports them, such as the zip.Writer. The modification time of the
+// entries is left empty if ZipModTime is the zero time.
+//
+// TextMetrics specifies the metrics measuring the width of the text, which
+// are used to lay out the text on checking and fitting the text in the
+// shapes, the approximate widths of the characters of the common sans-serif
+// fonts are used if it is nil.
+//
+// DiagnosticsCollector specifies the collector receiving the non-fatal
+// issues found on reading the presentation, such as the unknown parts, the
+// skipped elements and the converted namespaces, so the services ingesting
+// the presentations can record what may not round-trip faithfully.
+//
+// Logger specifies the structured logger reporting the repair actions, and
+// the non-fatal issues found on reading the presentation if the
+// DiagnosticsCollector isn't set, which are discarded if it is nil.
 type Options struct {
-	MaxCalcIterations uint
-	Password          string
-	RawCellValue      bool
-	UnzipSizeLimit    int64
-	UnzipXMLSizeLimit int64
-	TmpDir            string
-	ShortDatePattern  string
-	LongDatePattern   string
-	LongTimePattern   string
+	MaxCalcIterations    uint
+	Password             string
+	RawCellValue         bool
+	UnzipSizeLimit       int64
+	UnzipXMLSizeLimit    int64
+	TmpDir               string
+	TempFileProvider     TempFileProvider
+	ShortDatePattern     string
+	LongDatePattern      string
+	LongTimePattern      string
+	DecimalSeparator     string
+	ThousandsSeparator   string
+	CurrencySymbol       string
+	MaxImageDPI          int
+	ImageQuality         int
+	DeduplicateMedia     bool
+	AlternateContent     AlternateContentPolicy
+	Charset              string
+	MaxParts             int
+	MaxXMLDepth          int
+	MaxXMLAttributes     int
+	MaxXMLEntities       int
+	StrictPartNames      bool
+	MemoryMap            bool
+	ReadOnly             bool
+	ZipModTime           time.Time
+	ZipComment           string
+	TextMetrics          TextMetrics
+	DiagnosticsCollector DiagnosticsCollector
+	Logger               *slog.Logger
 }
 
 // OpenFile take the name of a presentation file and returns a populated
 // presentation file struct for it.
 //
 // Close the file by Close function after opening the slides.
-func OpenFile(filename string, opts ...Options) (*File, error) {
+func OpenFile(filename string, opts ...Option) (*File, error) {
 	file, err := os.Open(filepath.Clean(filename))
 	if err != nil {
 		return nil, err
 	}
-	f, err := OpenReader(file, opts...)
-	if err != nil {
+	f := newFile()
+	if f.applyOptions(opts...); f.options.MemoryMap {
+		if f.mapped, err = mmapFile(file); err == nil {
+			f.mappedFile, _ = file.Stat()
+			if _, err = f.openReaderAt(bytes.NewReader(f.mapped), int64(len(f.mapped))); err != nil {
+				_ = f.Close()
+				_ = file.Close()
+				return nil, err
+			}
+			f.Path = filename
+			return f, file.Close()
+		}
+	}
+	if f, err = OpenReader(file, opts...); err != nil {
 		if closeErr := file.Close(); closeErr != nil {
 			return f, closeErr
 		}
@@ -110,21 +218,30 @@ func (f *File) checkOpenReaderOptions() error {
 	if f.options.UnzipXMLSizeLimit > f.options.UnzipSizeLimit {
 		return ErrOptionsUnzipSizeLimit
 	}
+	if err := f.checkCharset(); err != nil {
+		return err
+	}
 	return nil //f.checkDateTimePattern()
 }
 
 // OpenReader read data stream from io.Reader and return a populated presentation file.
-func OpenReader(r io.Reader, opts ...Options) (*File, error) {
+func OpenReader(r io.Reader, opts ...Option) (*File, error) {
 	b, err := io.ReadAll(r)
 	if err != nil {
 		return nil, err
 	}
 	f := newFile()
-	f.options = f.getOptions(opts...)
-	if err = f.checkOpenReaderOptions(); err != nil {
+	f.applyOptions(opts...)
+	return f.openReaderAt(bytes.NewReader(b), int64(len(b)))
+}
+
+// openReaderAt provides a function to read the presentation from the
+// io.ReaderAt by given size of the package.
+func (f *File) openReaderAt(r io.ReaderAt, size int64) (*File, error) {
+	if err := f.checkOpenReaderOptions(); err != nil {
 		return nil, err
 	}
-	zr, err := zip.NewReader(bytes.NewReader(b), int64(len(b)))
+	zr, err := zip.NewReader(r, size)
 	if err != nil {
 		return nil, err
 	}
@@ -138,24 +255,41 @@ func OpenReader(r io.Reader, opts ...Options) (*File, error) {
 	for k, v := range file {
 		f.Pkg.Store(k, v)
 	}
+	if err = f.decodeCharset(); err != nil {
+		return nil, err
+	}
+	if err = f.resolveAlternateContent(); err != nil {
+		return nil, err
+	}
 
-	f.slideMap, err = f.getSlideMap()
-	if err != nil {
+	if f.slideMap, err = f.getSlideMap(); err != nil {
 		return f, err
 	}
+	if err = f.diagnoseParts(); err != nil {
+		return nil, err
+	}
+	if err = f.customPartsReader(); err != nil {
+		return nil, err
+	}
 
 	f.Theme, err = f.themeReader()
+	if err == nil && f.options.ReadOnly {
+		// Load the parts cached on demand, so the reading functions
+		// called by the goroutines don't set them concurrently.
+		if _, err = f.contentTypesReader(); err == nil {
+			_, err = f.presentationReader()
+		}
+	}
 	return f, err
 }
 
-// getOptions provides a function to parse the optional settings for open
-// and reading presentation.
-func (f *File) getOptions(opts ...Options) *Options {
-	options := f.options
-	for _, opt := range opts {
-		options = &opt
+// checkReadOnly returns ErrReadOnly if the presentation is opened in
+// read-only mode.
+func (f *File) checkReadOnly() error {
+	if f.options.ReadOnly {
+		return ErrReadOnly
 	}
-	return options
+	return nil
 }
 
 // Creates new XML decoder with charset reader.
@@ -182,7 +316,7 @@ func (f *File) slideReader(slideID int) (slide *decodeSlide, err error) {
 	}
 
 	slide = new(decodeSlide)
-	if attrs, ok := f.xmlAttr.Load(path); !ok {
+	if attrs, ok := f.xmlAttr.Load(path); !ok && !f.options.ReadOnly {
 		d := f.xmlNewDecoder(bytes.NewReader(f.readBytes(path)))
 		if attrs == nil {
 			attrs = []xml.Attr{}
@@ -195,6 +329,11 @@ func (f *File) slideReader(slideID int) (slide *decodeSlide, err error) {
 		return
 	}
 	err = nil
+	if f.options.ReadOnly {
+		s, _ := f.Slide.LoadOrStore(path, slide)
+		slide = s.(*decodeSlide)
+		return
+	}
 	if _, ok = f.checked.Load(path); !ok {
 		f.checked.Store(path, true)
 	}
@@ -204,8 +343,9 @@ func (f *File) slideReader(slideID int) (slide *decodeSlide, err error) {
 }
 
 // addRels provides a function to add relationships by given XML path,
-// relationship type, target and target mode.
-func (f *File) addRels(relPath, relType, target, targetMode string) int {
+// relationship type, target and target mode, and returns the relationship ID
+// allocated by the nextID function of the relationships.
+func (f *File) addRels(relPath, relType, target, targetMode string) string {
 	uniqPart := map[string]string{
 		SourceRelationshipCustomProperties: "/docProps/custom.xml",
 	}
@@ -215,25 +355,17 @@ func (f *File) addRels(relPath, relType, target, targetMode string) int {
 	}
 	rels.mu.Lock()
 	defer rels.mu.Unlock()
-	var rID int
 	for idx, rel := range rels.Relationships {
-		ID, _ := strconv.Atoi(strings.TrimPrefix(rel.ID, "rId"))
-		if ID > rID {
-			rID = ID
-		}
 		if relType == rel.Type {
 			if partName, ok := uniqPart[rel.Type]; ok {
 				rels.Relationships[idx].Target = partName
-				return rID
+				return rel.ID
 			}
 		}
 	}
-	rID++
-	var ID bytes.Buffer
-	ID.WriteString("rId")
-	ID.WriteString(strconv.Itoa(rID))
+	rID := rels.nextID()
 	rels.Relationships = append(rels.Relationships, relationship{
-		ID:         ID.String(),
+		ID:         rID,
 		Type:       relType,
 		Target:     target,
 		TargetMode: targetMode,
@@ -241,3 +373,39 @@ func (f *File) addRels(relPath, relType, target, targetMode string) int {
 	f.Relationships.Store(relPath, rels)
 	return rID
 }
+
+// nextID returns the relationship ID which isn't used in the relationships.
+// The IDs allocated by other applications are not always sequential or in
+// the "rId" followed by number format, so the number of the ID is greater
+// than the numbers of all existing "rId" IDs and the ID is checked against
+// all existing IDs. The caller should hold the lock of the relationships.
+func (rels *relationships) nextID() string {
+	used := make(map[string]bool, len(rels.Relationships))
+	var n int
+	for _, rel := range rels.Relationships {
+		used[rel.ID] = true
+		if num, err := strconv.Atoi(strings.TrimPrefix(rel.ID, "rId")); err == nil && strings.HasPrefix(rel.ID, "rId") {
+			n = max(n, num)
+		}
+	}
+	for n++; used["rId"+strconv.Itoa(n)]; n++ {
+	}
+	return "rId" + strconv.Itoa(n)
+}
+
+// deleteRels provides a function to delete relationships by given
+// relationships path and relationship ID.
+func (f *File) deleteRels(relPath, rID string) {
+	rels, _ := f.relsReader(relPath)
+	if rels == nil {
+		return
+	}
+	rels.mu.Lock()
+	defer rels.mu.Unlock()
+	for k, v := range rels.Relationships {
+		if v.ID == rID {
+			rels.Relationships = append(rels.Relationships[:k], rels.Relationships[k+1:]...)
+			return
+		}
+	}
+}
@@ -0,0 +1,200 @@
+package gopptx
+
+import (
+	"archive/zip"
+	"bytes"
+	"errors"
+	"io"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestSaveMemoryMappedFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "Book1.pptx")
+	f := NewFile()
+	slideID, err := f.NewSlide()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, id := range f.GetSlideList() {
+		if _, err = f.AddTextBox(id, TextBoxOptions{Text: strings.Repeat("text ", 100)}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err = f.SaveAs(path); err != nil {
+		t.Fatal(err)
+	}
+	if err = f.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// Keep the first slide in the mapped file and edit the second slide
+	f, err = OpenFile(path, Options{MemoryMap: true, UnzipXMLSizeLimit: 128})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = f.AddTextBox(slideID, TextBoxOptions{Text: "edited"}); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 2; i++ {
+		if err = f.Save(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err = f.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	f, err = OpenFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	if len(f.GetSlideList()) != 2 {
+		t.Fatalf("expected 2 slides, got %d", len(f.GetSlideList()))
+	}
+	for _, id := range f.GetSlideList() {
+		content, err := f.GetSlideXML(id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !strings.Contains(string(content), "text text") {
+			t.Errorf("the content of the slide %d is lost", id)
+		}
+	}
+	content, err := f.GetSlideXML(slideID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(content), "edited") {
+		t.Error("the edited slide isn't saved")
+	}
+}
+
+// openTestFile returns the presentation opened from the new presentation, the
+// content of the parts is replaced by the given function before opening.
+func openTestFile(t *testing.T, replace func(name string, content []byte) []byte, opts ...Option) (*File, error) {
+	buf, err := NewFile().WriteToBuffer()
+	if err != nil {
+		t.Fatal(err)
+	}
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var out bytes.Buffer
+	zw := zip.NewWriter(&out)
+	for _, file := range zr.File {
+		rc, err := file.Open()
+		if err != nil {
+			t.Fatal(err)
+		}
+		content, err := io.ReadAll(rc)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_ = rc.Close()
+		w, err := zw.Create(file.Name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err = w.Write(replace(file.Name, content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err = zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return OpenReader(&out, opts...)
+}
+
+func TestReadOnly(t *testing.T) {
+	f := NewFile()
+	if err := f.SetSlideTitle(f.GetSlideList()[0], "Title"); err != nil {
+		t.Fatal(err)
+	}
+	buf, err := f.WriteToBuffer()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f, err = OpenReader(buf, Options{ReadOnly: true}); err != nil {
+		t.Fatal(err)
+	}
+	slideID := f.GetSlideList()[0]
+	parts := map[string]int{}
+	f.Pkg.Range(func(k, v interface{}) bool {
+		parts[k.(string)] = len(v.([]byte))
+		return true
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if title, err := f.GetSlideTitle(slideID); err != nil || title != "Title" {
+				t.Errorf("expected the title, got %q %v", title, err)
+			}
+			if _, err := f.GetShapes(slideID); err != nil {
+				t.Error(err)
+			}
+			if err := f.ExportMarkdown(io.Discard); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+	f.Pkg.Range(func(k, v interface{}) bool {
+		if size, ok := parts[k.(string)]; !ok || size != len(v.([]byte)) {
+			t.Errorf("the part %s is changed by reading", k)
+		}
+		return true
+	})
+
+	for name, fn := range map[string]func() error{
+		"NewSlide":      func() error { _, err := f.NewSlide(); return err },
+		"SetSlideTitle": func() error { return f.SetSlideTitle(slideID, "") },
+		"DeleteSlide":   func() error { return f.DeleteSlide(slideID) },
+		"Unprotect":     func() error { return f.Unprotect("") },
+		"Save":          func() error { return f.Save() },
+		"SaveAs":        func() error { return f.SaveAs("Book1.pptx") },
+		"WriteToBuffer": func() error { _, err := f.WriteToBuffer(); return err },
+	} {
+		if err := fn(); !errors.Is(err, ErrReadOnly) {
+			t.Errorf("%s: expected ErrReadOnly, got %v", name, err)
+		}
+	}
+}
+
+func TestAddRels(t *testing.T) {
+	f := NewFile()
+	for _, c := range []struct {
+		ids      []string
+		relType  string
+		expected string
+		count    int
+	}{
+		{relType: SourceRelationshipImage, expected: "rId1", count: 1},
+		{ids: []string{"rId1", "rId3"}, relType: SourceRelationshipImage, expected: "rId4", count: 3},
+		{ids: []string{"R7a9d2", "rId2", "rIdX", "image"}, relType: SourceRelationshipImage, expected: "rId3", count: 5},
+		{ids: []string{"rId2"}, relType: SourceRelationshipCustomProperties, expected: "rId2", count: 1},
+	} {
+		rels := &relationships{}
+		for _, id := range c.ids {
+			rels.Relationships = append(rels.Relationships, relationship{ID: id, Type: c.relType, Target: "../media/" + id + ".png"})
+		}
+		relPath := "ppt/slides/_rels/slide9.xml.rels"
+		f.Relationships.Store(relPath, rels)
+		if rID := f.addRels(relPath, c.relType, "../media/image1.png", ""); rID != c.expected {
+			t.Errorf("expected the relationship ID %s of %v, got %s", c.expected, c.ids, rID)
+		}
+		if rels, _ = f.relsReader(relPath); len(rels.Relationships) != c.count {
+			t.Errorf("expected %d relationships, got %v", c.count, rels.Relationships)
+		}
+	}
+	if rels, _ := f.relsReader("ppt/slides/_rels/slide9.xml.rels"); rels.Relationships[0].Target != "/docProps/custom.xml" {
+		t.Errorf("expected the target of the unique part is replaced, got %s", rels.Relationships[0].Target)
+	}
+}
@@ -0,0 +1,165 @@
+// Copyright 2026 kenny-not-dead. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+//
+// Package gopptx provides functionality to create and manipulate PowerPoint
+// (.pptx) files in Go, using the Office Open XML (ECMA-376) format.
+
+package gopptx
+
+import "math"
+
+// GridOptions directly maps the settings of the grid layout. The rows and
+// columns are calculated by the number of the shapes if they are zero, such
+// as the 2 × 2 grid for 4 shapes. The offset and size of the bounding box of
+// the grid and the gutters between the cells are specified in EMUs, the
+// bounding box is the whole slide if the width or height is zero. The shapes
+// are stretched to the cells, unless KeepAspectRatio is true, which scales
+// the shapes to fit the cells with keeping the aspect ratio and centers them
+// in the cells.
+type GridOptions struct {
+	Rows            int
+	Columns         int
+	OffsetX         int
+	OffsetY         int
+	Width           int
+	Height          int
+	GutterX         int
+	GutterY         int
+	KeepAspectRatio bool
+}
+
+// ArrangeGrid provides a function to place the shapes, the pictures or the
+// graphic frames into the cells of the grid row by row by given slide id,
+// shape ids in the order of the cells and the grid settings. For example,
+// place 6 pictures into the 2 × 3 grid with the 0.1 inch gutters inside the
+// margins of the slide:
+//
+//	err := f.ArrangeGrid(256, []int{2, 3, 4, 5, 6, 7}, &gopptx.GridOptions{
+//	    Rows:            2,
+//	    Columns:         3,
+//	    OffsetX:         457200,
+//	    OffsetY:         457200,
+//	    Width:           8229600,
+//	    Height:          4229100,
+//	    GutterX:         91440,
+//	    GutterY:         91440,
+//	    KeepAspectRatio: true,
+//	})
+func (f *File) ArrangeGrid(slideID int, shapeIDs []int, opts *GridOptions) error {
+	if err := f.checkReadOnly(); err != nil {
+		return err
+	}
+	slide, err := f.slideReader(slideID)
+	if err != nil {
+		return err
+	}
+	if opts == nil {
+		opts = &GridOptions{}
+	}
+	box := *opts
+	if box.Width <= 0 || box.Height <= 0 {
+		slideWidth, slideHeight, err := f.getSlideSize()
+		if err != nil {
+			return err
+		}
+		box.OffsetX, box.OffsetY, box.Width, box.Height = 0, 0, slideWidth, slideHeight
+	}
+	xfrms := make([]*DecodeXfrm, len(shapeIDs))
+	for i, shapeID := range shapeIDs {
+		if xfrms[i] = slide.getShapeXfrm(shapeID); xfrms[i] == nil {
+			return ErrShapeNotExist{shapeID}
+		}
+	}
+	for i, cell := range getGridCells(len(shapeIDs), &box) {
+		xfrm := xfrms[i]
+		offset, extents := cell.Offset, cell.Extents
+		if opts.KeepAspectRatio && xfrm.Extents != nil && xfrm.Extents.CX > 0 && xfrm.Extents.CY > 0 {
+			if extents.CX*xfrm.Extents.CY > extents.CY*xfrm.Extents.CX {
+				extents.CX = extents.CY * xfrm.Extents.CX / xfrm.Extents.CY
+			} else {
+				extents.CY = extents.CX * xfrm.Extents.CY / xfrm.Extents.CX
+			}
+			offset.X += (cell.Extents.CX - extents.CX) / 2
+			offset.Y += (cell.Extents.CY - extents.CY) / 2
+		}
+		xfrm.Offset, xfrm.Extents = &offset, &extents
+	}
+	return nil
+}
+
+// gridCell directly maps the offset and size of the cell of the grid.
+type gridCell struct {
+	Offset  Offset
+	Extents Extents
+}
+
+// getGridCells returns the cells of the grid row by row by given number of
+// the shapes and the grid settings with the bounding box.
+func getGridCells(count int, opts *GridOptions) []gridCell {
+	if count == 0 {
+		return nil
+	}
+	rows, cols := opts.Rows, opts.Columns
+	switch {
+	case rows <= 0 && cols <= 0:
+		cols = int(math.Ceil(math.Sqrt(float64(count))))
+		rows = (count + cols - 1) / cols
+	case rows <= 0:
+		rows = (count + cols - 1) / cols
+	case cols <= 0:
+		cols = (count + rows - 1) / rows
+	}
+	cellWidth := (opts.Width - opts.GutterX*(cols-1)) / cols
+	cellHeight := (opts.Height - opts.GutterY*(rows-1)) / rows
+	cells := make([]gridCell, 0, min(count, rows*cols))
+	for i := 0; i < count && i < rows*cols; i++ {
+		cells = append(cells, gridCell{
+			Offset: Offset{
+				X: opts.OffsetX + i%cols*(cellWidth+opts.GutterX),
+				Y: opts.OffsetY + i/cols*(cellHeight+opts.GutterY),
+			},
+			Extents: Extents{CX: cellWidth, CY: cellHeight},
+		})
+	}
+	return cells
+}
+
+// getShapeXfrm returns the transform of the shape, the picture or the graphic
+// frame by given shape id, the transform is created if the shape doesn't have
+// it. It returns nil if the shape doesn't exist.
+func (ds *decodeSlide) getShapeXfrm(shapeID int) *DecodeXfrm {
+	shapeTree := &ds.CommonSlideData.ShapeTree
+	var spPr **DecodeShapeProperties
+	for i, shape := range shapeTree.Shape {
+		if shape.NonVisualShapeProperties != nil && shape.NonVisualShapeProperties.CommonNonVisualProperties != nil &&
+			shape.NonVisualShapeProperties.CommonNonVisualProperties.ID == shapeID {
+			spPr = &shapeTree.Shape[i].ShapeProperties
+		}
+	}
+	for i, pic := range shapeTree.Picture {
+		if pic.NonVisualPictureProperties != nil && pic.NonVisualPictureProperties.CommonNonVisualProperties != nil &&
+			pic.NonVisualPictureProperties.CommonNonVisualProperties.ID == shapeID {
+			spPr = &shapeTree.Picture[i].ShapeProperties
+		}
+	}
+	if spPr != nil {
+		if *spPr == nil {
+			*spPr = &DecodeShapeProperties{}
+		}
+		if (*spPr).Xfrm == nil {
+			(*spPr).Xfrm = &DecodeXfrm{}
+		}
+		return (*spPr).Xfrm
+	}
+	for i, gf := range shapeTree.GraphicFrame {
+		if gf.NonVisualGraphicFrameProperties != nil && gf.NonVisualGraphicFrameProperties.CommonNonVisualProperties != nil &&
+			gf.NonVisualGraphicFrameProperties.CommonNonVisualProperties.ID == shapeID {
+			if gf.Xfrm == nil {
+				shapeTree.GraphicFrame[i].Xfrm = &DecodeXfrm{}
+			}
+			return shapeTree.GraphicFrame[i].Xfrm
+		}
+	}
+	return nil
+}
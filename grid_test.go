@@ -0,0 +1,69 @@
+package gopptx
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestArrangeGrid(t *testing.T) {
+	for _, c := range []struct {
+		opts     *GridOptions
+		expected []gridCell
+	}{
+		{
+			expected: []gridCell{
+				{Offset{X: 0, Y: 0}, Extents{CX: 5040312, CY: 2835275}},
+				{Offset{X: 5040312, Y: 0}, Extents{CX: 5040312, CY: 2835275}},
+				{Offset{X: 0, Y: 2835275}, Extents{CX: 5040312, CY: 2835275}},
+			},
+		},
+		{
+			opts: &GridOptions{Columns: 2, OffsetX: 100, OffsetY: 200, Width: 2100, Height: 2200, GutterX: 100, GutterY: 200},
+			expected: []gridCell{
+				{Offset{X: 100, Y: 200}, Extents{CX: 1000, CY: 1000}},
+				{Offset{X: 1200, Y: 200}, Extents{CX: 1000, CY: 1000}},
+				{Offset{X: 100, Y: 1400}, Extents{CX: 1000, CY: 1000}},
+			},
+		},
+		{
+			opts: &GridOptions{Rows: 1, Width: 3000000, Height: 1000000, GutterX: 100000, KeepAspectRatio: true},
+			expected: []gridCell{
+				{Offset{X: 0, Y: 266667}, Extents{CX: 933333, CY: 466666}},
+				{Offset{X: 1033333, Y: 266667}, Extents{CX: 933333, CY: 466666}},
+				{Offset{X: 2066666, Y: 266667}, Extents{CX: 933333, CY: 466666}},
+			},
+		},
+	} {
+		f := NewFile()
+		slideID := f.GetSlideList()[0]
+		var shapeIDs []int
+		for i := 0; i < 3; i++ {
+			shape := addTestShape(t, f, slideID, "", "Cell")
+			shapeIDs = append(shapeIDs, shape.NonVisualShapeProperties.CommonNonVisualProperties.ID)
+		}
+		if err := f.ArrangeGrid(slideID, shapeIDs, c.opts); err != nil {
+			t.Fatal(err)
+		}
+		slide, err := f.slideReader(slideID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var cells []gridCell
+		for _, shapeID := range shapeIDs {
+			xfrm := slide.getShapeXfrm(shapeID)
+			cells = append(cells, gridCell{*xfrm.Offset, *xfrm.Extents})
+		}
+		if !reflect.DeepEqual(cells, c.expected) {
+			t.Errorf("expected the cells %v, got %v", c.expected, cells)
+		}
+	}
+
+	f := NewFile()
+	if err := f.ArrangeGrid(f.GetSlideList()[0], []int{7, 99}, nil); !errors.Is(err, ErrShapeNotExist{99}) {
+		t.Errorf("expected ErrShapeNotExist, got %v", err)
+	}
+	if err := f.ArrangeGrid(300, nil, nil); !errors.Is(err, ErrSlideNotExist{300}) {
+		t.Errorf("expected ErrSlideNotExist, got %v", err)
+	}
+}
@@ -0,0 +1,207 @@
+// Copyright 2026 kenny-not-dead. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+//
+// Package gopptx provides functionality to create and manipulate PowerPoint
+// (.pptx) files in Go, using the Office Open XML (ECMA-376) format.
+
+package gopptx
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+// This section defines the names of the guide shapes added onto the slide
+// masters by the AddSafeAreaGuides function, which are used to replace the
+// shapes on adding the guides again.
+const (
+	bleedGuideName    = "Bleed Guide"
+	safeAreaGuideName = "Safe Area Guide"
+)
+
+// SafeAreaOptions directly maps the settings of the safe area and bleed
+// guides. The bleed is the distance from the edges of the slide to the trim
+// lines of the print, and the safe margin is the distance from the trim
+// lines to the safe area, both specified in EMUs. The safe margin defaults to
+// the 5 percent of the trimmed width and height on each side, which is the
+// action safe area of the video. The color of the guides defaults to
+// "F26B43". The guides are added as the drawing guides of the slide masters,
+// which are never printed or rendered, unless AsShapes is true, which adds
+// the locked rectangles with the dashed outlines onto the slide masters
+// instead for the applications without the support of the master guides.
+// Note that the shapes are visible in the printed and exported output.
+type SafeAreaOptions struct {
+	Bleed      int
+	SafeMargin int
+	Color      string
+	AsShapes   bool
+}
+
+// AddSafeAreaGuides provides a function to add the non-printing guides of
+// the trim lines and the safe area onto every slide master by given guide
+// settings, for the presentations destined for the video rendering or the
+// print. The trim guides are added only if the bleed is specified. Adding
+// the guides again keeps the existing guides at the same positions, and
+// replaces the guide shapes added before. For example, add the guides with
+// the 0.125 inch bleed and the 0.25 inch safe margin:
+//
+//	err := f.AddSafeAreaGuides(&gopptx.SafeAreaOptions{
+//	    Bleed:      114300,
+//	    SafeMargin: 228600,
+//	})
+func (f *File) AddSafeAreaGuides(opts *SafeAreaOptions) error {
+	if err := f.checkReadOnly(); err != nil {
+		return err
+	}
+	if opts == nil {
+		opts = &SafeAreaOptions{}
+	}
+	slideWidth, slideHeight, err := f.getSlideSize()
+	if err != nil {
+		return err
+	}
+	marginX, marginY := opts.SafeMargin, opts.SafeMargin
+	if opts.SafeMargin == 0 {
+		marginX, marginY = (slideWidth-2*opts.Bleed)/20, (slideHeight-2*opts.Bleed)/20
+	}
+	if opts.Bleed < 0 || opts.SafeMargin < 0 || 2*(opts.Bleed+marginX) >= slideWidth || 2*(opts.Bleed+marginY) >= slideHeight {
+		return ErrSafeAreaSize
+	}
+	clr := "F26B43"
+	if opts.Color != "" {
+		clr = strings.TrimPrefix(opts.Color, "#")
+	}
+	var areas []safeArea
+	if opts.Bleed > 0 {
+		areas = append(areas, safeArea{name: bleedGuideName, insetX: opts.Bleed, insetY: opts.Bleed})
+	}
+	areas = append(areas, safeArea{name: safeAreaGuideName, insetX: opts.Bleed + marginX, insetY: opts.Bleed + marginY})
+	for _, masterXMLPath := range f.getSlideMasterPaths() {
+		master, err := f.xmlNodeReader(f.readXML(masterXMLPath))
+		if err != nil {
+			return err
+		}
+		if !opts.AsShapes {
+			addSafeAreaGuides(master, areas, slideWidth, slideHeight, clr)
+		} else if shapeTree := master.find("cSld", "spTree"); shapeTree != nil {
+			addSafeAreaShapes(master, shapeTree, areas, slideWidth, slideHeight, clr)
+		} else {
+			return newSlideMasterShapeTreeError(masterXMLPath)
+		}
+		f.saveFileList(masterXMLPath, master.bytes())
+	}
+	return nil
+}
+
+// safeArea defines the rectangle of the guides by given name of the guide
+// shape and the distances from the edges of the slide in EMUs.
+type safeArea struct {
+	name           string
+	insetX, insetY int
+}
+
+// addSafeAreaGuides adds the guides of the rectangles to the guide list
+// extension of the slide master by given root element of the slide master,
+// the rectangles, the slide size and the hex RGB color of the guides. The
+// positions of the guides are specified in eighths of a point.
+func addSafeAreaGuides(master *xmlNode, areas []safeArea, slideWidth, slideHeight int, clr string) {
+	p, a := master.prefix(NameSpacePresentationMLMain), master.prefix(NameSpaceDrawingMLMain)
+	extLst := master.child(p + "extLst")
+	if extLst == nil {
+		extLst = newXMLNode(p + "extLst")
+		master.Children = append(master.Children, extLst)
+	}
+	var list *xmlNode
+	for _, ext := range extLst.findAll("ext") {
+		if ext.attr("uri") == ExtURISlideGuideList {
+			list = ext.find("sldGuideLst")
+		}
+	}
+	if list == nil {
+		list = newXMLNode("p15:sldGuideLst", "xmlns:p15", NameSpacePowerPointR15.Value)
+		ext := newXMLNode(p+"ext", "uri", ExtURISlideGuideList)
+		ext.Children = append(ext.Children, list)
+		extLst.Children = append(extLst.Children, ext)
+	}
+	g, declare := strings.TrimSuffix(list.Name, "sldGuideLst"), a == ""
+	if declare {
+		a = "a:"
+	}
+	existing, guideID := map[string]bool{}, 0
+	for _, guide := range list.findAll("guide") {
+		existing[guide.attr("orient")+guide.attr("pos")] = true
+		id, _ := strconv.Atoi(guide.attr("id"))
+		guideID = max(guideID, id)
+	}
+	for _, area := range areas {
+		for _, guide := range []struct {
+			orient string
+			pos    int
+		}{
+			{"", area.insetX}, {"", slideWidth - area.insetX}, {"horz", area.insetY}, {"horz", slideHeight - area.insetY},
+		} {
+			pos := strconv.Itoa(int(math.Round(float64(guide.pos) * 8 / EMUPerPoint)))
+			if existing[guide.orient+pos] {
+				continue
+			}
+			existing[guide.orient+pos] = true
+			guideID++
+			node := newXMLNode(g+"guide", "id", strconv.Itoa(guideID))
+			if guide.orient != "" {
+				node.setAttr("orient", guide.orient)
+			}
+			node.setAttr("pos", pos)
+			node.setAttr("userDrawn", "1")
+			color := newXMLNode(g + "clr")
+			if declare {
+				color.setAttr("xmlns:a", NameSpaceDrawingMLMain)
+			}
+			color.Children = append(color.Children, newXMLNode(a+"srgbClr", "val", clr))
+			node.Children = append(node.Children, color)
+			list.Children = append(list.Children, node)
+		}
+	}
+}
+
+// addSafeAreaShapes adds the locked rectangles with the dashed outlines to
+// the shape tree of the slide master by given root element and shape tree of
+// the slide master, the rectangles, the slide size and the hex RGB color of
+// the outlines. The guide shapes added before are removed.
+func addSafeAreaShapes(master, shapeTree *xmlNode, areas []safeArea, slideWidth, slideHeight int, clr string) {
+	p, a := master.prefix(NameSpacePresentationMLMain), master.prefix(NameSpaceDrawingMLMain)
+	children := shapeTree.Children[:0]
+	for _, child := range shapeTree.Children {
+		if name := child.find("nvSpPr", "cNvPr").attr("name"); localName(child.Name) != "sp" ||
+			(name != bleedGuideName && name != safeAreaGuideName) {
+			children = append(children, child)
+		}
+	}
+	shapeTree.Children = children
+	shapeID := getMaxShapeID(master)
+	for _, area := range areas {
+		shapeID++
+		sp := newXMLNode(p + "sp")
+		nvSpPr := newXMLNode(p + "nvSpPr")
+		cNvSpPr := newXMLNode(p + "cNvSpPr")
+		cNvSpPr.Children = append(cNvSpPr.Children, newXMLNode(a+"spLocks", "noGrp", "1", "noSelect", "1", "noRot", "1",
+			"noMove", "1", "noResize", "1", "noTextEdit", "1", "noChangeShapeType", "1"))
+		nvSpPr.Children = append(nvSpPr.Children, newXMLNode(p+"cNvPr", "id", strconv.Itoa(shapeID), "name", area.name),
+			cNvSpPr, newXMLNode(p+"nvPr", "userDrawn", "1"))
+		xfrm := newXMLNode(a + "xfrm")
+		xfrm.Children = append(xfrm.Children,
+			newXMLNode(a+"off", "x", strconv.Itoa(area.insetX), "y", strconv.Itoa(area.insetY)),
+			newXMLNode(a+"ext", "cx", strconv.Itoa(slideWidth-2*area.insetX), "cy", strconv.Itoa(slideHeight-2*area.insetY)))
+		prstGeom := newXMLNode(a+"prstGeom", "prst", "rect")
+		prstGeom.Children = append(prstGeom.Children, newXMLNode(a+"avLst"))
+		solidFill := newXMLNode(a + "solidFill")
+		solidFill.Children = append(solidFill.Children, newXMLNode(a+"srgbClr", "val", clr))
+		ln := newXMLNode(a+"ln", "w", strconv.Itoa(EMUPerPoint))
+		ln.Children = append(ln.Children, solidFill, newXMLNode(a+"prstDash", "val", "dash"))
+		spPr := newXMLNode(p + "spPr")
+		spPr.Children = append(spPr.Children, xfrm, prstGeom, newXMLNode(a+"noFill"), ln)
+		sp.Children = append(sp.Children, nvSpPr, spPr)
+		shapeTree.insert(sp, p+"extLst")
+	}
+}
@@ -0,0 +1,63 @@
+package gopptx
+
+import (
+	"errors"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestAddSafeAreaGuides(t *testing.T) {
+	const masterXMLPath = "ppt/slideMasters/slideMaster1.xml"
+	f := NewFile()
+	for _, opts := range []*SafeAreaOptions{{Bleed: 114300, SafeMargin: 228600}, {Bleed: 114300, SafeMargin: 228600, Color: "#00B0F0"}} {
+		if err := f.AddSafeAreaGuides(opts); err != nil {
+			t.Fatal(err)
+		}
+	}
+	buf, err := f.WriteToBuffer()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f, err = OpenReader(buf); err != nil {
+		t.Fatal(err)
+	}
+	guide := regexp.MustCompile(`<p15:guide id="\d+"( orient="horz")? pos="(\d+)" userDrawn="1"><p15:clr><a:srgbClr val="F26B43"/>`)
+	var guides []string
+	for _, match := range guide.FindAllStringSubmatch(string(f.readXML(masterXMLPath)), -1) {
+		guides = append(guides, strings.TrimSpace(match[1]+" "+match[2]))
+	}
+	expected := []string{"72", "6278", `orient="horz" 72`, `orient="horz" 3500`, "216", "6134", `orient="horz" 216`, `orient="horz" 3356`}
+	if !reflect.DeepEqual(guides, expected) {
+		t.Errorf("expected the guides %v, got %v", expected, guides)
+	}
+	if err = f.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	for range 2 {
+		if err = f.AddSafeAreaGuides(&SafeAreaOptions{AsShapes: true}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	content := string(f.readXML(masterXMLPath))
+	for _, element := range []string{
+		`name="Safe Area Guide"/><p:cNvSpPr><a:spLocks noGrp="1" noSelect="1"`,
+		`<a:off x="504031" y="283527"/><a:ext cx="9072563" cy="5103496"/>`,
+		`<a:noFill/><a:ln w="12700"><a:solidFill><a:srgbClr val="F26B43"/></a:solidFill><a:prstDash val="dash"/></a:ln>`,
+	} {
+		if !strings.Contains(content, element) {
+			t.Errorf("expected %s in the slide master, got %s", element, content)
+		}
+	}
+	if count := strings.Count(content, "Safe Area Guide"); count != 1 || strings.Contains(content, "Bleed Guide") {
+		t.Errorf("expected the guide shape is replaced, got %d guide shapes", count)
+	}
+
+	for _, opts := range []*SafeAreaOptions{{Bleed: -1}, {SafeMargin: 2835275}, {Bleed: 2000000, SafeMargin: 900000}} {
+		if err = f.AddSafeAreaGuides(opts); !errors.Is(err, ErrSafeAreaSize) {
+			t.Errorf("expected ErrSafeAreaSize, got %v", err)
+		}
+	}
+}
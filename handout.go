@@ -0,0 +1,195 @@
+// Copyright 2026 kenny-not-dead. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+//
+// Package gopptx provides functionality to create and manipulate PowerPoint
+// (.pptx) files in Go, using the Office Open XML (ECMA-376) format.
+
+package gopptx
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"strconv"
+	"strings"
+)
+
+// HandoutOptions directly maps the settings of the speaker handouts. The
+// template is the presentation which the pages of the handouts are appended
+// to, the new presentation is created if it's nil. The slides per page is
+// the number of the rows of the thumbnails and the notes on each page with
+// default 3. The margin is the space around and between the rows in EMUs
+// with default 228600 (0.25 inch), and the notes font size is in points with
+// default 12.
+type HandoutOptions struct {
+	Template      *File
+	SlidesPerPage int
+	Margin        int
+	NotesFontSize int
+}
+
+// BuildHandouts provides a function to create the speaker handouts of the
+// presentation, which is the new presentation with the pages of the slide
+// thumbnails rendered by the RenderThumbnails function, each paired with the
+// slide number, the title and the speaker notes of the slide, for the
+// presenter printouts. The outlined thumbnails keep the aspect ratio of the
+// slides and take at most the half of the page width, and the notes are
+// shrunk on overflow when the handouts are opened by the office
+// applications, which can also convert the handouts to PDF. For example,
+// create the handouts with 2 slides per page:
+//
+//	handouts, err := f.BuildHandouts(&gopptx.HandoutOptions{SlidesPerPage: 2})
+//	if err != nil {
+//	    fmt.Println(err)
+//	    return
+//	}
+//	if err := handouts.SaveAs("handouts.pptx"); err != nil {
+//	    fmt.Println(err)
+//	}
+func (f *File) BuildHandouts(opts *HandoutOptions) (*File, error) {
+	if opts == nil {
+		opts = &HandoutOptions{}
+	}
+	perPage, margin, fontSize := opts.SlidesPerPage, opts.Margin, opts.NotesFontSize
+	if perPage <= 0 {
+		perPage = 3
+	}
+	if margin <= 0 {
+		margin = 228600
+	}
+	if fontSize <= 0 {
+		fontSize = 12
+	}
+	slideWidth, slideHeight, err := f.getSlideSize()
+	if err != nil {
+		return nil, err
+	}
+	h, reuseFirst := opts.Template, false
+	if h == nil {
+		h, reuseFirst = NewFile(), true
+	}
+	pageWidth, pageHeight, err := h.getSlideSize()
+	if err != nil {
+		return h, err
+	}
+	rowHeight := max((pageHeight-(perPage+1)*margin)/perPage, 1)
+	thumbWidth, thumbHeight := rowHeight*slideWidth/slideHeight, rowHeight
+	if maxWidth := (pageWidth - 3*margin) / 2; thumbWidth > maxWidth {
+		thumbWidth, thumbHeight = maxWidth, maxWidth*slideHeight/slideWidth
+	}
+	notesWidth := max(pageWidth-3*margin-thumbWidth, 1)
+	var slideID int
+	for idx, srcID := range f.GetSlideList() {
+		row := idx % perPage
+		if row == 0 {
+			if reuseFirst {
+				slideID, reuseFirst = defaultXMLSlideID, false
+			} else if slideID, err = h.NewSlide(); err != nil {
+				return h, err
+			}
+			slide, err := h.slideReader(slideID)
+			if err != nil {
+				return h, err
+			}
+			slide.CommonSlideData.ShapeTree.Shape = nil
+		}
+		// Render the thumbnails at 192 DPI for the printouts.
+		img, err := f.renderSlide(srcID, 2*thumbWidth/EMUPerPixel)
+		if err != nil {
+			return h, err
+		}
+		// Outline the thumbnails, the backgrounds of which are white as the pages.
+		bounds := img.Bounds()
+		for _, edge := range []image.Rectangle{
+			image.Rect(0, 0, bounds.Dx(), 2), image.Rect(0, bounds.Dy()-2, bounds.Dx(), bounds.Dy()),
+			image.Rect(0, 0, 2, bounds.Dy()), image.Rect(bounds.Dx()-2, 0, bounds.Dx(), bounds.Dy()),
+		} {
+			draw.Draw(img, edge, image.NewUniform(color.RGBA{0x80, 0x80, 0x80, 0xFF}), image.Point{}, draw.Src)
+		}
+		var buf bytes.Buffer
+		if err = png.Encode(&buf, img); err != nil {
+			return h, err
+		}
+		number, title := strconv.Itoa(idx+1), ""
+		src, err := f.slideReader(srcID)
+		if err != nil {
+			return h, err
+		}
+		if shape := src.getTitleShape(); shape != nil {
+			title = strings.TrimSpace(strings.ReplaceAll(shape.TextBody.text(), "\n", " "))
+		}
+		offsetY := margin + row*(rowHeight+margin)
+		if _, err = h.AddPictureFromBytes(slideID, ".png", buf.Bytes(), &PictureOptions{
+			Name:    "Slide " + number,
+			AltText: title,
+			OffsetX: margin,
+			OffsetY: offsetY + (rowHeight-thumbHeight)/2,
+			Width:   thumbWidth,
+			Height:  thumbHeight,
+		}); err != nil {
+			return h, err
+		}
+		paragraphs, err := f.getNotesParagraphs(srcID)
+		if err != nil {
+			return h, err
+		}
+		heading := "Slide " + number
+		if title != "" {
+			heading += ": " + title
+		}
+		notes := make([]string, 0, len(paragraphs))
+		for _, p := range paragraphs {
+			notes = append(notes, p.text())
+		}
+		if text := strings.TrimSpace(strings.Join(notes, "\n")); text != "" {
+			notes = strings.Split(text, "\n")
+		} else {
+			notes = nil
+		}
+		slide, err := h.slideReader(slideID)
+		if err != nil {
+			return h, err
+		}
+		slide.CommonSlideData.ShapeTree.Shape = append(slide.CommonSlideData.ShapeTree.Shape,
+			newHandoutNotesShape(slide.nextShapeID(), "Notes "+number, heading, notes, fontSize,
+				Offset{X: 2*margin + thumbWidth, Y: offsetY}, Extents{CX: notesWidth, CY: rowHeight}))
+	}
+	return h, nil
+}
+
+// newHandoutNotesShape provides a function to create the text box of the
+// notes of the handouts by given shape ID, shape name, bold heading,
+// paragraphs of the notes, font size in points, offset and size of the text
+// box.
+func newHandoutNotesShape(shapeID int, name, heading string, notes []string, fontSize int, offset Offset, extents Extents) decodeShape {
+	anchor, txBox, sz, bold := "t", true, fontSize*100, 1
+	paragraphs := []DecodeParagraph{{
+		Runs: []DecodeRuns{{RunProperties: &DecodeRunProperties{Bold: &bold, Size: &sz}, Text: heading}},
+	}}
+	for _, text := range notes {
+		paragraph := DecodeParagraph{EndParagraphRunProperties: &DecodeRunProperties{Size: &sz}}
+		if text != "" {
+			paragraph.Runs = []DecodeRuns{{RunProperties: &DecodeRunProperties{Size: &sz}, Text: text}}
+		}
+		paragraphs = append(paragraphs, paragraph)
+	}
+	return decodeShape{
+		NonVisualShapeProperties: &decodeNonVisualShapeProperties{
+			CommonNonVisualProperties:      &CommonNonVisualProperties{ID: shapeID, Name: name},
+			CommonNonVisualShapeProperties: &decodeCommonNonVisualShapeProperties{TxBox: &txBox},
+			NonVisualProperties:            &decodeNonVisualProperties{},
+		},
+		ShapeProperties: &DecodeShapeProperties{
+			Xfrm:           &DecodeXfrm{Offset: &offset, Extents: &extents},
+			PresetGeometry: &DecodePresetGeometry{Preset: "rect", AdjustValueList: &AdjustValueList{}},
+			NoFill:         &noFill{},
+		},
+		TextBody: &DecodeTextBody{
+			BodyProperties: &DecodeBodyProperties{Anchor: &anchor, NormAutofit: &NormAutofit{}},
+			Paragraph:      paragraphs,
+		},
+	}
+}
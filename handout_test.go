@@ -0,0 +1,120 @@
+package gopptx
+
+import (
+	"bytes"
+	"slices"
+	"testing"
+)
+
+func TestBuildHandouts(t *testing.T) {
+	f := NewFile()
+	slideID := f.GetSlideList()[0]
+	slide, err := f.slideReader(slideID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	setPlaceholderParagraphs(slide.getTitleShape(), []JSONParagraph{{Runs: []JSONRun{{Text: "Intro"}}}, {Runs: []JSONRun{{Text: "Deck"}}}})
+	if err := f.addNotesSlide(slideID, []string{"", "Welcome", "", "Agenda", ""}); err != nil {
+		t.Fatal(err)
+	}
+	for range 3 {
+		if _, err := f.NewSlide(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, c := range []struct {
+		opts     *HandoutOptions
+		slides   int
+		pictures [][]string
+		picture  DecodeXfrm
+		notes    DecodeXfrm
+	}{
+		{
+			slides:   2,
+			pictures: [][]string{{"Slide 1|Intro Deck", "Slide 2|", "Slide 3|"}, {"Slide 4|"}},
+			picture:  DecodeXfrm{Offset: &Offset{X: 228600, Y: 228600}, Extents: &Extents{CX: 2818360, CY: 1585383}},
+			notes:    DecodeXfrm{Offset: &Offset{X: 3275560, Y: 228600}, Extents: &Extents{CX: 6576465, CY: 1585383}},
+		},
+		{
+			opts:     &HandoutOptions{Template: NewFile(), SlidesPerPage: 2, Margin: 457200},
+			slides:   3,
+			pictures: [][]string{nil, {"Slide 1|Intro Deck", "Slide 2|"}, {"Slide 3|", "Slide 4|"}},
+			picture:  DecodeXfrm{Offset: &Offset{X: 457200, Y: 457200}, Extents: &Extents{CX: 3821155, CY: 2149475}},
+			notes:    DecodeXfrm{Offset: &Offset{X: 4735555, Y: 457200}, Extents: &Extents{CX: 4887870, CY: 2149475}},
+		},
+		{
+			opts:     &HandoutOptions{SlidesPerPage: 1},
+			slides:   4,
+			pictures: [][]string{{"Slide 1|Intro Deck"}, {"Slide 2|"}, {"Slide 3|"}, {"Slide 4|"}},
+			picture:  DecodeXfrm{Offset: &Offset{X: 228600, Y: 1514082}, Extents: &Extents{CX: 4697412, CY: 2642386}},
+			notes:    DecodeXfrm{Offset: &Offset{X: 5154612, Y: 228600}, Extents: &Extents{CX: 4697413, CY: 5213350}},
+		},
+	} {
+		handouts, err := f.BuildHandouts(c.opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var buf bytes.Buffer
+		if err := handouts.Write(&buf); err != nil {
+			t.Fatal(err)
+		}
+		if handouts, err = OpenReader(&buf); err != nil {
+			t.Fatal(err)
+		}
+		pages := handouts.GetSlideList()
+		if len(pages) != c.slides {
+			t.Fatalf("expected %d slides of the handouts, got %v", c.slides, pages)
+		}
+		for i, expected := range c.pictures {
+			if expected == nil {
+				continue
+			}
+			page, err := handouts.slideReader(pages[i])
+			if err != nil {
+				t.Fatal(err)
+			}
+			var pictures []string
+			for _, pic := range page.CommonSlideData.ShapeTree.Picture {
+				cNvPr := pic.NonVisualPictureProperties.CommonNonVisualProperties
+				pictures = append(pictures, cNvPr.Name+"|"+cNvPr.Descr)
+			}
+			if !slices.Equal(pictures, expected) {
+				t.Errorf("expected the thumbnails %q on the page %d, got %q", expected, i+1, pictures)
+			}
+			if shapes := page.CommonSlideData.ShapeTree.Shape; len(shapes) != len(expected) {
+				t.Errorf("expected %d notes on the page %d, got %d", len(expected), i+1, len(shapes))
+			}
+		}
+		first := 0
+		for c.pictures[first] == nil {
+			first++
+		}
+		page, err := handouts.slideReader(pages[first])
+		if err != nil {
+			t.Fatal(err)
+		}
+		picture, notes := page.CommonSlideData.ShapeTree.Picture[0], page.CommonSlideData.ShapeTree.Shape[0]
+		if xfrm := picture.ShapeProperties.Xfrm; *xfrm.Offset != *c.picture.Offset || *xfrm.Extents != *c.picture.Extents {
+			t.Errorf("expected the thumbnail at %v %v, got %v %v", *c.picture.Offset, *c.picture.Extents, *xfrm.Offset, *xfrm.Extents)
+		}
+		if xfrm := notes.ShapeProperties.Xfrm; *xfrm.Offset != *c.notes.Offset || *xfrm.Extents != *c.notes.Extents {
+			t.Errorf("expected the notes at %v %v, got %v %v", *c.notes.Offset, *c.notes.Extents, *xfrm.Offset, *xfrm.Extents)
+		}
+		if name := notes.NonVisualShapeProperties.CommonNonVisualProperties.Name; name != "Notes 1" {
+			t.Errorf("expected the notes named Notes 1, got %q", name)
+		}
+		var texts []string
+		for _, p := range notes.TextBody.Paragraph {
+			texts = append(texts, p.text())
+		}
+		if expected := []string{"Slide 1: Intro Deck", "Welcome", "", "Agenda"}; !slices.Equal(texts, expected) {
+			t.Errorf("expected the notes %q, got %q", expected, texts)
+		}
+		if rPr := notes.TextBody.Paragraph[0].Runs[0].RunProperties; rPr.Bold == nil || *rPr.Bold != 1 || *rPr.Size != 1200 {
+			t.Errorf("expected the bold heading of 12 points, got %+v", rPr)
+		}
+	}
+	if _, err := f.BuildHandouts(&HandoutOptions{Template: &File{}}); err == nil {
+		t.Error("expected the error of the template without the presentation part")
+	}
+}
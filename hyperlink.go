@@ -0,0 +1,186 @@
+// Copyright 2026 kenny-not-dead. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+//
+// Package gopptx provides functionality to create and manipulate PowerPoint
+// (.pptx) files in Go, using the Office Open XML (ECMA-376) format.
+
+package gopptx
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Hyperlink directly maps the hlinkClick and hlinkHover elements of the
+// shapes and the text runs. The part is the name of the part containing the
+// hyperlink, such as "ppt/slides/slide1.xml", the slide id is the id of the
+// slide for the slide and the notes slide parts, and 0 for the slide masters
+// and the layouts. The shape id is the id of the shape containing the
+// hyperlink, and 0 if the hyperlink isn't in a shape.
+//
+// The target is the URL of the external hyperlink, or the part name of the
+// internal hyperlink, such as the slide of the "ppaction://hlinksldjump"
+// action. The target is empty if the hyperlink has no relationship, such as
+// the "ppaction://hlinkshowjump?jump=nextslide" action, or the relationship
+// doesn't exist.
+type Hyperlink struct {
+	Part           string
+	SlideID        int
+	ShapeID        int
+	Element        string
+	RelationshipID string
+	Action         string
+	Tooltip        string
+	Target         string
+	External       bool
+}
+
+// GetHyperlinks provides a function to get the hyperlinks of the slides, the
+// notes slides, the slide masters and the layouts in the presentation, the
+// slides are listed in the order of the presentation followed by the masters.
+// For example, print the external hyperlinks:
+//
+//	links, err := f.GetHyperlinks()
+//	if err != nil {
+//	    fmt.Println(err)
+//	    return
+//	}
+//	for _, link := range links {
+//	    if link.External {
+//	        fmt.Println(link.Part, link.ShapeID, link.Target)
+//	    }
+//	}
+func (f *File) GetHyperlinks() ([]Hyperlink, error) {
+	var links []Hyperlink
+	for _, slideID := range f.GetSlideList() {
+		slide, err := f.slideNodeReader(slideID)
+		if err != nil {
+			return links, err
+		}
+		slideXMLPath, _ := f.getSlideXMLPath(slideID)
+		links = f.getHyperlinks(links, slideXMLPath, slideID, slide, 0)
+		if notesXMLPath, ok := f.getRelTargetByType(slideXMLPath, SourceRelationshipNotesSlide); ok {
+			if links, err = f.getPartHyperlinks(links, notesXMLPath, slideID); err != nil {
+				return links, err
+			}
+		}
+	}
+	for _, masterXMLPath := range f.getSlideMasterPaths() {
+		master, err := f.xmlNodeReader(f.readXML(masterXMLPath))
+		if err != nil {
+			return links, err
+		}
+		links = f.getHyperlinks(links, masterXMLPath, 0, master, 0)
+		for _, layoutXMLPath := range f.getMasterLayoutPaths(masterXMLPath, master) {
+			if links, err = f.getPartHyperlinks(links, layoutXMLPath, 0); err != nil {
+				return links, err
+			}
+		}
+	}
+	return links, nil
+}
+
+// getPartHyperlinks provides a function to append the hyperlinks of the part
+// to the list by given list, part name and slide id.
+func (f *File) getPartHyperlinks(links []Hyperlink, partName string, slideID int) ([]Hyperlink, error) {
+	content := f.readBytes(partName)
+	if len(content) == 0 {
+		return links, nil
+	}
+	root, err := f.xmlNodeReader(content)
+	if err != nil {
+		return links, err
+	}
+	return f.getHyperlinks(links, partName, slideID, root, 0), nil
+}
+
+// getHyperlinks provides a function to append the hyperlinks in the children
+// of the element to the list by given list, part name, slide id, element and
+// the id of the shape containing the element.
+func (f *File) getHyperlinks(links []Hyperlink, partName string, slideID int, node *xmlNode, shapeID int) []Hyperlink {
+	for _, child := range node.Children {
+		if child.Name == "" {
+			continue
+		}
+		if name := localName(child.Name); name == "hlinkClick" || name == "hlinkHover" {
+			links = append(links, f.newHyperlink(partName, slideID, shapeID, child))
+			continue
+		}
+		childShapeID := shapeID
+		for _, nv := range child.Children {
+			if cNvPr := nv.find("cNvPr"); strings.HasPrefix(localName(nv.Name), "nv") && cNvPr != nil {
+				childShapeID, _ = strconv.Atoi(cNvPr.attr("id"))
+			}
+		}
+		links = f.getHyperlinks(links, partName, slideID, child, childShapeID)
+	}
+	return links
+}
+
+// newHyperlink provides a function to create the hyperlink by given part
+// name, slide id, shape id and the hlinkClick or hlinkHover element.
+func (f *File) newHyperlink(partName string, slideID, shapeID int, node *xmlNode) Hyperlink {
+	link := Hyperlink{
+		Part:    partName,
+		SlideID: slideID,
+		ShapeID: shapeID,
+		Element: localName(node.Name),
+		Action:  node.attr("action"),
+		Tooltip: node.attr("tooltip"),
+	}
+	for _, attr := range node.Attr {
+		if localName(attr.Name.Local) == "id" && attr.Name.Local != "id" {
+			link.RelationshipID = attr.Value
+		}
+	}
+	if link.RelationshipID != "" {
+		var internal bool
+		link.Target, internal = f.getRelTarget(partName, link.RelationshipID)
+		link.External = !internal && link.Target != ""
+	}
+	return link
+}
+
+// RewriteHyperlinks provides a function to update the targets of the
+// external hyperlinks in all parts of the presentation by given function,
+// which returns the new target by given current target, and false to keep
+// the target. It returns the number of the updated hyperlinks. For example,
+// point the hyperlinks of the staging site to the production site:
+//
+//	n, err := f.RewriteHyperlinks(func(target string) (string, bool) {
+//	    if strings.HasPrefix(target, "https://staging.example.com/") {
+//	        return strings.Replace(target, "staging.", "", 1), true
+//	    }
+//	    return target, false
+//	})
+func (f *File) RewriteHyperlinks(fn func(target string) (string, bool)) (int, error) {
+	if err := f.checkReadOnly(); err != nil {
+		return 0, err
+	}
+	var count int
+	for _, name := range f.getPartNames() {
+		if !strings.HasSuffix(name, ".rels") {
+			continue
+		}
+		rels, err := f.relsReader(name)
+		if err != nil {
+			return count, err
+		}
+		if rels == nil {
+			continue
+		}
+		rels.mu.Lock()
+		for i, rel := range rels.Relationships {
+			if rel.Type != SourceRelationshipHyperlink || rel.TargetMode != "External" {
+				continue
+			}
+			if target, ok := fn(rel.Target); ok && target != rel.Target {
+				rels.Relationships[i].Target = target
+				count++
+			}
+		}
+		rels.mu.Unlock()
+	}
+	return count, nil
+}
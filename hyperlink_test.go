@@ -0,0 +1,81 @@
+package gopptx
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// openTestHyperlinkFile provides a function to open the presentation with
+// the external hyperlink on the text run of the title, and the action
+// hyperlink without relationship on the subtitle.
+func openTestHyperlinkFile(t *testing.T) *File {
+	t.Helper()
+	f, err := openTestFile(t, func(name string, content []byte) []byte {
+		switch name {
+		case "ppt/slides/slide1.xml":
+			content = bytes.Replace(content, []byte("</a:pPr>"),
+				[]byte(`</a:pPr><a:r><a:rPr><a:hlinkClick r:id="rId9" tooltip="Docs"/></a:rPr><a:t>Docs</a:t></a:r>`), 1)
+			return bytes.Replace(content, []byte(`name="PlaceHolder 2">`),
+				[]byte(`name="PlaceHolder 2"><a:hlinkClick r:id="" action="ppaction://hlinkshowjump?jump=nextslide"/>`), 1)
+		case "ppt/slides/_rels/slide1.xml.rels":
+			return bytes.Replace(content, []byte("</Relationships>"),
+				[]byte(`<Relationship Id="rId9" Type="`+SourceRelationshipHyperlink+`" Target="https://staging.example.com/docs" TargetMode="External"/></Relationships>`), 1)
+		}
+		return content
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return f
+}
+
+func TestGetHyperlinks(t *testing.T) {
+	f := openTestHyperlinkFile(t)
+	links, err := f.GetHyperlinks()
+	if err != nil {
+		t.Fatal(err)
+	}
+	slideID := f.GetSlideList()[0]
+	expected := []Hyperlink{
+		{
+			Part: "ppt/slides/slide1.xml", SlideID: slideID, ShapeID: 7, Element: "hlinkClick", RelationshipID: "rId9",
+			Tooltip: "Docs", Target: "https://staging.example.com/docs", External: true,
+		},
+		{
+			Part: "ppt/slides/slide1.xml", SlideID: slideID, ShapeID: 8, Element: "hlinkClick",
+			Action: "ppaction://hlinkshowjump?jump=nextslide",
+		},
+	}
+	if !reflect.DeepEqual(links, expected) {
+		t.Errorf("expected the hyperlinks %v, got %v", expected, links)
+	}
+}
+
+func TestRewriteHyperlinks(t *testing.T) {
+	f := openTestHyperlinkFile(t)
+	rewrite := func(target string) (string, bool) {
+		return strings.Replace(target, "staging.", "", 1), strings.HasPrefix(target, "https://staging.")
+	}
+	for _, expected := range []int{1, 0} {
+		n, err := f.RewriteHyperlinks(rewrite)
+		if err != nil || n != expected {
+			t.Errorf("expected %d rewritten hyperlinks, got %d %v", expected, n, err)
+		}
+	}
+	buf, err := f.WriteToBuffer()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f, err = OpenReader(buf); err != nil {
+		t.Fatal(err)
+	}
+	links, err := f.GetHyperlinks()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(links) != 2 || links[0].Target != "https://example.com/docs" || links[1].Target != "" {
+		t.Errorf("expected the rewritten target https://example.com/docs, got %v", links)
+	}
+}
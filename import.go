@@ -0,0 +1,370 @@
+// Copyright 2026 kenny-not-dead. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+//
+// Package gopptx provides functionality to create and manipulate PowerPoint
+// (.pptx) files in Go, using the Office Open XML (ECMA-376) format.
+
+package gopptx
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"path"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// ImportSlideOptions directly maps the settings of importing a slide from
+// another presentation. The index is the zero-based index of the imported
+// slide in the presentation, and the slide is appended if it is nil. If
+// RemapLayout is true, the slide is related to the closest matching layout of
+// the presentation by the layout type and name instead of importing the
+// source layout, which keeps the merged presentation on one design.
+type ImportSlideOptions struct {
+	Index       *int
+	RemapLayout bool
+}
+
+// ImportSlide provides a function to copy the slide by given slide id from
+// the source presentation, and returns the slide id of the imported slide.
+// The parts used by the slide, such as the pictures, the charts and the
+// embedded objects, are copied with their dependencies. The source layout is
+// imported into the slide master of the presentation unless RemapLayout is
+// true, the identical layouts imported before are reused. The speaker notes
+// are not imported, and the hyperlinks to the other slides of the source
+// presentation are pointed to the imported slide. For example:
+//
+//	src, err := gopptx.OpenFile("source.pptx")
+//	if err != nil {
+//	    fmt.Println(err)
+//	    return
+//	}
+//	slideID, err := f.ImportSlide(src, 256, &gopptx.ImportSlideOptions{RemapLayout: true})
+func (f *File) ImportSlide(src *File, srcSlideID int, opts *ImportSlideOptions) (int, error) {
+	if err := f.checkReadOnly(); err != nil {
+		return -1, err
+	}
+	if opts == nil {
+		opts = &ImportSlideOptions{}
+	}
+	srcSlide, err := src.slideReader(srcSlideID)
+	if err != nil {
+		return -1, err
+	}
+	srcSlideXMLPath, _ := src.getSlideXMLPath(srcSlideID)
+	slide := new(decodeSlide)
+	if err = f.xmlNewDecoder(bytes.NewReader(marshalSlide(srcSlide))).Decode(slide); err != nil && err != io.EOF {
+		return -1, err
+	}
+	slideID, err := f.NewSlide(&NewSlideOptions{Index: opts.Index})
+	if err != nil {
+		return -1, err
+	}
+	slideXMLPath, _ := f.getSlideXMLPath(slideID)
+	f.Slide.Store(slideXMLPath, slide)
+	imported, rels := map[string]string{}, &relationships{}
+	srcRels, _ := src.relsReader(getPartRelsPath(srcSlideXMLPath))
+	for _, rel := range getRelationships(srcRels) {
+		if rel.TargetMode == "External" {
+			rels.Relationships = append(rels.Relationships, rel)
+			continue
+		}
+		target := resolveRelTarget(srcSlideXMLPath, rel.Target)
+		switch rel.Type {
+		case SourceRelationshipNotesSlide:
+			continue
+		case SourceRelationshipSlide:
+			target = slideXMLPath
+		case SourceRelationshipSlideLayout:
+			if target, err = f.importSlideLayout(src, target, opts.RemapLayout, imported); err != nil {
+				return slideID, err
+			}
+		default:
+			if target, err = f.importPart(src, target, imported); err != nil {
+				return slideID, err
+			}
+		}
+		rel.Target = getRelativeTarget(slideXMLPath, target)
+		rels.Relationships = append(rels.Relationships, rel)
+	}
+	f.Relationships.Store(getPartRelsPath(slideXMLPath), rels)
+	return slideID, nil
+}
+
+// getRelationships returns a copy of the relationships list.
+func getRelationships(rels *relationships) []relationship {
+	if rels == nil {
+		return nil
+	}
+	rels.mu.Lock()
+	defer rels.mu.Unlock()
+	return append([]relationship(nil), rels.Relationships...)
+}
+
+// importPart provides a function to copy the part by given source
+// presentation and part name with the parts it depends on, and returns the
+// part name in the presentation. The imported map records the copied parts
+// for reusing. The relationships to the slides, the layouts, the masters and
+// the notes are not followed.
+func (f *File) importPart(src *File, partName string, imported map[string]string) (string, error) {
+	if name, ok := imported[partName]; ok {
+		return name, nil
+	}
+	name := f.getNewPartName(partName)
+	imported[partName] = name
+	f.Pkg.Store(name, src.readBytes(partName))
+	if err := f.importContentType(src, partName, name); err != nil {
+		return name, err
+	}
+	srcRels, _ := src.relsReader(getPartRelsPath(partName))
+	if srcRels == nil {
+		return name, nil
+	}
+	rels := &relationships{}
+	for _, rel := range getRelationships(srcRels) {
+		switch rel.Type {
+		case SourceRelationshipSlide, SourceRelationshipSlideLayout, SourceRelationshipSlideMaster,
+			SourceRelationshipNotesSlide, SourceRelationshipNotesMaster:
+			continue
+		}
+		if rel.TargetMode != "External" {
+			target, err := f.importPart(src, resolveRelTarget(partName, rel.Target), imported)
+			if err != nil {
+				return name, err
+			}
+			rel.Target = getRelativeTarget(name, target)
+		}
+		rels.Relationships = append(rels.Relationships, rel)
+	}
+	f.Relationships.Store(getPartRelsPath(name), rels)
+	return name, nil
+}
+
+// importContentType provides a function to set the content type of the part
+// by given source presentation, source part name and part name.
+func (f *File) importContentType(src *File, srcPartName, partName string) error {
+	contentType, isDefault, err := src.getPartContentType(srcPartName)
+	if err != nil || contentType == "" {
+		return err
+	}
+	if isDefault {
+		return f.setContentTypeDefault(strings.TrimPrefix(path.Ext(srcPartName), "."), contentType)
+	}
+	return f.setContentTypes("/"+partName, contentType)
+}
+
+// getPartContentType provides a function to get the content type of the part
+// by given part name, and whether the content type is the default content
+// type of the file extension. The empty content type will be returned if the
+// part has no content type.
+func (f *File) getPartContentType(partName string) (string, bool, error) {
+	content, err := f.contentTypesReader()
+	if err != nil {
+		return "", false, err
+	}
+	content.mu.Lock()
+	overrides, defaults := content.Overrides, content.Defaults
+	content.mu.Unlock()
+	for _, override := range overrides {
+		if strings.TrimPrefix(override.PartName, "/") == partName {
+			return override.ContentType, false, nil
+		}
+	}
+	extension := strings.TrimPrefix(path.Ext(partName), ".")
+	for _, def := range defaults {
+		if strings.EqualFold(def.Extension, extension) {
+			return def.ContentType, true, nil
+		}
+	}
+	return "", false, nil
+}
+
+// getNewPartName provides a function to get an unused part name for the
+// part by given part name, which is numbered after the part name without the
+// trailing digits, such as "ppt/charts/chart3.xml".
+func (f *File) getNewPartName(partName string) string {
+	used := map[string]bool{}
+	for _, name := range f.getPartNames() {
+		used[name] = true
+	}
+	dir, ext := path.Dir(partName), path.Ext(partName)
+	base := strings.TrimRightFunc(strings.TrimSuffix(path.Base(partName), ext), unicode.IsDigit)
+	for i := 1; ; i++ {
+		if name := fmt.Sprintf("%s/%s%d%s", dir, base, i, ext); !used[name] {
+			return name
+		}
+	}
+}
+
+// importSlideLayout provides a function to get the layout of the
+// presentation for the source layout by given source presentation and layout
+// part name. If remap is true, the closest matching layout is returned,
+// otherwise the source layout is imported into the slide master of the
+// presentation, or the identical layout imported before is returned.
+func (f *File) importSlideLayout(src *File, layoutXMLPath string, remap bool, imported map[string]string) (string, error) {
+	srcLayout, err := src.slideLayoutReader(layoutXMLPath)
+	if err != nil {
+		return "", err
+	}
+	if remap {
+		return f.getClosestSlideLayoutPath(srcLayout)
+	}
+	content := src.readBytes(layoutXMLPath)
+	for _, name := range f.getSlideLayoutPaths() {
+		if bytes.Equal(f.readBytes(name), content) {
+			return name, nil
+		}
+	}
+	masterXMLPath, err := f.getSlideMasterPath()
+	if err != nil {
+		return "", err
+	}
+	return f.importSlideLayoutPart(src, layoutXMLPath, masterXMLPath, imported)
+}
+
+// importSlideLayoutPart provides a function to copy the layout by given
+// source presentation and layout part name into the layout list of the slide
+// master by given master part name, and returns the part name of the
+// imported layout.
+func (f *File) importSlideLayoutPart(src *File, layoutXMLPath, masterXMLPath string, imported map[string]string) (string, error) {
+	name := f.getNewPartName(layoutXMLPath)
+	imported[layoutXMLPath] = name
+	f.Pkg.Store(name, src.readBytes(layoutXMLPath))
+	if err := f.importContentType(src, layoutXMLPath, name); err != nil {
+		return name, err
+	}
+	rels := &relationships{}
+	srcRels, _ := src.relsReader(getPartRelsPath(layoutXMLPath))
+	for _, rel := range getRelationships(srcRels) {
+		if rel.TargetMode != "External" {
+			target := masterXMLPath
+			if rel.Type != SourceRelationshipSlideMaster {
+				var err error
+				if target, err = f.importPart(src, resolveRelTarget(layoutXMLPath, rel.Target), imported); err != nil {
+					return name, err
+				}
+			}
+			rel.Target = getRelativeTarget(name, target)
+		}
+		rels.Relationships = append(rels.Relationships, rel)
+	}
+	f.Relationships.Store(getPartRelsPath(name), rels)
+	return name, f.addSlideLayoutID(masterXMLPath, name)
+}
+
+// getClosestSlideLayoutPath provides a function to get the part name of the
+// layout in the presentation which matches the given layout best. The layout
+// with the same type and name is preferred, then the same type except the
+// custom layouts, then the same name. The content layout or the first layout
+// will be returned if no layout matches.
+func (f *File) getClosestSlideLayoutPath(layout *decodeSlideLayout) (string, error) {
+	layoutType := func(l *decodeSlideLayout) string {
+		if l.Type == "" {
+			return "cust"
+		}
+		return l.Type
+	}
+	var best, fallback string
+	var bestScore int
+	fallbackType := ""
+	for _, name := range f.getSlideLayoutPaths() {
+		candidate, err := f.slideLayoutReader(name)
+		if err != nil {
+			return "", err
+		}
+		sameType := layoutType(candidate) == layoutType(layout)
+		sameName := strings.EqualFold(candidate.CommonSlideData.Name, layout.CommonSlideData.Name)
+		var score int
+		switch {
+		case sameType && sameName:
+			score = 3
+		case sameType && layoutType(layout) != "cust":
+			score = 2
+		case sameName:
+			score = 1
+		}
+		if score > bestScore {
+			best, bestScore = name, score
+		}
+		if fallback == "" || (candidate.Type == "obj" && fallbackType != "obj") {
+			fallback, fallbackType = name, candidate.Type
+		}
+	}
+	if best != "" {
+		return best, nil
+	}
+	if fallback == "" {
+		return "", ErrLayoutNotExist{layoutType(layout)}
+	}
+	return fallback, nil
+}
+
+// getSlideMasterPath provides a function to get the part name of the first
+// slide master of the presentation.
+func (f *File) getSlideMasterPath() (string, error) {
+	presentation, err := f.presentationReader()
+	if err != nil {
+		return "", err
+	}
+	for _, master := range presentation.MasterSlide.MasterSlide {
+		if name, ok := f.getRelTarget(defaultXMLPathPresentation, master.RelationshipID); ok {
+			return name, nil
+		}
+	}
+	if name, ok := f.getRelTargetByType(defaultXMLPathPresentation, SourceRelationshipSlideMaster); ok {
+		return name, nil
+	}
+	return defaultXMLPathSlideMaster, nil
+}
+
+// addSlideLayoutID provides a function to append the layout by given layout
+// part name to the layout list of the slide master, the layout ID is unique
+// among the master and the layout IDs of the presentation.
+func (f *File) addSlideLayoutID(masterXMLPath, layoutXMLPath string) error {
+	id, err := f.getMaxSlideMasterID()
+	if err != nil {
+		return err
+	}
+	root, err := f.xmlNodeReader(f.readXML(masterXMLPath))
+	if err != nil {
+		return err
+	}
+	p, r := root.prefix(NameSpacePresentationMLMain), root.prefix(SourceRelationship.Value)
+	list := root.child(p + "sldLayoutIdLst")
+	if list == nil {
+		list = newXMLNode(p + "sldLayoutIdLst")
+		root.insert(list, p+"transition", p+"timing", p+"hf", p+"txStyles", p+"extLst")
+	}
+	rID := f.addRels(getPartRelsPath(masterXMLPath), SourceRelationshipSlideLayout, getRelativeTarget(masterXMLPath, layoutXMLPath), "")
+	list.Children = append(list.Children, newXMLNode(p+"sldLayoutId", "id", strconv.Itoa(id+1), r+"id", rID))
+	f.saveFileList(masterXMLPath, root.bytes())
+	return nil
+}
+
+// getMaxSlideMasterID provides a function to get the maximum ID of the slide
+// masters and the layouts of the presentation, which share the same ID
+// space starting from 2147483648.
+func (f *File) getMaxSlideMasterID() (int, error) {
+	presentation, err := f.presentationReader()
+	if err != nil {
+		return 0, err
+	}
+	id := 2147483647
+	for _, master := range presentation.MasterSlide.MasterSlide {
+		id = max(id, master.SlideID)
+	}
+	for _, masterXMLPath := range f.getSlideMasterPaths() {
+		root, err := f.xmlNodeReader(f.readXML(masterXMLPath))
+		if err != nil {
+			return id, err
+		}
+		for _, layoutID := range root.find("sldLayoutIdLst").findAll("sldLayoutId") {
+			n, _ := strconv.Atoi(layoutID.attr("id"))
+			id = max(id, n)
+		}
+	}
+	return id, nil
+}
@@ -0,0 +1,71 @@
+package gopptx
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestImportSlide(t *testing.T) {
+	img := newTestPNG(t, 16, 16)
+	src := NewFile()
+	srcSlideID := src.GetSlideList()[0]
+	addTestShape(t, src, srcSlideID, "", "Imported")
+	if _, err := src.AddPictureFromBytes(srcSlideID, ".png", img, nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := src.addChart(srcSlideID, "column", "", Offset{}, Extents{CX: 914400, CY: 914400}); err != nil {
+		t.Fatal(err)
+	}
+	// Make the source layout different from the layout of the presentation
+	srcLayout := "ppt/slideLayouts/slideLayout1.xml"
+	src.Pkg.Store(srcLayout, bytes.Replace(bytes.Replace(src.readBytes(srcLayout),
+		[]byte(`type="title"`), []byte(`type="obj"`), 1), []byte(`name="Default"`), []byte(`name="Imported"`), 1))
+	f := NewFile()
+	if _, err := f.addChart(f.GetSlideList()[0], "pie", "", Offset{}, Extents{CX: 914400, CY: 914400}); err != nil {
+		t.Fatal(err)
+	}
+	index := 0
+	for _, c := range []struct {
+		opts   *ImportSlideOptions
+		index  int
+		layout string
+	}{
+		{opts: &ImportSlideOptions{RemapLayout: true}, index: 1, layout: "ppt/slideLayouts/slideLayout1.xml"},
+		{index: 2, layout: "ppt/slideLayouts/slideLayout2.xml"},
+		{opts: &ImportSlideOptions{Index: &index}, layout: "ppt/slideLayouts/slideLayout2.xml"},
+	} {
+		slideID, err := f.ImportSlide(src, srcSlideID, c.opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if idx, err := f.GetSlideIndex(slideID); err != nil || idx != c.index {
+			t.Errorf("expected the imported slide at %d, got %d %v", c.index, idx, err)
+		}
+		slide, err := f.slideReader(slideID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if shapes := slide.getShapes(); shapes[len(shapes)-1].TextBody.text() != "Imported" {
+			t.Error("the text of the imported slide is lost")
+		}
+		slideXMLPath, _ := f.getSlideXMLPath(slideID)
+		if target, ok := f.getRelTarget(slideXMLPath, slide.CommonSlideData.ShapeTree.Picture[0].BlipFill.Blip.Embed); !ok || !bytes.Equal(f.readPart(target), img) {
+			t.Error("the image of the imported slide is lost")
+		}
+		if target, ok := f.getRelTarget(slideXMLPath, slide.CommonSlideData.ShapeTree.GraphicFrame[0].chartRelationshipID()); !ok || target == "ppt/charts/chart1.xml" {
+			t.Errorf("expected the imported chart, got %s", target)
+		}
+		if target, ok := f.getRelTargetByType(slideXMLPath, SourceRelationshipSlideLayout); !ok || target != c.layout {
+			t.Errorf("expected the layout %s, got %s", c.layout, target)
+		}
+	}
+	if layouts := f.getSlideLayoutPaths(); len(layouts) != 2 {
+		t.Errorf("expected the imported layout is reused, got %v", layouts)
+	}
+	if err := f.Validate(); err != nil {
+		t.Error(err)
+	}
+	if _, err := f.ImportSlide(src, 300, nil); err != (ErrSlideNotExist{300}) {
+		t.Errorf("expected error %v, got %v", ErrSlideNotExist{300}, err)
+	}
+}
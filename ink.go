@@ -0,0 +1,209 @@
+// Copyright 2026 kenny-not-dead. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+//
+// Package gopptx provides functionality to create and manipulate PowerPoint
+// (.pptx) files in Go, using the Office Open XML (ECMA-376) format.
+
+package gopptx
+
+import (
+	"strconv"
+	"strings"
+)
+
+// InkAnnotation directly maps the ink annotation drawn on the slide, which
+// is stored in the InkML format in the ink part referred by the content part
+// of the slide, such as "ppt/ink/ink1.xml".
+type InkAnnotation struct {
+	ShapeID int
+	Name    string
+	Part    string
+	Strokes []InkStroke
+}
+
+// InkStroke directly maps the trace of the ink annotation. The color is the
+// hex RGB color of the brush, the width is the width of the brush in EMUs,
+// and the points are the X and Y coordinates of the trace in the coordinate
+// system of the ink.
+type InkStroke struct {
+	Color  string
+	Width  int
+	Points []InkPoint
+}
+
+// InkPoint directly maps the point of the trace of the ink annotation.
+type InkPoint struct {
+	X, Y float64
+}
+
+// inkUnits defined the number of EMUs per unit of the brush properties of
+// the InkML.
+var inkUnits = map[string]float64{
+	"cm":       360000,
+	"mm":       36000,
+	"in":       914400,
+	"pt":       EMUPerPoint,
+	"himetric": 360,
+}
+
+// GetInkAnnotations provides a function to get the ink annotations of the
+// slide by given slide id, such as the pen and highlighter strokes drawn in
+// the review of the presentation. The ink annotations are kept as is on
+// saving the presentation. For example:
+//
+//	annotations, err := f.GetInkAnnotations(256)
+//	if err != nil {
+//	    fmt.Println(err)
+//	    return
+//	}
+//	for _, annotation := range annotations {
+//	    for _, stroke := range annotation.Strokes {
+//	        fmt.Println(annotation.Name, stroke.Color, len(stroke.Points))
+//	    }
+//	}
+func (f *File) GetInkAnnotations(slideID int) ([]InkAnnotation, error) {
+	var annotations []InkAnnotation
+	slide, err := f.slideNodeReader(slideID)
+	if err != nil {
+		return annotations, err
+	}
+	slideXMLPath, _ := f.getSlideXMLPath(slideID)
+	var walkErr error
+	walkXMLNode(slide.find("cSld", "spTree"), func(node *xmlNode) {
+		if localName(node.Name) != "contentPart" || walkErr != nil {
+			return
+		}
+		var rID string
+		for _, attr := range node.Attr {
+			if localName(attr.Name.Local) == "id" {
+				rID = attr.Value
+			}
+		}
+		inkXMLPath, ok := f.getRelTarget(slideXMLPath, rID)
+		if !ok {
+			return
+		}
+		cNvPr := node.find("nvContentPartPr", "cNvPr")
+		annotation := InkAnnotation{Name: cNvPr.attr("name"), Part: inkXMLPath}
+		annotation.ShapeID, _ = strconv.Atoi(cNvPr.attr("id"))
+		if annotation.Strokes, walkErr = f.getInkStrokes(inkXMLPath); walkErr == nil {
+			annotations = append(annotations, annotation)
+		}
+	})
+	return annotations, walkErr
+}
+
+// getInkStrokes provides a function to get the strokes of the ink part by
+// given part name.
+func (f *File) getInkStrokes(inkXMLPath string) ([]InkStroke, error) {
+	ink, err := f.xmlNodeReader(f.readXML(inkXMLPath))
+	if err != nil {
+		return nil, err
+	}
+	brushes := map[string]InkStroke{}
+	var strokes []InkStroke
+	walkXMLNode(ink, func(node *xmlNode) {
+		switch localName(node.Name) {
+		case "brush":
+			var brush InkStroke
+			for _, prop := range node.findAll("brushProperty") {
+				switch prop.attr("name") {
+				case "color":
+					brush.Color = strings.TrimPrefix(prop.attr("value"), "#")
+				case "width":
+					value, _ := strconv.ParseFloat(prop.attr("value"), 64)
+					if unit, ok := inkUnits[prop.attr("units")]; ok {
+						value *= unit
+					}
+					brush.Width = int(value)
+				}
+			}
+			brushes["#"+node.attr("xml:id")] = brush
+		case "trace":
+			stroke := brushes[node.attr("brushRef")]
+			for _, values := range decodeInkTrace(node.text()) {
+				if len(values) >= 2 {
+					stroke.Points = append(stroke.Points, InkPoint{X: values[0], Y: values[1]})
+				}
+			}
+			strokes = append(strokes, stroke)
+		}
+	})
+	return strokes, nil
+}
+
+// decodeInkTrace returns the values of the channels of the points by given
+// content of the InkML trace element. The values prefixed by the single
+// quote and the double quote are the first and the second differences, and
+// the values prefixed by the exclamation mark are explicit, the prefix
+// applies to the following values of the channel.
+func decodeInkTrace(trace string) [][]float64 {
+	var (
+		points         [][]float64
+		modes          []byte
+		prev, velocity []float64
+	)
+	for _, point := range strings.Split(trace, ",") {
+		var values []float64
+		mode := byte(0)
+		for i := 0; i < len(point); {
+			c := point[i]
+			switch {
+			case c == '!' || c == '\'' || c == '"':
+				mode = c
+				i++
+				continue
+			case c == '*' || c == '?':
+				i++
+				if ch := len(values); ch < len(prev) {
+					values = append(values, prev[ch]+velocity[ch])
+				}
+				continue
+			case c != '-' && c != '+' && c != '.' && (c < '0' || c > '9'):
+				i++
+				continue
+			}
+			j := i + 1
+			for j < len(point) && (point[j] == '.' || point[j] >= '0' && point[j] <= '9' ||
+				(point[j] == 'e' || point[j] == 'E') && j+1 < len(point)) {
+				if point[j] == 'e' || point[j] == 'E' {
+					if point[j+1] == '-' || point[j+1] == '+' {
+						j++
+					}
+				}
+				j++
+			}
+			v, err := strconv.ParseFloat(point[i:j], 64)
+			i = j
+			if err != nil {
+				continue
+			}
+			ch := len(values)
+			for len(modes) <= ch {
+				modes, prev, velocity = append(modes, '!'), append(prev, 0), append(velocity, 0)
+			}
+			if mode != 0 {
+				modes[ch], mode = mode, 0
+			}
+			switch modes[ch] {
+			case '\'':
+				velocity[ch] = v
+			case '"':
+				velocity[ch] += v
+			default:
+				velocity[ch] = v - prev[ch]
+			}
+			values = append(values, prev[ch]+velocity[ch])
+		}
+		for ch, v := range values {
+			if ch < len(prev) {
+				prev[ch] = v
+			}
+		}
+		if len(values) > 0 {
+			points = append(points, values)
+		}
+	}
+	return points
+}
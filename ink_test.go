@@ -0,0 +1,79 @@
+package gopptx
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestGetInkAnnotations(t *testing.T) {
+	const (
+		contentParts = `<p:contentPart p14:bwMode="auto" r:id="rId90"><p14:nvContentPartPr><p14:cNvPr id="20" name="Ink 19"/><p14:cNvContentPartPr/><p14:nvPr/></p14:nvContentPartPr>` +
+			`<p14:xfrm><a:off x="0" y="0"/><a:ext cx="914400" cy="914400"/></p14:xfrm></p:contentPart>` +
+			`<mc:AlternateContent xmlns:mc="http://schemas.openxmlformats.org/markup-compatibility/2006"><mc:Choice Requires="p14">` +
+			`<p:contentPart p14:bwMode="auto" r:id="rId91"><p14:nvContentPartPr><p14:cNvPr id="21" name="Ink 20"/><p14:cNvContentPartPr/><p14:nvPr/></p14:nvContentPartPr></p:contentPart>` +
+			`</mc:Choice><mc:Fallback><p:pic><p:nvPicPr><p:cNvPr id="21" name="Ink 20"/><p:cNvPicPr/><p:nvPr/></p:nvPicPr><p:blipFill/><p:spPr/></p:pic></mc:Fallback></mc:AlternateContent>` +
+			`<p:contentPart r:id="rId99"><p14:nvContentPartPr><p14:cNvPr id="22" name="Ink 21"/><p14:cNvContentPartPr/><p14:nvPr/></p14:nvContentPartPr></p:contentPart>`
+		pen = `<inkml:ink xmlns:inkml="http://www.w3.org/2003/InkML"><inkml:definitions><inkml:brush xml:id="br0">` +
+			`<inkml:brushProperty name="width" value="0.05" units="cm"/><inkml:brushProperty name="color" value="#FF0000"/></inkml:brush></inkml:definitions>` +
+			`<inkml:trace brushRef="#br0">10 20, 12 23, 15 27</inkml:trace><inkml:trace brushRef="#br0">100 100,'5'5,"1"1,*-4</inkml:trace></inkml:ink>`
+		highlighter = `<inkml:ink xmlns:inkml="http://www.w3.org/2003/InkML"><inkml:definitions><inkml:brush xml:id="br1">` +
+			`<inkml:brushProperty name="width" value="10" units="pt"/><inkml:brushProperty name="color" value="#FFFF00"/></inkml:brush></inkml:definitions>` +
+			`<inkml:trace brushRef="#br1">0 0 1000, 1.5E1 -2 1000</inkml:trace></inkml:ink>`
+	)
+	f, err := openTestFile(t, func(name string, content []byte) []byte {
+		switch name {
+		case defaultXMLPathSlide:
+			return bytes.Replace(content, []byte("</p:spTree>"), []byte(contentParts+"</p:spTree>"), 1)
+		case getPartRelsPath(defaultXMLPathSlide):
+			return bytes.Replace(content, []byte("</Relationships>"), []byte(
+				`<Relationship Id="rId90" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/customXml" Target="../ink/ink1.xml"/>`+
+					`<Relationship Id="rId91" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/customXml" Target="../ink/ink2.xml"/>`+
+					`</Relationships>`), 1)
+		}
+		return content
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.Pkg.Store("ppt/ink/ink1.xml", []byte(pen))
+	f.Pkg.Store("ppt/ink/ink2.xml", []byte(highlighter))
+	slideID := f.GetSlideList()[0]
+	if _, err = f.AddTextBox(slideID, TextBoxOptions{Text: "text"}); err != nil {
+		t.Fatal(err)
+	}
+	buf, err := f.WriteToBuffer()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f, err = OpenReader(buf); err != nil {
+		t.Fatal(err)
+	}
+	content := string(f.readXML(defaultXMLPathSlide))
+	for _, element := range []string{`<p:contentPart p14:bwMode="auto" r:id="rId90">`, `<mc:Choice Requires="p14"><p:contentPart`, `<mc:Fallback><p:pic>`} {
+		if !strings.Contains(content, element) {
+			t.Errorf("the element %s of the ink annotations is lost: %s", element, content)
+		}
+	}
+	annotations, err := f.GetInkAnnotations(slideID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []InkAnnotation{
+		{ShapeID: 20, Name: "Ink 19", Part: "ppt/ink/ink1.xml", Strokes: []InkStroke{
+			{Color: "FF0000", Width: 18000, Points: []InkPoint{{X: 10, Y: 20}, {X: 12, Y: 23}, {X: 15, Y: 27}}},
+			{Color: "FF0000", Width: 18000, Points: []InkPoint{{X: 100, Y: 100}, {X: 105, Y: 105}, {X: 111, Y: 111}, {X: 117, Y: 113}}},
+		}},
+		{ShapeID: 21, Name: "Ink 20", Part: "ppt/ink/ink2.xml", Strokes: []InkStroke{
+			{Color: "FFFF00", Width: 127000, Points: []InkPoint{{X: 0, Y: 0}, {X: 15, Y: -2}}},
+		}},
+	}
+	if !reflect.DeepEqual(annotations, expected) {
+		t.Errorf("expected the ink annotations %v, got %v", expected, annotations)
+	}
+	if _, err = f.GetInkAnnotations(300); !errors.Is(err, ErrSlideNotExist{300}) {
+		t.Errorf("expected ErrSlideNotExist, got %v", err)
+	}
+}
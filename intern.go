@@ -0,0 +1,46 @@
+// Copyright 2026 kenny-not-dead. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+//
+// Package gopptx provides functionality to create and manipulate PowerPoint
+// (.pptx) files in Go, using the Office Open XML (ECMA-376) format.
+
+package gopptx
+
+import (
+	"encoding/xml"
+	"unique"
+)
+
+// internString returns the canonical copy of the string, so the frequently
+// repeated strings read from the package, such as the part names, the
+// relationship IDs and types and the namespaces, share one allocation across
+// the presentations. The canonical copies are released by the garbage
+// collector once they are no longer referenced.
+func internString(s string) string {
+	if s == "" {
+		return s
+	}
+	return unique.Make(s).Value()
+}
+
+// internAttrs interns the names and the values of the XML attributes in
+// place, and returns the attributes.
+func internAttrs(attrs []xml.Attr) []xml.Attr {
+	for i := range attrs {
+		attrs[i].Name.Space = internString(attrs[i].Name.Space)
+		attrs[i].Name.Local = internString(attrs[i].Name.Local)
+		attrs[i].Value = internString(attrs[i].Value)
+	}
+	return attrs
+}
+
+// intern interns the IDs, the targets, the types and the target modes of
+// the relationships.
+func (rels *relationships) intern() {
+	for i := range rels.Relationships {
+		rel := &rels.Relationships[i]
+		rel.ID, rel.Target = internString(rel.ID), internString(rel.Target)
+		rel.Type, rel.TargetMode = internString(rel.Type), internString(rel.TargetMode)
+	}
+}
@@ -0,0 +1,54 @@
+package gopptx
+
+import (
+	"encoding/xml"
+	"strings"
+	"testing"
+	"unsafe"
+)
+
+func TestInternString(t *testing.T) {
+	buf, err := NewFile().WriteToBuffer()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var files []*File
+	for range 2 {
+		f, err := OpenReader(strings.NewReader(buf.String()))
+		if err != nil {
+			t.Fatal(err)
+		}
+		files = append(files, f)
+	}
+	var strs [2][]string
+	for i, f := range files {
+		rels, err := f.relsReader(f.getPresentationRelsPath())
+		if err != nil || rels == nil || len(rels.Relationships) == 0 {
+			t.Fatalf("expected the relationships of the presentation, got %v", err)
+		}
+		rel := rels.Relationships[0]
+		root, err := f.xmlNodeReader(f.readXML(defaultXMLPathSlide))
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, name := range f.getPartNames() {
+			if name == defaultXMLPathSlide {
+				strs[i] = append(strs[i], name)
+			}
+		}
+		if _, err = f.slideReader(f.GetSlideList()[0]); err != nil {
+			t.Fatal(err)
+		}
+		attrs, _ := f.xmlAttr.Load(defaultXMLPathSlide)
+		namespace := attrs.([]xml.Attr)[0]
+		strs[i] = append(strs[i], rel.ID, rel.Type, rel.Target, root.Name, namespace.Name.Local, namespace.Value)
+	}
+	for i, s := range strs[0] {
+		if s != strs[1][i] || unsafe.StringData(s) != unsafe.StringData(strs[1][i]) {
+			t.Errorf("expected the presentations share the string %s", s)
+		}
+	}
+	if s := internString(strings.Repeat("a", 2)); s != "aa" || internString("") != "" {
+		t.Errorf("expected the same strings, got %s", s)
+	}
+}
@@ -0,0 +1,138 @@
+// Copyright 2026 kenny-not-dead. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+//
+// Package gopptx provides functionality to create and manipulate PowerPoint
+// (.pptx) files in Go, using the Office Open XML (ECMA-376) format.
+
+package gopptx
+
+import "iter"
+
+// SlideHandle directly maps the slide yielded by the slide iterator of the
+// presentation, the index is the zero-based index of the slide.
+type SlideHandle struct {
+	f     *File
+	ID    int
+	Index int
+}
+
+// ShapeHandle directly maps the shape yielded by the shape iterator of the
+// slide. The type is "shape", "picture", "graphicFrame" or "group", the
+// position and size of the shape are specified in EMUs, and the text is the
+// plain text of the shape with the paragraphs separated by the line feeds.
+type ShapeHandle struct {
+	ID          int
+	Type        string
+	Name        string
+	Description string
+	Placeholder string
+	X           int
+	Y           int
+	Width       int
+	Height      int
+	Text        string
+}
+
+// Slides provides a function to get the iterator of the slides in the
+// presentation, which yields the zero-based index and the handle of each
+// slide in order. For example, print the names of the shapes on each slide:
+//
+//	for idx, slide := range f.Slides() {
+//	    for _, shape := range slide.Shapes() {
+//	        fmt.Println(idx, slide.ID, shape.ID, shape.Name)
+//	    }
+//	}
+func (f *File) Slides() iter.Seq2[int, *SlideHandle] {
+	return func(yield func(int, *SlideHandle) bool) {
+		presentation, err := f.presentationReader()
+		if err != nil || presentation.Slides == nil {
+			return
+		}
+		for idx := 0; idx < len(presentation.Slides.Slide); idx++ {
+			if !yield(idx, &SlideHandle{f: f, ID: presentation.Slides.Slide[idx].SlideID, Index: idx}) {
+				return
+			}
+		}
+	}
+}
+
+// Shapes provides a function to get the iterator of the shapes in the shape
+// tree of the slide, which yields the zero-based index and the handle of each
+// shape. The group shapes are yielded as a whole without the shapes in the
+// groups. The iterator yields nothing if the slide can't be read.
+func (sh *SlideHandle) Shapes() iter.Seq2[int, *ShapeHandle] {
+	return func(yield func(int, *ShapeHandle) bool) {
+		slide, err := sh.f.slideReader(sh.ID)
+		if err != nil {
+			return
+		}
+		idx, shapeTree := 0, &slide.CommonSlideData.ShapeTree
+		next := func(handle *ShapeHandle, cNvPr *CommonNonVisualProperties, xfrm *DecodeXfrm) bool {
+			if cNvPr != nil {
+				handle.ID, handle.Name, handle.Description = cNvPr.ID, cNvPr.Name, cNvPr.Descr
+			}
+			if xfrm != nil && xfrm.Offset != nil {
+				handle.X, handle.Y = xfrm.Offset.X, xfrm.Offset.Y
+			}
+			if xfrm != nil && xfrm.Extents != nil {
+				handle.Width, handle.Height = xfrm.Extents.CX, xfrm.Extents.CY
+			}
+			idx++
+			return yield(idx-1, handle)
+		}
+		for i := range shapeTree.Shape {
+			shape := &shapeTree.Shape[i]
+			handle := &ShapeHandle{Type: "shape", Placeholder: shape.placeholderType(), Text: shape.TextBody.text()}
+			var cNvPr *CommonNonVisualProperties
+			if shape.NonVisualShapeProperties != nil {
+				cNvPr = shape.NonVisualShapeProperties.CommonNonVisualProperties
+			}
+			var xfrm *DecodeXfrm
+			if shape.ShapeProperties != nil {
+				xfrm = shape.ShapeProperties.Xfrm
+			}
+			if !next(handle, cNvPr, xfrm) {
+				return
+			}
+		}
+		for i := range shapeTree.Picture {
+			pic := &shapeTree.Picture[i]
+			var cNvPr *CommonNonVisualProperties
+			if pic.NonVisualPictureProperties != nil {
+				cNvPr = pic.NonVisualPictureProperties.CommonNonVisualProperties
+			}
+			var xfrm *DecodeXfrm
+			if pic.ShapeProperties != nil {
+				xfrm = pic.ShapeProperties.Xfrm
+			}
+			if !next(&ShapeHandle{Type: "picture"}, cNvPr, xfrm) {
+				return
+			}
+		}
+		for i := range shapeTree.GraphicFrame {
+			frame := &shapeTree.GraphicFrame[i]
+			var cNvPr *CommonNonVisualProperties
+			if frame.NonVisualGraphicFrameProperties != nil {
+				cNvPr = frame.NonVisualGraphicFrameProperties.CommonNonVisualProperties
+			}
+			if !next(&ShapeHandle{Type: "graphicFrame"}, cNvPr, frame.Xfrm) {
+				return
+			}
+		}
+		for i := range shapeTree.GroupShape {
+			group := &shapeTree.GroupShape[i]
+			var cNvPr *CommonNonVisualProperties
+			if group.NonVisualGroupShapeProperties != nil {
+				cNvPr = group.NonVisualGroupShapeProperties.CommonNonVisualProperties
+			}
+			var xfrm *DecodeXfrm
+			if group.GroupShapeProperties != nil {
+				xfrm = group.GroupShapeProperties.Xfrm
+			}
+			if !next(&ShapeHandle{Type: "group"}, cNvPr, xfrm) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package gopptx
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestSlidesAndShapes(t *testing.T) {
+	f := NewFile()
+	first := f.GetSlideList()[0]
+	second, err := f.NewSlide()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = f.CreateShape(first, DecodeShapeProperties{
+		Xfrm: &DecodeXfrm{Offset: &Offset{X: 100, Y: 200}, Extents: &Extents{CX: 300, CY: 400}},
+	}, DecodeTextBody{Paragraph: []DecodeParagraph{{Runs: []DecodeRuns{{Text: "Hello"}, {Text: " world"}}}, {Runs: []DecodeRuns{{Text: "Bye"}}}}}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = f.AddPictureFromBytes(first, ".png", newTestPNG(t, 8, 8), &PictureOptions{Name: "Logo", AltText: "Company logo", OffsetX: 500, OffsetY: 600, Width: 700, Height: 800}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = f.AddTableFromCSV(first, strings.NewReader("a,b\n1,2\n"), nil); err != nil {
+		t.Fatal(err)
+	}
+	type shape struct {
+		ID                      int
+		Type, Name, Description string
+		Placeholder             string
+		X, Y, Width, Height     int
+		Text                    string
+	}
+	shapes := map[int][]shape{}
+	var slideIDs []int
+	for idx, slide := range f.Slides() {
+		if idx != len(slideIDs) || slide.Index != idx {
+			t.Errorf("expected the slide index %d, got %d %d", len(slideIDs), idx, slide.Index)
+		}
+		slideIDs = append(slideIDs, slide.ID)
+		for i, handle := range slide.Shapes() {
+			if i != len(shapes[slide.ID]) {
+				t.Errorf("expected the shape index %d, got %d", len(shapes[slide.ID]), i)
+			}
+			shapes[slide.ID] = append(shapes[slide.ID], shape(*handle))
+		}
+	}
+	if !reflect.DeepEqual(slideIDs, []int{first, second}) {
+		t.Errorf("expected the slides %v, got %v", []int{first, second}, slideIDs)
+	}
+	placeholders := []shape{
+		{ID: 7, Type: "shape", Name: "PlaceHolder 1", Placeholder: "title", X: 504000, Y: 226080, Width: 9071640, Height: 946440},
+		{ID: 8, Type: "shape", Name: "PlaceHolder 2", Placeholder: "subTitle", X: 504000, Y: 1326600, Width: 9071640, Height: 3288240},
+	}
+	for slideID, expected := range map[int][]shape{
+		first: append(placeholders,
+			shape{ID: 9, Type: "shape", X: 100, Y: 200, Width: 300, Height: 400, Text: "Hello world\nBye"},
+			shape{ID: 10, Type: "picture", Name: "Logo", Description: "Company logo", X: 500, Y: 600, Width: 700, Height: 800},
+			shape{ID: 11, Type: "graphicFrame", Name: "Table 10", X: 457200, Y: 1600200, Width: 9166225, Height: 741680},
+		),
+		second: placeholders,
+	} {
+		if !reflect.DeepEqual(shapes[slideID], expected) {
+			t.Errorf("expected the shapes %+v of the slide %d, got %+v", expected, slideID, shapes[slideID])
+		}
+	}
+
+	var yielded int
+	for range f.Slides() {
+		yielded++
+		break
+	}
+	for range (&SlideHandle{f: f, ID: first}).Shapes() {
+		yielded++
+		break
+	}
+	for range (&SlideHandle{f: f, ID: 300}).Shapes() {
+		yielded++
+	}
+	if yielded != 2 {
+		t.Errorf("expected 2 yielded values, got %d", yielded)
+	}
+}
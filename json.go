@@ -0,0 +1,593 @@
+// Copyright 2026 kenny-not-dead. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+//
+// Package gopptx provides functionality to create and manipulate PowerPoint
+// (.pptx) files in Go, using the Office Open XML (ECMA-376) format.
+
+package gopptx
+
+import (
+	"bytes"
+	"encoding/json"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// JSONSlide directly maps the JSON schema of a slide. The position and size
+// of the shapes are specified in EMUs, and the font size in points.
+type JSONSlide struct {
+	ID     int         `json:"id"`
+	Shapes []JSONShape `json:"shapes"`
+}
+
+// JSONShape directly maps the JSON schema of a shape on the slide, the type
+// is "shape", "picture", "graphicFrame" or "group". The placeholder is the
+// placeholder type and the placeholder index links the placeholder to the
+// slide layout. The graphic is the graphic data of the graphic frame, such
+// as the table or the chart, and the shapes are the shapes in the group,
+// whose position and size are specified in the child coordinates of the
+// group.
+type JSONShape struct {
+	ID               int          `json:"id"`
+	Type             string       `json:"type"`
+	Name             string       `json:"name,omitempty"`
+	Description      string       `json:"description,omitempty"`
+	Placeholder      string       `json:"placeholder,omitempty"`
+	PlaceholderIndex *int         `json:"placeholderIndex,omitempty"`
+	X                int          `json:"x"`
+	Y                int          `json:"y"`
+	Width            int          `json:"width"`
+	Height           int          `json:"height"`
+	Geometry         string       `json:"geometry,omitempty"`
+	Text             *JSONText    `json:"text,omitempty"`
+	Image            *JSONImage   `json:"image,omitempty"`
+	Graphic          *JSONGraphic `json:"graphic,omitempty"`
+	Shapes           []JSONShape  `json:"shapes,omitempty"`
+}
+
+// JSONText directly maps the JSON schema of the text body of a shape, the
+// anchor is one of "t", "ctr" or "b".
+type JSONText struct {
+	Anchor     string          `json:"anchor,omitempty"`
+	Paragraphs []JSONParagraph `json:"paragraphs"`
+}
+
+// JSONParagraph directly maps the JSON schema of a paragraph, the align is
+// one of "l", "ctr", "r" or "just".
+type JSONParagraph struct {
+	Level int       `json:"level,omitempty"`
+	Align string    `json:"align,omitempty"`
+	Runs  []JSONRun `json:"runs"`
+}
+
+// JSONRun directly maps the JSON schema of a text run, the color is a hex RGB
+// value such as "FF0000". The field is the field type of the run, such as
+// "slidenum" or "datetime1", and is empty for the plain text runs.
+type JSONRun struct {
+	Text   string  `json:"text"`
+	Bold   bool    `json:"bold,omitempty"`
+	Strike bool    `json:"strike,omitempty"`
+	Size   float64 `json:"size,omitempty"`
+	Color  string  `json:"color,omitempty"`
+	Font   string  `json:"font,omitempty"`
+	Lang   string  `json:"lang,omitempty"`
+	Field  string  `json:"field,omitempty"`
+}
+
+// JSONImage directly maps the JSON schema of the image of a picture. When
+// unmarshalling, the picture keeps referring to the relationship ID if the
+// image data is empty or the same as the image of the relationship,
+// otherwise the image data will be added as a new media part.
+type JSONImage struct {
+	RelationshipID string `json:"relationshipId,omitempty"`
+	Extension      string `json:"extension,omitempty"`
+	ContentType    string `json:"contentType,omitempty"`
+	Data           []byte `json:"data,omitempty"`
+}
+
+// JSONGraphic directly maps the JSON schema of the graphic data of a graphic
+// frame, the URI identifies the type of the graphic, and the content is the
+// XML content of the graphic data. When unmarshalling, the graphic frame
+// keeps the graphic of the graphic frame with the same ID if the graphic is
+// nil.
+type JSONGraphic struct {
+	URI     string `json:"uri"`
+	Content string `json:"content"`
+}
+
+// MarshalSlideJSON provides a function to get the JSON encoding of the shapes,
+// geometry, text and styles of the slide by given slide id, so non-Go services
+// can inspect the slide content. The shapes are listed in the z-order of the
+// slide, from back to front. For example:
+//
+//	data, err := f.MarshalSlideJSON(256)
+func (f *File) MarshalSlideJSON(slideID int) ([]byte, error) {
+	slide, err := f.slideReader(slideID)
+	if err != nil {
+		return nil, err
+	}
+	slideXMLPath, _ := f.getSlideXMLPath(slideID)
+	tree := slide.CommonSlideData.ShapeTree
+	return json.Marshal(JSONSlide{
+		ID:     slideID,
+		Shapes: f.newJSONShapes(slideXMLPath, tree.Shape, tree.Picture, tree.GraphicFrame, tree.GroupShape),
+	})
+}
+
+// newJSONShapes provides a function to convert the shapes, pictures, graphic
+// frames and group shapes into the JSON schema in the document order by given
+// slide part path.
+func (f *File) newJSONShapes(slideXMLPath string, shapes []decodeShape, pics []decodePicture,
+	frames []decodeGraphicFrame, groups []decodeGroupShape,
+) []JSONShape {
+	type element struct {
+		order int
+		shape JSONShape
+	}
+	var elements []element
+	for _, shape := range shapes {
+		s := JSONShape{Type: "shape"}
+		if nvSpPr := shape.NonVisualShapeProperties; nvSpPr != nil {
+			setJSONNonVisualProperties(&s, nvSpPr.CommonNonVisualProperties, nvSpPr.NonVisualProperties)
+		}
+		setJSONShapeProperties(&s, shape.ShapeProperties)
+		s.Text = newJSONText(shape.TextBody)
+		elements = append(elements, element{order: shape.order, shape: s})
+	}
+	for _, pic := range pics {
+		s := JSONShape{Type: "picture"}
+		if nvPicPr := pic.NonVisualPictureProperties; nvPicPr != nil {
+			setJSONNonVisualProperties(&s, nvPicPr.CommonNonVisualProperties, nvPicPr.NonVisualProperties)
+		}
+		setJSONShapeProperties(&s, pic.ShapeProperties)
+		if pic.BlipFill != nil && pic.BlipFill.Blip != nil {
+			s.Image = &JSONImage{RelationshipID: pic.BlipFill.Blip.Embed}
+			if target, ok := f.getRelTarget(slideXMLPath, pic.BlipFill.Blip.Embed); ok {
+				s.Image.Extension = strings.ToLower(path.Ext(target))
+				s.Image.ContentType = supportedImageTypes[s.Image.Extension]
+				s.Image.Data = f.readBytes(target)
+			}
+		}
+		elements = append(elements, element{order: pic.order, shape: s})
+	}
+	for _, gf := range frames {
+		s := JSONShape{Type: "graphicFrame"}
+		if nvGraphicFramePr := gf.NonVisualGraphicFrameProperties; nvGraphicFramePr != nil {
+			setJSONNonVisualProperties(&s, nvGraphicFramePr.CommonNonVisualProperties, nvGraphicFramePr.NonVisualProperties)
+		}
+		setJSONXfrm(&s, gf.Xfrm)
+		if gf.Graphic != nil && gf.Graphic.GraphicData != nil {
+			s.Graphic = &JSONGraphic{URI: gf.Graphic.GraphicData.URI, Content: gf.Graphic.GraphicData.Content}
+		}
+		elements = append(elements, element{order: gf.order, shape: s})
+	}
+	for _, grpSp := range groups {
+		s := JSONShape{
+			Type:   "group",
+			Shapes: f.newJSONShapes(slideXMLPath, grpSp.Shape, grpSp.Picture, grpSp.GraphicFrame, grpSp.GroupShape),
+		}
+		if nvGrpSpPr := grpSp.NonVisualGroupShapeProperties; nvGrpSpPr != nil {
+			setJSONNonVisualProperties(&s, nvGrpSpPr.CommonNonVisualProperties, nvGrpSpPr.NonVisualProperties)
+		}
+		if grpSp.GroupShapeProperties != nil {
+			setJSONXfrm(&s, grpSp.GroupShapeProperties.Xfrm)
+		}
+		elements = append(elements, element{order: grpSp.order, shape: s})
+	}
+	slices.SortStableFunc(elements, func(a, b element) int { return compareShapeOrder(a.order, b.order) })
+	list := make([]JSONShape, len(elements))
+	for i, e := range elements {
+		list[i] = e.shape
+	}
+	return list
+}
+
+// UnmarshalSlideJSON provides a function to replace the shapes, pictures,
+// graphic frames and group shapes of the slide by given slide id and the JSON
+// encoding in the schema produced by MarshalSlideJSON, so non-Go services can
+// construct the slide content. The shapes are placed in the z-order of the
+// list, and the shapes without ID will be assigned with new IDs. The images
+// which are no longer used by the slide are removed. For example:
+//
+//	err := f.UnmarshalSlideJSON(256, []byte(`{"shapes":[{"type":"shape",
+//	    "x":457200,"y":457200,"width":4572000,"height":914400,"geometry":"rect",
+//	    "text":{"paragraphs":[{"runs":[{"text":"Hello","size":24}]}]}}]}`))
+func (f *File) UnmarshalSlideJSON(slideID int, data []byte) error {
+	if err := f.checkReadOnly(); err != nil {
+		return err
+	}
+	var model JSONSlide
+	if err := json.Unmarshal(data, &model); err != nil {
+		return err
+	}
+	slide, err := f.slideReader(slideID)
+	if err != nil {
+		return err
+	}
+	slideXMLPath, _ := f.getSlideXMLPath(slideID)
+	tree := &slide.CommonSlideData.ShapeTree
+	old := decodeGroupShape{Shape: tree.Shape, Picture: tree.Picture, GraphicFrame: tree.GraphicFrame, GroupShape: tree.GroupShape}
+	ctx := &jsonShapeContext{
+		slideXMLPath: slideXMLPath,
+		nextID:       max(slide.nextShapeID(), getJSONMaxShapeID(model.Shapes)+1),
+		frames:       map[int]decodeGraphicFrame{},
+		groups:       map[int]*DecodeXfrm{},
+	}
+	ctx.addPrevious(&old)
+	var group decodeGroupShape
+	for i, s := range model.Shapes {
+		if err = f.addJSONElement(ctx, &group, s, i+1); err != nil {
+			return err
+		}
+	}
+	tree.Shape, tree.Picture, tree.GraphicFrame, tree.GroupShape = group.Shape, group.Picture, group.GraphicFrame, group.GroupShape
+	// Remove the relationships of the images which are no longer referenced
+	// by the slide, such as the images replaced by the new image data.
+	rIDs := map[string]bool{}
+	getPictureRelationshipIDs(&old, rIDs)
+	content := marshalSlide(slide)
+	for rID := range rIDs {
+		if bytes.Contains(content, []byte(`"`+rID+`"`)) {
+			continue
+		}
+		mediaPath, ok := f.getRelTarget(slideXMLPath, rID)
+		f.deleteRels(getPartRelsPath(slideXMLPath), rID)
+		if ok && !f.isPartReferenced(mediaPath) {
+			f.Pkg.Delete(mediaPath)
+			f.deleteTempFile(mediaPath)
+		}
+	}
+	return nil
+}
+
+// jsonShapeContext defines the state of converting the shapes in the JSON
+// schema into the shape tree of the slide, which are the slide part path,
+// the ID of the next shape without ID, and the graphic frames and the
+// transforms of the group shapes on the slide before the conversion by the
+// shape IDs.
+type jsonShapeContext struct {
+	slideXMLPath string
+	nextID       int
+	frames       map[int]decodeGraphicFrame
+	groups       map[int]*DecodeXfrm
+}
+
+// addPrevious records the graphic frames and the transforms of the group
+// shapes of the slide before the conversion by given shapes of the slide.
+func (ctx *jsonShapeContext) addPrevious(group *decodeGroupShape) {
+	for _, gf := range group.GraphicFrame {
+		if nvGraphicFramePr := gf.NonVisualGraphicFrameProperties; nvGraphicFramePr != nil && nvGraphicFramePr.CommonNonVisualProperties != nil {
+			ctx.frames[nvGraphicFramePr.CommonNonVisualProperties.ID] = gf
+		}
+	}
+	for i := range group.GroupShape {
+		grpSp := &group.GroupShape[i]
+		if nvGrpSpPr := grpSp.NonVisualGroupShapeProperties; nvGrpSpPr != nil && nvGrpSpPr.CommonNonVisualProperties != nil &&
+			grpSp.GroupShapeProperties != nil {
+			ctx.groups[nvGrpSpPr.CommonNonVisualProperties.ID] = grpSp.GroupShapeProperties.Xfrm
+		}
+		ctx.addPrevious(grpSp)
+	}
+}
+
+// getJSONMaxShapeID returns the maximum ID of the shapes in the JSON schema,
+// including the shapes in the groups.
+func getJSONMaxShapeID(shapes []JSONShape) int {
+	var shapeID int
+	for _, s := range shapes {
+		shapeID = max(shapeID, s.ID, getJSONMaxShapeID(s.Shapes))
+	}
+	return shapeID
+}
+
+// getPictureRelationshipIDs provides a function to get the relationship IDs
+// of the images of the pictures by given shapes, including the pictures in
+// the groups.
+func getPictureRelationshipIDs(group *decodeGroupShape, rIDs map[string]bool) {
+	for _, pic := range group.Picture {
+		if pic.BlipFill != nil && pic.BlipFill.Blip != nil && pic.BlipFill.Blip.Embed != "" {
+			rIDs[pic.BlipFill.Blip.Embed] = true
+		}
+	}
+	for i := range group.GroupShape {
+		getPictureRelationshipIDs(&group.GroupShape[i], rIDs)
+	}
+}
+
+// addJSONShape provides a function to append the shape in the JSON schema to
+// the shape tree of the slide by given slide part path.
+func (f *File) addJSONShape(slide *decodeSlide, slideXMLPath string, s JSONShape) error {
+	tree := &slide.CommonSlideData.ShapeTree
+	group := decodeGroupShape{Shape: tree.Shape, Picture: tree.Picture, GraphicFrame: tree.GraphicFrame, GroupShape: tree.GroupShape}
+	ctx := &jsonShapeContext{
+		slideXMLPath: slideXMLPath,
+		nextID:       max(slide.nextShapeID(), getJSONMaxShapeID(s.Shapes)+1),
+		frames:       map[int]decodeGraphicFrame{},
+		groups:       map[int]*DecodeXfrm{},
+	}
+	err := f.addJSONElement(ctx, &group, s, 0)
+	tree.Shape, tree.Picture, tree.GraphicFrame, tree.GroupShape = group.Shape, group.Picture, group.GraphicFrame, group.GroupShape
+	return err
+}
+
+// addJSONElement provides a function to append the shape in the JSON schema
+// to the group by given document order of the shape in the group.
+func (f *File) addJSONElement(ctx *jsonShapeContext, group *decodeGroupShape, s JSONShape, order int) error {
+	if s.ID == 0 {
+		s.ID = ctx.nextID
+		ctx.nextID++
+	}
+	cNvPr := &CommonNonVisualProperties{ID: s.ID, Name: s.Name, Descr: s.Description}
+	if cNvPr.Name == "" {
+		cNvPr.Name = "Shape " + strconv.Itoa(s.ID-1)
+		switch s.Type {
+		case "picture":
+			cNvPr.Name = "Picture " + strconv.Itoa(s.ID-1)
+		case "graphicFrame":
+			cNvPr.Name = "Graphic Frame " + strconv.Itoa(s.ID-1)
+		case "group":
+			cNvPr.Name = "Group " + strconv.Itoa(s.ID-1)
+		}
+	}
+	nvPr := &decodeNonVisualProperties{}
+	if s.Placeholder != "" || s.PlaceholderIndex != nil {
+		nvPr.Ph = &Ph{}
+		if s.Placeholder != "" && (s.Placeholder != "obj" || s.PlaceholderIndex == nil) {
+			phType := s.Placeholder
+			nvPr.Ph.Type = &phType
+		}
+		if s.PlaceholderIndex != nil {
+			idx := *s.PlaceholderIndex
+			nvPr.Ph.Idx = &idx
+		}
+	}
+	offset, extents := Offset{X: s.X, Y: s.Y}, Extents{CX: s.Width, CY: s.Height}
+	switch s.Type {
+	case "picture":
+		if s.Image == nil {
+			return nil
+		}
+		rID := s.Image.RelationshipID
+		if target, ok := f.getRelTarget(ctx.slideXMLPath, rID); len(s.Image.Data) > 0 &&
+			(!ok || !bytes.Equal(f.readBytes(target), s.Image.Data)) {
+			contentType, ok := supportedImageTypes[strings.ToLower(s.Image.Extension)]
+			if !ok {
+				return ErrImgExt
+			}
+			mediaPath := f.addMedia(s.Image.Data, s.Image.Extension)
+			if err := f.setContentTypeDefault(s.Image.Extension, contentType); err != nil {
+				return err
+			}
+			rID = f.addRels(getPartRelsPath(ctx.slideXMLPath), SourceRelationshipImage, "../media/"+path.Base(mediaPath), "")
+		}
+		if rID == "" {
+			return nil
+		}
+		group.Picture = append(group.Picture, decodePicture{
+			NonVisualPictureProperties: &decodeNonVisualPictureProperties{
+				CommonNonVisualProperties:        cNvPr,
+				CommonNonVisualPictureProperties: &decodeCommonNonVisualPictureProperties{},
+				NonVisualProperties:              nvPr,
+			},
+			BlipFill: &decodeBlipFill{
+				Blip:    &decodeBlip{Embed: rID},
+				Stretch: &decodeStretch{FillRect: &FillRect{}},
+			},
+			ShapeProperties: newJSONShapeProperties(s),
+			order:           order,
+		})
+	case "graphicFrame":
+		previous, ok := ctx.frames[s.ID]
+		frame := decodeGraphicFrame{
+			NonVisualGraphicFrameProperties: &decodeNonVisualGraphicFrameProperties{
+				CommonNonVisualProperties:             cNvPr,
+				CommonNonVisualGraphicFrameProperties: &innerXML{},
+				NonVisualProperties:                   nvPr,
+			},
+			Xfrm:  &DecodeXfrm{Offset: &offset, Extents: &extents},
+			order: order,
+		}
+		if ok && previous.NonVisualGraphicFrameProperties != nil && previous.NonVisualGraphicFrameProperties.CommonNonVisualGraphicFrameProperties != nil {
+			frame.NonVisualGraphicFrameProperties.CommonNonVisualGraphicFrameProperties = previous.NonVisualGraphicFrameProperties.CommonNonVisualGraphicFrameProperties
+		}
+		if s.Graphic != nil {
+			frame.Graphic = &decodeGraphic{GraphicData: &GraphicData{URI: s.Graphic.URI, Content: s.Graphic.Content}}
+		} else if frame.Graphic = previous.Graphic; !ok || frame.Graphic == nil {
+			return nil
+		}
+		group.GraphicFrame = append(group.GraphicFrame, frame)
+	case "group":
+		grpSp := decodeGroupShape{
+			NonVisualGroupShapeProperties: &decodeNonVisualGroupShapeProperties{
+				CommonNonVisualProperties:           cNvPr,
+				CommonNonVisualGroupShapeProperties: &CommonNonVisualGroupShapeProperties{},
+				NonVisualProperties:                 nvPr,
+			},
+			order: order,
+		}
+		// Keep the child coordinates of the group, which the position and
+		// size of the shapes in the group are specified in.
+		childOffset, childExtents := offset, extents
+		if xfrm := ctx.groups[s.ID]; xfrm != nil && xfrm.ChildOffset != nil && xfrm.ChildExtents != nil {
+			childOffset, childExtents = *xfrm.ChildOffset, *xfrm.ChildExtents
+		}
+		grpSp.GroupShapeProperties = &decodeGroupShapeProperties{Xfrm: &DecodeXfrm{
+			Offset: &offset, Extents: &extents, ChildOffset: &childOffset, ChildExtents: &childExtents,
+		}}
+		for i, child := range s.Shapes {
+			if err := f.addJSONElement(ctx, &grpSp, child, i+1); err != nil {
+				return err
+			}
+		}
+		group.GroupShape = append(group.GroupShape, grpSp)
+	default:
+		group.Shape = append(group.Shape, decodeShape{
+			NonVisualShapeProperties: &decodeNonVisualShapeProperties{
+				CommonNonVisualProperties:      cNvPr,
+				CommonNonVisualShapeProperties: &decodeCommonNonVisualShapeProperties{},
+				NonVisualProperties:            nvPr,
+			},
+			ShapeProperties: newJSONShapeProperties(s),
+			TextBody:        newJSONTextBody(s.Text),
+			order:           order,
+		})
+	}
+	return nil
+}
+
+// setJSONNonVisualProperties provides a function to set the ID, name,
+// description and placeholder of the JSON shape by given non-visual
+// properties.
+func setJSONNonVisualProperties(s *JSONShape, cNvPr *CommonNonVisualProperties, nvPr *decodeNonVisualProperties) {
+	if cNvPr != nil {
+		s.ID, s.Name, s.Description = cNvPr.ID, cNvPr.Name, cNvPr.Descr
+	}
+	if nvPr == nil || nvPr.Ph == nil {
+		return
+	}
+	s.Placeholder = "obj"
+	if nvPr.Ph.Type != nil {
+		s.Placeholder = *nvPr.Ph.Type
+	}
+	if nvPr.Ph.Idx != nil {
+		idx := *nvPr.Ph.Idx
+		s.PlaceholderIndex = &idx
+	}
+}
+
+// setJSONShapeProperties provides a function to set the position, size and
+// geometry of the JSON shape by given shape properties.
+func setJSONShapeProperties(s *JSONShape, spPr *DecodeShapeProperties) {
+	if spPr == nil {
+		return
+	}
+	setJSONXfrm(s, spPr.Xfrm)
+	if spPr.PresetGeometry != nil {
+		s.Geometry = spPr.PresetGeometry.Preset
+	}
+}
+
+// setJSONXfrm provides a function to set the position and size of the JSON
+// shape by given transform.
+func setJSONXfrm(s *JSONShape, xfrm *DecodeXfrm) {
+	if xfrm != nil && xfrm.Offset != nil {
+		s.X, s.Y = xfrm.Offset.X, xfrm.Offset.Y
+	}
+	if xfrm != nil && xfrm.Extents != nil {
+		s.Width, s.Height = xfrm.Extents.CX, xfrm.Extents.CY
+	}
+}
+
+// newJSONText provides a function to convert the text body into the JSON
+// schema.
+func newJSONText(dt *DecodeTextBody) *JSONText {
+	if dt == nil {
+		return nil
+	}
+	text := &JSONText{Paragraphs: []JSONParagraph{}}
+	if dt.BodyProperties != nil && dt.BodyProperties.Anchor != nil {
+		text.Anchor = *dt.BodyProperties.Anchor
+	}
+	for _, dp := range dt.Paragraph {
+		p := JSONParagraph{Level: dp.level(), Runs: []JSONRun{}}
+		if dp.ParagraphProperties != nil && dp.ParagraphProperties.Align != nil {
+			p.Align = *dp.ParagraphProperties.Align
+		}
+		for _, dr := range dp.Runs {
+			r := JSONRun{Text: dr.Text}
+			if dr.FieldID != "" {
+				r.Field = dr.FieldType
+			}
+			if rPr := dr.RunProperties; rPr != nil {
+				r.Bold = rPr.Bold != nil && *rPr.Bold == 1
+				r.Strike = rPr.Strike != "" && rPr.Strike != "noStrike"
+				r.Lang = rPr.Lang
+				if rPr.Size != nil {
+					r.Size = float64(*rPr.Size) / 100
+				}
+				if rPr.SolidFill != nil && rPr.SolidFill.SolidRGBColor != nil {
+					r.Color = rPr.SolidFill.SolidRGBColor.Val
+				}
+				if rPr.Latin != nil {
+					r.Font = rPr.Latin.Typeface
+				}
+			}
+			p.Runs = append(p.Runs, r)
+		}
+		text.Paragraphs = append(text.Paragraphs, p)
+	}
+	return text
+}
+
+// newJSONShapeProperties provides a function to convert the position, size
+// and geometry of the JSON shape into shape properties.
+func newJSONShapeProperties(s JSONShape) *DecodeShapeProperties {
+	geometry := s.Geometry
+	if geometry == "" {
+		geometry = "rect"
+	}
+	return &DecodeShapeProperties{
+		Xfrm: &DecodeXfrm{
+			Offset:  &Offset{X: s.X, Y: s.Y},
+			Extents: &Extents{CX: s.Width, CY: s.Height},
+		},
+		PresetGeometry: &DecodePresetGeometry{Preset: geometry, AdjustValueList: &AdjustValueList{}},
+	}
+}
+
+// newJSONTextBody provides a function to convert the JSON text into the text
+// body of a shape.
+func newJSONTextBody(text *JSONText) *DecodeTextBody {
+	if text == nil {
+		return nil
+	}
+	dt := &DecodeTextBody{BodyProperties: &DecodeBodyProperties{}}
+	if text.Anchor != "" {
+		anchor := text.Anchor
+		dt.BodyProperties.Anchor = &anchor
+	}
+	for _, p := range text.Paragraphs {
+		dp := DecodeParagraph{}
+		if p.Level != 0 || p.Align != "" {
+			dp.ParagraphProperties = &ParagraphProperties{}
+			if p.Level != 0 {
+				level := p.Level
+				dp.ParagraphProperties.Level = &level
+			}
+			if p.Align != "" {
+				align := p.Align
+				dp.ParagraphProperties.Align = &align
+			}
+		}
+		for _, r := range p.Runs {
+			rPr := &DecodeRunProperties{Lang: r.Lang}
+			if r.Bold {
+				bold := 1
+				rPr.Bold = &bold
+			}
+			if r.Strike {
+				rPr.Strike = "sngStrike"
+			}
+			if r.Size > 0 {
+				size := int(r.Size * 100)
+				rPr.Size = &size
+			}
+			if r.Color != "" {
+				rPr.SolidFill = &DecodeSolidFill{SolidRGBColor: &SolidRGBColor{Val: r.Color}}
+			}
+			if r.Font != "" {
+				rPr.Latin = &Latin{Typeface: r.Font}
+			}
+			dr := DecodeRuns{RunProperties: rPr, Text: r.Text}
+			if r.Field != "" {
+				dr.FieldID, dr.FieldType = newGUID(), r.Field
+			}
+			dp.Runs = append(dp.Runs, dr)
+		}
+		dt.Paragraph = append(dt.Paragraph, dp)
+	}
+	return dt
+}
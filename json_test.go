@@ -0,0 +1,195 @@
+package gopptx
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestUnmarshalSlideJSON(t *testing.T) {
+	bodyIdx, objIdx := 1, 2
+	for _, c := range []struct {
+		name, data string
+		expected   []JSONShape
+		err        bool
+	}{
+		{name: "empty", data: `{"shapes":[]}`, expected: []JSONShape{}},
+		{
+			name: "shapes",
+			data: `{"shapes":[{"type":"shape","name":"Box","x":457200,"y":914400,"width":4572000,"height":914400,"geometry":"rect",
+				"text":{"anchor":"ctr","paragraphs":[{"align":"r","runs":[{"text":"Hello","bold":true,"size":24,"color":"FF0000","font":"Arial"}]},
+				{"level":1,"runs":[{"text":"World","strike":true,"lang":"en-US"}]}]}},
+				{"type":"shape","placeholder":"title","x":1,"y":2,"width":3,"height":4,"text":{"paragraphs":[{"runs":[]}]}}]}`,
+			expected: []JSONShape{
+				{
+					ID: 9, Type: "shape", Name: "Box", X: 457200, Y: 914400, Width: 4572000, Height: 914400, Geometry: "rect",
+					Text: &JSONText{Anchor: "ctr", Paragraphs: []JSONParagraph{
+						{Align: "r", Runs: []JSONRun{{Text: "Hello", Bold: true, Size: 24, Color: "FF0000", Font: "Arial"}}},
+						{Level: 1, Runs: []JSONRun{{Text: "World", Strike: true, Lang: "en-US"}}},
+					}},
+				},
+				{ID: 10, Type: "shape", Name: "Shape 9", Placeholder: "title", X: 1, Y: 2, Width: 3, Height: 4, Geometry: "rect", Text: &JSONText{Paragraphs: []JSONParagraph{{Runs: []JSONRun{}}}}},
+			},
+		},
+		{
+			name: "placeholders",
+			data: `{"shapes":[{"id":2,"type":"shape","placeholder":"body","placeholderIndex":1,"x":1,"y":2,"width":3,"height":4},
+				{"id":3,"type":"shape","placeholder":"obj","placeholderIndex":2,"x":5,"y":6,"width":7,"height":8}]}`,
+			expected: []JSONShape{
+				{ID: 2, Type: "shape", Name: "Shape 1", Placeholder: "body", PlaceholderIndex: &bodyIdx, X: 1, Y: 2, Width: 3, Height: 4, Geometry: "rect"},
+				{ID: 3, Type: "shape", Name: "Shape 2", Placeholder: "obj", PlaceholderIndex: &objIdx, X: 5, Y: 6, Width: 7, Height: 8, Geometry: "rect"},
+			},
+		},
+		{
+			name: "groups",
+			data: `{"shapes":[{"id":2,"type":"shape","x":1,"y":2,"width":3,"height":4},{"id":3,"type":"group","x":10,"y":20,"width":30,"height":40,
+				"shapes":[{"id":4,"type":"shape","x":11,"y":21,"width":5,"height":6},{"type":"group","x":12,"y":22,"width":7,"height":8}]}]}`,
+			expected: []JSONShape{
+				{ID: 2, Type: "shape", Name: "Shape 1", X: 1, Y: 2, Width: 3, Height: 4, Geometry: "rect"},
+				{ID: 3, Type: "group", Name: "Group 2", X: 10, Y: 20, Width: 30, Height: 40, Shapes: []JSONShape{
+					{ID: 4, Type: "shape", Name: "Shape 3", X: 11, Y: 21, Width: 5, Height: 6, Geometry: "rect"},
+					{ID: 9, Type: "group", Name: "Group 8", X: 12, Y: 22, Width: 7, Height: 8},
+				}},
+			},
+		},
+		{
+			name: "z-order",
+			data: `{"shapes":[{"id":3,"type":"group","x":10,"y":20,"width":30,"height":40},{"id":2,"type":"shape","x":1,"y":2,"width":3,"height":4}]}`,
+			expected: []JSONShape{
+				{ID: 3, Type: "group", Name: "Group 2", X: 10, Y: 20, Width: 30, Height: 40},
+				{ID: 2, Type: "shape", Name: "Shape 1", X: 1, Y: 2, Width: 3, Height: 4, Geometry: "rect"},
+			},
+		},
+		{name: "invalid", data: `{"shapes":`, err: true},
+	} {
+		f := NewFile()
+		slideID := f.GetSlideList()[0]
+		if err := f.UnmarshalSlideJSON(slideID, []byte(c.data)); (err != nil) != c.err {
+			t.Fatalf("%s: unexpected error %v", c.name, err)
+		}
+		if c.err {
+			continue
+		}
+		data, err := f.MarshalSlideJSON(slideID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var slide JSONSlide
+		if err = json.Unmarshal(data, &slide); err != nil {
+			t.Fatal(err)
+		}
+		if slide.ID != slideID || !reflect.DeepEqual(slide.Shapes, c.expected) {
+			t.Errorf("%s: unexpected shapes of the slide %d, got %s", c.name, slideID, data)
+		}
+	}
+}
+
+func TestUnmarshalSlideJSONImage(t *testing.T) {
+	f := NewFile()
+	slideID := f.GetSlideList()[0]
+	if _, err := f.AddPictureFromBytes(slideID, ".png", newTestPNG(t, 8, 8), &PictureOptions{Width: 914400, Height: 914400}); err != nil {
+		t.Fatal(err)
+	}
+	data, err := f.MarshalSlideJSON(slideID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var slide JSONSlide
+	if err = json.Unmarshal(data, &slide); err != nil {
+		t.Fatal(err)
+	}
+	pic := slide.Shapes[len(slide.Shapes)-1]
+	slideXMLPath, _ := f.getSlideXMLPath(slideID)
+	mediaPath, _ := f.getRelTarget(slideXMLPath, pic.Image.RelationshipID)
+	for _, c := range []struct {
+		name    string
+		data    []byte
+		changed bool
+	}{
+		{name: "same", data: pic.Image.Data},
+		{name: "empty"},
+		{name: "changed", data: newTestPNG(t, 4, 4), changed: true},
+	} {
+		pic.Image.Data = c.data
+		slide.Shapes[len(slide.Shapes)-1] = pic
+		content, err := json.Marshal(slide)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = f.UnmarshalSlideJSON(slideID, content); err != nil {
+			t.Fatal(err)
+		}
+		if data, err = f.MarshalSlideJSON(slideID); err != nil {
+			t.Fatal(err)
+		}
+		var result JSONSlide
+		if err = json.Unmarshal(data, &result); err != nil {
+			t.Fatal(err)
+		}
+		image := result.Shapes[len(result.Shapes)-1].Image
+		if image == nil || (image.RelationshipID != pic.Image.RelationshipID) != c.changed {
+			t.Errorf("%s: unexpected image of the picture, got %s", c.name, data)
+		}
+		if c.changed && !bytes.Equal(image.Data, c.data) {
+			t.Errorf("%s: unexpected image data of the picture", c.name)
+		}
+		if _, ok := f.Pkg.Load(mediaPath); ok == c.changed {
+			t.Errorf("%s: expected the image %s kept %t, got %t", c.name, mediaPath, !c.changed, ok)
+		}
+	}
+}
+
+func TestUnmarshalSlideJSONGraphicFrame(t *testing.T) {
+	f := NewFile()
+	slideID := f.GetSlideList()[0]
+	if _, err := f.addChart(slideID, "column", "", Offset{X: 1, Y: 2}, Extents{CX: 3, CY: 4}); err != nil {
+		t.Fatal(err)
+	}
+	data, err := f.MarshalSlideJSON(slideID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var slide JSONSlide
+	if err = json.Unmarshal(data, &slide); err != nil {
+		t.Fatal(err)
+	}
+	frame := slide.Shapes[len(slide.Shapes)-1]
+	if frame.Type != "graphicFrame" || frame.X != 1 || frame.Y != 2 || frame.Width != 3 || frame.Height != 4 ||
+		frame.Graphic == nil || frame.Graphic.URI != NameSpaceDrawingMLChart.Value {
+		t.Fatalf("unexpected graphic frame, got %s", data)
+	}
+	for _, c := range []struct {
+		name     string
+		shape    JSONShape
+		expected *JSONGraphic
+	}{
+		{name: "keep", shape: JSONShape{ID: frame.ID, Type: "graphicFrame", Width: 5, Height: 6}, expected: frame.Graphic},
+		{name: "replace", shape: JSONShape{ID: frame.ID, Type: "graphicFrame", Graphic: &JSONGraphic{URI: "uri", Content: "<a/>"}}, expected: &JSONGraphic{URI: "uri", Content: "<a/>"}},
+		{name: "missing", shape: JSONShape{ID: frame.ID + 1, Type: "graphicFrame"}},
+	} {
+		content, err := json.Marshal(JSONSlide{Shapes: []JSONShape{c.shape}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = f.UnmarshalSlideJSON(slideID, content); err != nil {
+			t.Fatal(err)
+		}
+		if data, err = f.MarshalSlideJSON(slideID); err != nil {
+			t.Fatal(err)
+		}
+		var result JSONSlide
+		if err = json.Unmarshal(data, &result); err != nil {
+			t.Fatal(err)
+		}
+		if c.expected == nil {
+			if len(result.Shapes) != 0 {
+				t.Errorf("%s: expected no shapes, got %s", c.name, data)
+			}
+			continue
+		}
+		if len(result.Shapes) != 1 || !reflect.DeepEqual(result.Shapes[0].Graphic, c.expected) {
+			t.Errorf("%s: unexpected graphic frame, got %s", c.name, data)
+		}
+	}
+}
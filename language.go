@@ -0,0 +1,83 @@
+// Copyright 2026 kenny-not-dead. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+//
+// Package gopptx provides functionality to create and manipulate PowerPoint
+// (.pptx) files in Go, using the Office Open XML (ECMA-376) format.
+
+package gopptx
+
+import "regexp"
+
+// languageTagExp defined the pattern of the BCP 47 language tag, such as
+// "en-US" or "zh-Hans-CN".
+var languageTagExp = regexp.MustCompile(`^[A-Za-z]{2,8}(-[A-Za-z0-9]{1,8})*$`)
+
+// SetLanguage provides a function to set the language of all text runs and
+// the ends of the paragraphs in the slides and the speaker notes by given
+// BCP 47 language tag, so the spelling and grammar are checked in the right
+// locale. The text in the tables isn't changed. For example:
+//
+//	err := f.SetLanguage("de-DE")
+func (f *File) SetLanguage(langTag string) error {
+	if err := f.checkReadOnly(); err != nil {
+		return err
+	}
+	if !languageTagExp.MatchString(langTag) {
+		return ErrLanguageTag
+	}
+	for _, slideID := range f.GetSlideList() {
+		slide, err := f.slideReader(slideID)
+		if err != nil {
+			return err
+		}
+		setShapesLanguage(slide.CommonSlideData.ShapeTree.Shape, slide.CommonSlideData.ShapeTree.GroupShape, langTag)
+		slideXMLPath, _ := f.getSlideXMLPath(slideID)
+		notesXMLPath, ok := f.getRelTargetByType(slideXMLPath, SourceRelationshipNotesSlide)
+		if !ok || len(f.readXML(notesXMLPath)) == 0 {
+			continue
+		}
+		notes, err := f.xmlNodeReader(f.readXML(notesXMLPath))
+		if err != nil {
+			return err
+		}
+		a := notes.prefix(NameSpaceDrawingML.Value)
+		walkXMLNode(notes, func(node *xmlNode) {
+			switch localName(node.Name) {
+			case "r", "fld":
+				if node.find("rPr") == nil {
+					node.Children = append([]*xmlNode{newXMLNode(a + "rPr")}, node.Children...)
+				}
+			case "rPr", "endParaRPr":
+				node.setAttr("lang", langTag)
+			}
+		})
+		f.saveFileList(notesXMLPath, notes.bytes())
+	}
+	return nil
+}
+
+// setShapesLanguage sets the language of the text runs and the ends of the
+// paragraphs by given shapes, group shapes and language tag.
+func setShapesLanguage(shapes []decodeShape, groups []decodeGroupShape, langTag string) {
+	for i := range shapes {
+		if shapes[i].TextBody == nil {
+			continue
+		}
+		for j := range shapes[i].TextBody.Paragraph {
+			p := &shapes[i].TextBody.Paragraph[j]
+			for k := range p.Runs {
+				if p.Runs[k].RunProperties == nil {
+					p.Runs[k].RunProperties = &DecodeRunProperties{}
+				}
+				p.Runs[k].RunProperties.Lang = langTag
+			}
+			if p.EndParagraphRunProperties != nil {
+				p.EndParagraphRunProperties.Lang = langTag
+			}
+		}
+	}
+	for i := range groups {
+		setShapesLanguage(groups[i].Shape, groups[i].GroupShape, langTag)
+	}
+}
@@ -0,0 +1,53 @@
+package gopptx
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestSetLanguage(t *testing.T) {
+	f := NewFile()
+	slideID := f.GetSlideList()[0]
+	shape := addTestShape(t, f, slideID, "", "Hallo", "Welt")
+	shape.TextBody.Paragraph[1].Runs[0].RunProperties = &DecodeRunProperties{Lang: "en-US"}
+	shape.TextBody.Paragraph[1].EndParagraphRunProperties = &DecodeRunProperties{Lang: "en-US"}
+	if err := f.addNotesSlide(slideID, []string{"Notizen", "Mehr"}); err != nil {
+		t.Fatal(err)
+	}
+	slideXMLPath, _ := f.getSlideXMLPath(slideID)
+	notesXMLPath, _ := f.getRelTargetByType(slideXMLPath, SourceRelationshipNotesSlide)
+	f.Pkg.Store(notesXMLPath, bytes.Replace(f.readXML(notesXMLPath), []byte(`<a:rPr lang="en-US"/>`), nil, 1))
+	if err := f.SetLanguage("de-DE"); err != nil {
+		t.Fatal(err)
+	}
+	buf, err := f.WriteToBuffer()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f, err = OpenReader(buf); err != nil {
+		t.Fatal(err)
+	}
+	slide, err := f.slideReader(slideID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	shapes := slide.CommonSlideData.ShapeTree.Shape
+	for _, p := range shapes[len(shapes)-1].TextBody.Paragraph {
+		if rPr := p.Runs[0].RunProperties; rPr == nil || rPr.Lang != "de-DE" {
+			t.Errorf("expected the language de-DE of the run %s, got %+v", p.Runs[0].Text, rPr)
+		}
+	}
+	if endParaRPr := shapes[len(shapes)-1].TextBody.Paragraph[1].EndParagraphRunProperties; endParaRPr == nil || endParaRPr.Lang != "de-DE" {
+		t.Errorf("expected the language de-DE of the end of the paragraph, got %+v", endParaRPr)
+	}
+	notes := string(f.readXML(notesXMLPath))
+	if strings.Contains(notes, "en-US") || strings.Count(notes, `<a:rPr lang="de-DE"`) != 2 {
+		t.Errorf("expected the language de-DE of the notes, got %s", notes)
+	}
+	for _, langTag := range []string{"", "de_DE", "e", "en-US-"} {
+		if err = f.SetLanguage(langTag); err != ErrLanguageTag {
+			t.Errorf("expected ErrLanguageTag of %q, got %v", langTag, err)
+		}
+	}
+}
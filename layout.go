@@ -0,0 +1,286 @@
+// Copyright 2026 kenny-not-dead. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+//
+// Package gopptx provides functionality to create and manipulate PowerPoint
+// (.pptx) files in Go, using the Office Open XML (ECMA-376) format.
+
+package gopptx
+
+import (
+	"bytes"
+	"io"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// slideLayoutReader provides a function to get the pointer to the structure
+// after deserialization of the slide layout by given part path.
+func (f *File) slideLayoutReader(layoutXMLPath string) (*decodeSlideLayout, error) {
+	layout := new(decodeSlideLayout)
+	if err := f.xmlNewDecoder(bytes.NewReader(namespaceStrictToTransitional(f.readBytes(layoutXMLPath)))).
+		Decode(layout); err != nil && err != io.EOF {
+		return nil, err
+	}
+	return layout, nil
+}
+
+// getSlideLayoutPaths provides a function to get the part paths of all slide
+// layouts in the presentation, ordered by the layout part number.
+func (f *File) getSlideLayoutPaths() []string {
+	var paths []string
+	f.Pkg.Range(func(k, v interface{}) bool {
+		name := k.(string)
+		if strings.HasPrefix(name, "ppt/slideLayouts/slideLayout") && strings.HasSuffix(name, ".xml") {
+			paths = append(paths, name)
+		}
+		return true
+	})
+	number := func(name string) int {
+		n, _ := strconv.Atoi(strings.TrimSuffix(strings.TrimPrefix(name, "ppt/slideLayouts/slideLayout"), ".xml"))
+		return n
+	}
+	sort.Slice(paths, func(i, j int) bool { return number(paths[i]) < number(paths[j]) })
+	return paths
+}
+
+// getSlideLayoutPath provides a function to get the part path of the slide
+// layout by given layout name or layout type, case-insensitive.
+func (f *File) getSlideLayoutPath(name string) (string, error) {
+	for _, layoutXMLPath := range f.getSlideLayoutPaths() {
+		layout, err := f.slideLayoutReader(layoutXMLPath)
+		if err != nil {
+			return "", err
+		}
+		if strings.EqualFold(layout.CommonSlideData.Name, name) || strings.EqualFold(layout.Type, name) {
+			return layoutXMLPath, nil
+		}
+	}
+	return "", ErrLayoutNotExist{name}
+}
+
+// setSlideLayout provides a function to relate the slide to the slide layout
+// by given slide id and layout part path, the shapes of the slide will be
+// replaced by empty placeholders copied from the layout. The date, footer and
+// slide number placeholders are not copied.
+func (f *File) setSlideLayout(slideID int, layoutXMLPath string) error {
+	slide, err := f.slideReader(slideID)
+	if err != nil {
+		return err
+	}
+	layout, err := f.slideLayoutReader(layoutXMLPath)
+	if err != nil {
+		return err
+	}
+	slideXMLPath, _ := f.getSlideXMLPath(slideID)
+	f.setSlideLayoutRels(slideXMLPath, layoutXMLPath)
+	slide.CommonSlideData.ShapeTree.Shape = nil
+	for _, shape := range layout.CommonSlideData.ShapeTree.Shape {
+		switch shape.placeholderType() {
+		case "", "dt", "ftr", "sldNum":
+			continue
+		}
+		slide.CommonSlideData.ShapeTree.Shape = append(slide.CommonSlideData.ShapeTree.Shape,
+			newPlaceholderShape(shape, slide.nextShapeID()))
+	}
+	return nil
+}
+
+// setSlideLayoutRels provides a function to point the layout relationship of
+// the slide to the layout by given slide and layout part path.
+func (f *File) setSlideLayoutRels(slideXMLPath, layoutXMLPath string) {
+	target, found := "../slideLayouts/"+path.Base(layoutXMLPath), false
+	if rels, _ := f.relsReader(getPartRelsPath(slideXMLPath)); rels != nil {
+		rels.mu.Lock()
+		for idx, rel := range rels.Relationships {
+			if rel.Type == SourceRelationshipSlideLayout {
+				rels.Relationships[idx].Target, found = target, true
+			}
+		}
+		rels.mu.Unlock()
+	}
+	if !found {
+		f.addRels(getPartRelsPath(slideXMLPath), SourceRelationshipSlideLayout, target, "")
+	}
+}
+
+// SlideLayoutOptions directly maps the settings of changing the layout of a
+// slide. If RemapPlaceholders is true, the placeholders of the slide are
+// re-mapped to the placeholders of the new layout by the index and type, so
+// the content inherits the position and formatting of the new layout. The
+// master is the zero-based index of the slide master whose layouts are
+// searched, the layouts of all masters are searched if it is nil.
+type SlideLayoutOptions struct {
+	RemapPlaceholders bool
+	Master            *int
+}
+
+// SetSlideLayout provides a function to change the layout of the slide by
+// given slide id and layout name or layout type, case-insensitive, the
+// content of the slide is kept. For example, change the layout of the slide
+// to "Two Content" and re-map the placeholders:
+//
+//	err := f.SetSlideLayout(256, "Two Content", &gopptx.SlideLayoutOptions{RemapPlaceholders: true})
+func (f *File) SetSlideLayout(slideID int, layoutName string, opts *SlideLayoutOptions) error {
+	if err := f.checkReadOnly(); err != nil {
+		return err
+	}
+	slide, err := f.slideReader(slideID)
+	if err != nil {
+		return err
+	}
+	var master *int
+	if opts != nil {
+		master = opts.Master
+	}
+	layoutXMLPath, err := f.getMasterSlideLayoutPath(master, layoutName)
+	if err != nil {
+		return err
+	}
+	slideXMLPath, _ := f.getSlideXMLPath(slideID)
+	f.setSlideLayoutRels(slideXMLPath, layoutXMLPath)
+	if opts == nil || !opts.RemapPlaceholders {
+		return nil
+	}
+	layout, err := f.slideLayoutReader(layoutXMLPath)
+	if err != nil {
+		return err
+	}
+	remapPlaceholders(slide, layout)
+	return nil
+}
+
+// GetSlideLayoutName provides a function to get the name of the layout of the
+// slide by given slide id, the layout type will be returned if the layout has
+// no name. For example:
+//
+//	name, err := f.GetSlideLayoutName(256)
+func (f *File) GetSlideLayoutName(slideID int) (string, error) {
+	slideXMLPath, ok := f.getSlideXMLPath(slideID)
+	if !ok {
+		return "", ErrSlideNotExist{slideID}
+	}
+	layoutXMLPath, ok := f.getRelTargetByType(slideXMLPath, SourceRelationshipSlideLayout)
+	if !ok {
+		return "", nil
+	}
+	layout, err := f.slideLayoutReader(layoutXMLPath)
+	if err != nil || layout.CommonSlideData.Name != "" {
+		return layout.CommonSlideData.Name, err
+	}
+	return layout.Type, nil
+}
+
+// remapPlaceholders provides a function to update the placeholder type and
+// index of the slide shapes to the placeholders of the layout. The slide
+// placeholder is kept if the layout has the placeholder with the same index
+// and type class, or mapped to an unused layout placeholder with the same
+// type, then with the same type class.
+func remapPlaceholders(slide *decodeSlide, layout *decodeSlideLayout) {
+	phClass := func(ph *Ph) string {
+		phType := "obj"
+		if ph.Type != nil {
+			phType = *ph.Type
+		}
+		switch phType {
+		case "title", "ctrTitle":
+			return "title"
+		case "dt", "ftr", "sldNum", "hdr":
+			return phType
+		}
+		return "body"
+	}
+	phType := func(ph *Ph) string {
+		if ph.Type == nil {
+			return "obj"
+		}
+		return *ph.Type
+	}
+	var targets []*Ph
+	for _, shape := range layout.CommonSlideData.ShapeTree.Shape {
+		if shape.NonVisualShapeProperties != nil && shape.NonVisualShapeProperties.NonVisualProperties != nil &&
+			shape.NonVisualShapeProperties.NonVisualProperties.Ph != nil {
+			targets = append(targets, shape.NonVisualShapeProperties.NonVisualProperties.Ph)
+		}
+	}
+	used, pending := map[*Ph]bool{}, []*Ph{}
+	for _, shape := range slide.CommonSlideData.ShapeTree.Shape {
+		if shape.NonVisualShapeProperties == nil || shape.NonVisualShapeProperties.NonVisualProperties == nil ||
+			shape.NonVisualShapeProperties.NonVisualProperties.Ph == nil {
+			continue
+		}
+		ph, kept := shape.NonVisualShapeProperties.NonVisualProperties.Ph, false
+		for _, target := range targets {
+			if !used[target] && ph.Idx != nil && target.Idx != nil && *ph.Idx == *target.Idx && phClass(ph) == phClass(target) {
+				used[target], kept = true, true
+				break
+			}
+		}
+		if !kept {
+			pending = append(pending, ph)
+		}
+	}
+	for _, match := range []func(ph, target *Ph) bool{
+		func(ph, target *Ph) bool { return phType(ph) == phType(target) },
+		func(ph, target *Ph) bool { return phClass(ph) == phClass(target) },
+	} {
+		rest := pending[:0]
+		for _, ph := range pending {
+			found := false
+			for _, target := range targets {
+				if !used[target] && match(ph, target) {
+					ph.Type, ph.Idx, used[target], found = target.Type, target.Idx, true, true
+					break
+				}
+			}
+			if !found {
+				rest = append(rest, ph)
+			}
+		}
+		pending = rest
+	}
+}
+
+// newPlaceholderShape provides a function to create an empty slide
+// placeholder by given layout placeholder shape and shape ID. The position
+// and the formatting of the first paragraph are kept.
+func newPlaceholderShape(shape decodeShape, shapeID int) decodeShape {
+	ph := *shape.NonVisualShapeProperties.NonVisualProperties.Ph
+	name := "PlaceHolder " + strconv.Itoa(shapeID-1)
+	if cNvPr := shape.NonVisualShapeProperties.CommonNonVisualProperties; cNvPr != nil && cNvPr.Name != "" {
+		name = cNvPr.Name
+	}
+	noGroup := 1
+	placeholder := decodeShape{
+		NonVisualShapeProperties: &decodeNonVisualShapeProperties{
+			CommonNonVisualProperties: &CommonNonVisualProperties{ID: shapeID, Name: name},
+			CommonNonVisualShapeProperties: &decodeCommonNonVisualShapeProperties{
+				ShapeLocks: &ShapeLocks{NoGroup: &noGroup},
+			},
+			NonVisualProperties: &decodeNonVisualProperties{Ph: &ph},
+		},
+		ShapeProperties: &DecodeShapeProperties{},
+		TextBody: &DecodeTextBody{
+			BodyProperties: &DecodeBodyProperties{},
+			Paragraph:      []DecodeParagraph{{}},
+		},
+	}
+	if spPr := shape.ShapeProperties; spPr != nil && spPr.Xfrm != nil {
+		placeholder.ShapeProperties.Xfrm = spPr.Xfrm
+		placeholder.ShapeProperties.PresetGeometry = spPr.PresetGeometry
+	}
+	if txBody := shape.TextBody; txBody != nil {
+		if txBody.BodyProperties != nil {
+			placeholder.TextBody.BodyProperties = txBody.BodyProperties
+		}
+		if len(txBody.Paragraph) > 0 {
+			placeholder.TextBody.Paragraph[0] = DecodeParagraph{
+				ParagraphProperties:       txBody.Paragraph[0].ParagraphProperties,
+				EndParagraphRunProperties: txBody.Paragraph[0].EndParagraphRunProperties,
+			}
+		}
+	}
+	return placeholder
+}
@@ -0,0 +1,64 @@
+package gopptx
+
+import (
+	"errors"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+func TestSetSlideLayout(t *testing.T) {
+	for _, c := range []struct {
+		opts     *SlideLayoutOptions
+		expected []string
+	}{
+		{expected: []string{"title|", "obj|5", "dt|", "ftr|2", "pic|"}},
+		{opts: &SlideLayoutOptions{}, expected: []string{"title|", "obj|5", "dt|", "ftr|2", "pic|"}},
+		{opts: &SlideLayoutOptions{RemapPlaceholders: true}, expected: []string{"title|", "subTitle|", "dt|1", "ftr|2", "pic|"}},
+	} {
+		f := NewFile()
+		slideID := f.GetSlideList()[0]
+		slide, err := f.slideReader(slideID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		idx, ftrIdx := 5, 2
+		slide.CommonSlideData.ShapeTree.Shape[1].NonVisualShapeProperties.NonVisualProperties.Ph = &Ph{Idx: &idx}
+		addTestShape(t, f, slideID, "dt", "Date")
+		addTestShape(t, f, slideID, "ftr", "Footer").NonVisualShapeProperties.NonVisualProperties.Ph.Idx = &ftrIdx
+		addTestShape(t, f, slideID, "pic")
+		if err = f.SetSlideLayout(slideID, "TITLE", c.opts); err != nil {
+			t.Fatal(err)
+		}
+		var placeholders []string
+		for _, shape := range slide.CommonSlideData.ShapeTree.Shape {
+			ph, phType := shape.NonVisualShapeProperties.NonVisualProperties.Ph, "obj"
+			if ph.Type != nil {
+				phType = *ph.Type
+			}
+			if phType += "|"; ph.Idx != nil {
+				phType += strconv.Itoa(*ph.Idx)
+			}
+			placeholders = append(placeholders, phType)
+		}
+		if !reflect.DeepEqual(placeholders, c.expected) {
+			t.Errorf("expected the placeholders %v, got %v", c.expected, placeholders)
+		}
+		name, err := f.GetSlideLayoutName(slideID)
+		if err != nil || name != "Default" {
+			t.Errorf("expected the layout Default, got %q %v", name, err)
+		}
+	}
+
+	f := NewFile()
+	slideID := f.GetSlideList()[0]
+	if err := f.SetSlideLayout(slideID, "Missing", nil); !errors.Is(err, ErrLayoutNotExist{"Missing"}) {
+		t.Errorf("expected ErrLayoutNotExist, got %v", err)
+	}
+	if err := f.SetSlideLayout(300, "Default", nil); !errors.Is(err, ErrSlideNotExist{300}) {
+		t.Errorf("expected ErrSlideNotExist, got %v", err)
+	}
+	if _, err := f.GetSlideLayoutName(300); !errors.Is(err, ErrSlideNotExist{300}) {
+		t.Errorf("expected ErrSlideNotExist, got %v", err)
+	}
+}
@@ -14,8 +14,11 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"path"
 	"strconv"
 	"strings"
+
+	"golang.org/x/net/html/charset"
 )
 
 // ReadZipReader extract presentation with given options.
@@ -29,35 +32,194 @@ func (f *File) ReadZipReader(r *zip.Reader) (map[string][]byte, int, error) {
 		slides    int
 		unzipSize int64
 	)
+	if f.options.MaxParts > 0 && len(r.File) > f.options.MaxParts {
+		return fileList, slides, newPartCountLimitError(f.options.MaxParts)
+	}
 	for _, v := range r.File {
 		fileSize := v.FileInfo().Size()
 		unzipSize += fileSize
 		if unzipSize > f.options.UnzipSizeLimit {
 			return fileList, slides, newUnzipSizeLimitError(f.options.UnzipSizeLimit)
 		}
-		fileName := strings.ReplaceAll(v.Name, "\\", "/")
+		fileName, ok := getCanonicalPartName(v.Name)
+		if f.options.StrictPartNames && (!ok || fileName != strings.TrimSuffix(v.Name, "/")) {
+			return nil, 0, newInvalidPartNameError(v.Name)
+		}
+		if !ok {
+			f.diagnose(DiagnosticSkippedEntry, v.Name, "skipped the entry outside of the package")
+			continue
+		}
+		if fileName != strings.TrimSuffix(v.Name, "/") {
+			f.diagnose(DiagnosticRenamedEntry, v.Name, "converted the entry name to the canonical part name", "canonical", fileName)
+		}
 		if partName, ok := docPart[strings.ToLower(fileName)]; ok {
 			fileName = partName
 		}
+		fileName = internString(fileName)
+		if isMediaPart(fileName) && fileSize > f.options.UnzipXMLSizeLimit && !v.FileInfo().IsDir() {
+			if f.mapped != nil {
+				f.tempFiles.Store(fileName, v)
+				continue
+			}
+			tempFile, err := f.unzipToTemp(v)
+			if tempFile != "" {
+				f.tempFiles.Store(fileName, tempFile)
+			}
+			if err == nil {
+				continue
+			}
+		}
 		if strings.HasPrefix(strings.ToLower(fileName), "ppt/slides/slide") {
 			slides++
+			if fileSize > f.options.UnzipXMLSizeLimit && !v.FileInfo().IsDir() && f.mapped != nil {
+				if err = f.checkZipFileLimits(fileName, v); err != nil {
+					return nil, 0, err
+				}
+				f.tempFiles.Store(fileName, v)
+				continue
+			}
 			if fileSize > f.options.UnzipXMLSizeLimit && !v.FileInfo().IsDir() {
 				tempFile, err := f.unzipToTemp(v)
 				if tempFile != "" {
 					f.tempFiles.Store(fileName, tempFile)
 				}
 				if err == nil {
+					if err = f.checkZipFileLimits(fileName, v); err != nil {
+						return nil, 0, err
+					}
 					continue
 				}
 			}
 		}
-		if fileList[fileName], err = readFile(v); err != nil {
+		if fileList[fileName], err = f.readZipFile(fileName, v); err != nil {
 			return nil, 0, err
 		}
 	}
 	return fileList, slides, nil
 }
 
+// getCanonicalPartName returns the part name in the canonical form by given
+// name of the entry in the archive, the backslashes are converted to the
+// slashes, the leading slash is removed and the relative path segments are
+// resolved. It returns false if the name refers outside of the package, such
+// as the name starting with "../" or a drive letter.
+func getCanonicalPartName(name string) (string, bool) {
+	name = strings.TrimLeft(strings.ReplaceAll(name, "\\", "/"), "/")
+	if len(name) > 1 && name[1] == ':' {
+		return "", false
+	}
+	name = path.Clean(name)
+	if name == "." || name == ".." || strings.HasPrefix(name, "../") {
+		return "", false
+	}
+	return name, true
+}
+
+// entityCounter counts the entity and character references, which start
+// with the ampersand, in the XML content read through it.
+type entityCounter struct {
+	r     io.Reader
+	count int
+}
+
+// Read reads the XML content and counts the references.
+func (c *entityCounter) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.count += bytes.Count(p[:n], []byte("&"))
+	return n, err
+}
+
+// readZipFile provides a function to read the part in the archive by given
+// part name and the file in the archive. The XML limits of the options are
+// checked while the part is read, so the reading stops at the first element
+// exceeding the limits.
+func (f *File) readZipFile(name string, file *zip.File) ([]byte, error) {
+	if f.options.MaxXMLDepth <= 0 && f.options.MaxXMLAttributes <= 0 && f.options.MaxXMLEntities <= 0 {
+		return readFile(file)
+	}
+	rc, err := file.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+	buf := bytes.NewBuffer(make([]byte, 0, file.FileInfo().Size()))
+	if err = f.checkXMLLimits(name, io.TeeReader(rc, buf)); err != nil {
+		return nil, err
+	}
+	_, err = io.Copy(buf, rc)
+	return buf.Bytes(), err
+}
+
+// checkZipFileLimits provides a function to check the XML part in the
+// archive against the XML limits of the options by given part name and the
+// file in the archive.
+func (f *File) checkZipFileLimits(name string, file *zip.File) error {
+	if f.options.MaxXMLDepth <= 0 && f.options.MaxXMLAttributes <= 0 && f.options.MaxXMLEntities <= 0 {
+		return nil
+	}
+	rc, err := file.Open()
+	if err != nil {
+		return err
+	}
+	if err = f.checkXMLLimits(name, rc); err != nil {
+		_ = rc.Close()
+		return err
+	}
+	return rc.Close()
+}
+
+// checkXMLLimits provides a function to check the nesting depth, the
+// attribute counts of the elements and the entity references of the XML part
+// against the limits of the options by given part name and the content. The
+// entity declarations are rejected if the entity references are limited, and
+// the malformed XML parts are rejected. The parts which are not XML are
+// skipped.
+func (f *File) checkXMLLimits(name string, r io.Reader) error {
+	opts := f.options
+	if (opts.MaxXMLDepth <= 0 && opts.MaxXMLAttributes <= 0 && opts.MaxXMLEntities <= 0) ||
+		!(strings.HasSuffix(name, ".xml") || strings.HasSuffix(name, ".rels")) {
+		return nil
+	}
+	counter := &entityCounter{r: r}
+	var content io.Reader = counter
+	if enc, _ := charset.Lookup(opts.Charset); enc != nil {
+		// The parts which are not UTF-8 are converted after reading, decode
+		// them by the charset so only the structure of the XML is checked.
+		content = enc.NewDecoder().Reader(counter)
+	}
+	var (
+		dec   = f.xmlNewDecoder(content)
+		depth int
+	)
+	for {
+		token, err := dec.RawToken()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			if depth++; opts.MaxXMLDepth > 0 && depth > opts.MaxXMLDepth {
+				return newXMLLimitError(name, "nesting depth", opts.MaxXMLDepth)
+			}
+			if opts.MaxXMLAttributes > 0 && len(t.Attr) > opts.MaxXMLAttributes {
+				return newXMLLimitError(name, "attribute count", opts.MaxXMLAttributes)
+			}
+		case xml.EndElement:
+			depth--
+		case xml.Directive:
+			if opts.MaxXMLEntities > 0 && bytes.Contains(t, []byte("ENTITY")) {
+				return ErrXMLEntityDeclaration
+			}
+		}
+		if opts.MaxXMLEntities > 0 && counter.count > opts.MaxXMLEntities {
+			return newXMLLimitError(name, "entity reference count", opts.MaxXMLEntities)
+		}
+	}
+}
+
 // Read file content as string in an archive file.
 func readFile(file *zip.File) ([]byte, error) {
 	rc, err := file.Open()
@@ -71,10 +233,10 @@ func readFile(file *zip.File) ([]byte, error) {
 	return buff.Bytes(), rc.Close()
 }
 
-// unzipToTemp unzip the zip entity to the system temporary directory and
-// returned the unzipped file path.
+// unzipToTemp unzip the zip entity to the scratch file created by the
+// temporary file provider and returned the unzipped file name.
 func (f *File) unzipToTemp(zipFile *zip.File) (string, error) {
-	tmp, err := os.CreateTemp(f.options.TmpDir, "gopptx-")
+	tmp, err := f.tempFileProvider().Create(f.options.TmpDir, "gopptx-")
 	if err != nil {
 		return "", err
 	}
@@ -175,7 +337,7 @@ func getRootElement(d *xml.Decoder) []xml.Attr {
 				if !ns {
 					startElement.Attr = append(startElement.Attr, NameSpacePresentationML)
 				}
-				return startElement.Attr
+				return internAttrs(startElement.Attr)
 			}
 		}
 	}
@@ -270,19 +432,136 @@ func (f *File) readBytes(name string) []byte {
 		return content
 	}
 	content, _ = io.ReadAll(file)
-	f.Pkg.Store(name, content)
+	if !f.options.ReadOnly {
+		f.Pkg.Store(name, content)
+	}
 	_ = file.Close()
 	return content
 }
 
-// readTemp read file from system temporary directory by given path.
-func (f *File) readTemp(name string) (file *os.File, err error) {
-	path, ok := f.tempFiles.Load(name)
+// readPart read the part as bytes by given path, the part kept in the
+// temporary file is read without storing it in the package, so the large
+// media parts aren't held in the memory after reading.
+func (f *File) readPart(name string) []byte {
+	if content, ok := f.Pkg.Load(name); ok && content != nil {
+		return content.([]byte)
+	}
+	file, err := f.readTemp(name)
+	if err != nil {
+		return nil
+	}
+	content, _ := io.ReadAll(file)
+	_ = file.Close()
+	return content
+}
+
+// openPart provides a function to open the part for reading by given path,
+// the part kept in the temporary file or the mapped package is read in
+// streaming.
+func (f *File) openPart(name string) (io.ReadCloser, error) {
+	if content, ok := f.Pkg.Load(name); ok && content != nil {
+		return io.NopCloser(bytes.NewReader(content.([]byte))), nil
+	}
+	return f.readTemp(name)
+}
+
+// isMediaPart returns true if the part is a media part, such as the images,
+// the audios and the videos, by given part name.
+func isMediaPart(name string) bool {
+	return path.Dir(name) == "ppt/media"
+}
+
+// deleteTempFile provides a function to remove the part kept in the
+// temporary file by given part name.
+func (f *File) deleteTempFile(name string) {
+	if temp, ok := f.tempFiles.LoadAndDelete(name); ok {
+		if tempFile, ok := temp.(string); ok {
+			_ = f.tempFileProvider().Remove(tempFile)
+		}
+	}
+}
+
+// readTemp read file from the temporary file provider, or from the memory
+// mapped package for the file opened with the MemoryMap option, by given path.
+func (f *File) readTemp(name string) (file io.ReadCloser, err error) {
+	temp, ok := f.tempFiles.Load(name)
 	if !ok {
-		return
+		return nil, os.ErrNotExist
+	}
+	if zipFile, ok := temp.(*zip.File); ok {
+		return zipFile.Open()
 	}
-	file, err = os.Open(path.(string))
-	return
+	return f.tempFileProvider().Open(temp.(string))
+}
+
+// getPartRelsPath provides a function to get the relationships part path of
+// the given package part, for example: ppt/slides/slide1.xml will be
+// ppt/slides/_rels/slide1.xml.rels
+func getPartRelsPath(partName string) string {
+	return strings.TrimPrefix(path.Join(path.Dir(partName), "_rels", path.Base(partName)+".rels"), "/")
+}
+
+// resolveRelTarget provides a function to get the package part path by given
+// source part path and relationship target, compatible with both relative
+// and absolute targets.
+func resolveRelTarget(partName, target string) string {
+	target = strings.ReplaceAll(target, "\\", "/")
+	if strings.HasPrefix(target, "/") {
+		return strings.TrimPrefix(path.Clean(target), "/")
+	}
+	return strings.TrimPrefix(path.Join(path.Dir(partName), target), "/")
+}
+
+// getRelativeTarget provides a function to get the relative relationship
+// target by given source part path and target part path.
+func getRelativeTarget(partName, target string) string {
+	var from []string
+	if dir := path.Dir(partName); dir != "." {
+		from = strings.Split(dir, "/")
+	}
+	to := strings.Split(target, "/")
+	var i int
+	for i < len(from) && i < len(to)-1 && from[i] == to[i] {
+		i++
+	}
+	return strings.Repeat("../", len(from)-i) + strings.Join(to[i:], "/")
+}
+
+// getRelTarget provides a function to get the package part path of the
+// relationship by given source part path and relationship ID.
+func (f *File) getRelTarget(partName, rID string) (string, bool) {
+	rels, _ := f.relsReader(getPartRelsPath(partName))
+	if rels == nil {
+		return "", false
+	}
+	rels.mu.Lock()
+	defer rels.mu.Unlock()
+	for _, rel := range rels.Relationships {
+		if rel.ID == rID {
+			if rel.TargetMode == "External" {
+				return rel.Target, false
+			}
+			return resolveRelTarget(partName, rel.Target), true
+		}
+	}
+	return "", false
+}
+
+// getRelTargetByType provides a function to get the package part path of the
+// first internal relationship with the given type by given source part path.
+func (f *File) getRelTargetByType(partName, relType string) (string, bool) {
+	rels, _ := f.relsReader(getPartRelsPath(partName))
+	if rels == nil {
+		return "", false
+	}
+	rels.mu.Lock()
+	defer rels.mu.Unlock()
+	for _, rel := range rels.Relationships {
+		if rel.Type == relType && rel.TargetMode != "External" {
+			return resolveRelTarget(partName, rel.Target), true
+		}
+	}
+	return "", false
 }
 
 func (s *decodeSlideID) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
@@ -299,10 +578,377 @@ func (s *decodeSlideID) UnmarshalXML(d *xml.Decoder, start xml.StartElement) err
 
 				s.SlideID = val
 			default:
-				return unexpectedNamespace(attr.Name.Space)
+				s.UnexpectedNamespace = append(s.UnexpectedNamespace, attr.Name.Space)
 			}
 		}
 	}
 
 	return d.Skip()
 }
+
+// UnmarshalXML implements the xml.Unmarshaler interface for the shape tree
+// to record the document order of the shapes, which is the z-order of the
+// shapes on the slide.
+func (t *decodeShapeTree) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var group decodeGroupShape
+	for order := 1; ; order++ {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch e := token.(type) {
+		case xml.StartElement:
+			ok, err := group.decodeElement(d, &e, order)
+			if !ok && err == nil {
+				err = d.Skip()
+			}
+			if err != nil {
+				return err
+			}
+		case xml.EndElement:
+			t.NonVisualGroupShapeProperties, t.GroupShapeProperties = group.NonVisualGroupShapeProperties, group.GroupShapeProperties
+			t.Shape, t.Picture, t.GraphicFrame, t.GroupShape = group.Shape, group.Picture, group.GraphicFrame, group.GroupShape
+			t.ContentPart, t.AlternateContent, t.ExtensionList = group.ContentPart, group.AlternateContent, group.ExtensionList
+			return nil
+		}
+	}
+}
+
+// UnmarshalXML implements the xml.Unmarshaler interface for the group shape
+// to record the document order of the shapes in the group.
+func (g *decodeGroupShape) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for order := 1; ; order++ {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch e := token.(type) {
+		case xml.StartElement:
+			ok, err := g.decodeElement(d, &e, order)
+			if !ok && err == nil {
+				err = d.Skip()
+			}
+			if err != nil {
+				return err
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// decodeElement decodes the group shape properties, the shape, the content
+// part, the alternate content or the extension list in the group by given
+// start element and the document order of the element, it returns false if
+// the element isn't one of them.
+func (g *decodeGroupShape) decodeElement(d *xml.Decoder, start *xml.StartElement, order int) (bool, error) {
+	var err error
+	switch start.Name.Local {
+	case "nvGrpSpPr":
+		g.NonVisualGroupShapeProperties = &decodeNonVisualGroupShapeProperties{}
+		err = d.DecodeElement(g.NonVisualGroupShapeProperties, start)
+	case "grpSpPr":
+		g.GroupShapeProperties = &decodeGroupShapeProperties{}
+		err = d.DecodeElement(g.GroupShapeProperties, start)
+	case "sp":
+		sp := decodeShape{order: order}
+		err = d.DecodeElement(&sp, start)
+		g.Shape = append(g.Shape, sp)
+	case "pic":
+		pic := decodePicture{order: order}
+		err = d.DecodeElement(&pic, start)
+		g.Picture = append(g.Picture, pic)
+	case "graphicFrame":
+		gf := decodeGraphicFrame{order: order}
+		err = d.DecodeElement(&gf, start)
+		g.GraphicFrame = append(g.GraphicFrame, gf)
+	case "grpSp":
+		var grpSp decodeGroupShape
+		err = d.DecodeElement(&grpSp, start)
+		grpSp.order = order
+		g.GroupShape = append(g.GroupShape, grpSp)
+	case "contentPart":
+		cp := decodeContentPart{order: order}
+		err = d.DecodeElement(&cp, start)
+		g.ContentPart = append(g.ContentPart, cp)
+	case "AlternateContent":
+		ac := decodeAlternateContent{order: order}
+		err = d.DecodeElement(&ac, start)
+		g.AlternateContent = append(g.AlternateContent, ac)
+	case "extLst":
+		g.ExtensionList = &decodeExtensionList{}
+		err = d.DecodeElement(g.ExtensionList, start)
+	default:
+		return false, nil
+	}
+	return true, err
+}
+
+// xmlTextReplacer escapes the character data of the XML element tree, the
+// line breaks are kept for the readability of the indented content.
+var xmlTextReplacer = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;", "\r", "&#xD;")
+
+// xmlNode is a generic XML element tree, which keeps the namespace prefixes
+// of the element and attribute names as they are, for editing the parts that
+// are not modeled without losing the unknown content. The text node has an
+// empty name.
+type xmlNode struct {
+	Name     string
+	Attr     []xml.Attr
+	Children []*xmlNode
+	Text     string
+}
+
+// xmlNodeReader provides a function to parse the XML content into the element
+// tree, the processing instructions, comments and directives are dropped.
+func (f *File) xmlNodeReader(content []byte) (*xmlNode, error) {
+	var (
+		dec   = f.xmlNewDecoder(bytes.NewReader(content))
+		stack []*xmlNode
+		root  *xmlNode
+	)
+	rawName := func(name xml.Name) string {
+		if name.Space == "" {
+			return internString(name.Local)
+		}
+		return internString(name.Space + ":" + name.Local)
+	}
+	for {
+		token, err := dec.RawToken()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			node := &xmlNode{Name: rawName(t.Name)}
+			for _, attr := range t.Attr {
+				node.Attr = append(node.Attr, xml.Attr{Name: xml.Name{Local: rawName(attr.Name)}, Value: attr.Value})
+			}
+			if len(stack) == 0 {
+				if root != nil {
+					return nil, fmt.Errorf("unexpected element %s after the root element", node.Name)
+				}
+				root = node
+			} else {
+				parent := stack[len(stack)-1]
+				parent.Children = append(parent.Children, node)
+			}
+			stack = append(stack, node)
+		case xml.EndElement:
+			if len(stack) == 0 {
+				return nil, fmt.Errorf("unexpected end element %s", rawName(t.Name))
+			}
+			stack = stack[:len(stack)-1]
+		case xml.CharData:
+			if len(stack) > 0 {
+				parent := stack[len(stack)-1]
+				parent.Children = append(parent.Children, &xmlNode{Text: string(t)})
+			}
+		}
+	}
+	if root == nil || len(stack) > 0 {
+		return nil, io.ErrUnexpectedEOF
+	}
+	return root, nil
+}
+
+// newXMLNode returns an element by given name and the pairs of the attribute
+// names and values.
+func newXMLNode(name string, attrs ...string) *xmlNode {
+	node := &xmlNode{Name: name}
+	for i := 0; i+1 < len(attrs); i += 2 {
+		node.Attr = append(node.Attr, xml.Attr{Name: xml.Name{Local: attrs[i]}, Value: attrs[i+1]})
+	}
+	return node
+}
+
+// clone returns a deep copy of the element.
+func (n *xmlNode) clone() *xmlNode {
+	node := &xmlNode{Name: n.Name, Attr: append([]xml.Attr(nil), n.Attr...), Text: n.Text}
+	for _, child := range n.Children {
+		node.Children = append(node.Children, child.clone())
+	}
+	return node
+}
+
+// bytes returns the XML content of the element tree.
+func (n *xmlNode) bytes() []byte {
+	var buf bytes.Buffer
+	n.write(&buf)
+	return buf.Bytes()
+}
+
+// write writes the XML content of the element into the buffer.
+func (n *xmlNode) write(buf *bytes.Buffer) {
+	if n.Name == "" {
+		_, _ = xmlTextReplacer.WriteString(buf, n.Text)
+		return
+	}
+	buf.WriteString("<" + n.Name)
+	for _, attr := range n.Attr {
+		buf.WriteString(" " + attr.Name.Local + `="`)
+		_ = xml.EscapeText(buf, []byte(attr.Value))
+		buf.WriteString(`"`)
+	}
+	if len(n.Children) == 0 {
+		buf.WriteString("/>")
+		return
+	}
+	buf.WriteString(">")
+	for _, child := range n.Children {
+		child.write(buf)
+	}
+	buf.WriteString("</" + n.Name + ">")
+}
+
+// prefix returns the name prefix including the colon of the namespace
+// declared on the element by given namespace URI, such as "c:". An empty
+// string will be returned if the namespace is the default namespace or not
+// declared.
+func (n *xmlNode) prefix(space string) string {
+	for _, attr := range n.Attr {
+		if attr.Value == space && strings.HasPrefix(attr.Name.Local, "xmlns:") {
+			return strings.TrimPrefix(attr.Name.Local, "xmlns:") + ":"
+		}
+	}
+	return ""
+}
+
+// attr returns the value of the attribute by given attribute name.
+func (n *xmlNode) attr(name string) string {
+	if n == nil {
+		return ""
+	}
+	for _, attr := range n.Attr {
+		if attr.Name.Local == name {
+			return attr.Value
+		}
+	}
+	return ""
+}
+
+// setAttr sets the value of the attribute by given attribute name.
+func (n *xmlNode) setAttr(name, value string) {
+	for i := range n.Attr {
+		if n.Attr[i].Name.Local == name {
+			n.Attr[i].Value = value
+			return
+		}
+	}
+	n.Attr = append(n.Attr, xml.Attr{Name: xml.Name{Local: name}, Value: value})
+}
+
+// removeAttr removes the attribute by given attribute name.
+func (n *xmlNode) removeAttr(name string) {
+	attrs := n.Attr[:0]
+	for _, attr := range n.Attr {
+		if attr.Name.Local != name {
+			attrs = append(attrs, attr)
+		}
+	}
+	n.Attr = attrs
+}
+
+// child returns the first child element by given element name, nil will be
+// returned if the element has no such child.
+func (n *xmlNode) child(name string) *xmlNode {
+	for _, child := range n.Children {
+		if child.Name == name {
+			return child
+		}
+	}
+	return nil
+}
+
+// children returns the child elements by given element name.
+func (n *xmlNode) children(name string) []*xmlNode {
+	var list []*xmlNode
+	for _, child := range n.Children {
+		if child.Name == name {
+			list = append(list, child)
+		}
+	}
+	return list
+}
+
+// find returns the first descendant element by given local names of the
+// elements on the path, the namespace prefixes of the elements are ignored.
+// It returns nil if the element doesn't exist.
+func (n *xmlNode) find(names ...string) *xmlNode {
+	for _, name := range names {
+		if n == nil {
+			return nil
+		}
+		var next *xmlNode
+		for _, child := range n.Children {
+			if child.Name != "" && localName(child.Name) == name {
+				next = child
+				break
+			}
+		}
+		n = next
+	}
+	return n
+}
+
+// findAll returns the child elements by given local name, the namespace
+// prefixes of the elements are ignored.
+func (n *xmlNode) findAll(name string) []*xmlNode {
+	if n == nil {
+		return nil
+	}
+	var list []*xmlNode
+	for _, child := range n.Children {
+		if child.Name != "" && localName(child.Name) == name {
+			list = append(list, child)
+		}
+	}
+	return list
+}
+
+// localName returns the element name without the namespace prefix.
+func localName(name string) string {
+	if idx := strings.IndexByte(name, ':'); idx != -1 {
+		return name[idx+1:]
+	}
+	return name
+}
+
+// text returns the text content of the element.
+func (n *xmlNode) text() string {
+	var buf strings.Builder
+	for _, child := range n.Children {
+		if child.Name == "" {
+			buf.WriteString(child.Text)
+		} else {
+			buf.WriteString(child.text())
+		}
+	}
+	return buf.String()
+}
+
+// remove removes the child elements by given element names.
+func (n *xmlNode) remove(names ...string) {
+	children := n.Children[:0]
+	for _, child := range n.Children {
+		if child.Name == "" || inStrSlice(names, child.Name, true) == -1 {
+			children = append(children, child)
+		}
+	}
+	n.Children = children
+}
+
+// insert inserts the child element before the first child element which name
+// is one of the given names, or appends it if there is no such child.
+func (n *xmlNode) insert(child *xmlNode, before ...string) {
+	for i, c := range n.Children {
+		if c.Name != "" && inStrSlice(before, c.Name, true) != -1 {
+			n.Children = append(n.Children[:i], append([]*xmlNode{child}, n.Children[i:]...)...)
+			return
+		}
+	}
+	n.Children = append(n.Children, child)
+}
@@ -0,0 +1,249 @@
+package gopptx
+
+import (
+	"archive/zip"
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// newTestPNG returns the PNG image with the noise pixels by given size, which
+// isn't compressed to a small size.
+func newTestPNG(t *testing.T, width, height int) []byte {
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	seed := uint32(1)
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			seed = seed*1664525 + 1013904223
+			img.Set(x, y, color.RGBA{R: uint8(seed >> 24), G: uint8(seed >> 16), B: uint8(seed >> 8), A: 255})
+		}
+	}
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestSaveMemoryMappedMedia(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "Book1.pptx")
+	img := newTestPNG(t, 64, 64)
+	f := NewFile()
+	if _, err := f.AddPictureFromBytes(f.GetSlideList()[0], ".png", img, &PictureOptions{Width: 914400, Height: 914400}); err != nil {
+		t.Fatal(err)
+	}
+	if err := f.SaveAs(path); err != nil {
+		t.Fatal(err)
+	}
+	if err := f.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	f, err := OpenFile(path, Options{MemoryMap: true, UnzipXMLSizeLimit: int64(len(img) / 2)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var mapped int
+	f.tempFiles.Range(func(k, v interface{}) bool {
+		if isMediaPart(k.(string)) {
+			mapped++
+		}
+		return true
+	})
+	if f.mapped != nil && mapped != 1 {
+		t.Fatalf("expected the media part read from the mapped file, got %d parts", mapped)
+	}
+	if err = f.SetSlideTitle(f.GetSlideList()[0], "Title"); err != nil {
+		t.Fatal(err)
+	}
+	if err = f.Save(); err != nil {
+		t.Fatal(err)
+	}
+	if err = f.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	f, err = OpenFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	var found bool
+	for file, content := range f.MediaFiles() {
+		if found = bytes.Equal(content, img); !found {
+			t.Errorf("the content of the media part %s is changed", file.Path)
+		}
+	}
+	if !found {
+		t.Error("the media part is lost")
+	}
+}
+
+func TestCheckXMLLimits(t *testing.T) {
+	const slidePath = "ppt/slides/slide1.xml"
+	deep := strings.Repeat("<a:p>", 20) + strings.Repeat("</a:p>", 20)
+	for _, c := range []struct {
+		name    string
+		opts    Options
+		replace func(content []byte) []byte
+		err     string
+	}{
+		{name: "valid", opts: Options{MaxXMLDepth: 64, MaxXMLAttributes: 16, MaxXMLEntities: 16}},
+		{
+			name: "nesting depth", opts: Options{MaxXMLDepth: 16},
+			replace: func(content []byte) []byte {
+				return bytes.Replace(content, []byte("</p:sld>"), []byte(deep+"</p:sld>"), 1)
+			},
+			err: "nesting depth of " + slidePath,
+		},
+		{
+			name: "attribute count", opts: Options{MaxXMLAttributes: 4},
+			replace: func(content []byte) []byte {
+				return bytes.Replace(content, []byte("</p:sld>"), []byte(`<a:p a="1" b="1" c="1" d="1" e="1"/></p:sld>`), 1)
+			},
+			err: "attribute count of " + slidePath,
+		},
+		{
+			name: "entity reference count", opts: Options{MaxXMLEntities: 4},
+			replace: func(content []byte) []byte {
+				return bytes.Replace(content, []byte("</p:sld>"), []byte(strings.Repeat("&amp;", 5)+"</p:sld>"), 1)
+			},
+			err: "entity reference count of " + slidePath,
+		},
+		{
+			name: "entity declaration", opts: Options{MaxXMLEntities: 4},
+			replace: func(content []byte) []byte {
+				return bytes.Replace(content, []byte("<p:sld"), []byte(`<!DOCTYPE p:sld [<!ENTITY a "aaaa">]><p:sld`), 1)
+			},
+			err: ErrXMLEntityDeclaration.Error(),
+		},
+		{
+			name: "undefined entity", opts: Options{MaxXMLDepth: 64},
+			replace: func(content []byte) []byte {
+				return bytes.Replace(content, []byte("</p:sld>"), []byte("&a;&a;</p:sld>"), 1)
+			},
+			err: "invalid character entity &a;",
+		},
+		{
+			name: "truncated", opts: Options{MaxXMLDepth: 64},
+			replace: func(content []byte) []byte {
+				return content[:len(content)/2]
+			},
+			err: "unexpected EOF",
+		},
+	} {
+		f, err := openTestFile(t, func(name string, content []byte) []byte {
+			if name == slidePath && c.replace != nil {
+				return c.replace(content)
+			}
+			return content
+		}, c.opts)
+		if c.err == "" {
+			if err != nil {
+				t.Errorf("%s: expected no error, got %v", c.name, err)
+			}
+			continue
+		}
+		if err == nil || !strings.Contains(err.Error(), c.err) {
+			t.Errorf("%s: expected error %q, got %v", c.name, c.err, err)
+		}
+		if f != nil {
+			t.Errorf("%s: expected no presentation on error", c.name)
+		}
+	}
+}
+
+func TestGetCanonicalPartName(t *testing.T) {
+	for _, c := range []struct {
+		name, expected string
+		ok             bool
+	}{
+		{name: "ppt/slides/slide1.xml", expected: "ppt/slides/slide1.xml", ok: true},
+		{name: "/ppt/slides/slide1.xml", expected: "ppt/slides/slide1.xml", ok: true},
+		{name: "ppt\\slides\\slide1.xml", expected: "ppt/slides/slide1.xml", ok: true},
+		{name: "ppt/media/../slides/./slide1.xml", expected: "ppt/slides/slide1.xml", ok: true},
+		{name: "../evil.xml"},
+		{name: "ppt/../../evil.xml"},
+		{name: "..\\evil.xml"},
+		{name: "C:/evil.xml"},
+		{name: ".."},
+	} {
+		name, ok := getCanonicalPartName(c.name)
+		if name != c.expected || ok != c.ok {
+			t.Errorf("%q: expected %q %v, got %q %v", c.name, c.expected, c.ok, name, ok)
+		}
+	}
+}
+
+func TestReadZipReaderEntryNames(t *testing.T) {
+	buf, err := NewFile().WriteToBuffer()
+	if err != nil {
+		t.Fatal(err)
+	}
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, c := range []struct {
+		entry   string
+		strict  bool
+		err     bool
+		partOK  string
+		skipped bool
+	}{
+		{entry: "../evil.xml", skipped: true},
+		{entry: "../evil.xml", strict: true, err: true},
+		{entry: "/ppt/custom.xml", partOK: "ppt/custom.xml"},
+		{entry: "/ppt/custom.xml", strict: true, err: true},
+		{entry: "ppt/custom.xml", strict: true, partOK: "ppt/custom.xml"},
+	} {
+		var out bytes.Buffer
+		zw := zip.NewWriter(&out)
+		for _, file := range zr.File {
+			if err = zw.Copy(file); err != nil {
+				t.Fatal(err)
+			}
+		}
+		w, err := zw.Create(c.entry)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err = w.Write([]byte("<root/>")); err != nil {
+			t.Fatal(err)
+		}
+		if err = zw.Close(); err != nil {
+			t.Fatal(err)
+		}
+		var skipped bool
+		f, err := OpenReader(&out, Options{StrictPartNames: c.strict}, WithDiagnosticsCollector(DiagnosticsFunc(func(d Diagnostic) {
+			skipped = skipped || (d.Kind == DiagnosticSkippedEntry && d.Part == c.entry)
+		})))
+		if c.err {
+			if err == nil || !strings.Contains(err.Error(), "invalid part name") {
+				t.Errorf("%q: expected the invalid part name error, got %v", c.entry, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%q: %v", c.entry, err)
+		}
+		if c.partOK != "" {
+			if _, ok := f.Pkg.Load(c.partOK); !ok {
+				t.Errorf("%q: expected the part %s", c.entry, c.partOK)
+			}
+		}
+		f.Pkg.Range(func(k, v interface{}) bool {
+			if strings.Contains(k.(string), "..") {
+				t.Errorf("%q: unexpected part %s", c.entry, k)
+			}
+			return true
+		})
+		if skipped != c.skipped {
+			t.Errorf("%q: expected skipped %v, got %v", c.entry, c.skipped, skipped)
+		}
+	}
+}
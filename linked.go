@@ -0,0 +1,272 @@
+// Copyright 2026 kenny-not-dead. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+//
+// Package gopptx provides functionality to create and manipulate PowerPoint
+// (.pptx) files in Go, using the Office Open XML (ECMA-376) format.
+
+package gopptx
+
+import (
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// ExternalLink directly maps the relationship of the part to the external
+// resource, such as the linked workbook of the chart and the linked OLE
+// object. The part is the name of the part containing the relationship, such
+// as "ppt/charts/chart1.xml", and the target is the location of the external
+// resource. The hyperlinks are not included.
+type ExternalLink struct {
+	Part           string
+	RelationshipID string
+	Type           string
+	Target         string
+}
+
+// GetExternalLinks provides a function to get the external links of all parts
+// in the presentation in the order of the part names. For example:
+//
+//	links, err := f.GetExternalLinks()
+//	if err != nil {
+//	    fmt.Println(err)
+//	    return
+//	}
+//	for _, link := range links {
+//	    fmt.Println(link.Part, link.Target)
+//	}
+func (f *File) GetExternalLinks() ([]ExternalLink, error) {
+	var links []ExternalLink
+	for _, name := range f.getPartNames() {
+		if !strings.HasSuffix(name, ".rels") {
+			continue
+		}
+		rels, err := f.relsReader(name)
+		if err != nil {
+			return links, err
+		}
+		if rels == nil {
+			continue
+		}
+		partName := strings.TrimSuffix(strings.Replace(name, "_rels/", "", 1), ".rels")
+		rels.mu.Lock()
+		for _, rel := range rels.Relationships {
+			if rel.TargetMode == "External" && rel.Type != SourceRelationshipHyperlink {
+				links = append(links, ExternalLink{Part: partName, RelationshipID: rel.ID, Type: rel.Type, Target: rel.Target})
+			}
+		}
+		rels.mu.Unlock()
+	}
+	return links, nil
+}
+
+// SetExternalLinkTarget provides a function to set the location of the
+// external resource by given part name, relationship ID and the new target.
+// For example, re-point the linked workbook of the chart:
+//
+//	err := f.SetExternalLinkTarget("ppt/charts/chart1.xml", "rId1",
+//	    "file:///\\\\server\\share\\Reports\\Sales.xlsx")
+func (f *File) SetExternalLinkTarget(partName, rID, target string) error {
+	if err := f.checkReadOnly(); err != nil {
+		return err
+	}
+	rels, err := f.relsReader(getPartRelsPath(partName))
+	if err != nil {
+		return err
+	}
+	if rels != nil {
+		rels.mu.Lock()
+		defer rels.mu.Unlock()
+		for i, rel := range rels.Relationships {
+			if rel.ID == rID && rel.TargetMode == "External" {
+				rels.Relationships[i].Target = target
+				return nil
+			}
+		}
+	}
+	return ErrExternalLinkNotExist{Part: partName, RelationshipID: rID}
+}
+
+// EmbedExternalLink provides a function to convert the linked workbook of the
+// chart to the embedded workbook by given chart part name, relationship ID
+// and the content of the workbook, so the chart data can be edited without
+// the linked file. For example:
+//
+//	workbook, err := os.ReadFile("Sales.xlsx")
+//	if err != nil {
+//	    fmt.Println(err)
+//	    return
+//	}
+//	err = f.EmbedExternalLink("ppt/charts/chart1.xml", "rId1", workbook)
+func (f *File) EmbedExternalLink(partName, rID string, content []byte) error {
+	if err := f.checkReadOnly(); err != nil {
+		return err
+	}
+	rels, err := f.relsReader(getPartRelsPath(partName))
+	if err != nil {
+		return err
+	}
+	if rels == nil {
+		return ErrExternalLinkNotExist{Part: partName, RelationshipID: rID}
+	}
+	rels.mu.Lock()
+	defer rels.mu.Unlock()
+	for i, rel := range rels.Relationships {
+		if rel.ID != rID || rel.TargetMode != "External" {
+			continue
+		}
+		if !strings.HasPrefix(partName, "ppt/charts/") || rel.Type != SourceRelationshipOLEObject {
+			return ErrExternalLinkEmbed
+		}
+		extension := strings.ToLower(path.Ext(strings.ReplaceAll(rel.Target, "\\", "/")))
+		contentType := ContentTypeSheetML
+		if extension != ".xlsx" {
+			extension, contentType = ".bin", ContentTypeOLEObject
+		}
+		embedXMLPath := f.getEmbeddingPath(extension)
+		if err = f.setContentTypeDefault(extension, contentType); err != nil {
+			return err
+		}
+		f.Pkg.Store(embedXMLPath, content)
+		rels.Relationships[i] = relationship{
+			ID:     rel.ID,
+			Type:   SourceRelationshipPackage,
+			Target: getRelativeTarget(partName, embedXMLPath),
+		}
+		return nil
+	}
+	return ErrExternalLinkNotExist{Part: partName, RelationshipID: rID}
+}
+
+// getEmbeddingPath provides a function to get an unused part name of the
+// embedded object by given file extension.
+func (f *File) getEmbeddingPath(extension string) string {
+	for i := 1; ; i++ {
+		name := "ppt/embeddings/Microsoft_Excel_Worksheet" + strconv.Itoa(i) + extension
+		if extension != ".xlsx" {
+			name = "ppt/embeddings/oleObject" + strconv.Itoa(i) + extension
+		}
+		if _, ok := f.Pkg.Load(name); !ok {
+			return name
+		}
+	}
+}
+
+// EmbedLinkedPictures provides a function to convert the pictures linked to
+// the external images in all slides to the embedded pictures by given
+// function reading the image by the location of the linked image, the local
+// image files are read if the function is nil. The pictures stored both
+// embedded and linked keep the embedded image only. For example, embed the
+// images downloaded from the web server:
+//
+//	err := f.EmbedLinkedPictures(func(target string) ([]byte, error) {
+//	    resp, err := http.Get(target)
+//	    if err != nil {
+//	        return nil, err
+//	    }
+//	    defer resp.Body.Close()
+//	    return io.ReadAll(resp.Body)
+//	})
+func (f *File) EmbedLinkedPictures(read func(target string) ([]byte, error)) error {
+	if err := f.checkReadOnly(); err != nil {
+		return err
+	}
+	if read == nil {
+		read = readLinkedImage
+	}
+	for _, slideID := range f.GetSlideList() {
+		slide, err := f.slideReader(slideID)
+		if err != nil {
+			return err
+		}
+		slideXMLPath, _ := f.getSlideXMLPath(slideID)
+		for i, pic := range slide.CommonSlideData.ShapeTree.Picture {
+			if pic.BlipFill == nil || pic.BlipFill.Blip == nil || pic.BlipFill.Blip.Link == "" {
+				continue
+			}
+			blip, linkRID := slide.CommonSlideData.ShapeTree.Picture[i].BlipFill.Blip, pic.BlipFill.Blip.Link
+			if blip.Embed == "" {
+				target, _ := f.getRelTarget(slideXMLPath, linkRID)
+				extension := strings.ToLower(path.Ext(strings.ReplaceAll(strings.SplitN(target, "?", 2)[0], "\\", "/")))
+				contentType, ok := supportedImageTypes[extension]
+				if !ok {
+					return ErrImgExt
+				}
+				file, err := read(target)
+				if err != nil {
+					return err
+				}
+				mediaPath := f.addMedia(file, extension)
+				if err = f.setContentTypeDefault(extension, contentType); err != nil {
+					return err
+				}
+				blip.Embed = f.addRels(getPartRelsPath(slideXMLPath), SourceRelationshipImage, "../media/"+path.Base(mediaPath), "")
+			}
+			blip.Link = ""
+			f.deleteUnusedImage(slide, slideXMLPath, linkRID)
+		}
+	}
+	return nil
+}
+
+// LinkEmbeddedPictures provides a function to convert the embedded pictures
+// in all slides to the pictures linked to the external images by given
+// function returning the location of the external image by the part name
+// and content of the embedded image, the picture is kept embedded if the
+// function returns an empty location. The unused media parts are removed,
+// which reduces the size of the presentation. For example, save the images
+// beside the presentation and link to them:
+//
+//	err := f.LinkEmbeddedPictures(func(partName string, content []byte) string {
+//	    name := filepath.Join("images", path.Base(partName))
+//	    if err := os.WriteFile(name, content, 0o644); err != nil {
+//	        return ""
+//	    }
+//	    return name
+//	})
+func (f *File) LinkEmbeddedPictures(link func(partName string, content []byte) string) error {
+	if err := f.checkReadOnly(); err != nil {
+		return err
+	}
+	for _, slideID := range f.GetSlideList() {
+		slide, err := f.slideReader(slideID)
+		if err != nil {
+			return err
+		}
+		slideXMLPath, _ := f.getSlideXMLPath(slideID)
+		for i, pic := range slide.CommonSlideData.ShapeTree.Picture {
+			if pic.BlipFill == nil || pic.BlipFill.Blip == nil || pic.BlipFill.Blip.Embed == "" {
+				continue
+			}
+			mediaPath, ok := f.getRelTarget(slideXMLPath, pic.BlipFill.Blip.Embed)
+			if !ok {
+				continue
+			}
+			target := link(mediaPath, f.readBytes(mediaPath))
+			if target == "" {
+				continue
+			}
+			blip, embedRID := slide.CommonSlideData.ShapeTree.Picture[i].BlipFill.Blip, pic.BlipFill.Blip.Embed
+			blip.Link = f.addRels(getPartRelsPath(slideXMLPath), SourceRelationshipImage, target, "External")
+			blip.Embed = ""
+			f.deleteUnusedImage(slide, slideXMLPath, embedRID)
+		}
+	}
+	return nil
+}
+
+// readLinkedImage provides a function to read the local image file by given
+// location of the linked image, which is the file path or the file URL.
+func readLinkedImage(target string) ([]byte, error) {
+	if u, err := url.Parse(target); err == nil && u.Scheme == "file" {
+		target = strings.TrimPrefix(u.Path, "/")
+		if filepath.VolumeName(target) == "" {
+			target = "/" + target
+		}
+	}
+	return os.ReadFile(filepath.Clean(target))
+}
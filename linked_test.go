@@ -0,0 +1,176 @@
+package gopptx
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestExternalLinks(t *testing.T) {
+	f := NewFile()
+	slideID := f.GetSlideList()[0]
+	chartIdx, err := f.addChart(slideID, "column", "", Offset{}, Extents{CX: 914400, CY: 914400})
+	if err != nil {
+		t.Fatal(err)
+	}
+	chartXMLPath, _, err := f.chartReader(slideID, chartIdx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	slideXMLPath, _ := f.getSlideXMLPath(slideID)
+	chartRID := f.addRels(getPartRelsPath(chartXMLPath), SourceRelationshipOLEObject, `file:///C:\Reports\Sales.xlsx`, "External")
+	f.saveFileList(chartXMLPath, bytes.Replace(f.readXML(chartXMLPath), []byte("</c:chartSpace>"),
+		[]byte(`<c:externalData r:id="`+chartRID+`"/></c:chartSpace>`), 1))
+	objectRID := f.addRels(getPartRelsPath(slideXMLPath), SourceRelationshipOLEObject, "Clip.bin", "External")
+	f.addRels(getPartRelsPath(slideXMLPath), SourceRelationshipHyperlink, "https://example.com", "External")
+
+	links, err := f.GetExternalLinks()
+	if err != nil {
+		t.Fatal(err)
+	}
+	object := ExternalLink{Part: slideXMLPath, RelationshipID: objectRID, Type: SourceRelationshipOLEObject, Target: "Clip.bin"}
+	expected := []ExternalLink{
+		{Part: chartXMLPath, RelationshipID: chartRID, Type: SourceRelationshipOLEObject, Target: `file:///C:\Reports\Sales.xlsx`},
+		object,
+	}
+	if !reflect.DeepEqual(links, expected) {
+		t.Errorf("expected the external links %v, got %v", expected, links)
+	}
+
+	for _, c := range []struct {
+		err      error
+		expected error
+	}{
+		{f.SetExternalLinkTarget(chartXMLPath, chartRID, `file:///\\server\share\Sales.xlsx`), nil},
+		{f.SetExternalLinkTarget(chartXMLPath, "rId99", "Sales.xlsx"), ErrExternalLinkNotExist{Part: chartXMLPath, RelationshipID: "rId99"}},
+		{f.EmbedExternalLink(slideXMLPath, objectRID, []byte("object")), ErrExternalLinkEmbed},
+		{f.EmbedExternalLink("ppt/charts/chart9.xml", chartRID, []byte("workbook")), ErrExternalLinkNotExist{Part: "ppt/charts/chart9.xml", RelationshipID: chartRID}},
+	} {
+		if !errors.Is(c.err, c.expected) {
+			t.Errorf("expected %v, got %v", c.expected, c.err)
+		}
+	}
+	if links, err = f.GetExternalLinks(); err != nil || links[0].Target != `file:///\\server\share\Sales.xlsx` {
+		t.Errorf("expected the re-pointed chart link, got %v %v", links, err)
+	}
+
+	workbook := newTestWorkbook(t)
+	if err = f.EmbedExternalLink(chartXMLPath, chartRID, workbook); err != nil {
+		t.Fatal(err)
+	}
+	buf, err := f.WriteToBuffer()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f, err = OpenReader(buf); err != nil {
+		t.Fatal(err)
+	}
+	if links, err = f.GetExternalLinks(); err != nil || !reflect.DeepEqual(links, []ExternalLink{object}) {
+		t.Errorf("expected the external links %v, got %v %v", []ExternalLink{object}, links, err)
+	}
+	if target, ok := f.getRelTarget(chartXMLPath, chartRID); !ok || !bytes.Equal(f.readPart(target), workbook) {
+		t.Errorf("expected the embedded workbook, got %s", target)
+	}
+	if err = f.Validate(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestLinkedPictures(t *testing.T) {
+	f := NewFile()
+	slideID := f.GetSlideList()[0]
+	logo := newTestPNG(t, 24, 16)
+	logoPath := filepath.Join(t.TempDir(), "logo.png")
+	if err := os.WriteFile(logoPath, logo, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	for _, c := range []struct {
+		target   string
+		opts     *PictureOptions
+		expected int
+		err      error
+	}{
+		{target: logoPath, expected: 9},
+		{target: "https://example.com/banner.png", err: ErrImgSize},
+		{target: "https://example.com/banner.png", opts: &PictureOptions{Width: 914400, Height: 457200}, expected: 10},
+	} {
+		shapeID, err := f.AddLinkedPicture(slideID, c.target, c.opts)
+		if !errors.Is(err, c.err) {
+			t.Errorf("expected %v, got %v", c.err, err)
+		}
+		if err == nil && shapeID != c.expected {
+			t.Errorf("expected the picture shape id %d, got %d", c.expected, shapeID)
+		}
+	}
+	if _, err := f.AddLinkedPicture(300, logoPath, nil); !errors.Is(err, ErrSlideNotExist{300}) {
+		t.Errorf("expected ErrSlideNotExist, got %v", err)
+	}
+	slide, err := f.slideReader(slideID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	getBlips := func() [][2]string {
+		var blips [][2]string
+		for _, pic := range slide.CommonSlideData.ShapeTree.Picture {
+			blips = append(blips, [2]string{pic.BlipFill.Blip.Embed, pic.BlipFill.Blip.Link})
+		}
+		return blips
+	}
+	if size := slide.CommonSlideData.ShapeTree.Picture[0].ShapeProperties.Xfrm.Extents; size.CX != 228600 || size.CY != 152400 {
+		t.Errorf("expected the size of the local image 228600x152400, got %dx%d", size.CX, size.CY)
+	}
+	links, err := f.GetExternalLinks()
+	if err != nil || len(links) != 2 || links[0].Target != logoPath || links[1].Type != SourceRelationshipImage {
+		t.Errorf("expected the linked images, got %v %v", links, err)
+	}
+
+	banner := newTestPNG(t, 32, 16)
+	if err = f.EmbedLinkedPictures(func(target string) ([]byte, error) {
+		if strings.HasPrefix(target, "https://") {
+			return banner, nil
+		}
+		return readLinkedImage(target)
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if links, err = f.GetExternalLinks(); err != nil || len(links) != 0 {
+		t.Errorf("expected no external links, got %v %v", links, err)
+	}
+	slideXMLPath, _ := f.getSlideXMLPath(slideID)
+	for i, blip := range getBlips() {
+		target, ok := f.getRelTarget(slideXMLPath, blip[0])
+		if expected := [][]byte{logo, banner}[i]; blip[1] != "" || !ok || !bytes.Equal(f.readBytes(target), expected) {
+			t.Errorf("expected the embedded image of the picture %d, got %v %s", i, blip, target)
+		}
+	}
+
+	if err = f.LinkEmbeddedPictures(func(partName string, content []byte) string {
+		if bytes.Equal(content, banner) {
+			return "images/" + path.Base(partName)
+		}
+		return ""
+	}); err != nil {
+		t.Fatal(err)
+	}
+	blips := getBlips()
+	if target, _ := f.getRelTarget(slideXMLPath, blips[1][1]); blips[0][1] != "" || blips[1][0] != "" || !strings.HasPrefix(target, "images/image") {
+		t.Errorf("expected the banner linked, got %v %s", blips, target)
+	}
+	var media int
+	for _, name := range f.getPartNames() {
+		if strings.HasPrefix(name, "ppt/media/") {
+			media++
+		}
+	}
+	if media != 1 {
+		t.Errorf("expected the image of the linked picture is removed, got %d media parts", media)
+	}
+	if err = f.EmbedLinkedPictures(func(string) ([]byte, error) { return nil, os.ErrNotExist }); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("expected os.ErrNotExist, got %v", err)
+	}
+}
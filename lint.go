@@ -0,0 +1,178 @@
+// Copyright 2026 kenny-not-dead. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+//
+// Package gopptx provides functionality to create and manipulate PowerPoint
+// (.pptx) files in Go, using the Office Open XML (ECMA-376) format.
+
+package gopptx
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// This section defines the rules of the violations reported by the Lint
+// function.
+const (
+	LintRuleMaxWords       = "maxWords"
+	LintRuleMinFontSize    = "minFontSize"
+	LintRuleForbiddenFont  = "forbiddenFont"
+	LintRuleForbiddenColor = "forbiddenColor"
+	LintRuleMissingFooter  = "missingFooter"
+)
+
+// LintRules directly maps the style rules checked by the Lint function, the
+// zero values disable the rules. The max words is the maximum number of the
+// words on each slide, including the tables. The min font size is the
+// minimum size in points of the text. The forbidden fonts are the font
+// families, and the forbidden colors are the hex RGB colors of the text, the
+// fills and the outlines, which are compared case-insensitively. The require
+// footer specifies whether each slide should have the footer placeholder.
+type LintRules struct {
+	MaxWords        int
+	MinFontSize     float64
+	ForbiddenFonts  []string
+	ForbiddenColors []string
+	RequireFooter   bool
+}
+
+// LintViolation directly maps the violation of the style rules found by the
+// Lint function. The rule is one of the LintRule constants, the shape ID and
+// the shape name are the location of the violation on the slide, which are
+// empty for the violations of the whole slide, and the paragraph and the run
+// are the zero-based indexes of the text run, which are -1 for the
+// violations of the shape.
+type LintViolation struct {
+	Rule      string
+	SlideID   int
+	ShapeID   int
+	ShapeName string
+	Paragraph int
+	Run       int
+	Message   string
+}
+
+// Lint provides a function to check the slides against the style rules, and
+// returns the violations in the order of the slides, so the generated
+// presentations can be gated on the style rules in the continuous
+// integration. The fonts and colors are resolved with the inheritance from
+// the slide layouts, the slide masters and the theme. For example:
+//
+//	violations, err := f.Lint(&gopptx.LintRules{
+//	    MaxWords:        80,
+//	    MinFontSize:     12,
+//	    ForbiddenFonts:  []string{"Comic Sans MS"},
+//	    ForbiddenColors: []string{"FF0000"},
+//	    RequireFooter:   true,
+//	})
+//	if err != nil {
+//	    fmt.Println(err)
+//	    return
+//	}
+//	for _, v := range violations {
+//	    fmt.Println(v.SlideID, v.ShapeName, v.Rule, v.Message)
+//	}
+func (f *File) Lint(rules *LintRules) ([]LintViolation, error) {
+	var violations []LintViolation
+	if rules == nil {
+		return violations, nil
+	}
+	fonts, colors := map[string]bool{}, map[string]bool{}
+	for _, font := range rules.ForbiddenFonts {
+		fonts[strings.ToLower(font)] = true
+	}
+	for _, clr := range rules.ForbiddenColors {
+		colors[strings.ToUpper(strings.TrimPrefix(clr, "#"))] = true
+	}
+	for _, slideID := range f.GetSlideList() {
+		slide, err := f.slideNodeReader(slideID)
+		if err != nil {
+			return violations, err
+		}
+		spTree := slide.find("cSld", "spTree")
+		var (
+			words     int
+			hasFooter bool
+			shapes    []*xmlNode
+		)
+		walkXMLNode(spTree, func(node *xmlNode) {
+			switch localName(node.Name) {
+			case "t":
+				words += len(strings.Fields(node.text()))
+			case "sp":
+				if phType, _, _ := getPlaceholderNode(node); phType == "ftr" {
+					hasFooter = true
+				}
+				shapes = append(shapes, node)
+			}
+		})
+		if rules.MaxWords > 0 && words > rules.MaxWords {
+			violations = append(violations, LintViolation{
+				Rule: LintRuleMaxWords, SlideID: slideID, Paragraph: -1, Run: -1,
+				Message: fmt.Sprintf("slide has %d words, more than %d", words, rules.MaxWords),
+			})
+		}
+		if rules.RequireFooter && !hasFooter {
+			violations = append(violations, LintViolation{
+				Rule: LintRuleMissingFooter, SlideID: slideID, Paragraph: -1, Run: -1,
+				Message: "slide has no footer",
+			})
+		}
+		if rules.MinFontSize <= 0 && len(fonts) == 0 && len(colors) == 0 {
+			continue
+		}
+		for _, shape := range shapes {
+			r, err := f.newShapeStyleResolver(slideID, slide, shape)
+			if err != nil {
+				return violations, err
+			}
+			violations = append(violations, r.lint(slideID, rules, fonts, colors)...)
+		}
+	}
+	return violations, nil
+}
+
+// lint returns the violations of the font and color rules of the shape by
+// given slide id, rules and the forbidden fonts and colors.
+func (r *styleResolver) lint(slideID int, rules *LintRules, fonts, colors map[string]bool) []LintViolation {
+	var violations []LintViolation
+	cNvPr := r.shapes[0].find("nvSpPr", "cNvPr")
+	shapeID, _ := strconv.Atoi(cNvPr.attr("id"))
+	report := func(rule string, paragraph, run int, format string, args ...interface{}) {
+		violations = append(violations, LintViolation{
+			Rule: rule, SlideID: slideID, ShapeID: shapeID, ShapeName: cNvPr.attr("name"),
+			Paragraph: paragraph, Run: run, Message: fmt.Sprintf(format, args...),
+		})
+	}
+	if _, clr := r.shapeFill(); colors[strings.ToUpper(clr)] {
+		report(LintRuleForbiddenColor, -1, -1, "shape fill uses forbidden color %s", clr)
+	}
+	if _, clr, _ := r.shapeLine(); colors[strings.ToUpper(clr)] {
+		report(LintRuleForbiddenColor, -1, -1, "shape outline uses forbidden color %s", clr)
+	}
+	for i, paragraph := range r.shapes[0].find("txBody").findAll("p") {
+		var runIdx int
+		for _, child := range paragraph.Children {
+			if name := localName(child.Name); name != "r" && name != "fld" {
+				continue
+			}
+			runIdx++
+			if t := child.find("t"); t == nil || strings.TrimSpace(t.text()) == "" {
+				continue
+			}
+			font := r.font(child.find("rPr"), getParagraphLevel(paragraph))
+			if rules.MinFontSize > 0 && font.Size < rules.MinFontSize {
+				report(LintRuleMinFontSize, i, runIdx-1, "text size %gpt is less than %gpt", font.Size, rules.MinFontSize)
+			}
+			if fonts[strings.ToLower(font.Family)] {
+				report(LintRuleForbiddenFont, i, runIdx-1, "text uses forbidden font %s", font.Family)
+			}
+			if colors[strings.ToUpper(font.Color)] {
+				report(LintRuleForbiddenColor, i, runIdx-1, "text uses forbidden color %s", font.Color)
+			}
+		}
+	}
+	return violations
+}
@@ -0,0 +1,49 @@
+package gopptx
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestLint(t *testing.T) {
+	f := NewFile()
+	slideID := f.GetSlideList()[0]
+	shape := addTestShape(t, f, slideID, "", "Revenue grew by ten percent", "Costs")
+	shape.NonVisualShapeProperties.CommonNonVisualProperties.Name = "Summary"
+	shape.ShapeProperties.SolidFill = &DecodeSolidFill{SolidRGBColor: &SolidRGBColor{Val: "ff0000"}}
+	size := 1000
+	shape.TextBody.Paragraph[1].Runs[0].RunProperties = &DecodeRunProperties{
+		Size:      &size,
+		SolidFill: &DecodeSolidFill{SolidRGBColor: &SolidRGBColor{Val: "FF0000"}},
+		Latin:     &Latin{Typeface: "Comic Sans MS"},
+	}
+	nextSlideID, err := f.NewSlide()
+	if err != nil {
+		t.Fatal(err)
+	}
+	addTestShape(t, f, nextSlideID, "ftr", "Confidential")
+	violations, err := f.Lint(&LintRules{
+		MaxWords:        5,
+		MinFontSize:     12,
+		ForbiddenFonts:  []string{"comic sans ms"},
+		ForbiddenColors: []string{"#FF0000"},
+		RequireFooter:   true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []LintViolation{
+		{Rule: LintRuleMaxWords, SlideID: slideID, Paragraph: -1, Run: -1, Message: "slide has 6 words, more than 5"},
+		{Rule: LintRuleMissingFooter, SlideID: slideID, Paragraph: -1, Run: -1, Message: "slide has no footer"},
+		{Rule: LintRuleForbiddenColor, SlideID: slideID, ShapeID: 9, ShapeName: "Summary", Paragraph: -1, Run: -1, Message: "shape fill uses forbidden color FF0000"},
+		{Rule: LintRuleMinFontSize, SlideID: slideID, ShapeID: 9, ShapeName: "Summary", Paragraph: 1, Message: "text size 10pt is less than 12pt"},
+		{Rule: LintRuleForbiddenFont, SlideID: slideID, ShapeID: 9, ShapeName: "Summary", Paragraph: 1, Message: "text uses forbidden font Comic Sans MS"},
+		{Rule: LintRuleForbiddenColor, SlideID: slideID, ShapeID: 9, ShapeName: "Summary", Paragraph: 1, Message: "text uses forbidden color FF0000"},
+	}
+	if !reflect.DeepEqual(violations, expected) {
+		t.Errorf("expected the violations %v, got %v", expected, violations)
+	}
+	if violations, err = f.Lint(nil); err != nil || len(violations) != 0 {
+		t.Errorf("expected no violations without the rules, got %v %v", violations, err)
+	}
+}
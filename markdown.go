@@ -0,0 +1,324 @@
+// Copyright 2026 kenny-not-dead. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+//
+// Package gopptx provides functionality to create and manipulate PowerPoint
+// (.pptx) files in Go, using the Office Open XML (ECMA-376) format.
+
+package gopptx
+
+import (
+	"bufio"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+var (
+	markdownHeadingExp = regexp.MustCompile(`^(#{1,6})\s+(.*?)\s*#*\s*$`)
+	markdownBulletExp  = regexp.MustCompile(`^(\s*)(?:[-*+]|\d+[.)])\s+(.*)$`)
+	markdownImageExp   = regexp.MustCompile(`^!\[([^\]]*)\]\(\s*([^)\s]+)(?:\s+"[^"]*")?\s*\)$`)
+	markdownRuleExp    = regexp.MustCompile(`^(?:-{3,}|\*{3,}|_{3,})$`)
+	markdownLayoutExp  = regexp.MustCompile(`^<!--\s*layout:\s*(.*?)\s*-->$`)
+)
+
+// markdownSlide defines the content of a slide parsed from Markdown.
+type markdownSlide struct {
+	title      string
+	layout     string
+	paragraphs []markdownParagraph
+	images     []markdownImage
+	notes      []string
+}
+
+// markdownParagraph defines a paragraph of the slide body parsed from
+// Markdown.
+type markdownParagraph struct {
+	level int
+	text  string
+}
+
+// markdownImage defines an image of the slide parsed from Markdown.
+type markdownImage struct {
+	alt, path string
+}
+
+// BuildFromMarkdown provides a function to convert the Markdown document from
+// the given io.Reader into slides, the reverse of the outline export by
+// ExportMarkdown. Level 1 and 2 headings and thematic breaks start a new
+// slide, the heading text is used as the slide title, list items and
+// paragraphs are added into the body placeholder with the list nesting as the
+// outline level, images are placed by their file path, and blockquotes are
+// added as the speaker notes of the slide. The layout of a slide is chosen by
+// the name or the type of the slide layout in the HTML comment, such as
+// "<!-- layout: Two Content -->", in the slide, and the default layout is
+// used if it is absent. The slides are appended to the given template
+// presentation, a new presentation will be created if the template is nil,
+// and its default slide holds the first Markdown slide. For example:
+//
+//	file, err := os.Open("outline.md")
+//	if err != nil {
+//	    fmt.Println(err)
+//	    return
+//	}
+//	defer file.Close()
+//	f, err := gopptx.BuildFromMarkdown(file, nil)
+//	if err != nil {
+//	    fmt.Println(err)
+//	    return
+//	}
+//	if err := f.SaveAs("outline.pptx"); err != nil {
+//	    fmt.Println(err)
+//	}
+func BuildFromMarkdown(r io.Reader, template *File) (*File, error) {
+	slides, err := parseMarkdownSlides(r)
+	if err != nil {
+		return nil, err
+	}
+	f, reuseFirst := template, false
+	if f == nil {
+		f, reuseFirst = NewFile(), true
+	}
+	for idx, s := range slides {
+		slideID := defaultXMLSlideID
+		if idx > 0 || !reuseFirst {
+			if slideID, err = f.NewSlide(&NewSlideOptions{Layout: s.layout}); err != nil {
+				return f, err
+			}
+		} else if s.layout != "" {
+			layoutXMLPath, err := f.getSlideLayoutPath(s.layout)
+			if err != nil {
+				return f, err
+			}
+			if err = f.setSlideLayout(slideID, layoutXMLPath); err != nil {
+				return f, err
+			}
+		}
+		if err = f.setMarkdownSlide(slideID, s); err != nil {
+			return f, err
+		}
+		if len(s.notes) > 0 {
+			if err = f.addNotesSlide(slideID, s.notes); err != nil {
+				return f, err
+			}
+		}
+	}
+	return f, nil
+}
+
+// parseMarkdownSlides provides a function to split the Markdown document into
+// slides.
+func parseMarkdownSlides(r io.Reader) ([]markdownSlide, error) {
+	var (
+		slides  []markdownSlide
+		current *markdownSlide
+		scanner = bufio.NewScanner(r)
+	)
+	newSlide := func(title string) {
+		// The heading following the layout comment or the thematic break is
+		// the title of the slide, which is still empty.
+		if current != nil && current.title == "" && len(current.paragraphs) == 0 &&
+			len(current.images) == 0 && len(current.notes) == 0 {
+			current.title = title
+			return
+		}
+		slides = append(slides, markdownSlide{title: title})
+		current = &slides[len(slides)-1]
+	}
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), " \t")
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" {
+			continue
+		}
+		if strings.HasPrefix(trimmed, ">") {
+			if current == nil {
+				newSlide("")
+			}
+			current.notes = append(current.notes, strings.TrimPrefix(strings.TrimPrefix(trimmed, ">"), " "))
+			continue
+		}
+		if matches := markdownLayoutExp.FindStringSubmatch(trimmed); matches != nil {
+			if current == nil {
+				newSlide("")
+			}
+			current.layout = matches[1]
+			continue
+		}
+		if matches := markdownHeadingExp.FindStringSubmatch(trimmed); matches != nil {
+			if len(matches[1]) <= 2 {
+				newSlide(matches[2])
+				continue
+			}
+			trimmed = matches[2]
+		}
+		if markdownRuleExp.MatchString(trimmed) {
+			newSlide("")
+			continue
+		}
+		if current == nil {
+			newSlide("")
+		}
+		if matches := markdownImageExp.FindStringSubmatch(trimmed); matches != nil {
+			current.images = append(current.images, markdownImage{alt: matches[1], path: matches[2]})
+			continue
+		}
+		paragraph := markdownParagraph{text: trimmed}
+		if matches := markdownBulletExp.FindStringSubmatch(line); matches != nil {
+			indent := strings.ReplaceAll(matches[1], "\t", "  ")
+			paragraph = markdownParagraph{level: min(len(indent)/2, 8), text: matches[2]}
+		}
+		current.paragraphs = append(current.paragraphs, paragraph)
+	}
+	return slides, scanner.Err()
+}
+
+// setMarkdownSlide provides a function to fill the placeholders of the slide
+// by given slide id and parsed Markdown slide content.
+func (f *File) setMarkdownSlide(slideID int, s markdownSlide) error {
+	slide, err := f.slideReader(slideID)
+	if err != nil {
+		return err
+	}
+	var title, body *decodeShape
+	for i := range slide.CommonSlideData.ShapeTree.Shape {
+		shape := &slide.CommonSlideData.ShapeTree.Shape[i]
+		if title == nil && shape.isTitle() {
+			title = shape
+		}
+		if body == nil && shape.isBody() {
+			body = shape
+		}
+	}
+	if title != nil {
+		setMarkdownText(title, []markdownParagraph{{text: s.title}}, false)
+	}
+	if body == nil || len(s.images) == 0 && len(s.paragraphs) == 0 {
+		return nil
+	}
+	frame, size, err := f.getMarkdownBodyFrame(slideID, body)
+	if err != nil {
+		return err
+	}
+	if len(s.paragraphs) > 0 {
+		setMarkdownText(body, s.paragraphs, true)
+		if len(s.images) > 0 {
+			size.CX /= 2
+			if body.ShapeProperties == nil {
+				body.ShapeProperties = &DecodeShapeProperties{}
+			}
+			body.ShapeProperties.Xfrm = &DecodeXfrm{Offset: &Offset{X: frame.X, Y: frame.Y}, Extents: &Extents{CX: size.CX, CY: size.CY}}
+			frame.X += size.CX
+		}
+	}
+	if len(s.images) == 0 {
+		return nil
+	}
+	height := size.CY / len(s.images)
+	for idx, img := range s.images {
+		file, err := os.ReadFile(filepath.Clean(img.path))
+		if err != nil {
+			return err
+		}
+		opts := &PictureOptions{AltText: img.alt, OffsetX: frame.X, OffsetY: frame.Y + idx*height}
+		if cx, cy, err := getPictureSize(file, &PictureOptions{}); err == nil {
+			// Fit the picture into the frame with keeping the aspect ratio.
+			opts.Width, opts.Height = size.CX, size.CX*cy/cx
+			if opts.Height > height {
+				opts.Width, opts.Height = height*cx/cy, height
+			}
+			opts.OffsetX += (size.CX - opts.Width) / 2
+			opts.OffsetY += (height - opts.Height) / 2
+		}
+		if _, err = f.AddPictureFromBytes(slideID, filepath.Ext(img.path), file, opts); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getMarkdownBodyFrame provides a function to get the offset and size of the
+// body placeholder by given slide id and body placeholder. The placeholder
+// without the transform inherits the offset and size from the layout or the
+// master, and the whole slide is used if neither positions the placeholder.
+func (f *File) getMarkdownBodyFrame(slideID int, body *decodeShape) (Offset, Extents, error) {
+	var xfrm *DecodeXfrm
+	if body.ShapeProperties != nil {
+		xfrm = body.ShapeProperties.Xfrm
+	}
+	if xfrm == nil || xfrm.Offset == nil || xfrm.Extents == nil {
+		slideXMLPath, _ := f.getSlideXMLPath(slideID)
+		var err error
+		if xfrm, err = f.getPlaceholderXfrm(slideXMLPath, body.NonVisualShapeProperties.NonVisualProperties.Ph); err != nil {
+			return Offset{}, Extents{}, err
+		}
+	}
+	if xfrm != nil && xfrm.Offset != nil && xfrm.Extents != nil {
+		return *xfrm.Offset, *xfrm.Extents, nil
+	}
+	width, height, err := f.getSlideSize()
+	return Offset{}, Extents{CX: width, CY: height}, err
+}
+
+// setMarkdownText provides a function to replace the paragraphs of the shape
+// text body by given Markdown paragraphs. The run formatting is derived from
+// the properties of the first paragraph of the shape.
+func setMarkdownText(shape *decodeShape, paragraphs []markdownParagraph, bullets bool) {
+	if shape.TextBody == nil {
+		shape.TextBody = &DecodeTextBody{BodyProperties: &DecodeBodyProperties{}}
+	}
+	var (
+		pPr *ParagraphProperties
+		rPr *DecodeRunProperties
+	)
+	if len(shape.TextBody.Paragraph) > 0 {
+		first := shape.TextBody.Paragraph[0]
+		pPr, rPr = first.ParagraphProperties, first.EndParagraphRunProperties
+		if len(first.Runs) > 0 && first.Runs[0].RunProperties != nil {
+			rPr = first.Runs[0].RunProperties
+		}
+	}
+	shape.TextBody.Paragraph = shape.TextBody.Paragraph[:0]
+	for _, p := range paragraphs {
+		paragraph := DecodeParagraph{ParagraphProperties: pPr, EndParagraphRunProperties: rPr}
+		if bullets {
+			level, align := p.level, "l"
+			paragraph.ParagraphProperties = &ParagraphProperties{Level: &level, Align: &align}
+		}
+		for _, r := range parseMarkdownRuns(p.text) {
+			run := DecodeRuns{Text: r.Text}
+			if rPr != nil {
+				props := *rPr
+				run.RunProperties = &props
+			}
+			if r.RunProperties != nil {
+				if run.RunProperties == nil {
+					run.RunProperties = &DecodeRunProperties{}
+				}
+				run.RunProperties.Bold = r.RunProperties.Bold
+			}
+			paragraph.Runs = append(paragraph.Runs, run)
+		}
+		shape.TextBody.Paragraph = append(shape.TextBody.Paragraph, paragraph)
+	}
+}
+
+// parseMarkdownRuns provides a function to split the Markdown inline text into
+// runs, the strong emphasis will be parsed as bold runs.
+func parseMarkdownRuns(text string) []DecodeRuns {
+	var runs []DecodeRuns
+	for idx, part := range strings.Split(text, "**") {
+		if part == "" {
+			continue
+		}
+		run := DecodeRuns{Text: part}
+		if idx%2 == 1 {
+			bold := 1
+			run.RunProperties = &DecodeRunProperties{Bold: &bold}
+		}
+		runs = append(runs, run)
+	}
+	return runs
+}
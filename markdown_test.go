@@ -0,0 +1,79 @@
+package gopptx
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseMarkdownSlides(t *testing.T) {
+	for _, c := range []struct {
+		name     string
+		markdown string
+		slides   []markdownSlide
+	}{
+		{
+			name:     "thematic break before heading",
+			markdown: "# First\n\n---\n\n# Second\n",
+			slides:   []markdownSlide{{title: "First"}, {title: "Second"}},
+		},
+		{
+			name:     "layout",
+			markdown: "<!-- layout: Title Only -->\n# First\n- Point\n  - Detail\n### Text\n",
+			slides: []markdownSlide{{title: "First", layout: "Title Only", paragraphs: []markdownParagraph{
+				{text: "Point"}, {level: 1, text: "Detail"}, {text: "Text"},
+			}}},
+		},
+		{
+			name:     "notes",
+			markdown: "> Welcome\n## First\n> Say hello\n>\n> Say goodbye\n# Second\n",
+			slides: []markdownSlide{
+				{notes: []string{"Welcome"}},
+				{title: "First", notes: []string{"Say hello", "", "Say goodbye"}},
+				{title: "Second"},
+			},
+		},
+		{
+			name:     "image",
+			markdown: "Text\n![Logo](logo.png \"Logo\")\n",
+			slides: []markdownSlide{{paragraphs: []markdownParagraph{{text: "Text"}},
+				images: []markdownImage{{alt: "Logo", path: "logo.png"}}}},
+		},
+	} {
+		slides, err := parseMarkdownSlides(strings.NewReader(c.markdown))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(slides, c.slides) {
+			t.Errorf("%s: expected the slides %+v, got %+v", c.name, c.slides, slides)
+		}
+	}
+}
+
+func TestBuildFromMarkdown(t *testing.T) {
+	const markdown = "# Intro\n\n- Point\n  - Detail\n\n> Say hello\n>\n> Say goodbye\n\n# Close\n\n> Thanks\n"
+	f, err := BuildFromMarkdown(strings.NewReader(markdown), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 2; i++ {
+		buf, err := f.WriteToBuffer()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if f, err = OpenReader(buf); err != nil {
+			t.Fatal(err)
+		}
+	}
+	var buf bytes.Buffer
+	if err = f.ExportMarkdown(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if buf.String() != markdown {
+		t.Errorf("expected the outline %q, got %q", markdown, buf.String())
+	}
+	if presentation, err := f.presentationReader(); err != nil || presentation.NotesMaster == nil {
+		t.Errorf("expected the notes master of the presentation, got %v", err)
+	}
+}
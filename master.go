@@ -0,0 +1,619 @@
+// Copyright 2026 kenny-not-dead. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+//
+// Package gopptx provides functionality to create and manipulate PowerPoint
+// (.pptx) files in Go, using the Office Open XML (ECMA-376) format.
+
+package gopptx
+
+import (
+	"encoding/xml"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// SlideMaster directly maps the slide master and its layouts in the order of
+// the layout list of the master.
+type SlideMaster struct {
+	Name    string
+	Layouts []SlideLayout
+}
+
+// SlideLayout directly maps the slide layout, the slide IDs are the slides
+// using the layout in the order of the slides, and the layout is unused if
+// there is no slide ID.
+type SlideLayout struct {
+	Name     string
+	Type     string
+	SlideIDs []int
+}
+
+// GetSlideMasters provides a function to get the slide masters of the
+// presentation with their layouts and the slides using each layout. For
+// example, print the unused layouts:
+//
+//	masters, err := f.GetSlideMasters()
+//	if err != nil {
+//	    fmt.Println(err)
+//	    return
+//	}
+//	for _, master := range masters {
+//	    for _, layout := range master.Layouts {
+//	        if len(layout.SlideIDs) == 0 {
+//	            fmt.Println(master.Name, layout.Name)
+//	        }
+//	    }
+//	}
+func (f *File) GetSlideMasters() ([]SlideMaster, error) {
+	used := map[string][]int{}
+	for _, slideID := range f.GetSlideList() {
+		slideXMLPath, _ := f.getSlideXMLPath(slideID)
+		if layoutXMLPath, ok := f.getRelTargetByType(slideXMLPath, SourceRelationshipSlideLayout); ok {
+			used[layoutXMLPath] = append(used[layoutXMLPath], slideID)
+		}
+	}
+	var masters []SlideMaster
+	for _, masterXMLPath := range f.getSlideMasterPaths() {
+		root, err := f.xmlNodeReader(f.readXML(masterXMLPath))
+		if err != nil {
+			return masters, err
+		}
+		master := SlideMaster{Name: root.find("cSld").attr("name")}
+		for _, layoutXMLPath := range f.getMasterLayoutPaths(masterXMLPath, root) {
+			layout, err := f.slideLayoutReader(layoutXMLPath)
+			if err != nil {
+				return masters, err
+			}
+			master.Layouts = append(master.Layouts, SlideLayout{
+				Name:     layout.CommonSlideData.Name,
+				Type:     layout.Type,
+				SlideIDs: used[layoutXMLPath],
+			})
+		}
+		masters = append(masters, master)
+	}
+	return masters, nil
+}
+
+// SetSlideMasterName provides a function to rename the slide master by given
+// zero-based index of the master in the order of the master parts and the
+// new name. For example:
+//
+//	err := f.SetSlideMasterName(0, "Corporate")
+func (f *File) SetSlideMasterName(index int, name string) error {
+	if err := f.checkReadOnly(); err != nil {
+		return err
+	}
+	paths := f.getSlideMasterPaths()
+	if index < 0 || index >= len(paths) {
+		return ErrSlideMasterNotExist{index}
+	}
+	return f.setCommonSlideDataName(paths[index], name)
+}
+
+// SetSlideLayoutName provides a function to rename the slide layout by given
+// layout name or layout type, case-insensitive, and the new name. For
+// example:
+//
+//	err := f.SetSlideLayoutName("Title and Content", "Agenda")
+func (f *File) SetSlideLayoutName(layoutName, name string) error {
+	if err := f.checkReadOnly(); err != nil {
+		return err
+	}
+	layoutXMLPath, err := f.getSlideLayoutPath(layoutName)
+	if err != nil {
+		return err
+	}
+	return f.setCommonSlideDataName(layoutXMLPath, name)
+}
+
+// ImportSlideMaster provides a function to copy the slide master by given
+// source presentation and zero-based index of the master in the source
+// presentation, with its theme and layouts, and returns the zero-based index
+// of the imported master in the presentation. The source presentation can be
+// the presentation itself to duplicate the master. The slides can be
+// assigned to the layouts of the imported master by the Master option of
+// the NewSlide and SetSlideLayout functions. For example, import the second
+// master of the template and create a slide with its title layout:
+//
+//	tpl, err := gopptx.OpenFile("template.pptx")
+//	if err != nil {
+//	    fmt.Println(err)
+//	    return
+//	}
+//	idx, err := f.ImportSlideMaster(tpl, 1)
+//	if err != nil {
+//	    fmt.Println(err)
+//	    return
+//	}
+//	slideID, err := f.NewSlide(&gopptx.NewSlideOptions{Master: &idx, Layout: "Title Slide"})
+func (f *File) ImportSlideMaster(src *File, index int) (int, error) {
+	if err := f.checkReadOnly(); err != nil {
+		return -1, err
+	}
+	srcMasters := src.getSlideMasterPaths()
+	if index < 0 || index >= len(srcMasters) {
+		return -1, ErrSlideMasterNotExist{index}
+	}
+	srcMasterXMLPath := srcMasters[index]
+	srcRoot, err := src.xmlNodeReader(src.readXML(srcMasterXMLPath))
+	if err != nil {
+		return -1, err
+	}
+	srcLayouts := src.getMasterLayoutPaths(srcMasterXMLPath, srcRoot)
+	presentation, err := f.presentationReader()
+	if err != nil {
+		return -1, err
+	}
+	id, err := f.getMaxSlideMasterID()
+	if err != nil {
+		return -1, err
+	}
+	masterXMLPath := f.getNewPartName(srcMasterXMLPath)
+	imported := map[string]string{srcMasterXMLPath: masterXMLPath}
+	srcRoot.remove(srcRoot.prefix(NameSpacePresentationMLMain) + "sldLayoutIdLst")
+	f.saveFileList(masterXMLPath, srcRoot.bytes())
+	if err = f.importContentType(src, srcMasterXMLPath, masterXMLPath); err != nil {
+		return -1, err
+	}
+	rels := &relationships{}
+	for _, rel := range getRelationships(src.getRels(getPartRelsPath(srcMasterXMLPath))) {
+		if rel.Type == SourceRelationshipSlideLayout {
+			continue
+		}
+		if rel.TargetMode != "External" {
+			target, err := f.importPart(src, resolveRelTarget(srcMasterXMLPath, rel.Target), imported)
+			if err != nil {
+				return -1, err
+			}
+			rel.Target = getRelativeTarget(masterXMLPath, target)
+		}
+		rels.Relationships = append(rels.Relationships, rel)
+	}
+	f.Relationships.Store(getPartRelsPath(masterXMLPath), rels)
+	rID := f.addRels(f.getPresentationRelsPath(), SourceRelationshipSlideMaster,
+		getRelativeTarget(f.getPresentationPath(), masterXMLPath), "")
+	presentation.MasterSlide.MasterSlide = append(presentation.MasterSlide.MasterSlide,
+		decodeSlideID{RelationshipID: rID, SlideID: id + 1})
+	for _, srcLayoutXMLPath := range srcLayouts {
+		if _, err = f.importSlideLayoutPart(src, srcLayoutXMLPath, masterXMLPath, imported); err != nil {
+			return -1, err
+		}
+	}
+	return slices.Index(f.getSlideMasterPaths(), masterXMLPath), nil
+}
+
+// MoveSlideLayout provides a function to move the slide layout by given
+// layout name or layout type, case-insensitive, to the slide master by given
+// zero-based index of the master, the slides using the layout keep using it
+// and inherit the design of the new master. For example:
+//
+//	err := f.MoveSlideLayout("Title Only", 1)
+func (f *File) MoveSlideLayout(layoutName string, index int) error {
+	if err := f.checkReadOnly(); err != nil {
+		return err
+	}
+	masters := f.getSlideMasterPaths()
+	if index < 0 || index >= len(masters) {
+		return ErrSlideMasterNotExist{index}
+	}
+	layoutXMLPath, err := f.getSlideLayoutPath(layoutName)
+	if err != nil {
+		return err
+	}
+	masterXMLPath := masters[index]
+	if oldMasterXMLPath, ok := f.getRelTargetByType(layoutXMLPath, SourceRelationshipSlideMaster); ok {
+		if oldMasterXMLPath == masterXMLPath {
+			return nil
+		}
+		if err = f.deleteSlideLayoutID(oldMasterXMLPath, layoutXMLPath); err != nil {
+			return err
+		}
+	}
+	target, found := getRelativeTarget(layoutXMLPath, masterXMLPath), false
+	if rels := f.getRels(getPartRelsPath(layoutXMLPath)); rels != nil {
+		rels.mu.Lock()
+		for idx, rel := range rels.Relationships {
+			if rel.Type == SourceRelationshipSlideMaster {
+				rels.Relationships[idx].Target, found = target, true
+			}
+		}
+		rels.mu.Unlock()
+	}
+	if !found {
+		f.addRels(getPartRelsPath(layoutXMLPath), SourceRelationshipSlideMaster, target, "")
+	}
+	return f.addSlideLayoutID(masterXMLPath, layoutXMLPath)
+}
+
+// deleteSlideLayoutID provides a function to remove the layout by given
+// layout part name from the layout list of the slide master by given master
+// part name, along with the relationship of the master to the layout.
+func (f *File) deleteSlideLayoutID(masterXMLPath, layoutXMLPath string) error {
+	root, err := f.xmlNodeReader(f.readXML(masterXMLPath))
+	if err != nil {
+		return err
+	}
+	list := root.find("sldLayoutIdLst")
+	if list == nil {
+		return nil
+	}
+	for _, layoutID := range list.findAll("sldLayoutId") {
+		for _, attr := range layoutID.Attr {
+			if localName(attr.Name.Local) != "id" || attr.Name.Local == "id" {
+				continue
+			}
+			if target, ok := f.getRelTarget(masterXMLPath, attr.Value); ok && target == layoutXMLPath {
+				list.Children = slices.DeleteFunc(list.Children, func(child *xmlNode) bool { return child == layoutID })
+				f.deleteRels(getPartRelsPath(masterXMLPath), attr.Value)
+			}
+		}
+	}
+	f.saveFileList(masterXMLPath, root.bytes())
+	return nil
+}
+
+// getMasterSlideLayoutPath provides a function to get the part path of the
+// slide layout by given zero-based index of the slide master and the layout
+// name or layout type, case-insensitive. The layouts of all masters are
+// searched if the index is nil, and the first layout of the master is
+// returned if the name is empty.
+func (f *File) getMasterSlideLayoutPath(master *int, name string) (string, error) {
+	if master == nil {
+		return f.getSlideLayoutPath(name)
+	}
+	masters := f.getSlideMasterPaths()
+	if *master < 0 || *master >= len(masters) {
+		return "", ErrSlideMasterNotExist{*master}
+	}
+	root, err := f.xmlNodeReader(f.readXML(masters[*master]))
+	if err != nil {
+		return "", err
+	}
+	for _, layoutXMLPath := range f.getMasterLayoutPaths(masters[*master], root) {
+		layout, err := f.slideLayoutReader(layoutXMLPath)
+		if err != nil {
+			return "", err
+		}
+		if name == "" || strings.EqualFold(layout.CommonSlideData.Name, name) || strings.EqualFold(layout.Type, name) {
+			return layoutXMLPath, nil
+		}
+	}
+	return "", ErrLayoutNotExist{name}
+}
+
+// RemoveUnusedLayouts provides a function to remove the slide layouts which
+// are not used by any slide, along with their relationships, content types
+// and the parts only used by them, such as the pictures. The slide masters
+// left without layouts are also removed, but the presentation keeps at least
+// one slide master. It returns the number of the removed layouts. For
+// example:
+//
+//	removed, err := f.RemoveUnusedLayouts()
+func (f *File) RemoveUnusedLayouts() (int, error) {
+	if err := f.checkReadOnly(); err != nil {
+		return 0, err
+	}
+	used := map[string]bool{}
+	for _, slideID := range f.GetSlideList() {
+		slideXMLPath, _ := f.getSlideXMLPath(slideID)
+		if layoutXMLPath, ok := f.getRelTargetByType(slideXMLPath, SourceRelationshipSlideLayout); ok {
+			used[layoutXMLPath] = true
+		}
+	}
+	var (
+		removed int
+		empty   []string
+		masters = f.getSlideMasterPaths()
+	)
+	for _, masterXMLPath := range masters {
+		root, err := f.xmlNodeReader(f.readXML(masterXMLPath))
+		if err != nil {
+			return removed, err
+		}
+		list := root.find("sldLayoutIdLst")
+		var count, kept int
+		for _, layoutID := range list.findAll("sldLayoutId") {
+			var rID string
+			for _, attr := range layoutID.Attr {
+				if localName(attr.Name.Local) == "id" && attr.Name.Local != "id" {
+					rID = attr.Value
+				}
+			}
+			layoutXMLPath, ok := f.getRelTarget(masterXMLPath, rID)
+			if !ok || used[layoutXMLPath] {
+				kept++
+				continue
+			}
+			list.Children = slices.DeleteFunc(list.Children, func(child *xmlNode) bool { return child == layoutID })
+			f.deleteRels(getPartRelsPath(masterXMLPath), rID)
+			f.deletePart(layoutXMLPath)
+			count++
+		}
+		if count > 0 {
+			f.saveFileList(masterXMLPath, root.bytes())
+			removed += count
+		}
+		if kept == 0 {
+			empty = append(empty, masterXMLPath)
+		}
+	}
+	if len(empty) == len(masters) {
+		empty = empty[1:]
+	}
+	for _, masterXMLPath := range empty {
+		if err := f.deleteSlideMaster(masterXMLPath); err != nil {
+			return removed, err
+		}
+	}
+	return removed, nil
+}
+
+// deleteSlideMaster provides a function to remove the slide master by given
+// master part path from the presentation and the master list of the
+// presentation, the master list will refer to the first remaining master if
+// it becomes empty.
+func (f *File) deleteSlideMaster(masterXMLPath string) error {
+	presentation, err := f.presentationReader()
+	if err != nil {
+		return err
+	}
+	presentationXMLPath, presentationRelsPath := f.getPresentationPath(), f.getPresentationRelsPath()
+	var removedRID, keptRID string
+	for _, rel := range getRelationships(f.getRels(presentationRelsPath)) {
+		if rel.Type != SourceRelationshipSlideMaster {
+			continue
+		}
+		if resolveRelTarget(presentationXMLPath, rel.Target) == masterXMLPath {
+			removedRID = rel.ID
+			f.deleteRels(presentationRelsPath, rel.ID)
+		} else if keptRID == "" {
+			keptRID = rel.ID
+		}
+	}
+	f.deletePart(masterXMLPath)
+	if removedRID == "" {
+		return nil
+	}
+	masters := presentation.MasterSlide.MasterSlide
+	idx := slices.IndexFunc(masters, func(master decodeSlideID) bool { return master.RelationshipID == removedRID })
+	if idx == -1 {
+		return nil
+	}
+	if len(masters) > 1 {
+		presentation.MasterSlide.MasterSlide = slices.Delete(masters, idx, idx+1)
+		return nil
+	}
+	if keptRID == "" {
+		for _, name := range f.getSlideMasterPaths() {
+			keptRID = f.addRels(presentationRelsPath, SourceRelationshipSlideMaster,
+				getRelativeTarget(presentationXMLPath, name), "")
+			break
+		}
+	}
+	masters[idx].RelationshipID = keptRID
+	return nil
+}
+
+// getRels provides a function to get the relationships by given
+// relationships part path, nil will be returned if the part doesn't exist.
+func (f *File) getRels(relPath string) *relationships {
+	rels, _ := f.relsReader(relPath)
+	return rels
+}
+
+// deletePart provides a function to remove the part by given part name with
+// its relationships and content type. The parts related by the removed part
+// are also removed if no other part refers to them, except the slides, the
+// layouts and the masters.
+func (f *File) deletePart(partName string) {
+	relPath := getPartRelsPath(partName)
+	rels := getRelationships(f.getRels(relPath))
+	f.Pkg.Delete(partName)
+	f.deleteTempFile(partName)
+	f.Pkg.Delete(relPath)
+	f.Relationships.Delete(relPath)
+	_ = f.removeContentTypeOverride(partName)
+	_ = f.removeContentTypeOverride(relPath)
+	for _, rel := range rels {
+		switch rel.Type {
+		case SourceRelationshipSlide, SourceRelationshipSlideLayout, SourceRelationshipSlideMaster:
+			continue
+		}
+		if target := resolveRelTarget(partName, rel.Target); rel.TargetMode != "External" && !f.isPartReferenced(target) {
+			f.deletePart(target)
+		}
+	}
+}
+
+// setCommonSlideDataName provides a function to set the name of the common
+// slide data of the master or layout by given part path and name.
+func (f *File) setCommonSlideDataName(partName, name string) error {
+	root, err := f.xmlNodeReader(f.readXML(partName))
+	if err != nil {
+		return err
+	}
+	cSld := root.find("cSld")
+	if cSld == nil {
+		return nil
+	}
+	if name == "" {
+		cSld.removeAttr("name")
+	} else {
+		cSld.setAttr("name", name)
+	}
+	f.saveFileList(partName, root.bytes())
+	return nil
+}
+
+// getSlideMasterPaths provides a function to get the part paths of all slide
+// masters in the presentation, ordered by the master part number.
+func (f *File) getSlideMasterPaths() []string {
+	var paths []string
+	f.Pkg.Range(func(k, v interface{}) bool {
+		name := k.(string)
+		if strings.HasPrefix(name, "ppt/slideMasters/slideMaster") && strings.HasSuffix(name, ".xml") {
+			paths = append(paths, name)
+		}
+		return true
+	})
+	number := func(name string) int {
+		n, _ := strconv.Atoi(strings.TrimSuffix(strings.TrimPrefix(name, "ppt/slideMasters/slideMaster"), ".xml"))
+		return n
+	}
+	sort.Slice(paths, func(i, j int) bool { return number(paths[i]) < number(paths[j]) })
+	return paths
+}
+
+// getMasterLayoutPaths provides a function to get the part paths of the
+// layouts in the layout list of the slide master by given master part path
+// and the element tree of the master.
+func (f *File) getMasterLayoutPaths(masterXMLPath string, root *xmlNode) []string {
+	var paths []string
+	for _, layoutID := range root.find("sldLayoutIdLst").findAll("sldLayoutId") {
+		var rID string
+		for _, attr := range layoutID.Attr {
+			if localName(attr.Name.Local) == "id" && attr.Name.Local != "id" {
+				rID = attr.Value
+			}
+		}
+		if layoutXMLPath, ok := f.getRelTarget(masterXMLPath, rID); ok {
+			paths = append(paths, layoutXMLPath)
+		}
+	}
+	return paths
+}
+
+// getMasterPaths provides a function to get the part paths of all slide
+// masters, notes masters and handout masters in the presentation.
+func (f *File) getMasterPaths() []string {
+	paths := f.getSlideMasterPaths()
+	for _, name := range f.getPartNames() {
+		if (strings.HasPrefix(name, "ppt/notesMasters/") || strings.HasPrefix(name, "ppt/handoutMasters/")) &&
+			strings.HasSuffix(name, ".xml") {
+			paths = append(paths, name)
+		}
+	}
+	return paths
+}
+
+// masterThemeWriter provides a function to repair the themes and the color
+// mappings of the masters before saving the presentation, so PowerPoint
+// doesn't disregard the edits of the masters and the themes.
+func (f *File) masterThemeWriter() error {
+	_, err := f.checkMasterThemes(true)
+	return err
+}
+
+// checkMasterThemes provides a function to check that each master refers to
+// an existing theme which isn't shared with the other masters, and has the
+// complete color mapping to the theme colors, and returns the found problems.
+// The problems are repaired if fix is true: the master without the theme
+// gets a copy of the theme of the first master or the default theme, the
+// master sharing the theme gets a copy of the theme, and the missing or
+// invalid color mappings are set to the default mapping.
+func (f *File) checkMasterThemes(fix bool) ([]error, error) {
+	var problems []error
+	used, defaultMap := map[string]string{}, getColorMap()
+	for _, masterXMLPath := range f.getMasterPaths() {
+		themeXMLPath, ok := f.getRelTargetByType(masterXMLPath, SourceRelationshipTheme)
+		switch {
+		case !ok || len(f.readXML(themeXMLPath)) == 0:
+			problems = append(problems, fmt.Errorf("master %s has no theme", masterXMLPath))
+			if fix {
+				if err := f.setMasterTheme(masterXMLPath, used); err != nil {
+					return problems, err
+				}
+				f.logger().Warn("added the missing theme of the master", "part", masterXMLPath)
+			}
+		case used[themeXMLPath] != "":
+			problems = append(problems, fmt.Errorf("theme %s is shared by masters %s and %s",
+				themeXMLPath, used[themeXMLPath], masterXMLPath))
+			if fix {
+				if err := f.setMasterTheme(masterXMLPath, used); err != nil {
+					return problems, err
+				}
+				f.logger().Warn("copied the shared theme of the master", "part", masterXMLPath, "theme", themeXMLPath)
+			}
+		default:
+			used[themeXMLPath] = masterXMLPath
+		}
+		root, err := f.xmlNodeReader(f.readXML(masterXMLPath))
+		if err != nil {
+			return problems, err
+		}
+		clrMap, missing, repaired := root.find("clrMap"), false, false
+		if clrMap == nil {
+			problems = append(problems, fmt.Errorf("master %s has no color mapping", masterXMLPath))
+			p := root.prefix(NameSpacePresentationML.Value)
+			clrMap, missing = newXMLNode(p+"clrMap"), true
+			root.insert(clrMap, p+"sldLayoutIdLst", p+"transition", p+"timing", p+"hf", p+"txStyles", p+"notesStyle", p+"extLst")
+		}
+		for _, name := range colorMapNames {
+			if value := clrMap.attr(name); inStrSlice(themeColorNames, value, true) == -1 {
+				if !missing {
+					problems = append(problems, fmt.Errorf("master %s has invalid color mapping %s=%q", masterXMLPath, name, value))
+				}
+				clrMap.setAttr(name, defaultMap[name])
+				repaired = true
+			}
+		}
+		if repaired && fix {
+			f.saveFileList(masterXMLPath, root.bytes())
+			f.logger().Warn("repaired the color mapping of the master", "part", masterXMLPath)
+		}
+	}
+	return problems, nil
+}
+
+// setMasterTheme provides a function to set a new theme of the master by
+// given master part path and the themes used by the masters, the new theme is
+// the copy of the theme of the first master or the default theme.
+func (f *File) setMasterTheme(masterXMLPath string, used map[string]string) error {
+	var themeXMLPath string
+	for _, name := range f.getMasterPaths() {
+		if target, ok := f.getRelTargetByType(name, SourceRelationshipTheme); ok && used[target] != "" {
+			themeXMLPath = target
+			break
+		}
+	}
+	var name string
+	if themeXMLPath != "" {
+		imported := map[string]string{}
+		for _, rel := range getRelationships(f.getRels(getPartRelsPath(themeXMLPath))) {
+			if rel.TargetMode != "External" {
+				target := resolveRelTarget(themeXMLPath, rel.Target)
+				imported[target] = target
+			}
+		}
+		var err error
+		if name, err = f.importPart(f, themeXMLPath, imported); err != nil {
+			return err
+		}
+	} else {
+		name = f.getNewPartName(defaultXMLPathTheme)
+		f.Pkg.Store(name, []byte(xml.Header+templateTheme))
+		if err := f.setContentTypes("/"+name, ContentTypeTheme); err != nil {
+			return err
+		}
+	}
+	used[name] = masterXMLPath
+	target := getRelativeTarget(masterXMLPath, name)
+	if rels := f.getRels(getPartRelsPath(masterXMLPath)); rels != nil {
+		rels.mu.Lock()
+		for i, rel := range rels.Relationships {
+			if rel.Type == SourceRelationshipTheme {
+				rels.Relationships[i].Target = target
+				rels.mu.Unlock()
+				return nil
+			}
+		}
+		rels.mu.Unlock()
+	}
+	f.addRels(getPartRelsPath(masterXMLPath), SourceRelationshipTheme, target, "")
+	return nil
+}
@@ -0,0 +1,270 @@
+package gopptx
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestSetSlideMasterName(t *testing.T) {
+	f := NewFile()
+	slideID := f.GetSlideList()[0]
+	nextSlideID, err := f.NewSlide()
+	if err != nil {
+		t.Fatal(err)
+	}
+	masters, err := f.GetSlideMasters()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []SlideMaster{{Layouts: []SlideLayout{{Name: "Default", Type: "title", SlideIDs: []int{slideID, nextSlideID}}}}}
+	if !reflect.DeepEqual(masters, expected) {
+		t.Errorf("expected the masters %v, got %v", expected, masters)
+	}
+
+	if err = f.SetSlideMasterName(0, "Corporate & Co"); err != nil {
+		t.Fatal(err)
+	}
+	if err = f.SetSlideLayoutName("TITLE", "Agenda"); err != nil {
+		t.Fatal(err)
+	}
+	buf, err := f.WriteToBuffer()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f, err = OpenReader(buf); err != nil {
+		t.Fatal(err)
+	}
+	if masters, err = f.GetSlideMasters(); err != nil {
+		t.Fatal(err)
+	}
+	expected = []SlideMaster{{Name: "Corporate & Co", Layouts: []SlideLayout{{Name: "Agenda", Type: "title", SlideIDs: []int{slideID, nextSlideID}}}}}
+	if !reflect.DeepEqual(masters, expected) {
+		t.Errorf("expected the masters %v, got %v", expected, masters)
+	}
+	if name, err := f.GetSlideLayoutName(slideID); err != nil || name != "Agenda" {
+		t.Errorf("expected the layout Agenda, got %q %v", name, err)
+	}
+
+	for _, c := range []struct {
+		err      error
+		expected error
+	}{
+		{f.SetSlideMasterName(1, "Missing"), ErrSlideMasterNotExist{1}},
+		{f.SetSlideMasterName(-1, "Missing"), ErrSlideMasterNotExist{-1}},
+		{f.SetSlideLayoutName("Default", "Missing"), ErrLayoutNotExist{"Default"}},
+	} {
+		if !errors.Is(c.err, c.expected) {
+			t.Errorf("expected %v, got %v", c.expected, c.err)
+		}
+	}
+}
+
+func TestRemoveUnusedLayouts(t *testing.T) {
+	src := NewFile()
+	// Make the source layout different from the layout of the presentation
+	srcLayout := "ppt/slideLayouts/slideLayout1.xml"
+	src.Pkg.Store(srcLayout, bytes.Replace(src.readBytes(srcLayout), []byte(`name="Default"`), []byte(`name="Imported"`), 1))
+	f := NewFile()
+	slideID, err := f.ImportSlide(src, src.GetSlideList()[0], nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, c := range []struct {
+		deleted  bool
+		removed  int
+		expected []SlideLayout
+	}{
+		{removed: 0, expected: []SlideLayout{{Name: "Default", Type: "title", SlideIDs: []int{256}}, {Name: "Imported", Type: "title", SlideIDs: []int{slideID}}}},
+		{deleted: true, removed: 1, expected: []SlideLayout{{Name: "Default", Type: "title", SlideIDs: []int{256}}}},
+	} {
+		if c.deleted {
+			if err = f.DeleteSlide(slideID); err != nil {
+				t.Fatal(err)
+			}
+		}
+		removed, err := f.RemoveUnusedLayouts()
+		if err != nil || removed != c.removed {
+			t.Errorf("expected %d removed layouts, got %d %v", c.removed, removed, err)
+		}
+		masters, err := f.GetSlideMasters()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(masters) != 1 || !reflect.DeepEqual(masters[0].Layouts, c.expected) {
+			t.Errorf("expected the layouts %v, got %v", c.expected, masters)
+		}
+	}
+	for _, name := range []string{"ppt/slideLayouts/slideLayout2.xml", "ppt/slideLayouts/_rels/slideLayout2.xml.rels"} {
+		if _, ok := f.Pkg.Load(name); ok {
+			t.Errorf("the part %s of the unused layout isn't removed", name)
+		}
+	}
+	if bytes.Contains(f.readBytes(defaultXMLPathContentTypes), []byte("slideLayout2.xml")) {
+		t.Error("the content type of the unused layout isn't removed")
+	}
+	if err = f.Validate(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCheckMasterThemes(t *testing.T) {
+	const masterXMLPath = "ppt/slideMasters/slideMaster1.xml"
+	f, err := openTestFile(t, func(name string, content []byte) []byte {
+		switch name {
+		case masterXMLPath:
+			return bytes.Replace(content, []byte(`bg1="lt1"`), []byte(`bg1="background"`), 1)
+		case getPartRelsPath(masterXMLPath):
+			return regexp.MustCompile(`<Relationship Id="rId1"[^>]*(/>|></Relationship>)`).ReplaceAll(content, nil)
+		}
+		return content
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	slideID := f.GetSlideList()[0]
+	if err = f.addNotesSlide(slideID, []string{"Notes"}); err != nil {
+		t.Fatal(err)
+	}
+	notesMasterXMLPath, err := f.getNotesMasterPath()
+	if err != nil {
+		t.Fatal(err)
+	}
+	notesThemeXMLPath, _ := f.getRelTargetByType(notesMasterXMLPath, SourceRelationshipTheme)
+	for _, expected := range []string{
+		"master " + masterXMLPath + " has no theme",
+		"master " + masterXMLPath + ` has invalid color mapping bg1="background"`,
+	} {
+		if err = f.Validate(); err == nil || !strings.Contains(err.Error(), expected) {
+			t.Errorf("expected the problem %s, got %v", expected, err)
+		}
+	}
+
+	buf, err := f.WriteToBuffer()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f, err = OpenReader(buf); err != nil {
+		t.Fatal(err)
+	}
+	if err = f.Validate(); err != nil {
+		t.Errorf("expected the repaired masters, got %v", err)
+	}
+	themeXMLPath, ok := f.getRelTargetByType(masterXMLPath, SourceRelationshipTheme)
+	if !ok || themeXMLPath == notesThemeXMLPath || !bytes.Equal(f.readXML(themeXMLPath), f.readXML(notesThemeXMLPath)) {
+		t.Errorf("expected the copy of the theme %s, got %s", notesThemeXMLPath, themeXMLPath)
+	}
+	if !bytes.Contains(f.readXML(masterXMLPath), []byte(`bg1="lt1"`)) {
+		t.Errorf("expected the default color mapping of bg1, got %s", f.readXML(masterXMLPath))
+	}
+
+	f.addRels(getPartRelsPath(notesMasterXMLPath), SourceRelationshipTheme, getRelativeTarget(notesMasterXMLPath, themeXMLPath), "")
+	if rels := f.getRels(getPartRelsPath(notesMasterXMLPath)); rels != nil {
+		rels.Relationships = rels.Relationships[1:]
+	}
+	expected := "theme " + themeXMLPath + " is shared by masters " + masterXMLPath + " and " + notesMasterXMLPath
+	if err = f.Validate(); err == nil || err.Error() != expected {
+		t.Errorf("expected the problem %s, got %v", expected, err)
+	}
+	if _, err = f.WriteToBuffer(); err != nil {
+		t.Fatal(err)
+	}
+	if err = f.Validate(); err != nil {
+		t.Errorf("expected the copied theme of the notes master, got %v", err)
+	}
+}
+
+func TestImportSlideMaster(t *testing.T) {
+	src := NewFile()
+	if err := src.SetSlideMasterName(0, "Brand"); err != nil {
+		t.Fatal(err)
+	}
+	if err := src.SetSlideLayoutName("Default", "Agenda"); err != nil {
+		t.Fatal(err)
+	}
+	f := NewFile()
+	slideID := f.GetSlideList()[0]
+	idx, err := f.ImportSlideMaster(src, 0)
+	if err != nil || idx != 1 {
+		t.Fatalf("expected the imported master 1, got %d %v", idx, err)
+	}
+	nextSlideID, err := f.NewSlide(&NewSlideOptions{Master: &idx})
+	if err != nil {
+		t.Fatal(err)
+	}
+	thirdSlideID, err := f.NewSlide()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = f.SetSlideLayout(thirdSlideID, "Agenda", &SlideLayoutOptions{Master: &idx}); err != nil {
+		t.Fatal(err)
+	}
+	buf, err := f.WriteToBuffer()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f, err = OpenReader(buf); err != nil {
+		t.Fatal(err)
+	}
+	masters, err := f.GetSlideMasters()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []SlideMaster{
+		{Layouts: []SlideLayout{{Name: "Default", Type: "title", SlideIDs: []int{slideID}}}},
+		{Name: "Brand", Layouts: []SlideLayout{{Name: "Agenda", Type: "title", SlideIDs: []int{nextSlideID, thirdSlideID}}}},
+	}
+	if !reflect.DeepEqual(masters, expected) {
+		t.Errorf("expected the masters %v, got %v", expected, masters)
+	}
+	if err = f.Validate(); err != nil {
+		t.Error(err)
+	}
+	themes := map[string]bool{}
+	for _, masterXMLPath := range f.getSlideMasterPaths() {
+		themeXMLPath, _ := f.getRelTargetByType(masterXMLPath, SourceRelationshipTheme)
+		themes[themeXMLPath] = true
+	}
+	if len(themes) != 2 {
+		t.Errorf("expected the imported master has its own theme, got %v", themes)
+	}
+
+	if err = f.MoveSlideLayout("Agenda", 0); err != nil {
+		t.Fatal(err)
+	}
+	if masters, err = f.GetSlideMasters(); err != nil {
+		t.Fatal(err)
+	}
+	expected = []SlideMaster{
+		{Layouts: []SlideLayout{
+			{Name: "Default", Type: "title", SlideIDs: []int{slideID}},
+			{Name: "Agenda", Type: "title", SlideIDs: []int{nextSlideID, thirdSlideID}},
+		}},
+		{Name: "Brand"},
+	}
+	if !reflect.DeepEqual(masters, expected) {
+		t.Errorf("expected the masters %v, got %v", expected, masters)
+	}
+	if err = f.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	missing := 2
+	for _, c := range []struct {
+		err      error
+		expected error
+	}{
+		{func() error { _, err := f.ImportSlideMaster(src, 1); return err }(), ErrSlideMasterNotExist{1}},
+		{func() error { _, err := f.NewSlide(&NewSlideOptions{Master: &missing}); return err }(), ErrSlideMasterNotExist{2}},
+		{f.SetSlideLayout(slideID, "Default", &SlideLayoutOptions{Master: &idx}), ErrLayoutNotExist{"Default"}},
+		{f.MoveSlideLayout("Agenda", 2), ErrSlideMasterNotExist{2}},
+		{f.MoveSlideLayout("Missing", 0), ErrLayoutNotExist{"Missing"}},
+	} {
+		if !errors.Is(c.err, c.expected) {
+			t.Errorf("expected %v, got %v", c.expected, c.err)
+		}
+	}
+}
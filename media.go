@@ -0,0 +1,530 @@
+// Copyright 2026 kenny-not-dead. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+//
+// Package gopptx provides functionality to create and manipulate PowerPoint
+// (.pptx) files in Go, using the Office Open XML (ECMA-376) format.
+
+package gopptx
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/xml"
+	"image"
+	"image/draw"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"iter"
+	"math"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// defaultImageQuality is the JPEG quality of the re-encoded images if the
+// ImageQuality of the options is not specified.
+const defaultImageQuality = 85
+
+// MediaFile directly maps the media part of the presentation. The name is
+// the file name of the part with the original extension, the path is the
+// part name in the package, and the slide IDs are the slides referencing the
+// media in the order of the slides.
+type MediaFile struct {
+	Name     string
+	Path     string
+	SlideIDs []int
+}
+
+// ExtractMedia provides a function to write every media part of the
+// presentation into the directory by given directory path with the original
+// file names, the directory will be created if it doesn't exist. It returns
+// the manifest of the extracted files. For example:
+//
+//	files, err := f.ExtractMedia("media")
+//	if err != nil {
+//	    fmt.Println(err)
+//	    return
+//	}
+//	for _, file := range files {
+//	    fmt.Println(file.Name, file.SlideIDs)
+//	}
+func (f *File) ExtractMedia(dir string) ([]MediaFile, error) {
+	if err := os.MkdirAll(filepath.Clean(dir), os.ModePerm); err != nil {
+		return nil, err
+	}
+	var files []MediaFile
+	for file, data := range f.MediaFiles() {
+		if err := os.WriteFile(filepath.Join(dir, file.Name), data, 0o644); err != nil {
+			return files, err
+		}
+		files = append(files, file)
+	}
+	return files, nil
+}
+
+// MediaFiles returns an iterator over the media parts of the presentation
+// and their data, in the order of the part names. For example:
+//
+//	for file, data := range f.MediaFiles() {
+//	    fmt.Println(file.Name, len(data), file.SlideIDs)
+//	}
+func (f *File) MediaFiles() iter.Seq2[MediaFile, []byte] {
+	return func(yield func(MediaFile, []byte) bool) {
+		refs := f.getMediaSlideIDs()
+		for _, name := range f.getMediaPartNames() {
+			file := MediaFile{Name: path.Base(name), Path: name, SlideIDs: refs[name]}
+			if !yield(file, f.readPart(name)) {
+				return
+			}
+		}
+	}
+}
+
+// getMediaPartNames provides a function to get the sorted part names of the
+// media parts, including the large media parts kept in the temporary files.
+func (f *File) getMediaPartNames() []string {
+	var names []string
+	collect := func(k, v interface{}) bool {
+		if name := k.(string); isMediaPart(name) && !slices.Contains(names, name) {
+			names = append(names, name)
+		}
+		return true
+	}
+	f.Pkg.Range(collect)
+	f.tempFiles.Range(collect)
+	sort.Strings(names)
+	return names
+}
+
+// getMediaSlideIDs provides a function to get the slide IDs referencing each
+// media part, the key of the map is the part name of the media.
+func (f *File) getMediaSlideIDs() map[string][]int {
+	refs := map[string][]int{}
+	for _, slideID := range f.GetSlideList() {
+		slideXMLPath, _ := f.getSlideXMLPath(slideID)
+		rels, _ := f.relsReader(getPartRelsPath(slideXMLPath))
+		if rels == nil {
+			continue
+		}
+		rels.mu.Lock()
+		for _, rel := range rels.Relationships {
+			if rel.TargetMode == "External" {
+				continue
+			}
+			if target := resolveRelTarget(slideXMLPath, rel.Target); path.Dir(target) == "ppt/media" && !slices.Contains(refs[target], slideID) {
+				refs[target] = append(refs[target], slideID)
+			}
+		}
+		rels.mu.Unlock()
+	}
+	return refs
+}
+
+// DeduplicateMedia provides a function to collapse the byte-identical media
+// parts of the presentation into one part, the relationships referring to
+// the duplicates are rewritten to the kept part, which is the first one in
+// the order of the part names. It returns the number of the removed parts.
+// Set the DeduplicateMedia of the options to deduplicate the media in the
+// saved package without changing the presentation. For example:
+//
+//	removed, err := f.DeduplicateMedia()
+//	if err != nil {
+//	    fmt.Println(err)
+//	    return
+//	}
+//	fmt.Println(removed)
+func (f *File) DeduplicateMedia() (int, error) {
+	if err := f.checkReadOnly(); err != nil {
+		return 0, err
+	}
+	replaced, err := f.getDuplicateMedia()
+	if err != nil || len(replaced) == 0 {
+		return 0, err
+	}
+	for _, name := range f.getPartNames() {
+		if !strings.HasSuffix(name, ".rels") {
+			continue
+		}
+		rels, _ := f.relsReader(name)
+		if rels == nil {
+			continue
+		}
+		source := strings.TrimSuffix(strings.Replace(name, "_rels/", "", 1), ".rels")
+		rels.mu.Lock()
+		for i, rel := range rels.Relationships {
+			if rel.TargetMode == "External" {
+				continue
+			}
+			if keep, ok := replaced[resolveRelTarget(source, rel.Target)]; ok {
+				rels.Relationships[i].Target = getRelativeTarget(source, keep)
+			}
+		}
+		rels.mu.Unlock()
+	}
+	for name := range replaced {
+		f.Pkg.Delete(name)
+		f.deleteTempFile(name)
+	}
+	return len(replaced), nil
+}
+
+// getDuplicateMedia provides a function to get the byte-identical media
+// parts, the key of the map is the part name of the duplicate and the value
+// is the part name of the kept part, which is the first one in the order of
+// the part names. The parts are compared by the SHA-256 digest and the size
+// of the content, which is read in streaming, so the large media parts kept
+// in the temporary files aren't read into the memory.
+func (f *File) getDuplicateMedia() (map[string]string, error) {
+	type digest struct {
+		sum  [sha256.Size]byte
+		size int64
+	}
+	kept, replaced := map[digest]string{}, map[string]string{}
+	for _, name := range f.getMediaPartNames() {
+		r, err := f.openPart(name)
+		if err != nil {
+			return nil, err
+		}
+		h := sha256.New()
+		size, err := io.Copy(h, r)
+		_ = r.Close()
+		if err != nil {
+			return nil, err
+		}
+		key := digest{size: size}
+		h.Sum(key.sum[:0])
+		if keep, ok := kept[key]; ok {
+			replaced[name] = keep
+			continue
+		}
+		kept[key] = name
+	}
+	return replaced, nil
+}
+
+// CompressImages provides a function to downscale the embedded JPEG and PNG
+// images which resolution is higher than the given maximum DPI at their
+// displayed size on the slides, the cropped parts of the pictures are taken
+// into account. The images are re-encoded in the original format with the
+// ImageQuality of the options as JPEG quality (default 85), and replaced only
+// if the result is smaller. The images used by multiple pictures are sized
+// for the largest one, and the images referenced by other parts, such as the
+// backgrounds, the layouts and the masters, or the JPEG images with EXIF
+// orientation are kept unchanged. Set the MaxImageDPI of the options to
+// compress the images in the saved package without changing the
+// presentation. For example, downscale the images to 150 DPI:
+//
+//	if err := f.CompressImages(150); err != nil {
+//	    fmt.Println(err)
+//	}
+func (f *File) CompressImages(maxDPI int) error {
+	if err := f.checkReadOnly(); err != nil {
+		return err
+	}
+	compressed, err := f.getCompressedImages(maxDPI, nil)
+	if err != nil {
+		return err
+	}
+	for mediaPath, data := range compressed {
+		f.Pkg.Store(mediaPath, data)
+	}
+	return nil
+}
+
+// getCompressedImages provides a function to get the content of the
+// downscaled images by given maximum DPI and the duplicate media parts
+// mapped to the kept parts, which are skipped and sized with the kept parts.
+// The key of the map is the part name of the image, and only the images
+// which are smaller after downscaling are returned.
+func (f *File) getCompressedImages(maxDPI int, replaced map[string]string) (map[string][]byte, error) {
+	if maxDPI <= 0 {
+		return nil, nil
+	}
+	sizes, err := f.getMediaDisplaySizes(maxDPI)
+	if err != nil {
+		return nil, err
+	}
+	for name, keep := range replaced {
+		if size, ok := sizes[name]; ok {
+			if prev, ok := sizes[keep]; ok && (prev.X <= 0 || prev.Y <= 0 || size.X <= 0 || size.Y <= 0) {
+				size = image.Point{}
+			} else if ok {
+				size = image.Pt(max(prev.X, size.X), max(prev.Y, size.Y))
+			}
+			sizes[keep] = size
+			delete(sizes, name)
+		}
+	}
+	quality := f.options.ImageQuality
+	if quality <= 0 || quality > 100 {
+		quality = defaultImageQuality
+	}
+	compressed := map[string][]byte{}
+	for mediaPath, size := range sizes {
+		if size.X <= 0 || size.Y <= 0 {
+			continue
+		}
+		// Check the pixel size of the image in streaming before reading it,
+		// so the images which don't need downscaling aren't read into the
+		// memory.
+		r, err := f.openPart(mediaPath)
+		if err != nil {
+			continue
+		}
+		cfg, _, err := image.DecodeConfig(r)
+		_ = r.Close()
+		if err != nil || size.X >= cfg.Width || size.Y >= cfg.Height {
+			continue
+		}
+		data := f.readPart(mediaPath)
+		if downscaled := downscaleImage(data, size, quality); downscaled != nil && len(downscaled) < len(data) {
+			compressed[mediaPath] = downscaled
+		}
+	}
+	return compressed, nil
+}
+
+// getSavedMedia provides a function to get the content of the parts changed
+// in the saved package by the DeduplicateMedia and MaxImageDPI options, and
+// the duplicate media parts which are left out of the saved package, without
+// changing the presentation. The changed parts are the downscaled images and
+// the relationships parts referring to the duplicate media parts.
+func (f *File) getSavedMedia() (map[string][]byte, map[string]string, error) {
+	var (
+		replaced map[string]string
+		err      error
+	)
+	if f.options.DeduplicateMedia {
+		if replaced, err = f.getDuplicateMedia(); err != nil {
+			return nil, nil, err
+		}
+	}
+	parts, err := f.getCompressedImages(f.options.MaxImageDPI, replaced)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(replaced) == 0 {
+		return parts, replaced, nil
+	}
+	if parts == nil {
+		parts = map[string][]byte{}
+	}
+	for _, name := range f.getPartNames() {
+		if !strings.HasSuffix(name, ".rels") {
+			continue
+		}
+		rels, err := f.xmlNodeReader(f.readPart(name))
+		if err != nil {
+			continue
+		}
+		source, changed := strings.TrimSuffix(strings.Replace(name, "_rels/", "", 1), ".rels"), false
+		for _, rel := range rels.findAll("Relationship") {
+			if rel.attr("TargetMode") == "External" {
+				continue
+			}
+			if keep, ok := replaced[resolveRelTarget(source, rel.attr("Target"))]; ok {
+				rel.setAttr("Target", getRelativeTarget(source, keep))
+				changed = true
+			}
+		}
+		if changed {
+			parts[name] = append([]byte(xml.Header), rels.bytes()...)
+		}
+	}
+	return parts, replaced, nil
+}
+
+// getMediaDisplaySizes provides a function to get the required pixel size of
+// the media parts by given DPI, which is the size of the largest picture
+// using the media. The media parts referenced by the parts other than the
+// pictures on the slides get the zero size.
+func (f *File) getMediaDisplaySizes(dpi int) (map[string]image.Point, error) {
+	sizes, used := map[string]image.Point{}, map[string]bool{}
+	for _, slideID := range f.GetSlideList() {
+		slide, err := f.slideReader(slideID)
+		if err != nil {
+			return nil, err
+		}
+		slideXMLPath, _ := f.getSlideXMLPath(slideID)
+		for _, pic := range slide.CommonSlideData.ShapeTree.Picture {
+			if pic.BlipFill == nil || pic.BlipFill.Blip == nil || pic.BlipFill.Blip.Embed == "" {
+				continue
+			}
+			used[slideXMLPath+"#"+pic.BlipFill.Blip.Embed] = true
+			mediaPath, ok := f.getRelTarget(slideXMLPath, pic.BlipFill.Blip.Embed)
+			if !ok {
+				continue
+			}
+			var size image.Point
+			if pic.ShapeProperties != nil && pic.ShapeProperties.Xfrm != nil && pic.ShapeProperties.Xfrm.Extents != nil {
+				size = getPictureDisplaySize(pic.ShapeProperties.Xfrm.Extents, pic.BlipFill.SrcRect, dpi)
+			}
+			if prev, ok := sizes[mediaPath]; ok && (prev.X <= 0 || prev.Y <= 0 || size.X <= 0 || size.Y <= 0) {
+				size = image.Point{}
+			} else if ok {
+				size = image.Pt(max(prev.X, size.X), max(prev.Y, size.Y))
+			}
+			sizes[mediaPath] = size
+		}
+	}
+	for _, name := range f.getPartNames() {
+		if !strings.HasSuffix(name, ".rels") {
+			continue
+		}
+		rels, _ := f.readRels(name)
+		source := strings.TrimSuffix(strings.Replace(name, "_rels/", "", 1), ".rels")
+		for _, rel := range rels {
+			if rel.TargetMode == "External" || used[source+"#"+rel.ID] {
+				continue
+			}
+			if target := resolveRelTarget(source, rel.Target); path.Dir(target) == "ppt/media" {
+				sizes[target] = image.Point{}
+			}
+		}
+	}
+	return sizes, nil
+}
+
+// getPictureDisplaySize returns the pixel size of the whole image by given
+// extents and source rectangle of the picture at the DPI.
+func getPictureDisplaySize(ext *Extents, srcRect *SourceRectangle, dpi int) image.Point {
+	width, height := float64(ext.CX), float64(ext.CY)
+	if srcRect != nil {
+		crop := func(a, b *int) float64 {
+			var v int
+			if a != nil {
+				v += *a
+			}
+			if b != nil {
+				v += *b
+			}
+			return float64(100000-v) / 100000
+		}
+		if x := crop(srcRect.L, srcRect.R); x > 0 {
+			width /= x
+		}
+		if y := crop(srcRect.T, srcRect.B); y > 0 {
+			height /= y
+		}
+	}
+	return image.Pt(
+		int(math.Ceil(width/EMUPerInch*float64(dpi))),
+		int(math.Ceil(height/EMUPerInch*float64(dpi))),
+	)
+}
+
+// downscaleImage returns the re-encoded image data by given JPEG or PNG image
+// data, the minimum required pixel size and the JPEG quality, which keeps the
+// aspect ratio of the image. It returns nil if the image needs no downscaling
+// or can't be processed.
+func downscaleImage(data []byte, size image.Point, quality int) []byte {
+	cfg, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil || (format != "jpeg" && format != "png") || cfg.Width == 0 || cfg.Height == 0 {
+		return nil
+	}
+	if format == "jpeg" && getJPEGOrientation(data) > 1 {
+		return nil
+	}
+	scale := max(float64(size.X)/float64(cfg.Width), float64(size.Y)/float64(cfg.Height))
+	if scale >= 1 {
+		return nil
+	}
+	src, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil
+	}
+	dst := resizeImage(src, max(1, int(math.Ceil(float64(cfg.Width)*scale))), max(1, int(math.Ceil(float64(cfg.Height)*scale))))
+	var buf bytes.Buffer
+	if format == "jpeg" {
+		err = jpeg.Encode(&buf, dst, &jpeg.Options{Quality: quality})
+	} else {
+		err = (&png.Encoder{CompressionLevel: png.BestCompression}).Encode(&buf, dst)
+	}
+	if err != nil {
+		return nil
+	}
+	return buf.Bytes()
+}
+
+// resizeImage downscales the image to the given width and height by averaging
+// the source pixels covered by each destination pixel.
+func resizeImage(src image.Image, width, height int) *image.RGBA {
+	bounds := src.Bounds()
+	rgba, ok := src.(*image.RGBA)
+	if !ok {
+		rgba = image.NewRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+		draw.Draw(rgba, rgba.Bounds(), src, bounds.Min, draw.Src)
+	}
+	srcWidth, srcHeight := rgba.Bounds().Dx(), rgba.Bounds().Dy()
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		y0, y1 := y*srcHeight/height, max((y+1)*srcHeight/height, y*srcHeight/height+1)
+		for x := 0; x < width; x++ {
+			x0, x1 := x*srcWidth/width, max((x+1)*srcWidth/width, x*srcWidth/width+1)
+			var sum [4]int
+			for sy := y0; sy < y1; sy++ {
+				off := rgba.PixOffset(rgba.Rect.Min.X+x0, rgba.Rect.Min.Y+sy)
+				for sx := x0; sx < x1; sx++ {
+					for c := 0; c < 4; c++ {
+						sum[c] += int(rgba.Pix[off+c])
+					}
+					off += 4
+				}
+			}
+			n, off := (y1-y0)*(x1-x0), dst.PixOffset(x, y)
+			for c := 0; c < 4; c++ {
+				dst.Pix[off+c] = uint8((sum[c] + n/2) / n)
+			}
+		}
+	}
+	return dst
+}
+
+// getJPEGOrientation returns the EXIF orientation of the JPEG image by given
+// image data, zero will be returned if the image has no orientation tag.
+func getJPEGOrientation(data []byte) int {
+	for i := 2; i+4 <= len(data) && data[i] == 0xFF; {
+		marker, length := data[i+1], int(binary.BigEndian.Uint16(data[i+2:]))
+		if marker == 0xDA || i+2+length > len(data) {
+			break
+		}
+		if segment := data[i+4 : i+2+length]; marker == 0xE1 && bytes.HasPrefix(segment, []byte("Exif\x00\x00")) {
+			return getTIFFOrientation(segment[6:])
+		}
+		i += 2 + length
+	}
+	return 0
+}
+
+// getTIFFOrientation returns the orientation tag in the first IFD of the
+// TIFF structure of the EXIF data.
+func getTIFFOrientation(tiff []byte) int {
+	if len(tiff) < 8 {
+		return 0
+	}
+	var order binary.ByteOrder = binary.BigEndian
+	if string(tiff[:2]) == "II" {
+		order = binary.LittleEndian
+	}
+	offset := int(order.Uint32(tiff[4:]))
+	if offset+2 > len(tiff) {
+		return 0
+	}
+	count := int(order.Uint16(tiff[offset:]))
+	for i := 0; i < count; i++ {
+		entry := offset + 2 + i*12
+		if entry+12 > len(tiff) {
+			break
+		}
+		if order.Uint16(tiff[entry:]) == 0x0112 {
+			return int(order.Uint16(tiff[entry+8:]))
+		}
+	}
+	return 0
+}
@@ -0,0 +1,152 @@
+package gopptx
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestSaveMedia(t *testing.T) {
+	img := newTestPNG(t, 256, 256)
+	f := NewFile(Options{DeduplicateMedia: true, MaxImageDPI: 72})
+	slideID := f.GetSlideList()[0]
+	for i := 0; i < 2; i++ {
+		if _, err := f.AddPictureFromBytes(slideID, ".png", img, &PictureOptions{Width: 914400, Height: 914400}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	var saved []byte
+	for i := 0; i < 2; i++ {
+		buf, err := f.WriteToBuffer()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if saved != nil && !bytes.Equal(saved, buf.Bytes()) {
+			t.Error("the saved package is changed by saving again")
+		}
+		saved = buf.Bytes()
+	}
+
+	var media int
+	for _, content := range f.MediaFiles() {
+		if media++; !bytes.Equal(content, img) {
+			t.Error("the media part of the presentation is changed by saving")
+		}
+	}
+	if media != 2 {
+		t.Errorf("expected 2 media parts in the presentation, got %d", media)
+	}
+
+	f, err := OpenReader(bytes.NewReader(saved))
+	if err != nil {
+		t.Fatal(err)
+	}
+	media = 0
+	for file, content := range f.MediaFiles() {
+		if media++; len(content) >= len(img) {
+			t.Errorf("the media part %s isn't compressed", file.Path)
+		}
+		if len(file.SlideIDs) != 1 || file.SlideIDs[0] != slideID {
+			t.Errorf("the media part %s isn't referenced by the slide, got %v", file.Path, file.SlideIDs)
+		}
+	}
+	if media != 1 {
+		t.Errorf("expected 1 media part in the saved package, got %d", media)
+	}
+	slide, err := f.slideReader(slideID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	slideXMLPath, _ := f.getSlideXMLPath(slideID)
+	for _, pic := range slide.CommonSlideData.ShapeTree.Picture {
+		if target, ok := f.getRelTarget(slideXMLPath, pic.BlipFill.Blip.Embed); !ok || f.readPart(target) == nil {
+			t.Errorf("the picture %s refers to the missing media part", pic.BlipFill.Blip.Embed)
+		}
+	}
+}
+
+func TestDeduplicateMedia(t *testing.T) {
+	img := newTestPNG(t, 16, 16)
+	f := NewFile()
+	slideID := f.GetSlideList()[0]
+	for i := 0; i < 3; i++ {
+		if _, err := f.AddPictureFromBytes(slideID, ".png", img, &PictureOptions{Width: 914400, Height: 914400}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	removed, err := f.DeduplicateMedia()
+	if err != nil || removed != 2 {
+		t.Fatalf("expected 2 removed parts, got %d %v", removed, err)
+	}
+	slideXMLPath, _ := f.getSlideXMLPath(slideID)
+	rels, err := f.readRels(getPartRelsPath(slideXMLPath))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var targets int
+	for _, rel := range rels {
+		if rel.Type != SourceRelationshipImage {
+			continue
+		}
+		if targets++; rel.Target != "../media/image1.png" {
+			t.Errorf("expected the target ../media/image1.png, got %s", rel.Target)
+		}
+	}
+	if targets != 3 {
+		t.Errorf("expected 3 image relationships, got %d", targets)
+	}
+
+	buf, err := f.WriteToBuffer()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f, err = OpenReader(buf, Options{ReadOnly: true}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = f.DeduplicateMedia(); !errors.Is(err, ErrReadOnly) {
+		t.Errorf("expected ErrReadOnly, got %v", err)
+	}
+}
+
+func TestExtractMedia(t *testing.T) {
+	logo, photo := newTestPNG(t, 16, 16), newTestPNG(t, 32, 16)
+	f := NewFile()
+	slideID := f.GetSlideList()[0]
+	nextSlideID, err := f.NewSlide()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, pic := range []struct {
+		slideID int
+		file    []byte
+	}{{slideID, logo}, {nextSlideID, photo}, {nextSlideID, logo}} {
+		if _, err = f.AddPictureFromBytes(pic.slideID, ".png", pic.file, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	dir := filepath.Join(t.TempDir(), "media")
+	files, err := f.ExtractMedia(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []MediaFile{
+		{Name: "image1.png", Path: "ppt/media/image1.png", SlideIDs: []int{slideID}},
+		{Name: "image2.png", Path: "ppt/media/image2.png", SlideIDs: []int{nextSlideID}},
+		{Name: "image3.png", Path: "ppt/media/image3.png", SlideIDs: []int{nextSlideID}},
+	}
+	if !reflect.DeepEqual(files, expected) {
+		t.Fatalf("expected the manifest %v, got %v", expected, files)
+	}
+	for i, content := range [][]byte{logo, photo, logo} {
+		data, err := os.ReadFile(filepath.Join(dir, files[i].Name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(data, content) {
+			t.Errorf("unexpected content of the extracted file %s", files[i].Name)
+		}
+	}
+}
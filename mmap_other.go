@@ -0,0 +1,24 @@
+//go:build !unix
+
+// Copyright 2026 kenny-not-dead. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+//
+// Package gopptx provides functionality to create and manipulate PowerPoint
+// (.pptx) files in Go, using the Office Open XML (ECMA-376) format.
+
+package gopptx
+
+import "os"
+
+// mmapFile returns ErrMemoryMapUnsupported on the platforms which don't
+// support the memory mapped files.
+func mmapFile(file *os.File) ([]byte, error) {
+	return nil, ErrMemoryMapUnsupported
+}
+
+// munmapFile does nothing on the platforms which don't support the memory
+// mapped files.
+func munmapFile(b []byte) error {
+	return nil
+}
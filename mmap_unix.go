@@ -0,0 +1,32 @@
+//go:build unix
+
+// Copyright 2026 kenny-not-dead. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+//
+// Package gopptx provides functionality to create and manipulate PowerPoint
+// (.pptx) files in Go, using the Office Open XML (ECMA-376) format.
+
+package gopptx
+
+import (
+	"os"
+	"syscall"
+)
+
+// mmapFile maps the content of the file into memory read-only by given file.
+func mmapFile(file *os.File) ([]byte, error) {
+	fi, err := file.Stat()
+	if err != nil {
+		return nil, err
+	}
+	if fi.Size() <= 0 || int64(int(fi.Size())) != fi.Size() {
+		return nil, ErrMemoryMapUnsupported
+	}
+	return syscall.Mmap(int(file.Fd()), 0, int(fi.Size()), syscall.PROT_READ, syscall.MAP_SHARED)
+}
+
+// munmapFile unmaps the memory mapped by the mmapFile function.
+func munmapFile(b []byte) error {
+	return syscall.Munmap(b)
+}
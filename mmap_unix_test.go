@@ -0,0 +1,72 @@
+//go:build unix
+
+package gopptx
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestWriteMemoryMappedFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "Book1.pptx")
+	f := NewFile()
+	slideID := f.GetSlideList()[0]
+	if _, err := f.AddTextBox(slideID, TextBoxOptions{Text: "mapped"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := f.SaveAs(path); err != nil {
+		t.Fatal(err)
+	}
+	f, err := OpenFile(path, Options{MemoryMap: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	if f.mapped == nil {
+		t.Fatal("the file isn't mapped into memory")
+	}
+	checkFile := func(text string) {
+		f, err := OpenFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer f.Close()
+		content, err := f.GetSlideXML(slideID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !strings.Contains(string(content), text) {
+			t.Errorf("expected the text %s in the saved file", text)
+		}
+	}
+	write := func(flag int) error {
+		file, err := os.OpenFile(path, flag, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer file.Close()
+		return f.Write(file)
+	}
+
+	// Writing into the mapped file in place is refused, the file isn't
+	// truncated on opening, since the truncation breaks the mapping.
+	if err = write(os.O_WRONLY); !errors.Is(err, ErrMemoryMapOverwrite) {
+		t.Fatalf("expected ErrMemoryMapOverwrite, got %v", err)
+	}
+	checkFile("mapped")
+
+	// The file saved over the mapped file isn't mapped, it can be written
+	if _, err = f.AddTextBox(slideID, TextBoxOptions{Text: "edited"}); err != nil {
+		t.Fatal(err)
+	}
+	if err = f.Save(); err != nil {
+		t.Fatal(err)
+	}
+	if err = write(os.O_WRONLY | os.O_TRUNC); err != nil {
+		t.Fatal(err)
+	}
+	checkFile("edited")
+}
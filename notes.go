@@ -0,0 +1,256 @@
+// Copyright 2026 kenny-not-dead. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+//
+// Package gopptx provides functionality to create and manipulate PowerPoint
+// (.pptx) files in Go, using the Office Open XML (ECMA-376) format.
+
+package gopptx
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// NotesPlaceholder directly maps the placeholder of the notes slide. The type
+// is the placeholder type, such as "sldImg" for the slide image, "body" for
+// the notes body, "hdr", "ftr", "dt" and "sldNum" for the header, the
+// footer, the date and the slide number. The position and size are
+// specified in EMUs, which are zero if the placeholder inherits them from the
+// notes master. The text body is nil for the slide image placeholder.
+type NotesPlaceholder struct {
+	ShapeID  int
+	Name     string
+	Type     string
+	X        int
+	Y        int
+	Width    int
+	Height   int
+	TextBody *DecodeTextBody
+}
+
+// notesSlideReader provides a function to get the pointer to the structure
+// after deserialization of the notes slide by given slide id. It returns nil
+// if the slide has no speaker notes.
+func (f *File) notesSlideReader(slideID int) (*decodeNotesSlide, error) {
+	slideXMLPath, ok := f.getSlideXMLPath(slideID)
+	if !ok {
+		return nil, ErrSlideNotExist{slideID}
+	}
+	notesXMLPath, ok := f.getRelTargetByType(slideXMLPath, SourceRelationshipNotesSlide)
+	if !ok {
+		return nil, nil
+	}
+	content := f.readBytes(notesXMLPath)
+	if len(content) == 0 {
+		return nil, nil
+	}
+	notes := new(decodeNotesSlide)
+	if err := f.xmlNewDecoder(bytes.NewReader(namespaceStrictToTransitional(content))).
+		Decode(notes); err != nil && err != io.EOF {
+		return nil, err
+	}
+	return notes, nil
+}
+
+// getNotesParagraphs provides a function to get the paragraphs of the notes
+// body placeholder by given slide id.
+func (f *File) getNotesParagraphs(slideID int) ([]DecodeParagraph, error) {
+	notes, err := f.notesSlideReader(slideID)
+	if err != nil || notes == nil {
+		return nil, err
+	}
+	for _, shape := range notes.CommonSlideData.ShapeTree.Shape {
+		if shape.placeholderType() == "body" && shape.TextBody != nil {
+			return shape.TextBody.Paragraph, nil
+		}
+	}
+	return nil, nil
+}
+
+// addNotesSlide provides a function to create the notes slide of the slide
+// by given slide id and the paragraphs of the speaker notes, the slide should
+// have no notes slide. The notes master with its theme is created if the
+// presentation has no notes master.
+func (f *File) addNotesSlide(slideID int, paragraphs []string) error {
+	slideXMLPath, ok := f.getSlideXMLPath(slideID)
+	if !ok {
+		return ErrSlideNotExist{slideID}
+	}
+	notesMasterXMLPath, err := f.getNotesMasterPath()
+	if err != nil {
+		return err
+	}
+	notesXMLPath := f.getUnusedPartPath("ppt/notesSlides/notesSlide%d.xml")
+	var buf bytes.Buffer
+	buf.WriteString(xml.Header + `<p:notes xmlns:a="` + NameSpaceDrawingML.Value + `" xmlns:p="` + NameSpacePresentationML.Value +
+		`" xmlns:r="` + SourceRelationship.Value + `"><p:cSld><p:spTree><p:nvGrpSpPr><p:cNvPr id="1" name=""/><p:cNvGrpSpPr/><p:nvPr/>` +
+		`</p:nvGrpSpPr><p:grpSpPr/><p:sp><p:nvSpPr><p:cNvPr id="2" name="Slide Image Placeholder 1"/><p:cNvSpPr>` +
+		`<a:spLocks noGrp="1" noRot="1" noChangeAspect="1"/></p:cNvSpPr><p:nvPr><p:ph type="sldImg"/></p:nvPr></p:nvSpPr>` +
+		`<p:spPr/></p:sp><p:sp><p:nvSpPr><p:cNvPr id="3" name="Notes Placeholder 2"/><p:cNvSpPr><a:spLocks noGrp="1"/>` +
+		`</p:cNvSpPr><p:nvPr><p:ph type="body" idx="1"/></p:nvPr></p:nvSpPr><p:spPr/><p:txBody><a:bodyPr/><a:lstStyle/>`)
+	for _, text := range paragraphs {
+		if text == "" {
+			buf.WriteString(`<a:p/>`)
+			continue
+		}
+		buf.WriteString(`<a:p><a:r><a:rPr lang="en-US"/><a:t>`)
+		if err = xml.EscapeText(&buf, []byte(text)); err != nil {
+			return err
+		}
+		buf.WriteString(`</a:t></a:r></a:p>`)
+	}
+	buf.WriteString(`</p:txBody></p:sp></p:spTree></p:cSld><p:clrMapOvr><a:masterClrMapping/></p:clrMapOvr></p:notes>`)
+	f.Pkg.Store(notesXMLPath, buf.Bytes())
+	if err = f.setContentTypes("/"+notesXMLPath, ContentTypeNotesSlide); err != nil {
+		return err
+	}
+	notesRelsPath := getPartRelsPath(notesXMLPath)
+	f.addRels(notesRelsPath, SourceRelationshipNotesMaster, "../"+strings.TrimPrefix(notesMasterXMLPath, "ppt/"), "")
+	f.addRels(notesRelsPath, SourceRelationshipSlide, "../"+strings.TrimPrefix(slideXMLPath, "ppt/"), "")
+	f.addRels(getPartRelsPath(slideXMLPath), SourceRelationshipNotesSlide, "../"+strings.TrimPrefix(notesXMLPath, "ppt/"), "")
+	return nil
+}
+
+// getNotesMasterPath provides a function to get the part path of the notes
+// master of the presentation, the notes master is created with a copy of the
+// default theme if the presentation has no notes master.
+func (f *File) getNotesMasterPath() (string, error) {
+	if notesMasterXMLPath, ok := f.getRelTargetByType(f.getPresentationPath(), SourceRelationshipNotesMaster); ok {
+		return notesMasterXMLPath, nil
+	}
+	presentation, err := f.presentationReader()
+	if err != nil {
+		return "", err
+	}
+	themeXMLPath := f.getUnusedPartPath("ppt/theme/theme%d.xml")
+	f.Pkg.Store(themeXMLPath, []byte(xml.Header+templateTheme))
+	if err = f.setContentTypes("/"+themeXMLPath, ContentTypeTheme); err != nil {
+		return "", err
+	}
+	notesMasterXMLPath := f.getUnusedPartPath("ppt/notesMasters/notesMaster%d.xml")
+	f.Pkg.Store(notesMasterXMLPath, []byte(xml.Header+templateNotesMaster))
+	if err = f.setContentTypes("/"+notesMasterXMLPath, ContentTypeNotesMaster); err != nil {
+		return "", err
+	}
+	f.addRels(getPartRelsPath(notesMasterXMLPath), SourceRelationshipTheme, "../"+strings.TrimPrefix(themeXMLPath, "ppt/"), "")
+	rID := f.addRels(f.getPresentationRelsPath(), SourceRelationshipNotesMaster, strings.TrimPrefix(notesMasterXMLPath, "ppt/"), "")
+	presentation.NotesMaster = &decodeNotesMasterList{NotesMaster: []decodeNotesMasterID{{RelationshipID: rID}}}
+	return notesMasterXMLPath, nil
+}
+
+// getUnusedPartPath provides a function to get the first part path which
+// isn't used in the package by given path format with the part number.
+func (f *File) getUnusedPartPath(format string) string {
+	for i := 1; ; i++ {
+		partName := fmt.Sprintf(format, i)
+		if _, ok := f.Pkg.Load(partName); !ok {
+			return partName
+		}
+	}
+}
+
+// GetNotesPlaceholders provides a function to get the placeholders of the
+// notes slide by given slide id, so the formatting of the speaker notes such
+// as the font sizes and the bullets can be read with the text, rather than
+// only the plain text. It returns nil if the slide has no notes slide. For
+// example:
+//
+//	placeholders, err := f.GetNotesPlaceholders(256)
+//	if err != nil {
+//	    fmt.Println(err)
+//	    return
+//	}
+//	for _, ph := range placeholders {
+//	    fmt.Println(ph.Type, ph.Name, ph.Width, ph.Height)
+//	}
+func (f *File) GetNotesPlaceholders(slideID int) ([]NotesPlaceholder, error) {
+	notes, err := f.notesSlideReader(slideID)
+	if err != nil || notes == nil {
+		return nil, err
+	}
+	var placeholders []NotesPlaceholder
+	for _, shape := range notes.CommonSlideData.ShapeTree.Shape {
+		phType := shape.placeholderType()
+		if phType == "" {
+			continue
+		}
+		ph := NotesPlaceholder{Type: phType, TextBody: shape.TextBody}
+		if nvSpPr := shape.NonVisualShapeProperties; nvSpPr != nil && nvSpPr.CommonNonVisualProperties != nil {
+			ph.ShapeID, ph.Name = nvSpPr.CommonNonVisualProperties.ID, nvSpPr.CommonNonVisualProperties.Name
+		}
+		if spPr := shape.ShapeProperties; spPr != nil && spPr.Xfrm != nil {
+			if spPr.Xfrm.Offset != nil {
+				ph.X, ph.Y = spPr.Xfrm.Offset.X, spPr.Xfrm.Offset.Y
+			}
+			if spPr.Xfrm.Extents != nil {
+				ph.Width, ph.Height = spPr.Xfrm.Extents.CX, spPr.Xfrm.Extents.CY
+			}
+		}
+		placeholders = append(placeholders, ph)
+	}
+	return placeholders, nil
+}
+
+// SetNotesPlaceholderText provides a function to set the text body of the
+// placeholder of the notes slide by given slide id, placeholder type such as
+// "body", "hdr" and "ftr", and the text body, which keeps the other
+// placeholders of the notes slide, such as the slide image. The slide should
+// have the notes slide with the placeholder. For example, set the speaker
+// notes with the bullets at 14 points:
+//
+//	size := 1400
+//	err := f.SetNotesPlaceholderText(256, "body", gopptx.DecodeTextBody{
+//	    BodyProperties: &gopptx.DecodeBodyProperties{},
+//	    Paragraph: []gopptx.DecodeParagraph{
+//	        {Runs: []gopptx.DecodeRuns{{RunProperties: &gopptx.DecodeRunProperties{Size: &size}, Text: "Open with the revenue"}}},
+//	        {Runs: []gopptx.DecodeRuns{{RunProperties: &gopptx.DecodeRunProperties{Size: &size}, Text: "Mention the new markets"}}},
+//	    },
+//	})
+func (f *File) SetNotesPlaceholderText(slideID int, phType string, textBody DecodeTextBody) error {
+	if err := f.checkReadOnly(); err != nil {
+		return err
+	}
+	slideXMLPath, ok := f.getSlideXMLPath(slideID)
+	if !ok {
+		return ErrSlideNotExist{slideID}
+	}
+	notesXMLPath, ok := f.getRelTargetByType(slideXMLPath, SourceRelationshipNotesSlide)
+	if !ok || len(f.readBytes(notesXMLPath)) == 0 {
+		return ErrNotesSlideNotExist{slideID}
+	}
+	notes, err := f.xmlNodeReader(f.readBytes(notesXMLPath))
+	if err != nil {
+		return err
+	}
+	var shape *xmlNode
+	walkXMLNode(notes.find("cSld", "spTree"), func(node *xmlNode) {
+		if t, _, ok := getPlaceholderNode(node); shape == nil && ok && localName(node.Name) == "sp" &&
+			(t == phType || t == "obj" && phType == "body") {
+			shape = node
+		}
+	})
+	if shape == nil {
+		return ErrPlaceholderNotExist
+	}
+	p := notes.prefix(NameSpacePresentationML.Value)
+	var buf bytes.Buffer
+	enc := xml.NewEncoder(&buf)
+	if err = enc.EncodeElement(newTextBody(&textBody), xml.StartElement{Name: xml.Name{Local: p + "txBody"}}); err != nil {
+		return err
+	}
+	if err = enc.Flush(); err != nil {
+		return err
+	}
+	txBody, err := f.xmlNodeReader(buf.Bytes())
+	if err != nil {
+		return err
+	}
+	shape.remove(p + "txBody")
+	shape.insert(txBody, p+"extLst")
+	f.saveFileList(notesXMLPath, notes.bytes())
+	return nil
+}
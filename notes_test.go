@@ -0,0 +1,91 @@
+package gopptx
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestNotesPlaceholders(t *testing.T) {
+	f := NewFile()
+	slideID := f.GetSlideList()[0]
+	if placeholders, err := f.GetNotesPlaceholders(slideID); err != nil || placeholders != nil {
+		t.Errorf("expected no placeholders without the notes slide, got %v %v", placeholders, err)
+	}
+	if err := f.addNotesSlide(slideID, []string{"Old notes"}); err != nil {
+		t.Fatal(err)
+	}
+	placeholders, err := f.GetNotesPlaceholders(slideID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(placeholders) != 2 || placeholders[1].TextBody.text() != "Old notes" {
+		t.Fatalf("expected the slide image and the notes body placeholders, got %v", placeholders)
+	}
+	size := 1400
+	if err = f.SetNotesPlaceholderText(slideID, "body", DecodeTextBody{
+		BodyProperties: &DecodeBodyProperties{},
+		Paragraph: []DecodeParagraph{
+			{Runs: []DecodeRuns{{RunProperties: &DecodeRunProperties{Size: &size}, Text: "Open with the revenue"}}},
+			{Runs: []DecodeRuns{{RunProperties: &DecodeRunProperties{Size: &size}, Text: "Mention the new markets"}}},
+		},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	buf, err := f.WriteToBuffer()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f, err = OpenReader(buf); err != nil {
+		t.Fatal(err)
+	}
+	if placeholders, err = f.GetNotesPlaceholders(slideID); err != nil {
+		t.Fatal(err)
+	}
+	type notesPlaceholder struct {
+		ShapeID    int
+		Name, Type string
+		Text       []string
+		Sizes      []int
+	}
+	var actual []notesPlaceholder
+	for _, ph := range placeholders {
+		m := notesPlaceholder{ShapeID: ph.ShapeID, Name: ph.Name, Type: ph.Type}
+		if ph.TextBody != nil {
+			for _, p := range ph.TextBody.Paragraph {
+				for _, r := range p.Runs {
+					m.Text = append(m.Text, r.Text)
+					if r.RunProperties != nil && r.RunProperties.Size != nil {
+						m.Sizes = append(m.Sizes, *r.RunProperties.Size)
+					}
+				}
+			}
+		}
+		actual = append(actual, m)
+	}
+	expected := []notesPlaceholder{
+		{ShapeID: 2, Name: "Slide Image Placeholder 1", Type: "sldImg"},
+		{ShapeID: 3, Name: "Notes Placeholder 2", Type: "body", Text: []string{"Open with the revenue", "Mention the new markets"}, Sizes: []int{1400, 1400}},
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("expected the notes placeholders %v, got %v", expected, actual)
+	}
+
+	nextSlideID, err := f.NewSlide()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, c := range []struct {
+		slideID  int
+		phType   string
+		expected error
+	}{
+		{slideID: slideID, phType: "ftr", expected: ErrPlaceholderNotExist},
+		{slideID: nextSlideID, phType: "body", expected: ErrNotesSlideNotExist{nextSlideID}},
+		{slideID: 300, phType: "body", expected: ErrSlideNotExist{300}},
+	} {
+		if err = f.SetNotesPlaceholderText(c.slideID, c.phType, DecodeTextBody{}); !errors.Is(err, c.expected) {
+			t.Errorf("expected %v, got %v", c.expected, err)
+		}
+	}
+}
@@ -0,0 +1,108 @@
+// Copyright 2026 kenny-not-dead. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+//
+// Package gopptx provides functionality to create and manipulate PowerPoint
+// (.pptx) files in Go, using the Office Open XML (ECMA-376) format.
+
+package gopptx
+
+// SlideNumbering directly maps the settings of the slide numbers of the
+// presentation. The first slide number is the number of the first slide,
+// which is 1 by default, so the presentations continuing another volume can
+// be numbered from the offset. The show slide number specifies whether the
+// slide number placeholders of the slide masters are shown, and the show on
+// title slide specifies whether the slide number, date and footer
+// placeholders are shown on the title slides. The nil settings are kept as
+// is on setting the slide numbering.
+type SlideNumbering struct {
+	FirstSlideNumber int
+	ShowSlideNumber  *bool
+	ShowOnTitleSlide *bool
+}
+
+// GetSlideNumbering provides a function to get the settings of the slide
+// numbers of the presentation, the slide number is shown if any slide
+// master shows it. For example:
+//
+//	numbering, err := f.GetSlideNumbering()
+//	if err != nil {
+//	    fmt.Println(err)
+//	    return
+//	}
+//	fmt.Println(numbering.FirstSlideNumber)
+func (f *File) GetSlideNumbering() (SlideNumbering, error) {
+	showSlideNumber, showOnTitleSlide := false, true
+	numbering := SlideNumbering{FirstSlideNumber: 1, ShowSlideNumber: &showSlideNumber, ShowOnTitleSlide: &showOnTitleSlide}
+	presentation, err := f.presentationReader()
+	if err != nil {
+		return numbering, err
+	}
+	if presentation.FirstSlideNum != nil {
+		numbering.FirstSlideNumber = *presentation.FirstSlideNum
+	}
+	if presentation.ShowSpecialPlsOnTitle != nil {
+		showOnTitleSlide = *presentation.ShowSpecialPlsOnTitle
+	}
+	for _, masterXMLPath := range f.getSlideMasterPaths() {
+		root, err := f.xmlNodeReader(f.readXML(masterXMLPath))
+		if err != nil {
+			return numbering, err
+		}
+		if hf := root.find("hf"); hf == nil || hf.attr("sldNum") != "0" && hf.attr("sldNum") != "false" {
+			showSlideNumber = true
+		}
+	}
+	return numbering, nil
+}
+
+// SetSlideNumbering provides a function to set the settings of the slide
+// numbers of the presentation, the slide numbers of the slides are the
+// first slide number plus the zero-based index of the slides, and the slide
+// number fields are updated by the UpdateFields function. For example,
+// continue the numbering from the previous volume of 24 slides:
+//
+//	show := true
+//	err := f.SetSlideNumbering(&gopptx.SlideNumbering{FirstSlideNumber: 25, ShowSlideNumber: &show})
+func (f *File) SetSlideNumbering(numbering *SlideNumbering) error {
+	if err := f.checkReadOnly(); err != nil {
+		return err
+	}
+	if numbering == nil {
+		return nil
+	}
+	presentation, err := f.presentationReader()
+	if err != nil {
+		return err
+	}
+	if numbering.FirstSlideNumber != 0 {
+		first := numbering.FirstSlideNumber
+		if presentation.FirstSlideNum = &first; first == 1 {
+			presentation.FirstSlideNum = nil
+		}
+	}
+	if numbering.ShowOnTitleSlide != nil {
+		show := *numbering.ShowOnTitleSlide
+		if presentation.ShowSpecialPlsOnTitle = &show; show {
+			presentation.ShowSpecialPlsOnTitle = nil
+		}
+	}
+	if numbering.ShowSlideNumber == nil {
+		return nil
+	}
+	for _, masterXMLPath := range f.getSlideMasterPaths() {
+		root, err := f.xmlNodeReader(f.readXML(masterXMLPath))
+		if err != nil {
+			return err
+		}
+		p := root.prefix(NameSpacePresentationMLMain)
+		hf := root.child(p + "hf")
+		if hf == nil {
+			hf = newXMLNode(p + "hf")
+			root.insert(hf, p+"txStyles", p+"extLst")
+		}
+		hf.setAttr("sldNum", boolToVal(*numbering.ShowSlideNumber))
+		f.saveFileList(masterXMLPath, root.bytes())
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+package gopptx
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+func TestSlideNumbering(t *testing.T) {
+	f := NewFile()
+	nextSlideID, err := f.NewSlide()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = f.CreateShape(nextSlideID, DecodeShapeProperties{}, DecodeTextBody{Paragraph: []DecodeParagraph{{Runs: []DecodeRuns{
+		{FieldID: "{B6F15528-21DE-4FAA-801E-634DDDAF4B2B}", FieldType: "slidenum", Text: "2"},
+	}}}}); err != nil {
+		t.Fatal(err)
+	}
+	show, hide := true, false
+	for _, c := range []struct {
+		numbering *SlideNumbering
+		expected  SlideNumbering
+		number    string
+	}{
+		{expected: SlideNumbering{FirstSlideNumber: 1, ShowSlideNumber: &show, ShowOnTitleSlide: &show}, number: "2"},
+		{numbering: &SlideNumbering{FirstSlideNumber: 25, ShowSlideNumber: &show, ShowOnTitleSlide: &hide},
+			expected: SlideNumbering{FirstSlideNumber: 25, ShowSlideNumber: &show, ShowOnTitleSlide: &hide}, number: "26"},
+		{numbering: &SlideNumbering{ShowSlideNumber: &hide},
+			expected: SlideNumbering{FirstSlideNumber: 25, ShowSlideNumber: &hide, ShowOnTitleSlide: &hide}, number: "26"},
+		{numbering: &SlideNumbering{FirstSlideNumber: 1, ShowOnTitleSlide: &show},
+			expected: SlideNumbering{FirstSlideNumber: 1, ShowSlideNumber: &hide, ShowOnTitleSlide: &show}, number: "2"},
+	} {
+		if err = f.SetSlideNumbering(c.numbering); err != nil {
+			t.Fatal(err)
+		}
+		if err = f.UpdateFields(); err != nil {
+			t.Fatal(err)
+		}
+		buf, err := f.WriteToBuffer()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if f, err = OpenReader(buf); err != nil {
+			t.Fatal(err)
+		}
+		numbering, err := f.GetSlideNumbering()
+		if err != nil || !reflect.DeepEqual(numbering, c.expected) {
+			t.Errorf("expected the slide numbering %d %t %t, got %d %t %t %v", c.expected.FirstSlideNumber, *c.expected.ShowSlideNumber, *c.expected.ShowOnTitleSlide,
+				numbering.FirstSlideNumber, *numbering.ShowSlideNumber, *numbering.ShowOnTitleSlide, err)
+		}
+		slide, err := f.slideReader(nextSlideID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		shapes := slide.CommonSlideData.ShapeTree.Shape
+		if number := shapes[len(shapes)-1].TextBody.text(); number != c.number {
+			t.Errorf("expected the slide number %s, got %s", c.number, number)
+		}
+	}
+	if content := f.readXML(defaultXMLPathPresentation); bytes.Contains(content, []byte("firstSlideNum")) || bytes.Contains(content, []byte("showSpecialPlsOnTitleSld")) {
+		t.Errorf("expected the default numbering isn't written, got %s", content)
+	}
+}
@@ -0,0 +1,287 @@
+// Copyright 2026 kenny-not-dead. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+//
+// Package gopptx provides functionality to create and manipulate PowerPoint
+// (.pptx) files in Go, using the Office Open XML (ECMA-376) format.
+
+package gopptx
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+// numberSymbols defines the decimal separator, the thousands separator and
+// the currency symbol of the formatted numbers.
+type numberSymbols struct {
+	decimal, thousands, currency string
+}
+
+// defaultNumberSymbols defined the default symbols of the formatted numbers.
+var defaultNumberSymbols = numberSymbols{decimal: ".", thousands: ",", currency: "$"}
+
+// ThousandsFormat returns the number format code with the thousands
+// separators by given number of decimal places, such as "#,##0.00", which
+// can be used in the table cells, the data labels of the charts and the
+// FormatNumber function.
+func ThousandsFormat(decimals int) string {
+	return "#,##0" + formatDecimals(decimals)
+}
+
+// PercentFormat returns the percentage number format code by given number of
+// decimal places, such as "0.0%".
+func PercentFormat(decimals int) string {
+	return "0" + formatDecimals(decimals) + "%"
+}
+
+// CurrencyFormat returns the accounting style currency number format code by
+// given number of decimal places, such as "$#,##0.00;($#,##0.00)", which
+// shows the negative amounts in parentheses. The "$" is replaced by the
+// CurrencySymbol option on formatting.
+func CurrencyFormat(decimals int) string {
+	code := "$#,##0" + formatDecimals(decimals)
+	return code + ";(" + code + ")"
+}
+
+// formatDecimals returns the decimal places part of the number format code
+// by given number of decimal places.
+func formatDecimals(decimals int) string {
+	if decimals <= 0 {
+		return ""
+	}
+	return "." + strings.Repeat("0", decimals)
+}
+
+// FormatNumber provides a function to format the number by given number
+// format code, so the numbers in the text of the shapes are consistent with
+// the table cells and the data labels of the charts using the same format
+// codes. The format code supports the thousands separator, the decimal
+// places, the percentage, the quoted literal text, the currency symbols
+// such as "$" and "[$€-407]", and the sections for the positive, negative
+// and zero numbers separated by semicolons. The decimal separator, the
+// thousands separator and the currency symbol "$" are localized by the
+// DecimalSeparator, ThousandsSeparator and CurrencySymbol options. For
+// example:
+//
+//	f := gopptx.NewFile(gopptx.Options{
+//	    DecimalSeparator:   ",",
+//	    ThousandsSeparator: ".",
+//	    CurrencySymbol:     "€",
+//	})
+//	fmt.Println(f.FormatNumber(-1234.5, gopptx.CurrencyFormat(2))) // (€1.234,50)
+//	fmt.Println(f.FormatNumber(0.125, gopptx.PercentFormat(1)))    // 12,5%
+func (f *File) FormatNumber(value float64, format string) string {
+	return formatNumber(value, format, f.numberSymbols())
+}
+
+// FormatPercent provides a function to format the number as the percentage
+// by given number and number of decimal places, see FormatNumber for the
+// localization. For example:
+//
+//	text := f.FormatPercent(0.125, 1) // 12.5%
+func (f *File) FormatPercent(value float64, decimals int) string {
+	return f.FormatNumber(value, PercentFormat(decimals))
+}
+
+// FormatCurrency provides a function to format the number as the accounting
+// style currency amount by given number and number of decimal places, the
+// negative amounts are in parentheses, see FormatNumber for the
+// localization. For example:
+//
+//	text := f.FormatCurrency(-1234.5, 2) // ($1,234.50)
+func (f *File) FormatCurrency(value float64, decimals int) string {
+	return f.FormatNumber(value, CurrencyFormat(decimals))
+}
+
+// numberSymbols returns the symbols of the formatted numbers by the options.
+func (f *File) numberSymbols() numberSymbols {
+	symbols := defaultNumberSymbols
+	if f.options == nil {
+		return symbols
+	}
+	if f.options.DecimalSeparator != "" {
+		symbols.decimal = f.options.DecimalSeparator
+	}
+	if f.options.ThousandsSeparator != "" {
+		symbols.thousands = f.options.ThousandsSeparator
+	}
+	if f.options.CurrencySymbol != "" {
+		symbols.currency = f.options.CurrencySymbol
+	}
+	return symbols
+}
+
+// getFormatCode returns the number format code stored in the parts by given
+// format code, the unquoted "$" is replaced by the currency symbol of the
+// CurrencySymbol option, so the applications render the same symbol as the
+// FormatNumber function.
+func (f *File) getFormatCode(format string) string {
+	symbol := f.numberSymbols().currency
+	if symbol == defaultNumberSymbols.currency {
+		return format
+	}
+	var buf strings.Builder
+	for _, token := range tokenizeNumberFormat(format) {
+		if token == "$" {
+			token = "[$" + symbol + "]"
+		}
+		buf.WriteString(token)
+	}
+	return buf.String()
+}
+
+// tokenizeNumberFormat splits the number format code into the tokens, the
+// quoted text, the escaped characters and the bracketed text such as the
+// currency symbols are the single tokens.
+func tokenizeNumberFormat(format string) []string {
+	var tokens []string
+	for i := 0; i < len(format); {
+		j := i + 1
+		switch format[i] {
+		case '"':
+			if k := strings.IndexByte(format[j:], '"'); k >= 0 {
+				j += k + 1
+			} else {
+				j = len(format)
+			}
+		case '[':
+			if k := strings.IndexByte(format[j:], ']'); k >= 0 {
+				j += k + 1
+			} else {
+				j = len(format)
+			}
+		case '\\', '_', '*':
+			j = min(j+1, len(format))
+		}
+		for j < len(format) && format[j] >= 0x80 && format[j] < 0xC0 {
+			j++
+		}
+		tokens = append(tokens, format[i:j])
+		i = j
+	}
+	return tokens
+}
+
+// formatNumber returns the text of the number by given number format code
+// and symbols, see FormatNumber for the format code. The number is rounded
+// half away from zero as the applications do, and the shortest
+// representation of the number is returned if the format code is empty or
+// "General".
+func formatNumber(value float64, format string, symbols numberSymbols) string {
+	var sections [][]string
+	section := []string{}
+	for _, token := range tokenizeNumberFormat(format) {
+		if token == ";" {
+			sections, section = append(sections, section), []string{}
+			continue
+		}
+		section = append(section, token)
+	}
+	sections = append(sections, section)
+	negative := value < 0
+	switch {
+	case negative && len(sections) > 1 && len(sections[1]) > 0:
+		section, value, negative = sections[1], -value, false
+	case value == 0 && len(sections) > 2:
+		section = sections[2]
+	default:
+		section = sections[0]
+	}
+	if len(section) == 0 || strings.EqualFold(strings.Join(section, ""), "General") ||
+		math.IsNaN(value) || math.IsInf(value, 0) {
+		return strings.Replace(strconv.FormatFloat(value, 'f', -1, 64), ".", symbols.decimal, 1)
+	}
+	var (
+		prefix, suffix, integerCode, fractionCode strings.Builder
+		placeholders, fraction, thousands, scale  bool
+		percent                                   int
+	)
+	for _, token := range section {
+		literal := ""
+		switch c := token[0]; {
+		case strings.ContainsRune("0#?", rune(c)) && len(token) == 1:
+			placeholders = true
+			if fraction {
+				fractionCode.WriteByte(c)
+			} else {
+				integerCode.WriteByte(c)
+			}
+			if scale {
+				thousands, scale = true, false
+			}
+			continue
+		case c == '.' && placeholders && !fraction:
+			fraction = true
+			continue
+		case c == ',' && placeholders && !fraction:
+			scale = true
+			continue
+		case c == '%':
+			percent++
+			literal = token
+		case c == '$':
+			literal = symbols.currency
+		case c == '"':
+			literal = strings.Trim(token, `"`)
+		case c == '\\':
+			literal = token[1:]
+		case c == '_' || c == '*':
+			continue
+		case c == '[':
+			if symbol, ok := strings.CutPrefix(strings.Trim(token, "[]"), "$"); ok {
+				symbol, _, _ = strings.Cut(symbol, "-")
+				literal = symbol
+			}
+		default:
+			literal = token
+		}
+		if placeholders {
+			suffix.WriteString(literal)
+		} else {
+			prefix.WriteString(literal)
+		}
+	}
+	if !placeholders {
+		return prefix.String()
+	}
+	value *= math.Pow(100, float64(percent))
+	if scale {
+		value /= 1000
+	}
+	decimals, abs := fractionCode.Len(), math.Abs(value)
+	if scaled := abs * math.Pow10(decimals); !math.IsInf(scaled, 0) {
+		abs = math.Round(scaled) / math.Pow10(decimals)
+	}
+	text := strconv.FormatFloat(abs, 'f', decimals, 64)
+	integer, digits, _ := strings.Cut(text, ".")
+	if optional := len(strings.TrimRight(fractionCode.String(), "#?")); len(digits) > optional {
+		digits = digits[:optional] + strings.TrimRight(digits[optional:], "0")
+	}
+	minIntegers := strings.Count(integerCode.String(), "0")
+	if integer == "0" && minIntegers == 0 {
+		integer = ""
+	}
+	for len(integer) < minIntegers {
+		integer = "0" + integer
+	}
+	if thousands {
+		var buf strings.Builder
+		for i, digit := range integer {
+			if i > 0 && (len(integer)-i)%3 == 0 {
+				buf.WriteString(symbols.thousands)
+			}
+			buf.WriteRune(digit)
+		}
+		integer = buf.String()
+	}
+	if text = integer; digits != "" {
+		text += symbols.decimal + digits
+	}
+	text = prefix.String() + text + suffix.String()
+	if negative && strings.Trim(integer+digits, "0") != "" {
+		text = "-" + text
+	}
+	return text
+}
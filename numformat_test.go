@@ -0,0 +1,57 @@
+package gopptx
+
+import "testing"
+
+func TestFormatNumber(t *testing.T) {
+	localized := NewFile(Options{DecimalSeparator: ",", ThousandsSeparator: ".", CurrencySymbol: "€"})
+	for _, c := range []struct {
+		value             float64
+		format            string
+		expected, locally string
+	}{
+		{value: 1234.5, expected: "1234.5", locally: "1234,5"},
+		{value: 1234.5, format: "General", expected: "1234.5", locally: "1234,5"},
+		{value: 1234567.891, format: ThousandsFormat(2), expected: "1,234,567.89", locally: "1.234.567,89"},
+		{value: -1234.5, format: ThousandsFormat(0), expected: "-1,235", locally: "-1.235"},
+		{value: 0.125, format: PercentFormat(1), expected: "12.5%", locally: "12,5%"},
+		{value: 0.5, format: PercentFormat(0), expected: "50%", locally: "50%"},
+		{value: 1234.5, format: CurrencyFormat(2), expected: "$1,234.50", locally: "€1.234,50"},
+		{value: -1234.5, format: CurrencyFormat(2), expected: "($1,234.50)", locally: "(€1.234,50)"},
+		{value: 0, format: `#,##0;(#,##0);"-"`, expected: "-", locally: "-"},
+		{value: -0.001, format: "0.00", expected: "0.00", locally: "0,00"},
+		{value: 1.5, format: "0.0#", expected: "1.5", locally: "1,5"},
+		{value: 0.25, format: "#.00", expected: ".25", locally: ",25"},
+		{value: 7, format: "000", expected: "007", locally: "007"},
+		{value: 1234567, format: `#,##0,"K"`, expected: "1,235K", locally: "1.235K"},
+		{value: 42, format: `[$€-407]#,##0.00`, expected: "€42.00", locally: "€42,00"},
+		{value: 42, format: `0\ "pcs"`, expected: "42 pcs", locally: "42 pcs"},
+		{value: 42, format: `_($* #,##0_)`, expected: "$42", locally: "€42"},
+		{value: 42, format: `"Total"`, expected: "Total", locally: "Total"},
+	} {
+		if text := NewFile().FormatNumber(c.value, c.format); text != c.expected {
+			t.Errorf("expected %g formatted by %s as %s, got %s", c.value, c.format, c.expected, text)
+		}
+		if text := localized.FormatNumber(c.value, c.format); text != c.locally {
+			t.Errorf("expected %g formatted by %s as %s, got %s", c.value, c.format, c.locally, text)
+		}
+	}
+	if text := localized.FormatPercent(0.125, 1); text != "12,5%" {
+		t.Errorf("expected 12,5%%, got %s", text)
+	}
+	if text := localized.FormatCurrency(-1234.5, 0); text != "(€1.235)" {
+		t.Errorf("expected (€1.235), got %s", text)
+	}
+	for _, c := range []struct {
+		f        *File
+		format   string
+		expected string
+	}{
+		{f: NewFile(), format: CurrencyFormat(2), expected: "$#,##0.00;($#,##0.00)"},
+		{f: localized, format: CurrencyFormat(2), expected: "[$€]#,##0.00;([$€]#,##0.00)"},
+		{f: localized, format: `"$"0;[$$-409]0`, expected: `"$"0;[$$-409]0`},
+	} {
+		if code := c.f.getFormatCode(c.format); code != c.expected {
+			t.Errorf("expected the format code %s, got %s", c.expected, code)
+		}
+	}
+}
@@ -0,0 +1,175 @@
+// Copyright 2026 kenny-not-dead. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+//
+// Package gopptx provides functionality to create and manipulate PowerPoint
+// (.pptx) files in Go, using the Office Open XML (ECMA-376) format.
+
+package gopptx
+
+import (
+	"io"
+	"log/slog"
+)
+
+// Option is the optional setting for opening, creating and saving the
+// presentation, which is implemented by the Options and the functional
+// options such as WithTmpDir. The options are applied in order, so the
+// Options values and the functional options can be mixed. For example:
+//
+//	f, err := gopptx.OpenFile("Presentation.pptx",
+//	    gopptx.Options{MaxImageDPI: 150},
+//	    gopptx.WithTmpDir("/var/tmp"),
+//	    gopptx.WithUnzipLimits(64<<20, 16<<20),
+//	)
+type Option interface {
+	applyOption(f *File)
+}
+
+// optionFunc defines the functional option which sets the file directly.
+type optionFunc func(f *File)
+
+// applyOption implements the Option interface for the functional options.
+func (fn optionFunc) applyOption(f *File) {
+	fn(f)
+}
+
+// applyOption implements the Option interface for the Options. The Options
+// values are merged in order: the non-zero fields override the options set
+// before, and the zero fields keep them. So a later Options value can't reset
+// a field to the zero value, such as turning off the ReadOnly or clearing
+// the TmpDir, which can be done by the functional options, such as
+// WithTmpDir(""), or by passing a single Options value.
+func (o Options) applyOption(f *File) {
+	opts := f.options
+	mergeOption(&opts.MaxCalcIterations, o.MaxCalcIterations)
+	mergeOption(&opts.Password, o.Password)
+	mergeOption(&opts.RawCellValue, o.RawCellValue)
+	mergeOption(&opts.UnzipSizeLimit, o.UnzipSizeLimit)
+	mergeOption(&opts.UnzipXMLSizeLimit, o.UnzipXMLSizeLimit)
+	mergeOption(&opts.TmpDir, o.TmpDir)
+	mergeOption(&opts.TempFileProvider, o.TempFileProvider)
+	mergeOption(&opts.ShortDatePattern, o.ShortDatePattern)
+	mergeOption(&opts.LongDatePattern, o.LongDatePattern)
+	mergeOption(&opts.LongTimePattern, o.LongTimePattern)
+	mergeOption(&opts.DecimalSeparator, o.DecimalSeparator)
+	mergeOption(&opts.ThousandsSeparator, o.ThousandsSeparator)
+	mergeOption(&opts.CurrencySymbol, o.CurrencySymbol)
+	mergeOption(&opts.MaxImageDPI, o.MaxImageDPI)
+	mergeOption(&opts.ImageQuality, o.ImageQuality)
+	mergeOption(&opts.DeduplicateMedia, o.DeduplicateMedia)
+	mergeOption(&opts.AlternateContent, o.AlternateContent)
+	mergeOption(&opts.Charset, o.Charset)
+	mergeOption(&opts.MaxParts, o.MaxParts)
+	mergeOption(&opts.MaxXMLDepth, o.MaxXMLDepth)
+	mergeOption(&opts.MaxXMLAttributes, o.MaxXMLAttributes)
+	mergeOption(&opts.MaxXMLEntities, o.MaxXMLEntities)
+	mergeOption(&opts.StrictPartNames, o.StrictPartNames)
+	mergeOption(&opts.MemoryMap, o.MemoryMap)
+	mergeOption(&opts.ReadOnly, o.ReadOnly)
+	mergeOption(&opts.ZipComment, o.ZipComment)
+	mergeOption(&opts.TextMetrics, o.TextMetrics)
+	mergeOption(&opts.DiagnosticsCollector, o.DiagnosticsCollector)
+	mergeOption(&opts.Logger, o.Logger)
+	if !o.ZipModTime.IsZero() {
+		opts.ZipModTime = o.ZipModTime
+	}
+}
+
+// mergeOption sets the option by given value if the value isn't the zero
+// value.
+func mergeOption[T comparable](dst *T, src T) {
+	var zero T
+	if src != zero {
+		*dst = src
+	}
+}
+
+// WithTmpDir provides a function to set the directory of the temporary files
+// of the presentation.
+func WithTmpDir(dir string) Option {
+	return optionFunc(func(f *File) { f.options.TmpDir = dir })
+}
+
+// WithTempFileProvider provides a function to set the provider of the
+// scratch files of the presentation.
+func WithTempFileProvider(provider TempFileProvider) Option {
+	return optionFunc(func(f *File) { f.options.TempFileProvider = provider })
+}
+
+// WithUnzipLimits provides a function to set the limits of the unzipped size
+// of the presentation and of each XML part in bytes on reading, the zero
+// limit keeps the current limit.
+func WithUnzipLimits(sizeLimit, xmlSizeLimit int64) Option {
+	return optionFunc(func(f *File) {
+		if sizeLimit != 0 {
+			f.options.UnzipSizeLimit = sizeLimit
+		}
+		if xmlSizeLimit != 0 {
+			f.options.UnzipXMLSizeLimit = xmlSizeLimit
+		}
+	})
+}
+
+// WithPassword provides a function to set the password of the presentation.
+func WithPassword(password string) Option {
+	return optionFunc(func(f *File) { f.options.Password = password })
+}
+
+// WithZipWriter provides a function to set the function creating the ZIP
+// archive writer on saving the presentation, the default is the zip.Writer
+// of the archive/zip package.
+func WithZipWriter(zipWriter func(io.Writer) ZipWriter) Option {
+	return optionFunc(func(f *File) {
+		if zipWriter != nil {
+			f.ZipWriter = zipWriter
+		}
+	})
+}
+
+// WithLogger provides a function to set the logger reporting the repair
+// actions, and the non-fatal issues found on reading the presentation if the
+// diagnostics collector isn't set.
+func WithLogger(logger *slog.Logger) Option {
+	return optionFunc(func(f *File) { f.options.Logger = logger })
+}
+
+// WithTextMetrics provides a function to set the metrics measuring the width
+// of the text on checking and fitting the text in the shapes.
+func WithTextMetrics(metrics TextMetrics) Option {
+	return optionFunc(func(f *File) { f.options.TextMetrics = metrics })
+}
+
+// WithDiagnosticsCollector provides a function to set the collector
+// receiving the non-fatal issues found on reading the presentation.
+func WithDiagnosticsCollector(collector DiagnosticsCollector) Option {
+	return optionFunc(func(f *File) { f.options.DiagnosticsCollector = collector })
+}
+
+// logger returns the logger of the presentation, the messages are discarded
+// if the Logger option isn't set.
+func (f *File) logger() *slog.Logger {
+	if f.options != nil && f.options.Logger != nil {
+		return f.options.Logger
+	}
+	return slog.New(slog.DiscardHandler)
+}
+
+// textMetrics returns the metrics measuring the width of the text, the
+// default metrics are used if the TextMetrics option isn't set.
+func (f *File) textMetrics() TextMetrics {
+	if f.options != nil && f.options.TextMetrics != nil {
+		return f.options.TextMetrics
+	}
+	return defaultTextMetrics{}
+}
+
+// applyOptions provides a function to apply the optional settings for
+// opening, creating and saving the presentation in order.
+func (f *File) applyOptions(opts ...Option) {
+	for _, opt := range opts {
+		if opt != nil {
+			opt.applyOption(f)
+		}
+	}
+}
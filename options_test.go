@@ -0,0 +1,104 @@
+package gopptx
+
+import (
+	"bytes"
+	"io"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestApplyOptions(t *testing.T) {
+	modTime := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	for _, c := range []struct {
+		name     string
+		opts     []Option
+		expected Options
+	}{
+		{
+			name:     "default",
+			expected: Options{UnzipSizeLimit: UnzipSizeLimit, UnzipXMLSizeLimit: StreamChunkSize},
+		},
+		{
+			name: "merge the Options values",
+			opts: []Option{
+				Options{TmpDir: "/tmp", MaxImageDPI: 150, ReadOnly: true},
+				Options{Password: "password", MaxImageDPI: 96, ZipModTime: modTime},
+				nil,
+			},
+			expected: Options{
+				UnzipSizeLimit: UnzipSizeLimit, UnzipXMLSizeLimit: StreamChunkSize,
+				TmpDir: "/tmp", MaxImageDPI: 96, ReadOnly: true, Password: "password", ZipModTime: modTime,
+			},
+		},
+		{
+			name: "mix the functional options",
+			opts: []Option{
+				Options{TmpDir: "/tmp", Password: "password"},
+				WithTmpDir(""),
+				WithUnzipLimits(64<<20, 0),
+				WithPassword("secret"),
+				Options{UnzipXMLSizeLimit: 1 << 20},
+			},
+			expected: Options{UnzipSizeLimit: 64 << 20, UnzipXMLSizeLimit: 1 << 20, Password: "secret"},
+		},
+	} {
+		f := newFile()
+		f.applyOptions(c.opts...)
+		if *f.options != c.expected {
+			t.Errorf("%s: expected %+v, got %+v", c.name, c.expected, *f.options)
+		}
+	}
+}
+
+func TestWithZipWriter(t *testing.T) {
+	var created bool
+	f := NewFile(WithZipWriter(func(w io.Writer) ZipWriter {
+		created = true
+		return newFile().ZipWriter(w)
+	}), WithZipWriter(nil))
+	if _, err := f.WriteToBuffer(); err != nil {
+		t.Fatal(err)
+	}
+	if !created {
+		t.Error("the zip writer of the option isn't used")
+	}
+}
+
+func TestWithLogger(t *testing.T) {
+	for _, c := range []struct {
+		name   string
+		logger bool
+		logged int
+	}{
+		{name: "logger", logger: true, logged: 1},
+		{name: "no logger"},
+	} {
+		var (
+			buf  bytes.Buffer
+			opts []Option
+		)
+		if c.logger {
+			opts = append(opts, WithLogger(slog.New(slog.NewTextHandler(&buf, nil))))
+		}
+		f, err := openTestFile(t, func(name string, content []byte) []byte {
+			if name == defaultXMLPathPresentation {
+				return bytes.Replace(content, []byte(`<p:sldId `), []byte(`<p:sldId xmlns:x="urn:unexpected" x:id="1" `), 1)
+			}
+			return content
+		}, opts...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err = f.presentationReader(); err != nil {
+			t.Fatalf("%s: expected the unexpected namespace ignored, got %v", c.name, err)
+		}
+		if len(f.GetSlideList()) != 1 {
+			t.Errorf("%s: expected 1 slide, got %d", c.name, len(f.GetSlideList()))
+		}
+		if logged := strings.Count(buf.String(), "urn:unexpected"); logged != c.logged {
+			t.Errorf("%s: expected %d logged warnings, got %d", c.name, c.logged, logged)
+		}
+	}
+}
@@ -0,0 +1,144 @@
+// Copyright 2026 kenny-not-dead. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+//
+// Package gopptx provides functionality to create and manipulate PowerPoint
+// (.pptx) files in Go, using the Office Open XML (ECMA-376) format.
+
+package gopptx
+
+import "strings"
+
+// OutlineSlide directly maps the slide in the outline of the presentation,
+// which holds the title of the slide and the bullets of the body placeholder
+// of the slide.
+type OutlineSlide struct {
+	SlideID int
+	Title   string
+	Items   []OutlineItem
+}
+
+// OutlineItem directly maps the bullet in the outline of the presentation,
+// the children are the bullets of the next outline level under the bullet.
+type OutlineItem struct {
+	Text     string
+	Children []OutlineItem
+}
+
+// GetOutline provides a function to get the outline of the presentation as
+// in the outline view of PowerPoint, which is the list of the slides with the
+// titles and the tree of the bullets of the first body placeholder by the
+// outline levels of the paragraphs. The empty paragraphs are skipped. For
+// example:
+//
+//	outline, err := f.GetOutline()
+//	if err != nil {
+//	    fmt.Println(err)
+//	    return
+//	}
+//	for _, slide := range outline {
+//	    fmt.Println(slide.Title)
+//	    for _, item := range slide.Items {
+//	        fmt.Println("-", item.Text, len(item.Children))
+//	    }
+//	}
+func (f *File) GetOutline() ([]OutlineSlide, error) {
+	var outline []OutlineSlide
+	for _, slideID := range f.GetSlideList() {
+		slide, err := f.slideReader(slideID)
+		if err != nil {
+			return outline, err
+		}
+		item := OutlineSlide{SlideID: slideID}
+		if shape := slide.getTitleShape(); shape != nil {
+			item.Title = strings.TrimSpace(strings.ReplaceAll(shape.TextBody.text(), "\n", " "))
+		}
+		if shape := slide.getPlaceholder("body", nil, nil); shape != nil && shape.TextBody != nil {
+			item.Items = getOutlineItems(shape.TextBody.Paragraph)
+		}
+		outline = append(outline, item)
+	}
+	return outline, nil
+}
+
+// SetOutline provides a function to set the titles and regenerate the first
+// body placeholders of the slides by given outline, the slides are specified
+// by the slide IDs of the outline. The nesting depth of the bullets is the
+// outline level of the paragraphs, the empty title keeps the title of the
+// slide, and the body placeholder is kept if the slide has no bullets in the
+// outline. For example:
+//
+//	err := f.SetOutline([]gopptx.OutlineSlide{{
+//	    SlideID: 256,
+//	    Title:   "Agenda",
+//	    Items: []gopptx.OutlineItem{
+//	        {Text: "Results", Children: []gopptx.OutlineItem{{Text: "Revenue"}, {Text: "Costs"}}},
+//	        {Text: "Outlook"},
+//	    },
+//	}})
+func (f *File) SetOutline(outline []OutlineSlide) error {
+	if err := f.checkReadOnly(); err != nil {
+		return err
+	}
+	for _, item := range outline {
+		slide, err := f.slideReader(item.SlideID)
+		if err != nil {
+			return err
+		}
+		if item.Title != "" {
+			if err = f.SetSlideTitle(item.SlideID, item.Title); err != nil {
+				return err
+			}
+		}
+		if len(item.Items) == 0 {
+			continue
+		}
+		shape := slide.getPlaceholder("body", nil, nil)
+		if shape == nil {
+			return ErrPlaceholderNotExist
+		}
+		var paragraphs []JSONParagraph
+		var walk func(items []OutlineItem, level int)
+		walk = func(items []OutlineItem, level int) {
+			for _, child := range items {
+				paragraphs = append(paragraphs, JSONParagraph{Level: level, Runs: []JSONRun{{Text: child.Text}}})
+				walk(child.Children, min(level+1, 8))
+			}
+		}
+		walk(item.Items, 0)
+		setPlaceholderParagraphs(shape, paragraphs)
+	}
+	return nil
+}
+
+// getOutlineItems returns the tree of the bullets by given paragraphs, the
+// paragraph is nested under the last paragraph with a lower outline level.
+func getOutlineItems(paragraphs []DecodeParagraph) []OutlineItem {
+	type entry struct {
+		level int
+		text  string
+	}
+	var list []entry
+	for i := range paragraphs {
+		if text := strings.TrimSpace(paragraphs[i].text()); text != "" {
+			list = append(list, entry{level: paragraphs[i].level(), text: text})
+		}
+	}
+	var build func(idx, level int) ([]OutlineItem, int)
+	build = func(idx, level int) ([]OutlineItem, int) {
+		var items []OutlineItem
+		for idx < len(list) && list[idx].level >= level {
+			if last := len(items) - 1; last >= 0 && list[idx].level > level {
+				var children []OutlineItem
+				children, idx = build(idx, list[idx].level)
+				items[last].Children = append(items[last].Children, children...)
+				continue
+			}
+			items = append(items, OutlineItem{Text: list[idx].text})
+			idx++
+		}
+		return items, idx
+	}
+	items, _ := build(0, 0)
+	return items
+}
@@ -0,0 +1,79 @@
+package gopptx
+
+import (
+	"errors"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestOutline(t *testing.T) {
+	f := NewFile()
+	first := f.GetSlideList()[0]
+	second, err := f.NewSlide()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, slideID := range []int{first, second} {
+		slide, err := f.slideReader(slideID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		slide.CommonSlideData.ShapeTree.Shape = slices.DeleteFunc(slide.CommonSlideData.ShapeTree.Shape, func(shape decodeShape) bool {
+			return shape.isBody()
+		})
+	}
+	body := addTestShape(t, f, second, "body", "Intro", " ", "Revenue", "Costs", "Outlook")
+	for i, level := range []int{0, 0, 1, 2, 0} {
+		body.TextBody.Paragraph[i].ParagraphProperties = &ParagraphProperties{Level: &level}
+	}
+	outline, err := f.GetOutline()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []OutlineSlide{
+		{SlideID: first},
+		{SlideID: second, Items: []OutlineItem{
+			{Text: "Intro", Children: []OutlineItem{{Text: "Revenue", Children: []OutlineItem{{Text: "Costs"}}}}},
+			{Text: "Outlook"},
+		}},
+	}
+	if !reflect.DeepEqual(outline, expected) {
+		t.Errorf("expected the outline %+v, got %+v", expected, outline)
+	}
+	expected = []OutlineSlide{
+		{SlideID: first, Title: "Quarterly Review"},
+		{SlideID: second, Title: "Agenda", Items: []OutlineItem{
+			{Text: "Results", Children: []OutlineItem{{Text: "Revenue"}, {Text: "Costs"}}},
+			{Text: "Outlook"},
+		}},
+	}
+	if err = f.SetOutline(expected); err != nil {
+		t.Fatal(err)
+	}
+	buf, err := f.WriteToBuffer()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f, err = OpenReader(buf); err != nil {
+		t.Fatal(err)
+	}
+	if outline, err = f.GetOutline(); err != nil || !reflect.DeepEqual(outline, expected) {
+		t.Errorf("expected the outline %+v, got %+v %v", expected, outline, err)
+	}
+	if err = f.SetOutline([]OutlineSlide{{SlideID: first}}); err != nil {
+		t.Errorf("expected the slide without the items is kept, got %v", err)
+	}
+
+	for _, c := range []struct {
+		outline  []OutlineSlide
+		expected error
+	}{
+		{outline: []OutlineSlide{{SlideID: first, Items: []OutlineItem{{Text: "Item"}}}}, expected: ErrPlaceholderNotExist},
+		{outline: []OutlineSlide{{SlideID: 300, Title: "Title"}}, expected: ErrSlideNotExist{300}},
+	} {
+		if err = f.SetOutline(c.outline); !errors.Is(err, c.expected) {
+			t.Errorf("expected %v, got %v", c.expected, err)
+		}
+	}
+}
@@ -0,0 +1,130 @@
+// Copyright 2026 kenny-not-dead. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+//
+// Package gopptx provides functionality to create and manipulate PowerPoint
+// (.pptx) files in Go, using the Office Open XML (ECMA-376) format.
+
+package gopptx
+
+import "sort"
+
+// ColorUsage directly maps the color used in the presentation. The color is
+// the hex RGB color with the color transforms applied. The scheme color is
+// the name of the theme color such as "accent1" or "tx1" for the colors bound
+// to the theme, and empty for the explicit colors. The count is the number of
+// the color elements using the color.
+type ColorUsage struct {
+	Color       string
+	SchemeColor string
+	Count       int
+}
+
+// GetColorPalette provides a function to get the colors used by the slides,
+// the slide layouts and the slide masters in the presentation with the usage
+// counts, the colors bound to the theme are resolved by the color mapping of
+// the part. The colors are sorted by the usage counts in descending order.
+// For example, report the colors which are not in the brand palette:
+//
+//	palette, err := f.GetColorPalette()
+//	if err != nil {
+//	    fmt.Println(err)
+//	    return
+//	}
+//	brand := map[string]bool{"000000": true, "FFFFFF": true, "C00000": true}
+//	for _, usage := range palette {
+//	    if !brand[usage.Color] {
+//	        fmt.Println(usage.Color, usage.SchemeColor, usage.Count)
+//	    }
+//	}
+func (f *File) GetColorPalette() ([]ColorUsage, error) {
+	counts := make(map[ColorUsage]int)
+	for _, slideID := range f.GetSlideList() {
+		slide, err := f.slideNodeReader(slideID)
+		if err != nil {
+			return nil, err
+		}
+		slideXMLPath, _ := f.getSlideXMLPath(slideID)
+		layoutXMLPath, _ := f.getRelTargetByType(slideXMLPath, SourceRelationshipSlideLayout)
+		masterXMLPath, _ := f.getRelTargetByType(layoutXMLPath, SourceRelationshipSlideMaster)
+		r := &styleResolver{}
+		if r.colorMap, err = f.getSlideColorMap(layoutXMLPath, slide); err != nil {
+			return nil, err
+		}
+		if r.theme, err = f.getMasterThemeNode(masterXMLPath); err != nil {
+			return nil, err
+		}
+		r.countColors(counts, slide)
+	}
+	for _, masterXMLPath := range f.getSlideMasterPaths() {
+		master, err := f.xmlNodeReader(f.readXML(masterXMLPath))
+		if err != nil {
+			return nil, err
+		}
+		r := &styleResolver{colorMap: getColorMap(master)}
+		if r.theme, err = f.getMasterThemeNode(masterXMLPath); err != nil {
+			return nil, err
+		}
+		r.countColors(counts, master)
+		for _, layoutXMLPath := range f.getMasterLayoutPaths(masterXMLPath, master) {
+			layout, err := f.xmlNodeReader(f.readXML(layoutXMLPath))
+			if err != nil {
+				return nil, err
+			}
+			(&styleResolver{colorMap: getColorMap(master, layout), theme: r.theme}).countColors(counts, layout)
+		}
+	}
+	palette := make([]ColorUsage, 0, len(counts))
+	for usage, count := range counts {
+		usage.Count = count
+		palette = append(palette, usage)
+	}
+	sort.Slice(palette, func(i, j int) bool {
+		if palette[i].Count != palette[j].Count {
+			return palette[i].Count > palette[j].Count
+		}
+		if palette[i].Color != palette[j].Color {
+			return palette[i].Color < palette[j].Color
+		}
+		return palette[i].SchemeColor < palette[j].SchemeColor
+	})
+	return palette, nil
+}
+
+// getMasterThemeNode provides a function to get the element tree of the theme
+// of the slide master by given slide master part path.
+func (f *File) getMasterThemeNode(masterXMLPath string) (*xmlNode, error) {
+	themeXMLPath, ok := f.getRelTargetByType(masterXMLPath, SourceRelationshipTheme)
+	if !ok {
+		themeXMLPath = defaultXMLPathTheme
+	}
+	content := f.readXML(themeXMLPath)
+	if len(content) == 0 {
+		return nil, nil
+	}
+	return f.xmlNodeReader(content)
+}
+
+// countColors counts the resolved colors of the color elements in the element
+// tree by given counts keyed by the colors and the element tree. The
+// placeholder colors of the style matrix are skipped.
+func (r *styleResolver) countColors(counts map[ColorUsage]int, node *xmlNode) {
+	for _, child := range node.Children {
+		var usage ColorUsage
+		switch localName(child.Name) {
+		case "schemeClr":
+			if usage.SchemeColor = child.attr("val"); usage.SchemeColor == "phClr" {
+				continue
+			}
+		case "srgbClr", "sysClr", "prstClr":
+		case "":
+			continue
+		default:
+			r.countColors(counts, child)
+			continue
+		}
+		if usage.Color = r.color(&xmlNode{Children: []*xmlNode{child}}, ""); usage.Color != "" {
+			counts[usage]++
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package gopptx
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestGetColorPalette(t *testing.T) {
+	f := NewFile()
+	slideID := f.GetSlideList()[0]
+	getPalette := func() map[ColorUsage]int {
+		palette, err := f.GetColorPalette()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !sort.SliceIsSorted(palette, func(i, j int) bool { return palette[i].Count > palette[j].Count }) {
+			t.Errorf("expected the colors sorted by the usage counts, got %v", palette)
+		}
+		counts := map[ColorUsage]int{}
+		for _, usage := range palette {
+			counts[ColorUsage{Color: usage.Color, SchemeColor: usage.SchemeColor}] = usage.Count
+		}
+		return counts
+	}
+	base := getPalette()
+	for _, fill := range []*DecodeSolidFill{
+		{SchemeColor: &DecodeSchemeColor{Val: "accent2", LumMod: &ColorModifier{Val: 50000}}},
+		{SolidRGBColor: &SolidRGBColor{Val: "FF0000"}},
+		{SolidRGBColor: &SolidRGBColor{Val: "FF0000"}},
+	} {
+		if _, err := f.CreateShape(slideID, DecodeShapeProperties{
+			Xfrm:      &DecodeXfrm{Offset: &Offset{}, Extents: &Extents{CX: 914400, CY: 914400}},
+			SolidFill: fill,
+		}, DecodeTextBody{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, c := range []struct {
+		colorMap *ColorMap
+		expected map[ColorUsage]int
+	}{
+		{expected: map[ColorUsage]int{{Color: "013452", SchemeColor: "accent2"}: 1, {Color: "FF0000"}: 2}},
+		{colorMap: &ColorMap{Accent2: "accent1"}, expected: map[ColorUsage]int{{Color: "0C5201", SchemeColor: "accent2"}: 1, {Color: "FF0000"}: 2}},
+	} {
+		if c.colorMap != nil {
+			if err := f.SetSlideColorMap(slideID, c.colorMap); err != nil {
+				t.Fatal(err)
+			}
+		}
+		added := map[ColorUsage]int{}
+		for usage, count := range getPalette() {
+			if count != base[usage] {
+				added[usage] = count - base[usage]
+			}
+		}
+		if !reflect.DeepEqual(added, c.expected) {
+			t.Errorf("expected the added colors %v, got %v", c.expected, added)
+		}
+	}
+}
@@ -0,0 +1,132 @@
+// Copyright 2026 kenny-not-dead. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+//
+// Package gopptx provides functionality to create and manipulate PowerPoint
+// (.pptx) files in Go, using the Office Open XML (ECMA-376) format.
+
+package gopptx
+
+import (
+	"path"
+	"sync"
+)
+
+// PartHandler defines the interface of the handler of the custom parts which
+// are not supported by the package, such as the diagram or the ink parts. The
+// handlers are registered by the RegisterPartHandler function, so the
+// features of the custom parts can be developed in the external packages.
+//
+// ReadPart is invoked with the part name and the content of each matched
+// part on opening the presentation, and the opening fails if it returns an
+// error. WritePart is invoked with the part name of each matched part on
+// saving the presentation, it returns the content written instead of the
+// current content of the part, and the part is kept as is if the returned
+// content is nil.
+type PartHandler interface {
+	ReadPart(f *File, partName string, content []byte) error
+	WritePart(f *File, partName string) ([]byte, error)
+}
+
+// registeredPartHandler defines the registered part handler with the content
+// type and the part name pattern of the matched parts.
+type registeredPartHandler struct {
+	contentType string
+	pattern     string
+	handler     PartHandler
+}
+
+// partHandlers defined the registry of the part handlers.
+var partHandlers struct {
+	sync.RWMutex
+	list []registeredPartHandler
+}
+
+// RegisterPartHandler provides a function to register the handler of the
+// custom parts by given content type, part name pattern and the handler. The
+// part matches the handler if its content type equals the content type and
+// its part name matches the pattern, which has the syntax of the path.Match
+// function, such as "ppt/ink/*.xml". The empty content type or pattern
+// matches any part, but they can't be both empty. The handlers are invoked in
+// the order of the registration. For example:
+//
+//	err := gopptx.RegisterPartHandler(
+//	    "application/inkml+xml", "ppt/ink/*.xml", &inkHandler{})
+func RegisterPartHandler(contentType, pattern string, handler PartHandler) error {
+	if handler == nil || (contentType == "" && pattern == "") {
+		return ErrPartHandler
+	}
+	if _, err := path.Match(pattern, ""); err != nil {
+		return err
+	}
+	partHandlers.Lock()
+	defer partHandlers.Unlock()
+	partHandlers.list = append(partHandlers.list, registeredPartHandler{
+		contentType: contentType, pattern: pattern, handler: handler,
+	})
+	return nil
+}
+
+// getPartHandlers provides a function to get the registered handlers matched
+// the part by given part name.
+func (f *File) getPartHandlers(partName string) ([]PartHandler, error) {
+	partHandlers.RLock()
+	list := partHandlers.list
+	partHandlers.RUnlock()
+	if len(list) == 0 {
+		return nil, nil
+	}
+	contentType, _, err := f.getPartContentType(partName)
+	if err != nil {
+		return nil, err
+	}
+	var handlers []PartHandler
+	for _, h := range list {
+		if h.contentType != "" && h.contentType != contentType {
+			continue
+		}
+		if matched, _ := path.Match(h.pattern, partName); h.pattern != "" && !matched {
+			continue
+		}
+		handlers = append(handlers, h.handler)
+	}
+	return handlers, nil
+}
+
+// customPartsReader provides a function to invoke the registered handlers
+// with the matched parts on opening the presentation.
+func (f *File) customPartsReader() error {
+	for _, partName := range f.getPartNames() {
+		handlers, err := f.getPartHandlers(partName)
+		if err != nil {
+			return err
+		}
+		for _, handler := range handlers {
+			if err = handler.ReadPart(f, partName, f.readBytes(partName)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// customPartsWriter provides a function to invoke the registered handlers to
+// serialize the matched parts on saving the presentation.
+func (f *File) customPartsWriter() error {
+	for _, partName := range f.getPartNames() {
+		handlers, err := f.getPartHandlers(partName)
+		if err != nil {
+			return err
+		}
+		for _, handler := range handlers {
+			content, err := handler.WritePart(f, partName)
+			if err != nil {
+				return err
+			}
+			if content != nil {
+				f.Pkg.Store(partName, content)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,94 @@
+package gopptx
+
+import (
+	"bytes"
+	"errors"
+	"path"
+	"reflect"
+	"testing"
+)
+
+// testPartHandler defines the part handler which records the read parts and
+// returns the given content and error.
+type testPartHandler struct {
+	read    map[string]string
+	written []string
+	content []byte
+	err     error
+}
+
+func (h *testPartHandler) ReadPart(f *File, partName string, content []byte) error {
+	if h.read == nil {
+		h.read = map[string]string{}
+	}
+	h.read[partName] = string(content)
+	return h.err
+}
+
+func (h *testPartHandler) WritePart(f *File, partName string) ([]byte, error) {
+	h.written = append(h.written, partName)
+	return h.content, nil
+}
+
+func TestRegisterPartHandler(t *testing.T) {
+	list := partHandlers.list
+	t.Cleanup(func() { partHandlers.list = list })
+	const contentType = "application/vnd.example.item+xml"
+	for _, c := range []struct {
+		contentType, pattern string
+		handler              PartHandler
+		expected             error
+	}{
+		{contentType: contentType, expected: ErrPartHandler},
+		{handler: &testPartHandler{}, expected: ErrPartHandler},
+		{pattern: "ppt/custom/[", handler: &testPartHandler{}, expected: path.ErrBadPattern},
+	} {
+		if err := RegisterPartHandler(c.contentType, c.pattern, c.handler); !errors.Is(err, c.expected) {
+			t.Errorf("expected %v, got %v", c.expected, err)
+		}
+	}
+	byContentType, byPattern, mismatched := &testPartHandler{}, &testPartHandler{content: []byte("<item>2</item>")}, &testPartHandler{}
+	for _, c := range []struct {
+		contentType, pattern string
+		handler              PartHandler
+	}{
+		{contentType: contentType, handler: byContentType},
+		{pattern: "ppt/custom/*.xml", handler: byPattern},
+		{contentType: "text/plain", pattern: "ppt/custom/*.xml", handler: mismatched},
+	} {
+		if err := RegisterPartHandler(c.contentType, c.pattern, c.handler); err != nil {
+			t.Fatal(err)
+		}
+	}
+	f := NewFile()
+	f.Pkg.Store("ppt/custom/item1.xml", []byte("<item>1</item>"))
+	if err := f.setContentTypes("/ppt/custom/item1.xml", contentType); err != nil {
+		t.Fatal(err)
+	}
+	buf, err := f.WriteToBuffer()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = OpenReader(bytes.NewReader(buf.Bytes())); err != nil {
+		t.Fatal(err)
+	}
+	for _, h := range []*testPartHandler{byContentType, byPattern} {
+		if !reflect.DeepEqual(h.written, []string{"ppt/custom/item1.xml"}) {
+			t.Errorf("expected the written custom part, got %v", h.written)
+		}
+		if expected := map[string]string{"ppt/custom/item1.xml": "<item>2</item>"}; !reflect.DeepEqual(h.read, expected) {
+			t.Errorf("expected the read parts %v, got %v", expected, h.read)
+		}
+	}
+	if mismatched.read != nil || mismatched.written != nil {
+		t.Errorf("expected the handler of the other content type isn't invoked, got %v %v", mismatched.read, mismatched.written)
+	}
+
+	errRead := errors.New("read")
+	if err = RegisterPartHandler(contentType, "", &testPartHandler{err: errRead}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = OpenReader(bytes.NewReader(buf.Bytes())); err != errRead {
+		t.Errorf("expected the error of the handler, got %v", err)
+	}
+}
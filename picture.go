@@ -0,0 +1,602 @@
+// Copyright 2026 kenny-not-dead. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+//
+// Package gopptx provides functionality to create and manipulate PowerPoint
+// (.pptx) files in Go, using the Office Open XML (ECMA-376) format.
+
+package gopptx
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"image"
+	_ "image/gif"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"math"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// PictureOptions directly maps the format settings of the picture. The
+// offset and size are specified in EMUs, if the width or height is zero,
+// the size will be calculated by the image size at 96 DPI with keeping the
+// aspect ratio. The effects specify the color adjustments of the picture.
+type PictureOptions struct {
+	Name            string
+	AltText         string
+	OffsetX         int
+	OffsetY         int
+	Width           int
+	Height          int
+	LockAspectRatio bool
+	Effects         *PictureEffects
+}
+
+// PictureEffects directly maps the color adjustments of the picture. The
+// duotone is a pair of hex RGB colors which recolor the dark and light tones
+// of the picture. The brightness and contrast are percentages between -100
+// and 100, and the transparency is a percentage between 0 and 100.
+type PictureEffects struct {
+	Grayscale    bool
+	Duotone      []string
+	Brightness   int
+	Contrast     int
+	Transparency int
+}
+
+// AddPicture provides the method to add picture in a slide by given slide id,
+// picture file path and format settings, and returns the id of the picture
+// shape in the slide. Supported image types: BMP, EMF, GIF, JPEG, JPG, PNG,
+// SVG, TIF, TIFF and WMF, the width and height options are required for the
+// image types except GIF, JPEG and PNG. For example, add a picture at the top
+// left corner of the slide:
+//
+//	id, err := f.AddPicture(256, "image.png", &gopptx.PictureOptions{
+//	    OffsetX:         457200,
+//	    OffsetY:         457200,
+//	    LockAspectRatio: true,
+//	})
+func (f *File) AddPicture(slideID int, name string, opts *PictureOptions) (int, error) {
+	if err := f.checkReadOnly(); err != nil {
+		return -1, err
+	}
+	if _, ok := supportedImageTypes[strings.ToLower(filepath.Ext(name))]; !ok {
+		return -1, ErrImgExt
+	}
+	file, err := os.ReadFile(filepath.Clean(name))
+	if err != nil {
+		return -1, err
+	}
+	return f.AddPictureFromBytes(slideID, filepath.Ext(name), file, opts)
+}
+
+// AddPictureFromImage provides the method to add picture in a slide by given
+// slide id, image, image format and format settings, and returns the id of the
+// picture shape in the slide. The image is encoded by the image format, which
+// is one of ".png", ".jpg" and ".jpeg". It's useful for placing the images
+// generated in memory, such as the plots and the QR codes. For example:
+//
+//	img := image.NewRGBA(image.Rect(0, 0, 640, 480))
+//	draw.Draw(img, img.Bounds(), image.White, image.Point{}, draw.Src)
+//	id, err := f.AddPictureFromImage(256, img, ".png", nil)
+func (f *File) AddPictureFromImage(slideID int, img image.Image, format string, opts *PictureOptions) (int, error) {
+	if err := f.checkReadOnly(); err != nil {
+		return -1, err
+	}
+	var buf bytes.Buffer
+	switch strings.ToLower(format) {
+	case ".png":
+		if err := png.Encode(&buf, img); err != nil {
+			return -1, err
+		}
+	case ".jpg", ".jpeg":
+		if err := jpeg.Encode(&buf, img, nil); err != nil {
+			return -1, err
+		}
+	default:
+		return -1, ErrImgExt
+	}
+	return f.AddPictureFromBytes(slideID, format, buf.Bytes(), opts)
+}
+
+// AddPictureFromBytes provides the method to add picture in a slide by given
+// slide id, image file extension, image data and format settings, and
+// returns the id of the picture shape in the slide. For example:
+//
+//	file, err := os.ReadFile("image.jpg")
+//	if err != nil {
+//	    fmt.Println(err)
+//	    return
+//	}
+//	id, err := f.AddPictureFromBytes(256, ".jpg", file, nil)
+func (f *File) AddPictureFromBytes(slideID int, extension string, file []byte, opts *PictureOptions) (int, error) {
+	if err := f.checkReadOnly(); err != nil {
+		return -1, err
+	}
+	contentType, ok := supportedImageTypes[strings.ToLower(extension)]
+	if !ok {
+		return -1, ErrImgExt
+	}
+	if opts == nil {
+		opts = &PictureOptions{}
+	}
+	width, height, err := getPictureSize(file, opts)
+	if err != nil {
+		return -1, err
+	}
+	slide, err := f.slideReader(slideID)
+	if err != nil {
+		return -1, err
+	}
+	slideXMLPath, _ := f.getSlideXMLPath(slideID)
+	mediaPath := f.addMedia(file, extension)
+	if err = f.setContentTypeDefault(extension, contentType); err != nil {
+		return -1, err
+	}
+	rID := f.addRels(getPartRelsPath(slideXMLPath), SourceRelationshipImage, "../media/"+path.Base(mediaPath), "")
+	return slide.addPicture(&decodeBlip{Embed: rID}, width, height, opts), nil
+}
+
+// AddLinkedPicture provides the method to add the picture linked to the
+// external image in a slide by given slide id, location of the image and
+// format settings, and returns the id of the picture shape in the slide. The
+// image isn't stored in the presentation, the size is detected by the image
+// file if the location is a local file path of the GIF, JPEG or PNG image,
+// otherwise the width and height options are required. For example:
+//
+//	id, err := f.AddLinkedPicture(256, "file:///C:\\Images\\logo.png", &gopptx.PictureOptions{
+//	    Width:  914400,
+//	    Height: 914400,
+//	})
+func (f *File) AddLinkedPicture(slideID int, target string, opts *PictureOptions) (int, error) {
+	if err := f.checkReadOnly(); err != nil {
+		return -1, err
+	}
+	if opts == nil {
+		opts = &PictureOptions{}
+	}
+	width, height := opts.Width, opts.Height
+	if width == 0 || height == 0 {
+		file, err := readLinkedImage(target)
+		if err != nil {
+			return -1, ErrImgSize
+		}
+		if width, height, err = getPictureSize(file, opts); err != nil {
+			return -1, err
+		}
+	}
+	slide, err := f.slideReader(slideID)
+	if err != nil {
+		return -1, err
+	}
+	slideXMLPath, _ := f.getSlideXMLPath(slideID)
+	rID := f.addRels(getPartRelsPath(slideXMLPath), SourceRelationshipImage, target, "External")
+	return slide.addPicture(&decodeBlip{Link: rID}, width, height, opts), nil
+}
+
+// addPicture provides a function to add the picture in the slide by given
+// blip, size of the picture in EMUs and format settings, and returns the id
+// of the picture shape.
+func (ds *decodeSlide) addPicture(blip *decodeBlip, width, height int, opts *PictureOptions) int {
+	shapeID := ds.nextShapeID()
+	name := opts.Name
+	if name == "" {
+		name = "Picture " + strconv.Itoa(shapeID-1)
+	}
+	var picLocks *PictureLocks
+	if opts.LockAspectRatio {
+		noChangeAspect := 1
+		picLocks = &PictureLocks{NoChangeAspect: &noChangeAspect}
+	}
+	if opts.Effects != nil {
+		effects := newBlip(blip.Embed, opts.Effects)
+		effects.Link, blip = blip.Link, effects
+	}
+	ds.CommonSlideData.ShapeTree.Picture = append(ds.CommonSlideData.ShapeTree.Picture, decodePicture{
+		NonVisualPictureProperties: &decodeNonVisualPictureProperties{
+			CommonNonVisualProperties: &CommonNonVisualProperties{
+				ID:    shapeID,
+				Name:  name,
+				Descr: opts.AltText,
+			},
+			CommonNonVisualPictureProperties: &decodeCommonNonVisualPictureProperties{
+				PictureLocks: picLocks,
+			},
+			NonVisualProperties: &decodeNonVisualProperties{},
+		},
+		BlipFill: &decodeBlipFill{
+			Blip:    blip,
+			Stretch: &decodeStretch{FillRect: &FillRect{}},
+		},
+		ShapeProperties: &DecodeShapeProperties{
+			Xfrm: &DecodeXfrm{
+				Offset:  &Offset{X: opts.OffsetX, Y: opts.OffsetY},
+				Extents: &Extents{CX: width, CY: height},
+			},
+			PresetGeometry: &DecodePresetGeometry{Preset: "rect", AdjustValueList: &AdjustValueList{}},
+		},
+	})
+	return shapeID
+}
+
+// ReplaceImage provides a function to replace the image of the picture by
+// given slide id, name of the picture shape, image file extension and image
+// data. The transform, crop and effects of the picture are kept, so the new
+// image is stretched into the existing frame. For example, replace the image
+// of the picture named "Logo":
+//
+//	file, err := os.ReadFile("logo.png")
+//	if err != nil {
+//	    fmt.Println(err)
+//	    return
+//	}
+//	err = f.ReplaceImage(256, "Logo", ".png", file)
+func (f *File) ReplaceImage(slideID int, shapeName, extension string, file []byte) error {
+	if err := f.checkReadOnly(); err != nil {
+		return err
+	}
+	contentType, ok := supportedImageTypes[strings.ToLower(extension)]
+	if !ok {
+		return ErrImgExt
+	}
+	slide, err := f.slideReader(slideID)
+	if err != nil {
+		return err
+	}
+	pictures := slide.CommonSlideData.ShapeTree.Picture
+	idx := -1
+	for i, pic := range pictures {
+		if pic.NonVisualPictureProperties != nil && pic.NonVisualPictureProperties.CommonNonVisualProperties != nil &&
+			pic.NonVisualPictureProperties.CommonNonVisualProperties.Name == shapeName &&
+			pic.BlipFill != nil && pic.BlipFill.Blip != nil {
+			idx = i
+			break
+		}
+	}
+	if idx == -1 {
+		return ErrPictureNotExist{SlideID: slideID, Name: shapeName}
+	}
+	slideXMLPath, _ := f.getSlideXMLPath(slideID)
+	mediaPath := f.addMedia(file, extension)
+	if err = f.setContentTypeDefault(extension, contentType); err != nil {
+		return err
+	}
+	oldRID := pictures[idx].BlipFill.Blip.Embed
+	rID := f.addRels(getPartRelsPath(slideXMLPath), SourceRelationshipImage, "../media/"+path.Base(mediaPath), "")
+	pictures[idx].BlipFill.Blip.Embed = rID
+	f.deleteUnusedImage(slide, slideXMLPath, oldRID)
+	return nil
+}
+
+// deleteUnusedImage provides a function to delete the image relationship of
+// the slide by given slide, slide part path and relationship ID if no
+// picture of the slide refers to it, and the media part is deleted if no
+// other part refers to it.
+func (f *File) deleteUnusedImage(slide *decodeSlide, slideXMLPath, rID string) {
+	for _, pic := range slide.CommonSlideData.ShapeTree.Picture {
+		if pic.BlipFill != nil && pic.BlipFill.Blip != nil && (pic.BlipFill.Blip.Embed == rID || pic.BlipFill.Blip.Link == rID) {
+			return
+		}
+	}
+	mediaPath, ok := f.getRelTarget(slideXMLPath, rID)
+	f.deleteRels(getPartRelsPath(slideXMLPath), rID)
+	if ok && !f.isPartReferenced(mediaPath) {
+		f.Pkg.Delete(mediaPath)
+		f.deleteTempFile(mediaPath)
+	}
+}
+
+// PlaceholderImageOptions directly maps the settings of the picture filling
+// the picture placeholder. The mode is "cover" to crop the picture to fill
+// the frame, which is the default, or "contain" to show the whole picture in
+// the frame with the padding.
+type PlaceholderImageOptions struct {
+	Mode    string
+	AltText string
+}
+
+// SetPlaceholderImage provides a function to insert a picture into the
+// picture placeholder by given slide id, name of the placeholder, image file
+// extension, image data and settings. The placeholder is replaced with the
+// picture which inherits the position of the placeholder, and the picture is
+// cropped by the source rectangle to fit the frame without distortion. The
+// picture already filling the placeholder is replaced. The image types are
+// GIF, JPEG and PNG. For example, fill the placeholder named "Picture
+// Placeholder 2":
+//
+//	file, err := os.ReadFile("photo.jpg")
+//	if err != nil {
+//	    fmt.Println(err)
+//	    return
+//	}
+//	err = f.SetPlaceholderImage(256, "Picture Placeholder 2", ".jpg", file,
+//	    &gopptx.PlaceholderImageOptions{Mode: "cover"})
+func (f *File) SetPlaceholderImage(slideID int, shapeName, extension string, file []byte, opts *PlaceholderImageOptions) error {
+	if err := f.checkReadOnly(); err != nil {
+		return err
+	}
+	contentType, ok := supportedImageTypes[strings.ToLower(extension)]
+	if !ok {
+		return ErrImgExt
+	}
+	if opts == nil {
+		opts = &PlaceholderImageOptions{}
+	}
+	if opts.Mode != "" && opts.Mode != "cover" && opts.Mode != "contain" {
+		return ErrPlaceholderImageMode
+	}
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(file))
+	if err != nil || cfg.Width == 0 || cfg.Height == 0 {
+		return ErrImgSize
+	}
+	slide, err := f.slideReader(slideID)
+	if err != nil {
+		return err
+	}
+	shapeTree := &slide.CommonSlideData.ShapeTree
+	pic, shapeIdx := decodePicture{}, -1
+	for i, shape := range shapeTree.Shape {
+		if nvSpPr := shape.NonVisualShapeProperties; nvSpPr != nil && nvSpPr.CommonNonVisualProperties != nil &&
+			nvSpPr.CommonNonVisualProperties.Name == shapeName && shape.placeholderType() != "" {
+			shapeIdx, pic = i, decodePicture{
+				NonVisualPictureProperties: &decodeNonVisualPictureProperties{
+					CommonNonVisualProperties:        nvSpPr.CommonNonVisualProperties,
+					CommonNonVisualPictureProperties: &decodeCommonNonVisualPictureProperties{},
+					NonVisualProperties:              nvSpPr.NonVisualProperties,
+				},
+				ShapeProperties: shape.ShapeProperties,
+				order:           shape.order,
+			}
+			break
+		}
+	}
+	picIdx := -1
+	for i, p := range shapeTree.Picture {
+		if nvPicPr := p.NonVisualPictureProperties; shapeIdx == -1 && nvPicPr != nil && nvPicPr.CommonNonVisualProperties != nil &&
+			nvPicPr.CommonNonVisualProperties.Name == shapeName && nvPicPr.NonVisualProperties != nil &&
+			nvPicPr.NonVisualProperties.Ph != nil {
+			picIdx, pic = i, p
+			break
+		}
+	}
+	if shapeIdx == -1 && picIdx == -1 {
+		return ErrShapeNameNotExist{SlideID: slideID, Name: shapeName}
+	}
+	slideXMLPath, _ := f.getSlideXMLPath(slideID)
+	xfrm := (*DecodeXfrm)(nil)
+	if pic.ShapeProperties != nil && pic.ShapeProperties.Xfrm != nil && pic.ShapeProperties.Xfrm.Extents != nil {
+		xfrm = pic.ShapeProperties.Xfrm
+	} else if xfrm, err = f.getPlaceholderXfrm(slideXMLPath, pic.NonVisualPictureProperties.NonVisualProperties.Ph); err != nil {
+		return err
+	}
+	if xfrm == nil || xfrm.Extents == nil || xfrm.Extents.CX == 0 || xfrm.Extents.CY == 0 {
+		return ErrImgSize
+	}
+	mediaPath := f.addMedia(file, extension)
+	if err = f.setContentTypeDefault(extension, contentType); err != nil {
+		return err
+	}
+	rID := f.addRels(getPartRelsPath(slideXMLPath), SourceRelationshipImage, "../media/"+path.Base(mediaPath), "")
+	if opts.AltText != "" {
+		cNvPr := *pic.NonVisualPictureProperties.CommonNonVisualProperties
+		cNvPr.Descr = opts.AltText
+		pic.NonVisualPictureProperties.CommonNonVisualProperties = &cNvPr
+	}
+	noChangeAspect := 1
+	pic.NonVisualPictureProperties.CommonNonVisualPictureProperties = &decodeCommonNonVisualPictureProperties{
+		PictureLocks: &PictureLocks{NoChangeAspect: &noChangeAspect},
+	}
+	pic.BlipFill = &decodeBlipFill{
+		Blip:    newBlip(rID, nil),
+		SrcRect: getCropRect(cfg.Width, cfg.Height, xfrm.Extents.CX, xfrm.Extents.CY, opts.Mode == "contain"),
+		Stretch: &decodeStretch{FillRect: &FillRect{}},
+	}
+	if pic.ShapeProperties == nil {
+		pic.ShapeProperties = &DecodeShapeProperties{}
+	}
+	if picIdx != -1 {
+		oldBlip := shapeTree.Picture[picIdx].BlipFill
+		shapeTree.Picture[picIdx] = pic
+		if oldBlip != nil && oldBlip.Blip != nil && oldBlip.Blip.Embed != "" {
+			f.deleteUnusedImage(slide, slideXMLPath, oldBlip.Blip.Embed)
+		}
+		return nil
+	}
+	shapeTree.Shape = append(shapeTree.Shape[:shapeIdx], shapeTree.Shape[shapeIdx+1:]...)
+	shapeTree.Picture = append(shapeTree.Picture, pic)
+	return nil
+}
+
+// getCropRect returns the source rectangle which crops the image to fill the
+// frame without distortion by given image size, frame size and whether to
+// show the whole image. The negative insets pad the image to contain it in
+// the frame.
+func getCropRect(imgWidth, imgHeight, frameWidth, frameHeight int, contain bool) *SourceRectangle {
+	scaleX, scaleY := float64(frameWidth)/float64(imgWidth), float64(frameHeight)/float64(imgHeight)
+	scale := max(scaleX, scaleY)
+	if contain {
+		scale = min(scaleX, scaleY)
+	}
+	x := int(math.Round((1 - float64(frameWidth)/scale/float64(imgWidth)) / 2 * 100000))
+	y := int(math.Round((1 - float64(frameHeight)/scale/float64(imgHeight)) / 2 * 100000))
+	rect := &SourceRectangle{}
+	if x != 0 {
+		rect.L, rect.R = &x, &x
+	}
+	if y != 0 {
+		rect.T, rect.B = &y, &y
+	}
+	return rect
+}
+
+// getPlaceholderXfrm provides a function to get the transform inherited by
+// the slide placeholder from the layout or the master by given slide part
+// path and placeholder. The placeholders are matched by the index, then by
+// the type, and nil will be returned if no placeholder is positioned.
+func (f *File) getPlaceholderXfrm(slideXMLPath string, ph *Ph) (*DecodeXfrm, error) {
+	phType := func(ph *Ph) string {
+		if ph.Type == nil {
+			return "obj"
+		}
+		return *ph.Type
+	}
+	layoutXMLPath, _ := f.getRelTargetByType(slideXMLPath, SourceRelationshipSlideLayout)
+	masterXMLPath, _ := f.getRelTargetByType(layoutXMLPath, SourceRelationshipSlideMaster)
+	for _, partName := range []string{layoutXMLPath, masterXMLPath} {
+		if partName == "" {
+			continue
+		}
+		var part struct {
+			CommonSlideData decodeSlideData `xml:"cSld"`
+		}
+		if err := f.xmlNewDecoder(bytes.NewReader(namespaceStrictToTransitional(f.readBytes(partName)))).
+			Decode(&part); err != nil && err != io.EOF {
+			return nil, err
+		}
+		var byType *DecodeXfrm
+		for _, shape := range part.CommonSlideData.ShapeTree.Shape {
+			if shape.NonVisualShapeProperties == nil || shape.NonVisualShapeProperties.NonVisualProperties == nil ||
+				shape.NonVisualShapeProperties.NonVisualProperties.Ph == nil || shape.ShapeProperties == nil ||
+				shape.ShapeProperties.Xfrm == nil || shape.ShapeProperties.Xfrm.Extents == nil {
+				continue
+			}
+			target := shape.NonVisualShapeProperties.NonVisualProperties.Ph
+			if ph.Idx != nil && target.Idx != nil && *ph.Idx == *target.Idx && partName == layoutXMLPath {
+				return shape.ShapeProperties.Xfrm, nil
+			}
+			if byType == nil && (phType(target) == phType(ph) || partName == masterXMLPath && phType(target) == "body") {
+				byType = shape.ShapeProperties.Xfrm
+			}
+		}
+		if byType != nil {
+			return byType, nil
+		}
+	}
+	return nil, nil
+}
+
+// isPartReferenced provides a function to check if any internal relationship
+// in the package refers to the part by given part name.
+func (f *File) isPartReferenced(partName string) bool {
+	for _, name := range f.getPartNames() {
+		if !strings.HasSuffix(name, ".rels") {
+			continue
+		}
+		rels, _ := f.relsReader(name)
+		if rels == nil {
+			continue
+		}
+		source := strings.TrimSuffix(strings.Replace(name, "_rels/", "", 1), ".rels")
+		rels.mu.Lock()
+		for _, rel := range rels.Relationships {
+			if rel.TargetMode != "External" && resolveRelTarget(source, rel.Target) == partName {
+				rels.mu.Unlock()
+				return true
+			}
+		}
+		rels.mu.Unlock()
+	}
+	return false
+}
+
+// SetPictureEffects provides a function to set the color adjustments of the
+// picture by given slide id, shape id of the picture and the effects, the
+// existing color adjustments are replaced. For example, make the picture
+// grayscale and 20 percent brighter:
+//
+//	err := f.SetPictureEffects(256, 9, gopptx.PictureEffects{
+//	    Grayscale:  true,
+//	    Brightness: 20,
+//	})
+func (f *File) SetPictureEffects(slideID, shapeID int, effects PictureEffects) error {
+	if err := f.checkReadOnly(); err != nil {
+		return err
+	}
+	slide, err := f.slideReader(slideID)
+	if err != nil {
+		return err
+	}
+	for i, pic := range slide.CommonSlideData.ShapeTree.Picture {
+		if pic.NonVisualPictureProperties == nil || pic.NonVisualPictureProperties.CommonNonVisualProperties == nil ||
+			pic.NonVisualPictureProperties.CommonNonVisualProperties.ID != shapeID || pic.BlipFill == nil || pic.BlipFill.Blip == nil {
+			continue
+		}
+		blip := newBlip(pic.BlipFill.Blip.Embed, &effects)
+		blip.Link, blip.ExtensionList = pic.BlipFill.Blip.Link, pic.BlipFill.Blip.ExtensionList
+		slide.CommonSlideData.ShapeTree.Picture[i].BlipFill.Blip = blip
+		return nil
+	}
+	return ErrShapeNotExist{shapeID}
+}
+
+// newBlip returns the blip by given relationship ID of the image and the
+// color adjustments.
+func newBlip(rID string, effects *PictureEffects) *decodeBlip {
+	blip := &decodeBlip{Embed: rID}
+	if effects == nil {
+		return blip
+	}
+	if effects.Transparency > 0 {
+		blip.AlphaModFix = &AlphaModFix{Amount: (100 - min(effects.Transparency, 100)) * 1000}
+	}
+	if len(effects.Duotone) == 2 {
+		var buf bytes.Buffer
+		for _, clr := range effects.Duotone {
+			buf.WriteString(`<a:srgbClr val="`)
+			_ = xml.EscapeText(&buf, []byte(strings.TrimPrefix(clr, "#")))
+			buf.WriteString(`"/>`)
+		}
+		blip.Duotone = &innerXML{Content: buf.String()}
+	}
+	if effects.Grayscale {
+		blip.Grayscale = &struct{}{}
+	}
+	if effects.Brightness != 0 || effects.Contrast != 0 {
+		blip.Luminance = &Luminance{
+			Bright:   min(max(effects.Brightness, -100), 100) * 1000,
+			Contrast: min(max(effects.Contrast, -100), 100) * 1000,
+		}
+	}
+	return blip
+}
+
+// getPictureSize provides a function to get the size of the picture in EMUs
+// by given image data and format settings.
+func getPictureSize(file []byte, opts *PictureOptions) (int, int, error) {
+	if opts.Width > 0 && opts.Height > 0 {
+		return opts.Width, opts.Height, nil
+	}
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(file))
+	if err != nil || cfg.Width == 0 || cfg.Height == 0 {
+		return 0, 0, ErrImgSize
+	}
+	switch {
+	case opts.Width > 0:
+		return opts.Width, opts.Width * cfg.Height / cfg.Width, nil
+	case opts.Height > 0:
+		return opts.Height * cfg.Width / cfg.Height, opts.Height, nil
+	}
+	return cfg.Width * EMUPerPixel, cfg.Height * EMUPerPixel, nil
+}
+
+// addMedia provides a function to add a media part into the package by
+// given file data and extension, and returns the path of the media part.
+func (f *File) addMedia(file []byte, extension string) string {
+	var count int
+	for _, name := range f.getMediaPartNames() {
+		if strings.HasPrefix(name, "ppt/media/image") {
+			idx, _ := strconv.Atoi(strings.TrimSuffix(strings.TrimPrefix(name, "ppt/media/image"), path.Ext(name)))
+			count = max(count, idx)
+		}
+	}
+	mediaPath := fmt.Sprintf("ppt/media/image%d%s", count+1, strings.ToLower(extension))
+	f.Pkg.Store(mediaPath, file)
+	return mediaPath
+}
@@ -0,0 +1,244 @@
+package gopptx
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestReplaceImage(t *testing.T) {
+	logo, photo, replaced := newTestPNG(t, 16, 16), newTestPNG(t, 32, 16), newTestPNG(t, 8, 8)
+	f := NewFile()
+	slideID := f.GetSlideList()[0]
+	for _, pic := range []struct {
+		name string
+		file []byte
+	}{{"Logo", logo}, {"Photo", photo}} {
+		if _, err := f.AddPictureFromBytes(slideID, ".png", pic.file, &PictureOptions{Name: pic.name, OffsetX: 914400}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, c := range []struct {
+		name, extension string
+		err             error
+	}{
+		{name: "Logo", extension: ".txt", err: ErrImgExt},
+		{name: "Chart", extension: ".png", err: ErrPictureNotExist{SlideID: slideID, Name: "Chart"}},
+		{name: "Logo", extension: ".png"},
+	} {
+		if err := f.ReplaceImage(slideID, c.name, c.extension, replaced); err != c.err {
+			t.Errorf("expected error %v, got %v", c.err, err)
+		}
+	}
+	slide, err := f.slideReader(slideID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	slideXMLPath, _ := f.getSlideXMLPath(slideID)
+	for i, expected := range [][]byte{replaced, photo} {
+		pic := slide.CommonSlideData.ShapeTree.Picture[i]
+		if target, ok := f.getRelTarget(slideXMLPath, pic.BlipFill.Blip.Embed); !ok || !bytes.Equal(f.readPart(target), expected) {
+			t.Errorf("unexpected image of the picture %s", pic.NonVisualPictureProperties.CommonNonVisualProperties.Name)
+		}
+		if pic.ShapeProperties.Xfrm.Offset.X != 914400 || pic.ShapeProperties.Xfrm.Extents.CX != 152400*(i+1) {
+			t.Errorf("the frame of the picture is changed, got %+v", pic.ShapeProperties.Xfrm.Extents)
+		}
+	}
+	var media int
+	f.Pkg.Range(func(k, v interface{}) bool {
+		if strings.HasPrefix(k.(string), "ppt/media/") {
+			if media++; bytes.Equal(v.([]byte), logo) {
+				t.Error("the replaced image isn't deleted")
+			}
+		}
+		return true
+	})
+	if media != 2 {
+		t.Errorf("expected 2 media parts, got %d", media)
+	}
+}
+
+func TestAddPictureFromImage(t *testing.T) {
+	f := NewFile()
+	slideID := f.GetSlideList()[0]
+	img := image.NewRGBA(image.Rect(0, 0, 24, 12))
+	slideXMLPath, _ := f.getSlideXMLPath(slideID)
+	for i, c := range []struct {
+		format, expected string
+	}{
+		{format: ".png", expected: "png"},
+		{format: ".JPG", expected: "jpeg"},
+		{format: ".jpeg", expected: "jpeg"},
+	} {
+		if _, err := f.AddPictureFromImage(slideID, img, c.format, nil); err != nil {
+			t.Fatal(err)
+		}
+		slide, err := f.slideReader(slideID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		pic := slide.CommonSlideData.ShapeTree.Picture[i]
+		target, ok := f.getRelTarget(slideXMLPath, pic.BlipFill.Blip.Embed)
+		if !ok {
+			t.Fatalf("expected the image of the picture %d", i)
+		}
+		config, format, err := image.DecodeConfig(bytes.NewReader(f.readPart(target)))
+		if err != nil || format != c.expected || config.Width != 24 || config.Height != 12 {
+			t.Errorf("expected the 24x12 %s image, got %dx%d %s %v", c.expected, config.Width, config.Height, format, err)
+		}
+	}
+	for _, c := range []struct {
+		slideID  int
+		format   string
+		expected error
+	}{
+		{slideID: slideID, format: ".gif", expected: ErrImgExt},
+		{slideID: 300, format: ".png", expected: ErrSlideNotExist{300}},
+	} {
+		if id, err := f.AddPictureFromImage(c.slideID, img, c.format, nil); id != -1 || !errors.Is(err, c.expected) {
+			t.Errorf("expected -1 and %v, got %d %v", c.expected, id, err)
+		}
+	}
+}
+
+func TestSetPictureEffects(t *testing.T) {
+	f := NewFile()
+	slideID := f.GetSlideList()[0]
+	shapeID, err := f.AddPictureFromBytes(slideID, ".png", newTestPNG(t, 16, 16), &PictureOptions{
+		Effects: &PictureEffects{Grayscale: true, Transparency: 30},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, c := range []struct {
+		effects  *PictureEffects
+		shapeID  int
+		err      error
+		expected decodeBlip
+	}{
+		{shapeID: shapeID, expected: decodeBlip{AlphaModFix: &AlphaModFix{Amount: 70000}, Grayscale: &struct{}{}}},
+		{
+			effects: &PictureEffects{Duotone: []string{"#000000", "FFFFFF"}, Brightness: 20, Contrast: -150, Transparency: 120}, shapeID: shapeID,
+			expected: decodeBlip{
+				AlphaModFix: &AlphaModFix{}, Duotone: &innerXML{Content: `<a:srgbClr val="000000"/><a:srgbClr val="FFFFFF"/>`},
+				Luminance: &Luminance{Bright: 20000, Contrast: -100000},
+			},
+		},
+		{effects: &PictureEffects{}, shapeID: shapeID},
+		{effects: &PictureEffects{}, shapeID: shapeID + 1, err: ErrShapeNotExist{shapeID + 1}},
+	} {
+		if c.effects != nil {
+			if err = f.SetPictureEffects(slideID, c.shapeID, *c.effects); err != c.err {
+				t.Fatalf("expected error %v, got %v", c.err, err)
+			}
+		}
+		if c.err != nil {
+			continue
+		}
+		slide, err := f.slideReader(slideID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		blip := *slide.CommonSlideData.ShapeTree.Picture[0].BlipFill.Blip
+		if blip.Embed == "" {
+			t.Error("the image of the picture is lost")
+		}
+		blip.Embed = ""
+		if !reflect.DeepEqual(blip, c.expected) {
+			t.Errorf("expected the blip %+v, got %+v", c.expected, blip)
+		}
+	}
+}
+
+func TestSetPlaceholderImage(t *testing.T) {
+	f := NewFile()
+	slideID := f.GetSlideList()[0]
+	slide, err := f.slideReader(slideID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	slide.CommonSlideData.ShapeTree.Shape[1].ShapeProperties.Xfrm = nil
+	addTestShape(t, f, slideID, "pic").NonVisualShapeProperties.CommonNonVisualProperties.Name = "Picture Placeholder 3"
+	square := newTestPNG(t, 16, 16)
+	type srcRect struct{ L, T, R, B int }
+	for _, c := range []struct {
+		name string
+		file []byte
+		opts *PlaceholderImageOptions
+	}{
+		{name: "Picture Placeholder 3", file: square},
+		{name: "Picture Placeholder 3", file: newTestPNG(t, 8, 16), opts: &PlaceholderImageOptions{Mode: "contain", AltText: "Photo"}},
+		{name: "PlaceHolder 2", file: square, opts: &PlaceholderImageOptions{Mode: "cover"}},
+	} {
+		if err = f.SetPlaceholderImage(slideID, c.name, ".png", c.file, c.opts); err != nil {
+			t.Fatal(err)
+		}
+	}
+	buf, err := f.WriteToBuffer()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f, err = OpenReader(buf); err != nil {
+		t.Fatal(err)
+	}
+	if slide, err = f.slideReader(slideID); err != nil {
+		t.Fatal(err)
+	}
+	pictures := map[string]srcRect{}
+	for _, pic := range slide.CommonSlideData.ShapeTree.Picture {
+		var rect srcRect
+		for _, inset := range []struct {
+			value *int
+			dest  *int
+		}{{pic.BlipFill.SrcRect.L, &rect.L}, {pic.BlipFill.SrcRect.T, &rect.T}, {pic.BlipFill.SrcRect.R, &rect.R}, {pic.BlipFill.SrcRect.B, &rect.B}} {
+			if inset.value != nil {
+				*inset.dest = *inset.value
+			}
+		}
+		cNvPr := pic.NonVisualPictureProperties.CommonNonVisualProperties
+		pictures[cNvPr.Name+"|"+cNvPr.Descr] = rect
+		if nvPr := pic.NonVisualPictureProperties.NonVisualProperties; nvPr == nil || nvPr.Ph == nil {
+			t.Errorf("expected the picture %s is the placeholder", cNvPr.Name)
+		}
+	}
+	if expected := map[string]srcRect{
+		"Picture Placeholder 3|Photo": {L: -150000, R: -150000},
+		"PlaceHolder 2|":              {T: 31876, B: 31876},
+	}; !reflect.DeepEqual(pictures, expected) {
+		t.Errorf("expected the pictures %v, got %v", expected, pictures)
+	}
+	if len(slide.CommonSlideData.ShapeTree.Shape) != 1 || slide.CommonSlideData.ShapeTree.Picture[0].ShapeProperties.Xfrm != nil {
+		t.Error("expected the placeholders are replaced by the pictures inheriting the positions")
+	}
+	var media int
+	f.Pkg.Range(func(k, v interface{}) bool {
+		if strings.HasPrefix(k.(string), "ppt/media/") {
+			media++
+		}
+		return true
+	})
+	if media != 2 {
+		t.Errorf("expected the replaced image is deleted, got %d media parts", media)
+	}
+
+	for _, c := range []struct {
+		slideID         int
+		name, extension string
+		file            []byte
+		opts            *PlaceholderImageOptions
+		expected        error
+	}{
+		{slideID: slideID, name: "PlaceHolder 2", extension: ".txt", file: square, expected: ErrImgExt},
+		{slideID: slideID, name: "PlaceHolder 2", extension: ".png", file: square, opts: &PlaceholderImageOptions{Mode: "stretch"}, expected: ErrPlaceholderImageMode},
+		{slideID: slideID, name: "PlaceHolder 2", extension: ".png", file: []byte("image"), expected: ErrImgSize},
+		{slideID: slideID, name: "Missing", extension: ".png", file: square, expected: ErrShapeNameNotExist{SlideID: slideID, Name: "Missing"}},
+		{slideID: 300, name: "PlaceHolder 2", extension: ".png", file: square, expected: ErrSlideNotExist{300}},
+	} {
+		if err = f.SetPlaceholderImage(c.slideID, c.name, c.extension, c.file, c.opts); !errors.Is(err, c.expected) {
+			t.Errorf("expected %v, got %v", c.expected, err)
+		}
+	}
+}
@@ -0,0 +1,141 @@
+// Copyright 2026 kenny-not-dead. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+//
+// Package gopptx provides functionality to create and manipulate PowerPoint
+// (.pptx) files in Go, using the Office Open XML (ECMA-376) format.
+
+package gopptx
+
+// GetShapePlaceholder provides a function to get the placeholder settings of
+// the shape, the picture, the graphic frame or the group shape, including
+// the shapes in the groups, by given slide id and shape id, and whether the
+// shape is drawn by the user, which marks the shapes of the slide layouts
+// and the slide masters which aren't the placeholders added by the
+// application. It returns nil if the shape isn't a placeholder. For example:
+//
+//	ph, userDrawn, err := f.GetShapePlaceholder(256, 2)
+//	if err != nil {
+//	    fmt.Println(err)
+//	    return
+//	}
+//	if ph != nil && ph.Type != nil {
+//	    fmt.Println(*ph.Type, userDrawn)
+//	}
+func (f *File) GetShapePlaceholder(slideID, shapeID int) (*Ph, bool, error) {
+	nvPr, err := f.getShapeNonVisualProperties(slideID, shapeID)
+	if err != nil {
+		return nil, false, err
+	}
+	if *nvPr == nil {
+		return nil, false, nil
+	}
+	return (*nvPr).Ph.clone(), (*nvPr).UserDrawn != nil && *(*nvPr).UserDrawn, nil
+}
+
+// SetShapePlaceholder provides a function to set the placeholder settings of
+// the shape, the picture, the graphic frame or the group shape, including the
+// shapes in the groups, by given slide id, shape id and the placeholder
+// settings. The shape will be the ordinary shape if the placeholder settings
+// are nil. For example, link the shape to the body placeholder with index 1
+// of the slide layout:
+//
+//	phType, idx := "body", 1
+//	err := f.SetShapePlaceholder(256, 3, &gopptx.Ph{Type: &phType, Idx: &idx})
+func (f *File) SetShapePlaceholder(slideID, shapeID int, ph *Ph) error {
+	if err := f.checkReadOnly(); err != nil {
+		return err
+	}
+	nvPr, err := f.getShapeNonVisualProperties(slideID, shapeID)
+	if err != nil {
+		return err
+	}
+	if *nvPr == nil {
+		*nvPr = &decodeNonVisualProperties{}
+	}
+	(*nvPr).Ph = ph.clone()
+	return nil
+}
+
+// clone returns a deep copy of the placeholder settings, which doesn't share
+// the attribute values with the settings. It returns nil if the settings are
+// nil.
+func (ph *Ph) clone() *Ph {
+	if ph == nil {
+		return nil
+	}
+	clone := Ph{}
+	if ph.Type != nil {
+		phType := *ph.Type
+		clone.Type = &phType
+	}
+	if ph.Orient != nil {
+		orient := *ph.Orient
+		clone.Orient = &orient
+	}
+	if ph.Size != nil {
+		size := *ph.Size
+		clone.Size = &size
+	}
+	if ph.Idx != nil {
+		idx := *ph.Idx
+		clone.Idx = &idx
+	}
+	if ph.HasCustomPrompt != nil {
+		hasCustomPrompt := *ph.HasCustomPrompt
+		clone.HasCustomPrompt = &hasCustomPrompt
+	}
+	return &clone
+}
+
+// getShapeNonVisualProperties provides a function to get the pointer to the
+// non-visual properties of the shape, the picture, the graphic frame or the
+// group shape by given slide id and shape id, the shapes in the groups are
+// included.
+func (f *File) getShapeNonVisualProperties(slideID, shapeID int) (**decodeNonVisualProperties, error) {
+	slide, err := f.slideReader(slideID)
+	if err != nil {
+		return nil, err
+	}
+	shapeTree := &slide.CommonSlideData.ShapeTree
+	if nvPr := findNonVisualProperties(shapeID, shapeTree.Shape, shapeTree.Picture, shapeTree.GraphicFrame, shapeTree.GroupShape); nvPr != nil {
+		return nvPr, nil
+	}
+	return nil, ErrShapeNotExist{shapeID}
+}
+
+// findNonVisualProperties returns the pointer to the non-visual properties of
+// the first shape, picture, graphic frame or group shape with the shape ID
+// by given shape ID and the shapes, the shapes in the groups are searched
+// recursively. It returns nil if the shape doesn't exist.
+func findNonVisualProperties(shapeID int, shapes []decodeShape, pics []decodePicture, frames []decodeGraphicFrame, groups []decodeGroupShape) **decodeNonVisualProperties {
+	for i := range shapes {
+		if nvSpPr := shapes[i].NonVisualShapeProperties; nvSpPr != nil && nvSpPr.CommonNonVisualProperties != nil &&
+			nvSpPr.CommonNonVisualProperties.ID == shapeID {
+			return &nvSpPr.NonVisualProperties
+		}
+	}
+	for i := range pics {
+		if nvPicPr := pics[i].NonVisualPictureProperties; nvPicPr != nil && nvPicPr.CommonNonVisualProperties != nil &&
+			nvPicPr.CommonNonVisualProperties.ID == shapeID {
+			return &nvPicPr.NonVisualProperties
+		}
+	}
+	for i := range frames {
+		if nvGraphicFramePr := frames[i].NonVisualGraphicFrameProperties; nvGraphicFramePr != nil &&
+			nvGraphicFramePr.CommonNonVisualProperties != nil && nvGraphicFramePr.CommonNonVisualProperties.ID == shapeID {
+			return &nvGraphicFramePr.NonVisualProperties
+		}
+	}
+	for i := range groups {
+		grpSp := &groups[i]
+		if nvGrpSpPr := grpSp.NonVisualGroupShapeProperties; nvGrpSpPr != nil && nvGrpSpPr.CommonNonVisualProperties != nil &&
+			nvGrpSpPr.CommonNonVisualProperties.ID == shapeID {
+			return &nvGrpSpPr.NonVisualProperties
+		}
+		if nvPr := findNonVisualProperties(shapeID, grpSp.Shape, grpSp.Picture, grpSp.GraphicFrame, grpSp.GroupShape); nvPr != nil {
+			return nvPr
+		}
+	}
+	return nil
+}
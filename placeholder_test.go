@@ -0,0 +1,103 @@
+package gopptx
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// testGroupPlaceholder is the group shape with the user drawn placeholder
+// and the duplicated shape ID for the placeholder tests.
+const testGroupPlaceholder = `<p:grpSp><p:nvGrpSpPr><p:cNvPr id="20" name="Group"/><p:cNvGrpSpPr/><p:nvPr/></p:nvGrpSpPr>` +
+	`<p:grpSpPr/><p:sp><p:nvSpPr><p:cNvPr id="21" name="Vertical Body"/><p:cNvSpPr/>` +
+	`<p:nvPr userDrawn="1"><p:ph type="body" orient="vert" sz="half" idx="3" hasCustomPrompt="1"/></p:nvPr></p:nvSpPr>` +
+	`<p:spPr/></p:sp></p:grpSp>` +
+	`<p:sp><p:nvSpPr><p:cNvPr id="22" name="Date"/><p:cNvSpPr/><p:nvPr><p:ph type="dt"/></p:nvPr></p:nvSpPr><p:spPr/></p:sp>` +
+	`<p:sp><p:nvSpPr><p:cNvPr id="22" name="Duplicated"/><p:cNvSpPr/><p:nvPr/></p:nvSpPr><p:spPr/></p:sp>`
+
+func TestShapePlaceholder(t *testing.T) {
+	f, err := openTestFile(t, func(name string, content []byte) []byte {
+		if name != "ppt/slides/slide1.xml" {
+			return content
+		}
+		return bytes.Replace(content, []byte("</p:spTree>"), []byte(testGroupPlaceholder+"</p:spTree>"), 1)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	slideID := f.GetSlideList()[0]
+	title, date, body, vert, half, idx, customPrompt := "title", "dt", "body", "vert", "half", 3, true
+	for _, c := range []struct {
+		shapeID   int
+		ph        *Ph
+		userDrawn bool
+	}{
+		{shapeID: 7, ph: &Ph{Type: &title}},
+		{shapeID: 20},
+		{shapeID: 22, ph: &Ph{Type: &date}},
+		{shapeID: 21, ph: &Ph{Type: &body, Orient: &vert, Size: &half, Idx: &idx, HasCustomPrompt: &customPrompt}, userDrawn: true},
+	} {
+		ph, userDrawn, err := f.GetShapePlaceholder(slideID, c.shapeID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(ph, c.ph) || userDrawn != c.userDrawn {
+			t.Errorf("expected the placeholder of the shape %d %+v %t, got %+v %t", c.shapeID, c.ph, c.userDrawn, ph, userDrawn)
+		}
+	}
+	ph, _, err := f.GetShapePlaceholder(slideID, 21)
+	if err != nil {
+		t.Fatal(err)
+	}
+	*ph.Type = "pic"
+	if ph, _, _ = f.GetShapePlaceholder(slideID, 21); *ph.Type != "body" {
+		t.Errorf("expected the copy of the placeholder settings, got the type %q", *ph.Type)
+	}
+	footer, footerIdx := "ftr", 11
+	settings := &Ph{Type: &footer, Idx: &footerIdx}
+	if err = f.SetShapePlaceholder(slideID, 20, settings); err != nil {
+		t.Fatal(err)
+	}
+	footerIdx = 12
+	if err = f.SetShapePlaceholder(slideID, 21, nil); err != nil {
+		t.Fatal(err)
+	}
+	if err = f.SetShapePlaceholder(slideID, 8, nil); err != nil {
+		t.Fatal(err)
+	}
+	buf, err := f.WriteToBuffer()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data := buf.Bytes()
+	if f, err = OpenReader(bytes.NewReader(data)); err != nil {
+		t.Fatal(err)
+	}
+	content := f.readXML("ppt/slides/slide1.xml")
+	for _, expected := range []string{`<p:nvPr><p:ph type="ftr" idx="11"></p:ph></p:nvPr>`, `<p:nvPr userDrawn="true"></p:nvPr>`} {
+		if !bytes.Contains(content, []byte(expected)) {
+			t.Errorf("expected %s in the slide, got %s", expected, content)
+		}
+	}
+	for shapeID, expected := range map[int]*Ph{7: {Type: &title}, 8: nil, 20: {Type: &footer, Idx: &[]int{11}[0]}, 21: nil, 22: {Type: &date}} {
+		if ph, _, err := f.GetShapePlaceholder(slideID, shapeID); err != nil || !reflect.DeepEqual(ph, expected) {
+			t.Errorf("expected the placeholder of the shape %d %+v, got %+v %v", shapeID, expected, ph, err)
+		}
+	}
+	if _, _, err = f.GetShapePlaceholder(slideID, 30); !errors.Is(err, ErrShapeNotExist{30}) {
+		t.Errorf("expected ErrShapeNotExist, got %v", err)
+	}
+	if err = f.SetShapePlaceholder(slideID, 30, nil); !errors.Is(err, ErrShapeNotExist{30}) {
+		t.Errorf("expected ErrShapeNotExist, got %v", err)
+	}
+	if _, _, err = f.GetShapePlaceholder(300, 7); !errors.Is(err, ErrSlideNotExist{300}) {
+		t.Errorf("expected ErrSlideNotExist, got %v", err)
+	}
+	if f, err = OpenReader(bytes.NewReader(data), Options{ReadOnly: true}); err != nil {
+		t.Fatal(err)
+	}
+	if err = f.SetShapePlaceholder(slideID, 7, nil); !errors.Is(err, ErrReadOnly) {
+		t.Errorf("expected ErrReadOnly, got %v", err)
+	}
+}
@@ -12,10 +12,24 @@ import (
 	"encoding/xml"
 	"io"
 	"path/filepath"
-	"strconv"
+	"slices"
 	"strings"
 )
 
+// getSlideSize provides a function to get the width and height of the slides
+// in EMUs, the default size will be returned if the presentation doesn't
+// specify a valid slide size.
+func (f *File) getSlideSize() (int, int, error) {
+	presentation, err := f.presentationReader()
+	if err != nil {
+		return defaultSlideWidth, defaultSlideHeight, err
+	}
+	if presentation.SlideSize != nil && presentation.SlideSize.CX > 0 && presentation.SlideSize.CY > 0 {
+		return presentation.SlideSize.CX, presentation.SlideSize.CY, nil
+	}
+	return defaultSlideWidth, defaultSlideHeight, nil
+}
+
 // presentationReader provides a function to get the pointer to the presentation.xml
 // structure after deserialization.
 func (f *File) presentationReader() (*decodePresentation, error) {
@@ -33,10 +47,26 @@ func (f *File) presentationReader() (*decodePresentation, error) {
 			f.xmlAttr.Store(presPath, attrs)
 			f.addNameSpaces(presPath, SourceRelationship)
 		}
-		if err = f.xmlNewDecoder(bytes.NewReader(namespaceStrictToTransitional(f.readXML(presPath)))).
-			Decode(f.Presentation); err != nil && err != io.EOF {
+		err = f.xmlNewDecoder(bytes.NewReader(namespaceStrictToTransitional(f.readXML(presPath)))).
+			Decode(f.Presentation)
+		if f.Presentation.Slides == nil {
+			// The slide list is optional, keep an empty one so the
+			// presentation without slides can be read and edited.
+			f.Presentation.Slides = &decodeSlideList{}
+		}
+		if err != nil && err != io.EOF {
 			return f.Presentation, err
 		}
+		slideIDs := append([]decodeSlideID(nil), f.Presentation.MasterSlide.MasterSlide...)
+		if f.Presentation.Slides != nil {
+			slideIDs = append(slideIDs, f.Presentation.Slides.Slide...)
+		}
+		for _, s := range slideIDs {
+			for _, space := range s.UnexpectedNamespace {
+				f.diagnose(DiagnosticIgnoredAttribute, presPath, "ignored the id attribute in the unexpected namespace",
+					"namespace", space, "id", s.RelationshipID)
+			}
+		}
 	}
 
 	return f.Presentation, err
@@ -72,14 +102,29 @@ func (f *File) getPresentationRelsPath() (path string) {
 }
 
 // setPresentation update presentation.
-func (f *File) setPresentation(slideID, rid int) {
+func (f *File) setPresentation(slideID int, rID string) {
 	presentation, _ := f.presentationReader()
 	presentation.Slides.Slide = append(presentation.Slides.Slide, decodeSlideID{
 		SlideID:        slideID,
-		RelationshipID: "rId" + strconv.Itoa(rid),
+		RelationshipID: rID,
 	})
 }
 
+// removeContentTypeOverride provides a function to remove the content type
+// override of the part by given part name in the file [Content_Types].xml.
+func (f *File) removeContentTypeOverride(partName string) error {
+	content, err := f.contentTypesReader()
+	if err != nil {
+		return err
+	}
+	content.mu.Lock()
+	defer content.mu.Unlock()
+	content.Overrides = slices.DeleteFunc(content.Overrides, func(override contentTypeOverride) bool {
+		return strings.TrimPrefix(override.PartName, "/") == strings.TrimPrefix(partName, "/")
+	})
+	return err
+}
+
 // removeContentTypesPart provides a function to remove relationships by given
 // content type and part name in the file [Content_Types].xml.
 func (f *File) removeContentTypesPart(contentType, partName string) error {
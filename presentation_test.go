@@ -0,0 +1,29 @@
+package gopptx
+
+import "testing"
+
+func TestGetSlideSize(t *testing.T) {
+	for _, c := range []struct {
+		name          string
+		size          *slideSize
+		width, height int
+	}{
+		{name: "slide size", size: &slideSize{CX: 12192000, CY: 6858000}, width: 12192000, height: 6858000},
+		{name: "no slide size", width: defaultSlideWidth, height: defaultSlideHeight},
+		{name: "zero slide size", size: &slideSize{}, width: defaultSlideWidth, height: defaultSlideHeight},
+	} {
+		f := NewFile()
+		presentation, err := f.presentationReader()
+		if err != nil {
+			t.Fatal(err)
+		}
+		presentation.SlideSize = c.size
+		width, height, err := f.getSlideSize()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if width != c.width || height != c.height {
+			t.Errorf("%s: expected %dx%d, got %dx%d", c.name, c.width, c.height, width, height)
+		}
+	}
+}
@@ -0,0 +1,136 @@
+// Copyright 2026 kenny-not-dead. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+//
+// Package gopptx provides functionality to create and manipulate PowerPoint
+// (.pptx) files in Go, using the Office Open XML (ECMA-376) format.
+
+package gopptx
+
+import (
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/binary"
+	"hash"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+)
+
+// modifyVerifierHashes defined the hash functions of the modify password of
+// the presentation by the algorithm names and the legacy algorithm
+// identifiers.
+var modifyVerifierHashes = map[string]func() hash.Hash{
+	"SHA-1": sha1.New, "SHA-256": sha256.New, "SHA-384": sha512.New384, "SHA-512": sha512.New,
+	"4": sha1.New, "12": sha256.New, "13": sha512.New384, "14": sha512.New,
+}
+
+// Unprotect provides a function to remove the protection of the presentation
+// by given modify password, which removes the password to modify and the
+// mark as final flags, so the archived presentations can be refreshed. The
+// password is checked against the hash of the password to modify if the
+// presentation has one, and the ErrUnprotectPassword will be returned if it
+// doesn't match. For example:
+//
+//	if err := f.Unprotect("password"); err != nil {
+//	    fmt.Println(err)
+//	}
+func (f *File) Unprotect(password string) error {
+	if err := f.checkReadOnly(); err != nil {
+		return err
+	}
+	presentation, err := f.presentationReader()
+	if err != nil {
+		return err
+	}
+	if presentation.ModifyVerifier != nil {
+		if ok, err := checkModifyVerifier(presentation.ModifyVerifier, password); err != nil || !ok {
+			if err == nil {
+				err = ErrUnprotectPassword
+			}
+			return err
+		}
+		presentation.ModifyVerifier = nil
+	}
+	return f.removeMarkAsFinal()
+}
+
+// checkModifyVerifier returns true if the password matches the hash of the
+// password to modify by given modifyVerifier element and password. The hash
+// is the hash of the salt and the UTF-16LE password, then hashed with the
+// iteration number for the spin count times.
+func checkModifyVerifier(verifier *modifyVerifier, password string) (bool, error) {
+	algorithm, hashValue, saltValue, spinValue := verifier.AlgorithmName, verifier.HashValue,
+		verifier.SaltValue, verifier.SpinValue
+	if algorithm == "" {
+		algorithm, hashValue, saltValue, spinValue = verifier.CryptAlgorithmSid, verifier.HashData,
+			verifier.SaltData, verifier.SpinCount
+	}
+	if hashValue == "" {
+		return true, nil
+	}
+	newHash, ok := modifyVerifierHashes[strings.ToUpper(algorithm)]
+	if !ok {
+		return false, ErrUnsupportedHashAlgorithm
+	}
+	salt, err := base64.StdEncoding.DecodeString(saltValue)
+	if err != nil {
+		return false, err
+	}
+	spinCount, _ := strconv.Atoi(spinValue)
+	h := newHash()
+	h.Write(salt)
+	for _, c := range utf16.Encode([]rune(password)) {
+		h.Write([]byte{byte(c), byte(c >> 8)})
+	}
+	sum := h.Sum(nil)
+	iterator := make([]byte, 4)
+	for i := 0; i < spinCount; i++ {
+		binary.LittleEndian.PutUint32(iterator, uint32(i))
+		h.Reset()
+		h.Write(sum)
+		h.Write(iterator)
+		sum = h.Sum(nil)
+	}
+	return base64.StdEncoding.EncodeToString(sum) == hashValue, nil
+}
+
+// removeMarkAsFinal provides a function to remove the mark as final flags of
+// the presentation, which are the "_MarkAsFinal" custom property and the
+// "Final" content status of the core properties.
+func (f *File) removeMarkAsFinal() error {
+	for _, rel := range getRelationships(f.getRels("_rels/.rels")) {
+		if rel.Type != SourceRelationshipCustomProperties || rel.TargetMode == "External" {
+			continue
+		}
+		customXMLPath := resolveRelTarget("", rel.Target)
+		custom, err := f.xmlNodeReader(f.readXML(customXMLPath))
+		if err != nil {
+			return err
+		}
+		children, removed := custom.Children[:0], false
+		for _, child := range custom.Children {
+			if localName(child.Name) == "property" && child.attr("name") == "_MarkAsFinal" {
+				removed = true
+				continue
+			}
+			children = append(children, child)
+		}
+		if custom.Children = children; removed {
+			f.saveFileList(customXMLPath, custom.bytes())
+		}
+	}
+	if content := f.readXML(defaultXMLPathDocPropsCore); len(content) > 0 {
+		core, err := f.xmlNodeReader(content)
+		if err != nil {
+			return err
+		}
+		if status := core.find("contentStatus"); status != nil && status.text() == "Final" {
+			core.remove(status.Name)
+			f.saveFileList(defaultXMLPathDocPropsCore, core.bytes())
+		}
+	}
+	return nil
+}
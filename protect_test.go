@@ -0,0 +1,68 @@
+package gopptx
+
+import (
+	"bytes"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"testing"
+	"unicode/utf16"
+)
+
+// newTestModifyVerifier returns the modifyVerifier element with the SHA-512
+// hash of the password to modify by given password.
+func newTestModifyVerifier(password string) string {
+	salt, spinCount := []byte("0123456789abcdef"), 10
+	h := sha512.New()
+	h.Write(salt)
+	for _, c := range utf16.Encode([]rune(password)) {
+		h.Write([]byte{byte(c), byte(c >> 8)})
+	}
+	sum := h.Sum(nil)
+	iterator := make([]byte, 4)
+	for i := 0; i < spinCount; i++ {
+		binary.LittleEndian.PutUint32(iterator, uint32(i))
+		h.Reset()
+		h.Write(sum)
+		h.Write(iterator)
+		sum = h.Sum(nil)
+	}
+	return `<p:modifyVerifier algorithmName="SHA-512" hashValue="` + base64.StdEncoding.EncodeToString(sum) +
+		`" saltValue="` + base64.StdEncoding.EncodeToString(salt) + `" spinValue="10"/>`
+}
+
+func TestUnprotect(t *testing.T) {
+	verifier := newTestModifyVerifier("password")
+	for _, c := range []struct {
+		password  string
+		err       error
+		protected bool
+	}{
+		{password: "wrong", err: ErrUnprotectPassword, protected: true},
+		{password: "password"},
+	} {
+		f, err := openTestFile(t, func(name string, content []byte) []byte {
+			if name == defaultXMLPathPresentation {
+				return bytes.Replace(content, []byte("</p:presentation>"), []byte(verifier+"</p:presentation>"), 1)
+			}
+			return content
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = f.Unprotect(c.password); !errors.Is(err, c.err) {
+			t.Errorf("password %q: expected error %v, got %v", c.password, c.err, err)
+		}
+		if _, err = f.WriteToBuffer(); err != nil {
+			t.Fatal(err)
+		}
+		content := f.readXML(defaultXMLPathPresentation)
+		if protected := bytes.Contains(content, []byte("<p:modifyVerifier")); protected != c.protected {
+			t.Errorf("password %q: expected protected %v, got %v", c.password, c.protected, protected)
+		}
+		if c.protected && !bytes.Contains(content, []byte(`algorithmName="SHA-512"`)) {
+			t.Errorf("password %q: the modify verifier isn't kept", c.password)
+		}
+	}
+}
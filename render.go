@@ -0,0 +1,259 @@
+// Copyright 2026 kenny-not-dead. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+//
+// Package gopptx provides functionality to create and manipulate PowerPoint
+// (.pptx) files in Go, using the Office Open XML (ECMA-376) format.
+
+package gopptx
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"math"
+	"strconv"
+	"unicode/utf8"
+)
+
+const (
+	defaultTextInsetX = 91440
+	defaultTextInsetY = 45720
+	defaultFontSize   = 1800
+)
+
+// RenderThumbnails provides a function to render a small raster preview of
+// each slide as PNG image, in the order of the slides, for slide sorter
+// interfaces. The width of the previews is the given maximum width in pixels,
+// or the slide width at 96 DPI if it is smaller. The renderer draws the
+// pictures in GIF, JPEG and PNG format and the shape outlines, the text is
+// drawn as bars of the text color in place of the lines since the fonts are
+// not rasterized. For example:
+//
+//	thumbnails, err := f.RenderThumbnails(320)
+//	if err != nil {
+//	    fmt.Println(err)
+//	    return
+//	}
+//	for idx, thumbnail := range thumbnails {
+//	    if err := os.WriteFile(fmt.Sprintf("slide%d.png", idx+1), thumbnail, 0o644); err != nil {
+//	        fmt.Println(err)
+//	    }
+//	}
+func (f *File) RenderThumbnails(maxWidth int) ([][]byte, error) {
+	var thumbnails [][]byte
+	for _, slideID := range f.GetSlideList() {
+		img, err := f.renderSlide(slideID, maxWidth)
+		if err != nil {
+			return nil, err
+		}
+		var buf bytes.Buffer
+		if err = png.Encode(&buf, img); err != nil {
+			return nil, err
+		}
+		thumbnails = append(thumbnails, buf.Bytes())
+	}
+	return thumbnails, nil
+}
+
+// slideRenderer defines the state for rasterizing a slide.
+type slideRenderer struct {
+	img   *image.RGBA
+	scale float64
+}
+
+// renderSlide provides a function to rasterize the slide by given slide id and
+// the maximum image width in pixels.
+func (f *File) renderSlide(slideID, maxWidth int) (*image.RGBA, error) {
+	width, height, err := f.getSlideSize()
+	if err != nil {
+		return nil, err
+	}
+	slide, err := f.slideReader(slideID)
+	if err != nil {
+		return nil, err
+	}
+	maxWidth = max(1, min(maxWidth, width/EMUPerPixel))
+	r := &slideRenderer{scale: float64(maxWidth) / float64(width)}
+	r.img = image.NewRGBA(image.Rect(0, 0, maxWidth, max(1, int(float64(height)*r.scale+0.5))))
+	draw.Draw(r.img, r.img.Bounds(), image.White, image.Point{}, draw.Src)
+	slideXMLPath, _ := f.getSlideXMLPath(slideID)
+	for _, pic := range slide.CommonSlideData.ShapeTree.Picture {
+		f.drawPicture(r, slideXMLPath, pic)
+	}
+	for _, shape := range slide.getShapes() {
+		r.drawShape(shape)
+	}
+	return r.img, nil
+}
+
+// drawPicture provides a function to draw the embedded GIF, JPEG or PNG image
+// of the picture by given renderer, part name of the slide and the picture.
+func (f *File) drawPicture(r *slideRenderer, slideXMLPath string, pic decodePicture) {
+	if pic.ShapeProperties == nil || pic.ShapeProperties.Xfrm == nil ||
+		pic.BlipFill == nil || pic.BlipFill.Blip == nil {
+		return
+	}
+	target, ok := f.getRelTarget(slideXMLPath, pic.BlipFill.Blip.Embed)
+	if !ok {
+		return
+	}
+	if src, _, err := image.Decode(bytes.NewReader(f.readPart(target))); err == nil {
+		r.drawScaled(r.rect(pic.ShapeProperties.Xfrm), src)
+	}
+}
+
+// rect returns the pixel rectangle of the shape transform.
+func (r *slideRenderer) rect(xfrm *DecodeXfrm) image.Rectangle {
+	var rect image.Rectangle
+	if xfrm.Offset != nil {
+		rect.Min = image.Pt(r.px(xfrm.Offset.X), r.px(xfrm.Offset.Y))
+	}
+	rect.Max = rect.Min
+	if xfrm.Extents != nil {
+		rect.Max = rect.Min.Add(image.Pt(r.px(xfrm.Extents.CX), r.px(xfrm.Extents.CY)))
+	}
+	return rect
+}
+
+// px converts the English Metric Units to the pixels of the rendered image.
+func (r *slideRenderer) px(emu int) int {
+	return int(math.Round(float64(emu) * r.scale))
+}
+
+// drawScaled draws the source image into the rectangle with nearest neighbor
+// scaling.
+func (r *slideRenderer) drawScaled(rect image.Rectangle, src image.Image) {
+	bounds, clip := src.Bounds(), rect.Intersect(r.img.Bounds())
+	if rect.Dx() == 0 || rect.Dy() == 0 || bounds.Empty() {
+		return
+	}
+	for y := clip.Min.Y; y < clip.Max.Y; y++ {
+		sy := bounds.Min.Y + (y-rect.Min.Y)*bounds.Dy()/rect.Dy()
+		for x := clip.Min.X; x < clip.Max.X; x++ {
+			sx := bounds.Min.X + (x-rect.Min.X)*bounds.Dx()/rect.Dx()
+			draw.Draw(r.img, image.Rect(x, y, x+1, y+1), image.NewUniform(src.At(sx, sy)), image.Point{}, draw.Over)
+		}
+	}
+}
+
+// drawShape draws the solid fill, the outline and the text lines of the shape.
+func (r *slideRenderer) drawShape(shape decodeShape) {
+	if shape.ShapeProperties == nil || shape.ShapeProperties.Xfrm == nil {
+		return
+	}
+	rect := r.rect(shape.ShapeProperties.Xfrm)
+	if fill := shape.ShapeProperties.SolidFill; fill != nil && fill.SolidRGBColor != nil {
+		clr := parseHexColor(fill.SolidRGBColor.Val, color.RGBA{0x80, 0x80, 0x80, 0xff})
+		draw.Draw(r.img, rect, image.NewUniform(clr), image.Point{}, draw.Over)
+	}
+	if ln := shape.ShapeProperties.Ln; ln != nil && ln.NoFill == nil {
+		outline := image.NewUniform(color.RGBA{0x80, 0x80, 0x80, 0xff})
+		for _, edge := range []image.Rectangle{
+			image.Rect(rect.Min.X, rect.Min.Y, rect.Max.X, rect.Min.Y+1),
+			image.Rect(rect.Min.X, rect.Max.Y-1, rect.Max.X, rect.Max.Y),
+			image.Rect(rect.Min.X, rect.Min.Y, rect.Min.X+1, rect.Max.Y),
+			image.Rect(rect.Max.X-1, rect.Min.Y, rect.Max.X, rect.Max.Y),
+		} {
+			draw.Draw(r.img, edge, outline, image.Point{}, draw.Src)
+		}
+	}
+	if shape.TextBody != nil {
+		r.drawText(shape.ShapeProperties.Xfrm, shape.TextBody)
+	}
+}
+
+// textLine defines a rendered line of the text, the width and height are
+// specified in EMUs.
+type textLine struct {
+	indent, width, height int
+	align                 string
+	color                 color.RGBA
+}
+
+// drawText draws the lines of the text body as bars within the shape.
+func (r *slideRenderer) drawText(xfrm *DecodeXfrm, dt *DecodeTextBody) {
+	if xfrm.Offset == nil || xfrm.Extents == nil {
+		return
+	}
+	insets := [4]int{defaultTextInsetX, defaultTextInsetY, defaultTextInsetX, defaultTextInsetY}
+	var anchor string
+	if bodyPr := dt.BodyProperties; bodyPr != nil {
+		for i, inset := range []*int{bodyPr.LIns, bodyPr.TIns, bodyPr.RIns, bodyPr.BIns} {
+			if inset != nil {
+				insets[i] = *inset
+			}
+		}
+		if bodyPr.Anchor != nil {
+			anchor = *bodyPr.Anchor
+		}
+	}
+	left, top := xfrm.Offset.X+insets[0], xfrm.Offset.Y+insets[1]
+	width, height := xfrm.Extents.CX-insets[0]-insets[2], xfrm.Extents.CY-insets[1]-insets[3]
+	if width <= 0 {
+		return
+	}
+	var lines []textLine
+	var total int
+	for _, p := range dt.Paragraph {
+		size, clr := defaultFontSize, color.RGBA{0x40, 0x40, 0x40, 0xff}
+		rPr := p.EndParagraphRunProperties
+		if len(p.Runs) > 0 && p.Runs[0].RunProperties != nil {
+			rPr = p.Runs[0].RunProperties
+		}
+		if rPr != nil && rPr.Size != nil {
+			size = *rPr.Size
+		}
+		if rPr != nil && rPr.SolidFill != nil && rPr.SolidFill.SolidRGBColor != nil {
+			clr = parseHexColor(rPr.SolidFill.SolidRGBColor.Val, clr)
+		}
+		var align string
+		if p.ParagraphProperties != nil && p.ParagraphProperties.Align != nil {
+			align = *p.ParagraphProperties.Align
+		}
+		indent := p.level() * 457200
+		lineHeight := size * EMUPerPoint * 6 / 500
+		// Approximate the average character width as half of the font size.
+		textWidth := utf8.RuneCountInString(p.text()) * size * EMUPerPoint / 200
+		for {
+			line := textLine{indent: indent, width: min(textWidth, width-indent), height: lineHeight, align: align, color: clr}
+			lines, total = append(lines, line), total+lineHeight
+			if textWidth -= width - indent; textWidth <= 0 {
+				break
+			}
+		}
+	}
+	switch anchor {
+	case "ctr":
+		top += (height - total) / 2
+	case "b":
+		top += height - total
+	}
+	for _, line := range lines {
+		x := left + line.indent
+		switch line.align {
+		case "ctr":
+			x = left + (width-line.width)/2
+		case "r":
+			x = left + width - line.width
+		}
+		bar := image.Rect(r.px(x), r.px(top+line.height/4), r.px(x+line.width), r.px(top+line.height*3/4))
+		if bar.Dy() == 0 {
+			bar.Max.Y++
+		}
+		draw.Draw(r.img, bar, image.NewUniform(line.color), image.Point{}, draw.Over)
+		top += line.height
+	}
+}
+
+// parseHexColor returns the color of the hex RGB value, the default color
+// will be returned if the value is invalid.
+func parseHexColor(val string, def color.RGBA) color.RGBA {
+	rgb, err := strconv.ParseUint(val, 16, 32)
+	if err != nil || len(val) != 6 {
+		return def
+	}
+	return color.RGBA{uint8(rgb >> 16), uint8(rgb >> 8), uint8(rgb), 0xff}
+}
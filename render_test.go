@@ -0,0 +1,57 @@
+package gopptx
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"testing"
+)
+
+func TestRenderThumbnails(t *testing.T) {
+	f := NewFile()
+	slideID := f.GetSlideList()[0]
+	if _, err := f.CreateShape(slideID, DecodeShapeProperties{
+		Xfrm:      &DecodeXfrm{Offset: &Offset{X: 0, Y: 0}, Extents: &Extents{CX: 5040312, CY: 2835275}},
+		SolidFill: &DecodeSolidFill{SolidRGBColor: &SolidRGBColor{Val: "FF0000"}},
+	}, DecodeTextBody{}); err != nil {
+		t.Fatal(err)
+	}
+	for _, c := range []struct {
+		maxWidth      int
+		width, height int
+	}{
+		{maxWidth: 200, width: 200, height: 113},
+		{maxWidth: 4000, width: 1058, height: 595},
+		{maxWidth: 0, width: 1, height: 1},
+	} {
+		thumbnails, err := f.RenderThumbnails(c.maxWidth)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(thumbnails) != 1 {
+			t.Fatalf("expected 1 thumbnail, got %d", len(thumbnails))
+		}
+		img, err := png.Decode(bytes.NewReader(thumbnails[0]))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if size := img.Bounds().Size(); size != image.Pt(c.width, c.height) {
+			t.Errorf("expected the thumbnail size %dx%d, got %v", c.width, c.height, size)
+		}
+		if c.width == 1 {
+			continue
+		}
+		for _, p := range []struct {
+			point image.Point
+			color color.RGBA
+		}{
+			{image.Pt(c.width/4, c.height/4), color.RGBA{0xff, 0, 0, 0xff}},
+			{image.Pt(c.width*3/4, c.height*3/4), color.RGBA{0xff, 0xff, 0xff, 0xff}},
+		} {
+			if clr := color.RGBAModel.Convert(img.At(p.point.X, p.point.Y)); clr != p.color {
+				t.Errorf("expected the color %v at %v, got %v", p.color, p.point, clr)
+			}
+		}
+	}
+}
@@ -0,0 +1,170 @@
+// Copyright 2026 kenny-not-dead. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+//
+// Package gopptx provides functionality to create and manipulate PowerPoint
+// (.pptx) files in Go, using the Office Open XML (ECMA-376) format.
+
+package gopptx
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// FormatOptions directly maps the settings of the output of the SaveAll
+// function. The format is one of "presentation", "thumbnails", "html",
+// "markdown" and "notes", which is detected by the file extension of the
+// output if it's empty: the presentation extensions such as ".pptx", ".png"
+// for the thumbnails, ".html", ".md" and ".txt" for the notes. The max width
+// specifies the maximum width in pixels of the thumbnails, and the notes
+// format is the format of the exported notes, which is "text" by default.
+type FormatOptions struct {
+	Format      string
+	MaxWidth    int
+	NotesFormat string
+}
+
+// SaveAll provides a function to save the presentation and the artifacts
+// derived from it by given outputs keyed by the file paths, the package is
+// serialized once for the outputs of the same presentation type, and the
+// thumbnails are rendered once for the outputs of the same maximum width.
+// Each thumbnail is saved in the file which path is formatted by the path of
+// the output with the 1-based index of the slide if it contains the "%d"
+// verb, or has the index before the file extension. The PDF output isn't
+// supported. For example, save the presentation with the previews and the
+// text export in one pass:
+//
+//	err := f.SaveAll(map[string]gopptx.FormatOptions{
+//	    "out/Presentation.pptx":      {},
+//	    "out/thumbnails/slide%d.png": {MaxWidth: 320},
+//	    "out/Presentation.md":        {},
+//	    "out/Notes.json":             {Format: "notes", NotesFormat: "json"},
+//	})
+func (f *File) SaveAll(outputs map[string]FormatOptions) error {
+	if err := f.checkReadOnly(); err != nil {
+		return err
+	}
+	names := make([]string, 0, len(outputs))
+	for name := range outputs {
+		if len(name) > MaxFilePathLength {
+			return ErrMaxFilePathLength
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	formats := map[string]string{}
+	for _, name := range names {
+		format, err := getOutputFormat(name, outputs[name].Format)
+		if err != nil {
+			return err
+		}
+		formats[name] = format
+	}
+	var (
+		packages   = map[string][]byte{}
+		thumbnails = map[int][][]byte{}
+	)
+	for _, name := range names {
+		opts := outputs[name]
+		var content bytes.Buffer
+		switch formats[name] {
+		case "presentation":
+			contentType := supportedContentTypes[strings.ToLower(filepath.Ext(name))]
+			if _, ok := packages[contentType]; !ok {
+				if err := f.setContentTypePartProjectExtensions(contentType); err != nil {
+					return err
+				}
+				buf, err := f.WriteToBuffer()
+				if err != nil {
+					return err
+				}
+				packages[contentType] = buf.Bytes()
+			}
+			content.Write(packages[contentType])
+		case "thumbnails":
+			images, ok := thumbnails[opts.MaxWidth]
+			if !ok {
+				var err error
+				if images, err = f.RenderThumbnails(opts.MaxWidth); err != nil {
+					return err
+				}
+				thumbnails[opts.MaxWidth] = images
+			}
+			for idx, image := range images {
+				if err := os.WriteFile(filepath.Clean(getThumbnailPath(name, idx+1)), image, 0o644); err != nil {
+					return err
+				}
+			}
+			continue
+		case "html":
+			if err := f.ExportHTML(&content); err != nil {
+				return err
+			}
+		case "markdown":
+			if err := f.ExportMarkdown(&content); err != nil {
+				return err
+			}
+		case "notes":
+			format := opts.NotesFormat
+			if format == "" {
+				format = "text"
+			}
+			if err := f.ExportNotes(&content, format); err != nil {
+				return err
+			}
+		}
+		if err := os.WriteFile(filepath.Clean(name), content.Bytes(), 0o644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getOutputFormat returns the format of the output of the SaveAll function
+// by given file path and the format of the options, the format is detected
+// by the file extension if it's empty.
+func getOutputFormat(name, format string) (string, error) {
+	extension := strings.ToLower(filepath.Ext(name))
+	if format == "" {
+		switch extension {
+		case ".png":
+			format = "thumbnails"
+		case ".html", ".htm":
+			format = "html"
+		case ".md":
+			format = "markdown"
+		case ".txt":
+			format = "notes"
+		case ".pdf":
+			return "pdf", ErrOutputFormat
+		default:
+			format = "presentation"
+		}
+	}
+	switch format {
+	case "presentation":
+		if _, ok := supportedContentTypes[extension]; !ok {
+			return format, ErrPresentationFileFormat
+		}
+	case "thumbnails", "html", "markdown", "notes":
+	default:
+		return format, ErrOutputFormat
+	}
+	return format, nil
+}
+
+// getThumbnailPath returns the file path of the thumbnail by given file path
+// of the output and the 1-based index of the slide.
+func getThumbnailPath(name string, idx int) string {
+	if strings.Contains(name, "%d") {
+		return fmt.Sprintf(name, idx)
+	}
+	extension := filepath.Ext(name)
+	return strings.TrimSuffix(name, extension) + strconv.Itoa(idx) + extension
+}
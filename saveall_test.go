@@ -0,0 +1,92 @@
+package gopptx
+
+import (
+	"bytes"
+	"errors"
+	"image/png"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestSaveAll(t *testing.T) {
+	f := NewFile()
+	slideID := f.GetSlideList()[0]
+	if err := f.addNotesSlide(slideID, []string{"Welcome"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := f.NewSlide(); err != nil {
+		t.Fatal(err)
+	}
+	dir := t.TempDir()
+	if err := f.SaveAll(map[string]FormatOptions{
+		filepath.Join(dir, "Presentation.pptx"):      {},
+		filepath.Join(dir, "Copy.pptx"):              {},
+		filepath.Join(dir, "Slide.png"):              {MaxWidth: 320},
+		filepath.Join(dir, "thumbnails%d.png"):       {MaxWidth: 64},
+		filepath.Join(dir, "Presentation.html"):      {},
+		filepath.Join(dir, "Presentation.md"):        {},
+		filepath.Join(dir, "Notes.txt"):              {},
+		filepath.Join(dir, "Notes.json"):             {Format: "notes", NotesFormat: "json"},
+		filepath.Join(dir, "Presentation.data"):      {Format: "markdown"},
+		filepath.Join(dir, "Presentation.potx.pptx"): {Format: "presentation"},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	presentation, err := os.ReadFile(filepath.Join(dir, "Presentation.pptx"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if content, err := os.ReadFile(filepath.Join(dir, "Copy.pptx")); err != nil || !bytes.Equal(content, presentation) {
+		t.Errorf("expected the presentation is serialized once, got %v", err)
+	}
+	saved, err := OpenReader(bytes.NewReader(presentation))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if slides := saved.GetSlideList(); len(slides) != 2 {
+		t.Errorf("expected 2 slides in the saved presentation, got %v", slides)
+	}
+	for name, width := range map[string]int{"Slide1.png": 320, "Slide2.png": 320, "thumbnails1.png": 64, "thumbnails2.png": 64} {
+		file, err := os.Open(filepath.Join(dir, name))
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		cfg, err := png.DecodeConfig(file)
+		_ = file.Close()
+		if err != nil || cfg.Width != width {
+			t.Errorf("expected the thumbnail %s of the width %d, got %d %v", name, width, cfg.Width, err)
+		}
+	}
+	for name, expected := range map[string]string{
+		"Presentation.html": "<html",
+		"Presentation.md":   "# Slide 1",
+		"Presentation.data": "# Slide 1",
+		"Notes.txt":         "Welcome",
+		"Notes.json":        `"Welcome"`,
+	} {
+		if content, err := os.ReadFile(filepath.Join(dir, name)); err != nil || !strings.Contains(string(content), expected) {
+			t.Errorf("expected %s in the output %s, got %s %v", expected, name, content, err)
+		}
+	}
+
+	for _, c := range []struct {
+		name     string
+		opts     FormatOptions
+		expected error
+	}{
+		{name: "Presentation.pdf", expected: ErrOutputFormat},
+		{name: "Presentation.pptx", opts: FormatOptions{Format: "video"}, expected: ErrOutputFormat},
+		{name: "Presentation.data", opts: FormatOptions{Format: "presentation"}, expected: ErrPresentationFileFormat},
+		{name: strings.Repeat("a", MaxFilePathLength+1), expected: ErrMaxFilePathLength},
+	} {
+		if err = f.SaveAll(map[string]FormatOptions{filepath.Join(dir, "Notes.md"): {}, c.name: c.opts}); !errors.Is(err, c.expected) {
+			t.Errorf("expected %v, got %v", c.expected, err)
+		}
+	}
+	if _, err = os.Stat(filepath.Join(dir, "Notes.md")); !os.IsNotExist(err) {
+		t.Errorf("expected no output is saved on the invalid format, got %v", err)
+	}
+}
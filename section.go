@@ -0,0 +1,197 @@
+// Copyright 2026 kenny-not-dead. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+//
+// Package gopptx provides functionality to create and manipulate PowerPoint
+// (.pptx) files in Go, using the Office Open XML (ECMA-376) format.
+
+package gopptx
+
+import (
+	"crypto/rand"
+	"encoding/xml"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Section directly maps the section of the presentation, which groups the
+// slides by given slide IDs in the order of the slides.
+type Section struct {
+	Name     string
+	SlideIDs []int
+}
+
+// GetSections provides a function to get the sections of the presentation,
+// an empty list will be returned if the presentation has no sections.
+func (f *File) GetSections() ([]Section, error) {
+	list, err := f.sectionListReader()
+	if err != nil || list == nil {
+		return nil, err
+	}
+	sections := make([]Section, 0, len(list.Section))
+	for _, s := range list.Section {
+		section := Section{Name: s.Name, SlideIDs: []int{}}
+		for _, slide := range s.SlideIDs {
+			section.SlideIDs = append(section.SlideIDs, slide.ID)
+		}
+		sections = append(sections, section)
+	}
+	return sections, nil
+}
+
+// SetSections provides a function to replace the sections of the presentation
+// by given sections, the sections will be removed if the list is empty. Every
+// slide should be in one of the sections once, and the slides of the sections
+// should be in the order of the slides, otherwise ErrSectionSlides will be
+// returned. For example, put the first slide into
+// the section "Intro", and the others into the section "Details":
+//
+//	slides := f.GetSlideList()
+//	err := f.SetSections([]gopptx.Section{
+//	    {Name: "Intro", SlideIDs: slides[:1]},
+//	    {Name: "Details", SlideIDs: slides[1:]},
+//	})
+func (f *File) SetSections(sections []Section) error {
+	if err := f.checkReadOnly(); err != nil {
+		return err
+	}
+	if len(sections) > 0 {
+		var slideIDs []int
+		for _, s := range sections {
+			slideIDs = append(slideIDs, s.SlideIDs...)
+		}
+		if !slices.Equal(slideIDs, f.GetSlideList()) {
+			return ErrSectionSlides
+		}
+	}
+	return f.setSections(sections)
+}
+
+// setSections provides a function to replace the sections of the presentation
+// by given sections without checking the slides of the sections. The
+// sections with the same name as the existing sections keep their IDs in
+// order, and each ID is used by one section only.
+func (f *File) setSections(sections []Section) error {
+	presentation, err := f.presentationReader()
+	if err != nil {
+		return err
+	}
+	list, err := f.sectionListReader()
+	if err != nil {
+		return err
+	}
+	ids := map[string][]string{}
+	if list != nil {
+		for _, s := range list.Section {
+			ids[s.Name] = append(ids[s.Name], s.ID)
+		}
+	}
+	var exts []decodeExtension
+	if presentation.ExtensionList != nil {
+		for _, ext := range presentation.ExtensionList.Ext {
+			if ext.URI != ExtURISectionList {
+				exts = append(exts, ext)
+			}
+		}
+	}
+	if len(sections) > 0 {
+		sl := sectionList{XMLNSP14: NameSpacePowerPointR14.Value}
+		for _, s := range sections {
+			id := newGUID()
+			if reused := ids[s.Name]; len(reused) > 0 {
+				id, ids[s.Name] = reused[0], reused[1:]
+			}
+			sec := section{Name: s.Name, ID: id}
+			for _, slideID := range s.SlideIDs {
+				sec.SlideList.SlideID = append(sec.SlideList.SlideID, sectionSlideID{ID: slideID})
+			}
+			sl.Section = append(sl.Section, sec)
+		}
+		output, err := xml.Marshal(sl)
+		if err != nil {
+			return err
+		}
+		exts = append(exts, decodeExtension{URI: ExtURISectionList, Content: string(output)})
+	}
+	presentation.ExtensionList = &decodeExtensionList{Ext: exts}
+	return nil
+}
+
+// sectionListReader provides a function to get the pointer to the structure
+// after deserialization of the section list in the presentation extensions.
+// It returns nil if the presentation has no sections.
+func (f *File) sectionListReader() (*decodeSectionList, error) {
+	presentation, err := f.presentationReader()
+	if err != nil || presentation.ExtensionList == nil {
+		return nil, err
+	}
+	for _, ext := range presentation.ExtensionList.Ext {
+		if ext.URI != ExtURISectionList {
+			continue
+		}
+		list := new(decodeSectionList)
+		if err = xml.Unmarshal([]byte(ext.Content), list); err != nil {
+			return nil, err
+		}
+		return list, nil
+	}
+	return nil, nil
+}
+
+// updateSectionSlides provides a function to update the slides of the
+// sections by given callback function, which receives and returns the slide
+// IDs of each section. It does nothing if the presentation has no sections.
+func (f *File) updateSectionSlides(fn func(idx int, slideIDs []int) []int) error {
+	sections, err := f.GetSections()
+	if err != nil || len(sections) == 0 {
+		return err
+	}
+	for idx := range sections {
+		sections[idx].SlideIDs = fn(idx, sections[idx].SlideIDs)
+	}
+	return f.setSections(sections)
+}
+
+// moveSlide provides a function to move the slide by given slide id to the
+// position in the presentation by given zero-based index. The moved slide
+// joins the section of the slide before it, or the first section if it
+// becomes the first slide.
+func (f *File) moveSlide(slideID, index int) error {
+	presentation, err := f.presentationReader()
+	if err != nil {
+		return err
+	}
+	slides := presentation.Slides.Slide
+	from := slices.IndexFunc(slides, func(s decodeSlideID) bool { return s.SlideID == slideID })
+	if from == -1 {
+		return ErrSlideNotExist{slideID}
+	}
+	moved := slides[from]
+	slides = slices.Delete(slides, from, from+1)
+	index = min(max(index, 0), len(slides))
+	presentation.Slides.Slide = slices.Insert(slides, index, moved)
+	prev := -1
+	if index > 0 {
+		prev = presentation.Slides.Slide[index-1].SlideID
+	}
+	return f.updateSectionSlides(func(idx int, slideIDs []int) []int {
+		slideIDs = slices.DeleteFunc(slideIDs, func(id int) bool { return id == slideID })
+		if prev == -1 && idx == 0 {
+			return slices.Insert(slideIDs, 0, slideID)
+		}
+		if pos := slices.Index(slideIDs, prev); pos != -1 {
+			return slices.Insert(slideIDs, pos+1, slideID)
+		}
+		return slideIDs
+	})
+}
+
+// newGUID returns a random GUID in the registry format, such as
+// "{3F2504E0-4F89-41D3-9A0C-0305E82C3301}".
+func newGUID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	b[6], b[8] = b[6]&0x0f|0x40, b[8]&0x3f|0x80
+	return strings.ToUpper(fmt.Sprintf("{%x-%x-%x-%x-%x}", b[0:4], b[4:6], b[6:8], b[8:10], b[10:]))
+}
@@ -0,0 +1,80 @@
+package gopptx
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestSetSections(t *testing.T) {
+	f := NewFile()
+	for i := 0; i < 2; i++ {
+		if _, err := f.NewSlide(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	s := f.GetSlideList()
+	for _, c := range []struct {
+		name     string
+		sections []Section
+		err      error
+	}{
+		{name: "unknown slide", sections: []Section{{Name: "A", SlideIDs: []int{s[0], s[1], s[2], 1000}}}, err: ErrSectionSlides},
+		{name: "duplicate slide", sections: []Section{{Name: "A", SlideIDs: s}, {Name: "B", SlideIDs: s[2:]}}, err: ErrSectionSlides},
+		{name: "missing slide", sections: []Section{{Name: "A", SlideIDs: s[:1]}, {Name: "B", SlideIDs: s[2:]}}, err: ErrSectionSlides},
+		{name: "unordered slides", sections: []Section{{Name: "A", SlideIDs: []int{s[1], s[0]}}, {Name: "B", SlideIDs: s[2:]}}, err: ErrSectionSlides},
+		{name: "same name", sections: []Section{{Name: "A", SlideIDs: s[:1]}, {Name: "A", SlideIDs: s[1:2]}, {Name: "B", SlideIDs: s[2:]}}},
+		{name: "empty section", sections: []Section{{Name: "A", SlideIDs: s[:2]}, {Name: "A", SlideIDs: []int{}}, {Name: "A", SlideIDs: s[2:]}}},
+	} {
+		before, err := f.sectionListReader()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = f.SetSections(c.sections); !errors.Is(err, c.err) {
+			t.Fatalf("%s: expected error %v, got %v", c.name, c.err, err)
+		}
+		list, err := f.sectionListReader()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if c.err != nil {
+			if (before == nil) != (list == nil) || before != nil && len(before.Section) != len(list.Section) {
+				t.Errorf("%s: the sections are changed on error", c.name)
+			}
+			continue
+		}
+		ids := map[string]bool{}
+		for _, section := range list.Section {
+			if ids[section.ID] {
+				t.Errorf("%s: the section ID %s is used more than once", c.name, section.ID)
+			}
+			ids[section.ID] = true
+		}
+		if len(list.Section) != len(c.sections) {
+			t.Errorf("%s: expected %d sections, got %d", c.name, len(c.sections), len(list.Section))
+		}
+	}
+
+	// Keep the IDs of the existing sections with the same name in order
+	list, err := f.sectionListReader()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = f.SetSections([]Section{{Name: "A", SlideIDs: s[:1]}, {Name: "A", SlideIDs: s[1:]}}); err != nil {
+		t.Fatal(err)
+	}
+	updated, err := f.sectionListReader()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for idx, section := range updated.Section {
+		if section.ID != list.Section[idx].ID {
+			t.Errorf("expected the section ID %s at %d, got %s", list.Section[idx].ID, idx, section.ID)
+		}
+	}
+	if err = f.SetSections(nil); err != nil {
+		t.Fatal(err)
+	}
+	if sections, err := f.GetSections(); err != nil || len(sections) != 0 {
+		t.Errorf("expected no sections, got %v %v", sections, err)
+	}
+}
@@ -0,0 +1,117 @@
+// Copyright 2026 kenny-not-dead. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+//
+// Package gopptx provides functionality to create and manipulate PowerPoint
+// (.pptx) files in Go, using the Office Open XML (ECMA-376) format.
+
+package gopptx
+
+import "regexp"
+
+// DecodeShape defines the structure used to parse the shape in the shape
+// tree of the slide, which is passed to the mutation function of the
+// UpdateShapes function.
+type DecodeShape = decodeShape
+
+// ShapeSelector directly maps the conditions of selecting the shapes, the
+// shape is selected if it matches all the non-empty conditions. The slide
+// IDs are the slides to search, all slides are searched if it is empty. The
+// name is the regular expression matching the shape name, such as "^kpi-".
+// The geometry is the preset geometry of the shape, such as "rect" and
+// "ellipse". The placeholder is the placeholder type of the shape, such as
+// "title", "body" and "obj" for the placeholders without type.
+type ShapeSelector struct {
+	SlideIDs    []int
+	Name        string
+	Geometry    string
+	Placeholder string
+}
+
+// UpdateShapes provides a function to apply the mutation function to every
+// shape matching the selector, and returns the number of the updated shapes.
+// The shapes in the group shapes are included, and all shapes are selected
+// if the selector is nil. The updating stops on the first error returned by
+// the mutation function. For example, recolor every rectangle named
+// "kpi-*":
+//
+//	count, err := f.UpdateShapes(&gopptx.ShapeSelector{Name: "^kpi-", Geometry: "rect"},
+//	    func(slideID int, shape *gopptx.DecodeShape) error {
+//	        shape.ShapeProperties.SolidFill = &gopptx.DecodeSolidFill{
+//	            SolidRGBColor: &gopptx.SolidRGBColor{Val: "2E7D32"},
+//	        }
+//	        shape.ShapeProperties.NoFill = nil
+//	        return nil
+//	    })
+func (f *File) UpdateShapes(selector *ShapeSelector, mutator func(slideID int, shape *DecodeShape) error) (int, error) {
+	if err := f.checkReadOnly(); err != nil {
+		return 0, err
+	}
+	if selector == nil {
+		selector = &ShapeSelector{}
+	}
+	var (
+		count   int
+		nameExp *regexp.Regexp
+		err     error
+	)
+	if selector.Name != "" {
+		if nameExp, err = regexp.Compile(selector.Name); err != nil {
+			return count, err
+		}
+	}
+	slideIDs := selector.SlideIDs
+	if len(slideIDs) == 0 {
+		slideIDs = f.GetSlideList()
+	}
+	for _, slideID := range slideIDs {
+		slide, err := f.slideReader(slideID)
+		if err != nil {
+			return count, err
+		}
+		var update func(shapes []decodeShape, groups []decodeGroupShape) error
+		update = func(shapes []decodeShape, groups []decodeGroupShape) error {
+			for i := range shapes {
+				if !selector.match(&shapes[i], nameExp) {
+					continue
+				}
+				if err := mutator(slideID, &shapes[i]); err != nil {
+					return err
+				}
+				count++
+			}
+			for i := range groups {
+				if err := update(groups[i].Shape, groups[i].GroupShape); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+		shapeTree := &slide.CommonSlideData.ShapeTree
+		if err = update(shapeTree.Shape, shapeTree.GroupShape); err != nil {
+			return count, err
+		}
+	}
+	return count, nil
+}
+
+// match returns true if the shape matches the conditions of the selector by
+// given shape and compiled regular expression of the shape name.
+func (selector *ShapeSelector) match(shape *decodeShape, nameExp *regexp.Regexp) bool {
+	if nameExp != nil {
+		var name string
+		if nvSpPr := shape.NonVisualShapeProperties; nvSpPr != nil && nvSpPr.CommonNonVisualProperties != nil {
+			name = nvSpPr.CommonNonVisualProperties.Name
+		}
+		if !nameExp.MatchString(name) {
+			return false
+		}
+	}
+	if selector.Geometry != "" {
+		if spPr := shape.ShapeProperties; spPr == nil || spPr.PresetGeometry == nil ||
+			spPr.PresetGeometry.Preset != selector.Geometry {
+			return false
+		}
+	}
+	return selector.Placeholder == "" || shape.placeholderType() == selector.Placeholder
+}
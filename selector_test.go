@@ -0,0 +1,90 @@
+package gopptx
+
+import (
+	"errors"
+	"reflect"
+	"regexp/syntax"
+	"testing"
+)
+
+func TestUpdateShapes(t *testing.T) {
+	f := NewFile()
+	slideID := f.GetSlideList()[0]
+	if _, err := f.AddDiagram(slideID, DiagramProcess, []string{"Plan", "Build", "Ship"}, nil); err != nil {
+		t.Fatal(err)
+	}
+	nextSlideID, err := f.NewSlide()
+	if err != nil {
+		t.Fatal(err)
+	}
+	addTestShape(t, f, nextSlideID, "", "kpi").NonVisualShapeProperties.CommonNonVisualProperties.Name = "kpi-revenue"
+	for _, c := range []struct {
+		selector *ShapeSelector
+		expected []string
+	}{
+		{expected: []string{"PlaceHolder 1", "PlaceHolder 2", "Diagram Step Plan", "Diagram Label Plan", "Diagram Step Build", "Diagram Label Build",
+			"Diagram Step Ship", "Diagram Label Ship", "PlaceHolder 1", "PlaceHolder 2", "kpi-revenue"}},
+		{selector: &ShapeSelector{SlideIDs: []int{nextSlideID}}, expected: []string{"PlaceHolder 1", "PlaceHolder 2", "kpi-revenue"}},
+		{selector: &ShapeSelector{Name: "^Diagram Step", Geometry: "chevron"}, expected: []string{"Diagram Step Build", "Diagram Step Ship"}},
+		{selector: &ShapeSelector{Placeholder: "title"}, expected: []string{"PlaceHolder 1", "PlaceHolder 1"}},
+		{selector: &ShapeSelector{SlideIDs: []int{slideID}, Name: "Label", Placeholder: "body"}},
+	} {
+		var names []string
+		count, err := f.UpdateShapes(c.selector, func(_ int, shape *DecodeShape) error {
+			names = append(names, shape.NonVisualShapeProperties.CommonNonVisualProperties.Name)
+			return nil
+		})
+		if err != nil || count != len(c.expected) || !reflect.DeepEqual(names, c.expected) {
+			t.Errorf("expected the shapes %v, got %d %v %v", c.expected, count, names, err)
+		}
+	}
+
+	count, err := f.UpdateShapes(&ShapeSelector{Geometry: "chevron"}, func(_ int, shape *DecodeShape) error {
+		shape.ShapeProperties.SolidFill = &DecodeSolidFill{SolidRGBColor: &SolidRGBColor{Val: "2E7D32"}}
+		return nil
+	})
+	if err != nil || count != 2 {
+		t.Fatalf("expected 2 updated shapes, got %d %v", count, err)
+	}
+	buf, err := f.WriteToBuffer()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f, err = OpenReader(buf); err != nil {
+		t.Fatal(err)
+	}
+	var fills []string
+	if _, err = f.UpdateShapes(&ShapeSelector{Name: "Diagram Step"}, func(_ int, shape *DecodeShape) error {
+		fill := shape.ShapeProperties.SolidFill
+		if fill.SolidRGBColor != nil {
+			fills = append(fills, fill.SolidRGBColor.Val)
+		} else {
+			fills = append(fills, fill.SchemeColor.Val)
+		}
+		return nil
+	}); err != nil || !reflect.DeepEqual(fills, []string{"accent1", "2E7D32", "2E7D32"}) {
+		t.Errorf("expected the updated fills, got %v %v", fills, err)
+	}
+
+	errStop := errors.New("stop")
+	for _, c := range []struct {
+		selector *ShapeSelector
+		count    int
+		expected error
+	}{
+		{selector: &ShapeSelector{Geometry: "chevron"}, count: 1, expected: errStop},
+		{selector: &ShapeSelector{Name: "("}, expected: &syntax.Error{Code: syntax.ErrMissingParen, Expr: "("}},
+		{selector: &ShapeSelector{SlideIDs: []int{300}}, expected: ErrSlideNotExist{300}},
+	} {
+		var calls int
+		count, err = f.UpdateShapes(c.selector, func(int, *DecodeShape) error {
+			if calls++; calls > 1 {
+				return errStop
+			}
+			return nil
+		})
+		if count != c.count || err == nil || err.Error() != c.expected.Error() {
+			t.Errorf("expected %d updated shapes and %v, got %d %v", c.count, c.expected, count, err)
+		}
+	}
+}
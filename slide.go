@@ -13,29 +13,65 @@ import (
 	"fmt"
 	"io"
 	"path/filepath"
+	"slices"
 	"strconv"
 	"strings"
 )
 
-// NewSlide provides the function to create a new slide and
-// returns the index of the slide in the presentation after it appended.
-func (f *File) NewSlide() (int, error) {
+// NewSlideOptions directly maps the settings of creating a slide. The index
+// is the zero-based index of the created slide in the presentation, and the
+// slide is appended if it is nil. The layout is the name or the type of the
+// slide layout, case-insensitive, the empty placeholders of the layout are
+// copied to the slide if it isn't empty. The master is the zero-based index
+// of the slide master whose layouts are searched, the layouts of all masters
+// are searched if it is nil, and the first layout of the master is used if
+// the layout is empty.
+type NewSlideOptions struct {
+	Index  *int
+	Layout string
+	Master *int
+}
+
+// NewSlide provides the function to create a new slide and returns the slide
+// ID of the slide. The slide is appended to the presentation and the last
+// section unless the index is given by the options, and the slide inserted
+// at the index joins the section of the slide before it. For example, insert
+// a slide with the "Title Only" layout as the second slide:
+//
+//	index := 1
+//	slideID, err := f.NewSlide(&gopptx.NewSlideOptions{Index: &index, Layout: "Title Only"})
+func (f *File) NewSlide(opts ...*NewSlideOptions) (int, error) {
+	if err := f.checkReadOnly(); err != nil {
+		return -1, err
+	}
+	var options NewSlideOptions
+	for _, opt := range opts {
+		if opt != nil {
+			options = *opt
+		}
+	}
 	presentation, err := f.presentationReader()
 	if err != nil {
 		return -1, err
 	}
+	if presentation.Slides == nil {
+		presentation.Slides = &decodeSlideList{}
+	}
+	if options.Index != nil && (*options.Index < 0 || *options.Index > len(presentation.Slides.Slide)) {
+		return -1, ErrSlideIndex
+	}
+	var layoutXMLPath string
+	if options.Layout != "" || options.Master != nil {
+		if layoutXMLPath, err = f.getMasterSlideLayoutPath(options.Master, options.Layout); err != nil {
+			return -1, err
+		}
+	}
 
 	f.SlideCount++
 
-	slideID := defaultXMLSlideID
-
-	for _, s := range presentation.Slides.Slide {
-		if s.SlideID >= slideID {
-			slideID = s.SlideID + 1
-		}
-	}
+	slideID := f.nextSlideID()
 
-	nextFileIndex := len(presentation.Slides.Slide) + 1
+	nextFileIndex := f.nextSlideFileIndex()
 	fileName := "slide" + strconv.Itoa(nextFileIndex)
 
 	// Update [Content_Types].xml
@@ -51,7 +87,45 @@ func (f *File) NewSlide() (int, error) {
 	// Update presentation.xml
 	f.setPresentation(slideID, rID)
 
-	return slideID, nil
+	if layoutXMLPath != "" {
+		if err = f.setSlideLayout(slideID, layoutXMLPath); err != nil {
+			return slideID, err
+		}
+	}
+	if options.Index != nil {
+		return slideID, f.moveSlide(slideID, *options.Index)
+	}
+
+	// Append the slide to the last section
+	sections, err := f.GetSections()
+	if err != nil || len(sections) == 0 {
+		return slideID, err
+	}
+	sections[len(sections)-1].SlideIDs = append(sections[len(sections)-1].SlideIDs, slideID)
+
+	return slideID, f.setSections(sections)
+}
+
+// nextSlideFileIndex returns the number of the slide part name for the new
+// slide, which is greater than the numbers of the existing slide parts, so
+// the part names don't collide after the slides are deleted.
+func (f *File) nextSlideFileIndex() int {
+	var index int
+	number := func(name string) {
+		if strings.HasPrefix(name, "ppt/slides/slide") && strings.HasSuffix(name, ".xml") {
+			if n, err := strconv.Atoi(strings.TrimSuffix(strings.TrimPrefix(name, "ppt/slides/slide"), ".xml")); err == nil {
+				index = max(index, n)
+			}
+		}
+	}
+	for _, slideXMLPath := range f.slideMap {
+		number(slideXMLPath)
+	}
+	f.Pkg.Range(func(k, v interface{}) bool {
+		number(k.(string))
+		return true
+	})
+	return index + 1
 }
 
 // setContentTypes provides a function to read and update property of contents
@@ -70,6 +144,28 @@ func (f *File) setContentTypes(partName, contentType string) error {
 	return err
 }
 
+// setContentTypeDefault provides a function to add the default content type
+// of the given file extension into [Content_Types].xml if it doesn't exist.
+func (f *File) setContentTypeDefault(extension, contentType string) error {
+	content, err := f.contentTypesReader()
+	if err != nil {
+		return err
+	}
+	content.mu.Lock()
+	defer content.mu.Unlock()
+	extension = strings.TrimPrefix(strings.ToLower(extension), ".")
+	for _, v := range content.Defaults {
+		if strings.EqualFold(v.Extension, extension) {
+			return err
+		}
+	}
+	content.Defaults = append(content.Defaults, contentTypeDefault{
+		Extension:   extension,
+		ContentType: contentType,
+	})
+	return err
+}
+
 // contentTypesReader provides a function to get the pointer to the
 // [Content_Types].xml structure after deserialization.
 func (f *File) contentTypesReader() (*contentTypes, error) {
@@ -117,7 +213,7 @@ func (f *File) getSlideMap() (map[int]string, error) {
 	if err != nil {
 		return nil, err
 	}
-	if rels == nil {
+	if rels == nil || presentation.Slides == nil {
 		return maps, nil
 	}
 	for _, slide := range presentation.Slides.Slide {
@@ -148,7 +244,8 @@ func (f *File) relsReader(path string) (*relationships, error) {
 				Decode(&c); err != nil && err != io.EOF {
 				return nil, err
 			}
-			f.Relationships.Store(path, &c)
+			c.intern()
+			f.Relationships.LoadOrStore(path, &c)
 		}
 	}
 	if rels, _ = f.Relationships.Load(path); rels != nil {
@@ -157,6 +254,21 @@ func (f *File) relsReader(path string) (*relationships, error) {
 	return nil, nil
 }
 
+// readRels provides a function to get a copy of the relationships list by
+// given relationships part path, the relationships which aren't loaded are
+// parsed without caching, so reading them doesn't change the saved part.
+func (f *File) readRels(path string) ([]relationship, error) {
+	if rels, _ := f.Relationships.Load(path); rels != nil {
+		return getRelationships(rels.(*relationships)), nil
+	}
+	c := relationships{}
+	if err := f.xmlNewDecoder(bytes.NewReader(namespaceStrictToTransitional(f.readXML(path)))).
+		Decode(&c); err != nil && err != io.EOF {
+		return nil, err
+	}
+	return c.Relationships, nil
+}
+
 // getSlidePath construct a target XML as ppt/slides/slide%d by split
 // path, compatible with different types of relative paths in
 // presentation.xml.rels, for example: slides/slide%d.xml
@@ -192,8 +304,189 @@ func (ds *decodeSlide) getShapes() []decodeShape {
 	return ds.CommonSlideData.ShapeTree.Shape
 }
 
+// nextShapeID returns an unused shape id of the slide.
+func (ds *decodeSlide) nextShapeID() int {
+	shapeID := defaultXMLShapeID
+	for _, s := range ds.CommonSlideData.ShapeTree.Shape {
+		if s.NonVisualShapeProperties != nil && s.NonVisualShapeProperties.CommonNonVisualProperties != nil {
+			shapeID = max(shapeID, s.NonVisualShapeProperties.CommonNonVisualProperties.ID)
+		}
+	}
+	for _, p := range ds.CommonSlideData.ShapeTree.Picture {
+		if p.NonVisualPictureProperties != nil && p.NonVisualPictureProperties.CommonNonVisualProperties != nil {
+			shapeID = max(shapeID, p.NonVisualPictureProperties.CommonNonVisualProperties.ID)
+		}
+	}
+	for _, gf := range ds.CommonSlideData.ShapeTree.GraphicFrame {
+		if gf.NonVisualGraphicFrameProperties != nil && gf.NonVisualGraphicFrameProperties.CommonNonVisualProperties != nil {
+			shapeID = max(shapeID, gf.NonVisualGraphicFrameProperties.CommonNonVisualProperties.ID)
+		}
+	}
+	for _, gs := range ds.CommonSlideData.ShapeTree.GroupShape {
+		shapeID = max(shapeID, gs.maxShapeID())
+	}
+	return shapeID + 1
+}
+
+// maxShapeID returns the maximum shape id of the group shape and the shapes
+// in the group.
+func (dgs *decodeGroupShape) maxShapeID() int {
+	var shapeID int
+	if dgs.NonVisualGroupShapeProperties != nil && dgs.NonVisualGroupShapeProperties.CommonNonVisualProperties != nil {
+		shapeID = dgs.NonVisualGroupShapeProperties.CommonNonVisualProperties.ID
+	}
+	for _, s := range dgs.Shape {
+		if s.NonVisualShapeProperties != nil && s.NonVisualShapeProperties.CommonNonVisualProperties != nil {
+			shapeID = max(shapeID, s.NonVisualShapeProperties.CommonNonVisualProperties.ID)
+		}
+	}
+	for _, p := range dgs.Picture {
+		if p.NonVisualPictureProperties != nil && p.NonVisualPictureProperties.CommonNonVisualProperties != nil {
+			shapeID = max(shapeID, p.NonVisualPictureProperties.CommonNonVisualProperties.ID)
+		}
+	}
+	for _, gf := range dgs.GraphicFrame {
+		if gf.NonVisualGraphicFrameProperties != nil && gf.NonVisualGraphicFrameProperties.CommonNonVisualProperties != nil {
+			shapeID = max(shapeID, gf.NonVisualGraphicFrameProperties.CommonNonVisualProperties.ID)
+		}
+	}
+	for _, gs := range dgs.GroupShape {
+		shapeID = max(shapeID, gs.maxShapeID())
+	}
+	return shapeID
+}
+
+// placeholderType returns the placeholder type of the shape, an empty string
+// will be returned if the shape is not a placeholder.
+func (ds *decodeShape) placeholderType() string {
+	if ds.NonVisualShapeProperties == nil || ds.NonVisualShapeProperties.NonVisualProperties == nil ||
+		ds.NonVisualShapeProperties.NonVisualProperties.Ph == nil {
+		return ""
+	}
+	if ph := ds.NonVisualShapeProperties.NonVisualProperties.Ph; ph.Type != nil {
+		return *ph.Type
+	}
+	return "obj"
+}
+
+// isTitle returns true if the shape is the title placeholder of the slide.
+func (ds *decodeShape) isTitle() bool {
+	phType := ds.placeholderType()
+	return phType == "title" || phType == "ctrTitle"
+}
+
+// isBody returns true if the shape is a placeholder holding the content of
+// the slide, the title and the header, footer, date and slide number
+// placeholders are not included.
+func (ds *decodeShape) isBody() bool {
+	switch ds.placeholderType() {
+	case "", "title", "ctrTitle", "dt", "ftr", "hdr", "sldNum", "sldImg":
+		return false
+	}
+	return true
+}
+
+// getTitleShape returns the title placeholder of the slide, nil will be
+// returned if the slide has no title placeholder.
+func (ds *decodeSlide) getTitleShape() *decodeShape {
+	for i := range ds.CommonSlideData.ShapeTree.Shape {
+		if ds.CommonSlideData.ShapeTree.Shape[i].isTitle() {
+			return &ds.CommonSlideData.ShapeTree.Shape[i]
+		}
+	}
+	return nil
+}
+
+// GetSlideTitle provides a function to get the text of the title placeholder
+// of the slide by given slide id, the paragraphs are separated by the line
+// feeds. An empty string will be returned if the slide has no title
+// placeholder. For example:
+//
+//	title, err := f.GetSlideTitle(256)
+func (f *File) GetSlideTitle(slideID int) (string, error) {
+	slide, err := f.slideReader(slideID)
+	if err != nil {
+		return "", err
+	}
+	if shape := slide.getTitleShape(); shape != nil {
+		return shape.TextBody.text(), nil
+	}
+	return "", nil
+}
+
+// SetSlideTitle provides a function to set the text of the title placeholder
+// of the slide by given slide id and the title, the line feeds in the title
+// start new paragraphs. The formatting of the first text run of the title is
+// kept. For example:
+//
+//	err := f.SetSlideTitle(256, "Quarterly Review")
+func (f *File) SetSlideTitle(slideID int, title string) error {
+	if err := f.checkReadOnly(); err != nil {
+		return err
+	}
+	slide, err := f.slideReader(slideID)
+	if err != nil {
+		return err
+	}
+	shape := slide.getTitleShape()
+	if shape == nil {
+		return ErrPlaceholderNotExist
+	}
+	var rPr *DecodeRunProperties
+	if shape.TextBody != nil && len(shape.TextBody.Paragraph) > 0 && len(shape.TextBody.Paragraph[0].Runs) > 0 {
+		rPr = shape.TextBody.Paragraph[0].Runs[0].RunProperties
+	}
+	var paragraphs []JSONParagraph
+	for _, line := range strings.Split(title, "\n") {
+		paragraphs = append(paragraphs, JSONParagraph{Runs: []JSONRun{{Text: line}}})
+	}
+	setPlaceholderParagraphs(shape, paragraphs)
+	for i := range shape.TextBody.Paragraph {
+		for j := range shape.TextBody.Paragraph[i].Runs {
+			if rPr != nil {
+				props := *rPr
+				shape.TextBody.Paragraph[i].Runs[j].RunProperties = &props
+			}
+		}
+	}
+	return nil
+}
+
+// text returns the plain text of the text body, paragraphs are separated by
+// line breaks.
+func (dt *DecodeTextBody) text() string {
+	if dt == nil {
+		return ""
+	}
+	paragraphs := make([]string, len(dt.Paragraph))
+	for i := range dt.Paragraph {
+		paragraphs[i] = dt.Paragraph[i].text()
+	}
+	return strings.Join(paragraphs, "\n")
+}
+
+// text returns the plain text of the paragraph.
+func (dp *DecodeParagraph) text() string {
+	var buf strings.Builder
+	for _, r := range dp.Runs {
+		buf.WriteString(r.Text)
+	}
+	return buf.String()
+}
+
+// level returns the outline level of the paragraph starting from zero.
+func (dp *DecodeParagraph) level() int {
+	if dp.ParagraphProperties != nil && dp.ParagraphProperties.Level != nil {
+		return *dp.ParagraphProperties.Level
+	}
+	return 0
+}
+
 // SetShapeTextBody provides a function to set shape text body by given shape id.
 func (f *File) SetShapeTextBody(slideID int, shapeID int, textBody DecodeTextBody) error {
+	if err := f.checkReadOnly(); err != nil {
+		return err
+	}
 	shapes, err := f.GetShapes(slideID)
 	if err != nil {
 		return err
@@ -213,21 +506,17 @@ func (f *File) SetShapeTextBody(slideID int, shapeID int, textBody DecodeTextBod
 // CreateShape provides the function to create  a new shape by given slide id and
 // returns the id of the shape in the slide after it appended.
 func (f *File) CreateShape(slideID int, shapeProperties DecodeShapeProperties, textBody DecodeTextBody) (int, error) {
+	if err := f.checkReadOnly(); err != nil {
+		return -1, err
+	}
 	slide, err := f.slideReader(slideID)
 	if err != nil {
 		return -1, err
 	}
 
-    shapes := slide.getShapes()
+	shapes := slide.getShapes()
 
-	shapeID := defaultXMLShapeID
-	for _, s := range shapes {
-		if s.NonVisualShapeProperties != nil && s.NonVisualShapeProperties.CommonNonVisualProperties != nil {
-			shapeID = max(shapeID, s.NonVisualShapeProperties.CommonNonVisualProperties.ID)
-		}
-	}
-
-	shapeID++
+	shapeID := slide.nextShapeID()
 
 	newShape := decodeShape{
 		NonVisualShapeProperties: &decodeNonVisualShapeProperties{
@@ -241,19 +530,22 @@ func (f *File) CreateShape(slideID int, shapeProperties DecodeShapeProperties, t
 
 	shapes = append(shapes, newShape)
 
-    slide.CommonSlideData.ShapeTree.Shape = shapes
+	slide.CommonSlideData.ShapeTree.Shape = shapes
 
 	return shapeID, nil
 }
 
 // DeleteShape provides a function to delete shape on slide by given shape id.
 func (f *File) DeleteShape(slideID int, shapeID int) error {
+	if err := f.checkReadOnly(); err != nil {
+		return err
+	}
 	slide, err := f.slideReader(slideID)
 	if err != nil {
 		return err
 	}
 
-    shapes := slide.getShapes()
+	shapes := slide.getShapes()
 
 	deleteSlideIndex := -1
 	for i := range shapes {
@@ -272,7 +564,7 @@ func (f *File) DeleteShape(slideID int, shapeID int) error {
 	shapes = append(shapes[:deleteSlideIndex], shapes[deleteSlideIndex+1:]...)
 
 	slide.CommonSlideData.ShapeTree.Shape = shapes
-	
+
 	return nil
 }
 
@@ -306,12 +598,59 @@ func (ds *decodeSlide) getNonVisualGroupShapeProperties() *decodeNonVisualGroupS
 	return ds.CommonSlideData.ShapeTree.NonVisualGroupShapeProperties
 }
 
+// SetSlideShowMasterShapes provides a function to set whether the shapes of
+// the slide master and the layout, such as the logos and the background
+// graphics, are shown on the slide by given slide id. The animations of the
+// master placeholders are hidden together with the master shapes. For
+// example, hide the master decorations on the slide:
+//
+//	err := f.SetSlideShowMasterShapes(256, false)
+func (f *File) SetSlideShowMasterShapes(slideID int, show bool) error {
+	if err := f.checkReadOnly(); err != nil {
+		return err
+	}
+	s, err := f.slideReader(slideID)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.ShowMasterShapes, s.ShowMasterPhAnim = nil, nil
+	if !show {
+		showMasterShapes, showMasterPhAnim := false, false
+		s.ShowMasterShapes, s.ShowMasterPhAnim = &showMasterShapes, &showMasterPhAnim
+	}
+	return nil
+}
+
+// GetSlideShowMasterShapes provides a function to get whether the shapes of
+// the slide master and the layout are shown on the slide by given slide id.
+func (f *File) GetSlideShowMasterShapes(slideID int) (bool, error) {
+	s, err := f.slideReader(slideID)
+	if err != nil {
+		return true, err
+	}
+	return s.ShowMasterShapes == nil || *s.ShowMasterShapes, nil
+}
+
 // DeleteSlide provides a function to delete slide in a presentation by given slide id.
 func (f *File) DeleteSlide(slideID int) error {
+	if err := f.checkReadOnly(); err != nil {
+		return err
+	}
 	if idx, _ := f.GetSlideIndex(slideID); f.SlideCount == 1 || idx == -1 {
 		return nil
 	}
 
+	// Keep the active slide, or activate the slide at the same position if
+	// the active slide is deleted.
+	if active := f.GetActiveSlideIndex(); active != 0 {
+		if idx, _ := f.GetSlideIndex(slideID); idx < active || active == f.SlideCount-1 {
+			active--
+		}
+		_ = f.SetActiveSlide(active)
+	}
+
 	presentation, _ := f.presentationReader()
 	presentationRels, _ := f.relsReader(f.getPresentationRelsPath())
 
@@ -348,9 +687,12 @@ func (f *File) DeleteSlide(slideID int) error {
 		f.SlideCount--
 	}
 
-	// TODO: setActiveSlide
-	//index, err := f.GetSlideIndex(f.getActiveSlideID())
-	//f.SetActiveSlide(index)
+	if err := f.updateSectionSlides(func(idx int, slideIDs []int) []int {
+		return slices.DeleteFunc(slideIDs, func(id int) bool { return id == slideID })
+	}); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -369,6 +711,181 @@ func (f *File) deleteSlideFromPresentationRels(rID string) string {
 	return ""
 }
 
+// DuplicateSlide provides a function to duplicate the slide by given slide
+// id, and returns the slide id of the duplicated slide appended to the
+// presentation. For example:
+//
+//	slideID, err := f.DuplicateSlide(256)
+func (f *File) DuplicateSlide(slideID int) (int, error) {
+	if err := f.checkReadOnly(); err != nil {
+		return -1, err
+	}
+	return f.DuplicateSlideTo(slideID, len(f.GetSlideList()))
+}
+
+// DuplicateSlideTo provides a function to duplicate the slide by given slide
+// id, and insert the duplicated slide at the position by given zero-based
+// index, it returns the slide id of the duplicated slide. The duplicated
+// slide joins the section of the slide before it, or the first section if it
+// becomes the first slide. The pictures and the layout are shared with the
+// source slide, the other parts used by the slide, such as the charts, are
+// copied, and the speaker notes are not duplicated. For example, duplicate
+// the slide right after itself:
+//
+//	index, err := f.GetSlideIndex(256)
+//	if err != nil {
+//	    fmt.Println(err)
+//	    return
+//	}
+//	slideID, err := f.DuplicateSlideTo(256, index+1)
+func (f *File) DuplicateSlideTo(slideID, index int) (int, error) {
+	if err := f.checkReadOnly(); err != nil {
+		return -1, err
+	}
+	if index < 0 || index > len(f.GetSlideList()) {
+		return -1, ErrSlideIndex
+	}
+	// Copy the content of the source slide part as is, so the elements which
+	// aren't modeled by the package, such as the background and the
+	// connectors, are kept. The relationships of the duplicated slide keep the
+	// IDs of the source slide, so the content doesn't need to be changed.
+	content, err := f.GetSlideXML(slideID)
+	if err != nil {
+		return -1, err
+	}
+	srcSlideXMLPath, _ := f.getSlideXMLPath(slideID)
+	newSlideID, err := f.NewSlide()
+	if err != nil {
+		return -1, err
+	}
+	slideXMLPath, _ := f.getSlideXMLPath(newSlideID)
+	f.Slide.Delete(slideXMLPath)
+	f.xmlAttr.Delete(slideXMLPath)
+	f.tempFiles.Delete(slideXMLPath)
+	f.Pkg.Store(slideXMLPath, content)
+	imported, rels := map[string]string{}, &relationships{}
+	srcRels, _ := f.relsReader(getPartRelsPath(srcSlideXMLPath))
+	for _, rel := range getRelationships(srcRels) {
+		if rel.TargetMode == "External" {
+			rels.Relationships = append(rels.Relationships, rel)
+			continue
+		}
+		target := resolveRelTarget(srcSlideXMLPath, rel.Target)
+		switch rel.Type {
+		case SourceRelationshipNotesSlide:
+			// The notes slide refers back to its slide, so the speaker
+			// notes are not duplicated.
+			continue
+		case SourceRelationshipSlide, SourceRelationshipSlideLayout, SourceRelationshipImage:
+		default:
+			if target, err = f.importPart(f, target, imported); err != nil {
+				return newSlideID, err
+			}
+		}
+		rel.Target = getRelativeTarget(slideXMLPath, target)
+		rels.Relationships = append(rels.Relationships, rel)
+	}
+	f.Relationships.Store(getPartRelsPath(slideXMLPath), rels)
+	return newSlideID, f.moveSlide(newSlideID, index)
+}
+
+// GetSlideXML provides a function to get the XML content of the slide part
+// by given slide id, including the changes of the slide which haven't been
+// saved, so the constructs which the functions of the package don't support
+// can be patched with the SetSlideXML function. For example:
+//
+//	content, err := f.GetSlideXML(256)
+func (f *File) GetSlideXML(slideID int) ([]byte, error) {
+	slideXMLPath, ok := f.getSlideXMLPath(slideID)
+	if !ok {
+		return nil, ErrSlideNotExist{slideID}
+	}
+	if slide, ok := f.Slide.Load(slideXMLPath); ok && slide != nil {
+		return append([]byte(xml.Header), marshalSlide(slide.(*decodeSlide))...), nil
+	}
+	return append([]byte(nil), f.readBytes(slideXMLPath)...), nil
+}
+
+// SetSlideXML provides a function to replace the XML content of the slide
+// part by given slide id and the XML content. The content should be well
+// formed, the root element should be the p:sld element of the PresentationML
+// namespace with the common slide data, and all namespace prefixes should be
+// declared. The relationships of the slide are kept, so the content should
+// refer to the existing relationships only. For example:
+//
+//	content, err := f.GetSlideXML(256)
+//	if err != nil {
+//	    fmt.Println(err)
+//	    return
+//	}
+//	content = bytes.ReplaceAll(content, []byte(`<p:sld `), []byte(`<p:sld show="0" `))
+//	err = f.SetSlideXML(256, content)
+func (f *File) SetSlideXML(slideID int, content []byte) error {
+	if err := f.checkReadOnly(); err != nil {
+		return err
+	}
+	slideXMLPath, ok := f.getSlideXMLPath(slideID)
+	if !ok {
+		return ErrSlideNotExist{slideID}
+	}
+	if err := validateSlideXML(content); err != nil {
+		return err
+	}
+	f.Slide.Delete(slideXMLPath)
+	f.xmlAttr.Delete(slideXMLPath)
+	f.tempFiles.Delete(slideXMLPath)
+	f.Pkg.Store(slideXMLPath, append([]byte(nil), content...))
+	return nil
+}
+
+// validateSlideXML checks the XML content of the slide part is well formed,
+// the root element is the p:sld element with the common slide data and all
+// namespace prefixes are declared.
+func validateSlideXML(content []byte) error {
+	d := xml.NewDecoder(bytes.NewReader(content))
+	checkName := func(name xml.Name) error {
+		if name.Space != "" && name.Space != "xmlns" && name.Space != "xml" && !strings.Contains(name.Space, ":") {
+			return ErrXMLNameSpaceNotDeclared{Prefix: name.Space}
+		}
+		return nil
+	}
+	var depth int
+	var hasSlideData bool
+	for {
+		token, err := d.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			if depth == 0 && (t.Name.Space != NameSpacePresentationML.Value || t.Name.Local != "sld") {
+				return ErrSlideXMLRoot
+			}
+			if depth == 1 && t.Name.Space == NameSpacePresentationML.Value && t.Name.Local == "cSld" {
+				hasSlideData = true
+			}
+			if err = checkName(t.Name); err != nil {
+				return err
+			}
+			for _, attr := range t.Attr {
+				if err = checkName(attr.Name); err != nil {
+					return err
+				}
+			}
+			depth++
+		case xml.EndElement:
+			depth--
+		}
+	}
+	if !hasSlideData {
+		return ErrSlideXMLRoot
+	}
+	return nil
+}
+
 // GetSlideIndex provides a function to get a slide index of the presentation by
 // the given slide id. If slide doesn't exist, it will return an integer type value -1.
 func (f *File) GetSlideIndex(slideID int) (int, error) {
@@ -380,7 +897,79 @@ func (f *File) GetSlideIndex(slideID int) (int, error) {
 	return -1, nil
 }
 
-// GetSlideList provides a function to get slides of the presentation.
+// GetSlideID provides a function to get the slide ID of the presentation by
+// given zero-based slide index.
+func (f *File) GetSlideID(index int) (int, error) {
+	slideIDs := f.GetSlideList()
+	if index < 0 || index >= len(slideIDs) {
+		return -1, ErrSlideIndex
+	}
+	return slideIDs[index], nil
+}
+
+// nextSlideID returns an unused slide ID of the presentation, which is the
+// next ID of the largest slide ID, or the smallest unused slide ID if the
+// largest slide ID reaches the maximum value.
+func (f *File) nextSlideID() int {
+	slideIDs := f.GetSlideList()
+	if len(slideIDs) == 0 {
+		return defaultXMLSlideID
+	}
+	if slideID := slices.Max(slideIDs) + 1; slideID <= maxXMLSlideID {
+		return max(slideID, defaultXMLSlideID)
+	}
+	slideID := defaultXMLSlideID
+	for slices.Contains(slideIDs, slideID) {
+		slideID++
+	}
+	return slideID
+}
+
+// RenumberSlideIDs provides a function to renumber the slide IDs of the
+// presentation in the order of the slides starting from 256, and the slide
+// IDs in the sections and the active slide are updated accordingly. It
+// returns the mapping of the original slide IDs to the new slide IDs, so the
+// slide IDs held by the caller can be updated. For example:
+//
+//	ids, err := f.RenumberSlideIDs()
+//	if err != nil {
+//	    fmt.Println(err)
+//	    return
+//	}
+//	slideID = ids[slideID]
+func (f *File) RenumberSlideIDs() (map[int]int, error) {
+	if err := f.checkReadOnly(); err != nil {
+		return nil, err
+	}
+	presentation, err := f.presentationReader()
+	if err != nil {
+		return nil, err
+	}
+	ids := make(map[int]int, len(presentation.Slides.Slide))
+	slideMap := make(map[int]string, len(f.slideMap))
+	for idx := range presentation.Slides.Slide {
+		slide := &presentation.Slides.Slide[idx]
+		ids[slide.SlideID] = defaultXMLSlideID + idx
+		if path, ok := f.slideMap[slide.SlideID]; ok {
+			slideMap[defaultXMLSlideID+idx] = path
+		}
+		slide.SlideID = defaultXMLSlideID + idx
+	}
+	f.slideMap = slideMap
+	return ids, f.updateSectionSlides(func(_ int, slideIDs []int) []int {
+		for i, slideID := range slideIDs {
+			if id, ok := ids[slideID]; ok {
+				slideIDs[i] = id
+			}
+		}
+		return slideIDs
+	})
+}
+
+// GetSlideList provides a function to get the slide IDs of the presentation
+// in the order of the slides. The slide ID is the id attribute of the slide
+// in the presentation part, which identifies the slide in all functions and
+// keeps unchanged when the slides are added, moved or deleted.
 func (f *File) GetSlideList() (list []int) {
 	presentation, _ := f.presentationReader()
 	if presentation != nil {
@@ -391,17 +980,40 @@ func (f *File) GetSlideList() (list []int) {
 	return
 }
 
+// SetActiveSlide provides a function to set the active slide of the
+// presentation by given zero-based slide index, which is the first displayed
+// slide of the presentation. The active slide is persisted as the
+// firstSlideNum attribute of the presentation, so the number of the first
+// slide set by the SetSlideNumbering function is replaced. For example,
+// activate the second slide:
+//
+//	err := f.SetActiveSlide(1)
+func (f *File) SetActiveSlide(index int) error {
+	if err := f.checkReadOnly(); err != nil {
+		return err
+	}
+	presentation, err := f.presentationReader()
+	if err != nil {
+		return err
+	}
+	if index < 0 || index >= len(presentation.Slides.Slide) {
+		return ErrSlideIndex
+	}
+	first := index + 1
+	if presentation.FirstSlideNum = &first; index == 0 {
+		presentation.FirstSlideNum = nil
+	}
+	return nil
+}
+
 // GetActiveSlideIndex provides a function to get active slide index of the
-// presentation. If not found the active slide will be return integer 0.
+// presentation by the firstSlideNum attribute of the presentation. If not
+// found the active slide will be return integer 0.
 func (f *File) GetActiveSlideIndex() (index int) {
-	slideID := f.getActiveSlideID()
 	presentation, _ := f.presentationReader()
-	if presentation != nil {
-		for idx, slide := range presentation.Slides.Slide {
-			if slide.SlideID == slideID {
-				index = idx
-				return
-			}
+	if presentation != nil && presentation.FirstSlideNum != nil {
+		if idx := *presentation.FirstSlideNum - 1; idx > 0 && idx < len(presentation.Slides.Slide) {
+			index = idx
 		}
 	}
 	return
@@ -410,12 +1022,8 @@ func (f *File) GetActiveSlideIndex() (index int) {
 // getActiveSlideID provides a function to get active slide ID of the
 // presentation. If not found the active slide will be return integer 0.
 func (f *File) getActiveSlideID() int {
-	presentation, _ := f.presentationReader()
-	if presentation != nil {
-		// TODO: get active slide
-		if len(presentation.Slides.Slide) >= 1 {
-			return presentation.Slides.Slide[0].SlideID
-		}
+	if slideIDs := f.GetSlideList(); len(slideIDs) > 0 {
+		return slideIDs[f.GetActiveSlideIndex()]
 	}
 	return 0
 }
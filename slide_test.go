@@ -0,0 +1,347 @@
+package gopptx
+
+import (
+	"bytes"
+	"errors"
+	"regexp"
+	"testing"
+)
+
+func TestOpenWithoutSlideList(t *testing.T) {
+	f, err := openTestFile(t, func(name string, content []byte) []byte {
+		if name == defaultXMLPathPresentation {
+			return regexp.MustCompile(`(?s)<p:sldIdLst>.*</p:sldIdLst>`).ReplaceAll(content, nil)
+		}
+		return content
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if list := f.GetSlideList(); len(list) != 0 {
+		t.Fatalf("expected no slides, got %v", list)
+	}
+	slideID, err := f.NewSlide()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if list := f.GetSlideList(); len(list) != 1 || list[0] != slideID {
+		t.Fatalf("expected the slide %d, got %v", slideID, list)
+	}
+	if _, err = f.WriteToBuffer(); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Contains(f.readXML(defaultXMLPathPresentation), []byte("<p:sldIdLst>")) {
+		t.Error("the slide list isn't saved")
+	}
+}
+
+func TestNewSlideOptions(t *testing.T) {
+	index := func(i int) *int { return &i }
+	for _, c := range []struct {
+		name  string
+		opts  *NewSlideOptions
+		index int
+		err   error
+	}{
+		{name: "append", index: 1},
+		{name: "first", opts: &NewSlideOptions{Index: index(0)}, index: 0},
+		{name: "last", opts: &NewSlideOptions{Index: index(1)}, index: 1},
+		{name: "negative index", opts: &NewSlideOptions{Index: index(-1)}, err: ErrSlideIndex},
+		{name: "index out of range", opts: &NewSlideOptions{Index: index(2)}, err: ErrSlideIndex},
+		{name: "layout", opts: &NewSlideOptions{Layout: "default"}, index: 1},
+		{name: "layout type", opts: &NewSlideOptions{Layout: "Title", Master: index(0)}, index: 1},
+		{name: "first layout of master", opts: &NewSlideOptions{Master: index(0)}, index: 1},
+		{name: "layout not exist", opts: &NewSlideOptions{Layout: "Blank"}, err: ErrLayoutNotExist{"Blank"}},
+		{name: "master not exist", opts: &NewSlideOptions{Master: index(1)}, err: ErrSlideMasterNotExist{1}},
+	} {
+		f := NewFile()
+		slideID, err := f.NewSlide(c.opts)
+		if c.err != nil {
+			if !errors.Is(err, c.err) {
+				t.Errorf("%s: expected error %v, got %v", c.name, c.err, err)
+			}
+			if len(f.GetSlideList()) != 1 {
+				t.Errorf("%s: expected the slide isn't added on error", c.name)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%s: %v", c.name, err)
+		}
+		if idx, err := f.GetSlideIndex(slideID); err != nil || idx != c.index {
+			t.Errorf("%s: expected index %d, got %d %v", c.name, c.index, idx, err)
+		}
+		if c.opts != nil && (c.opts.Layout != "" || c.opts.Master != nil) {
+			if name, err := f.GetSlideLayoutName(slideID); err != nil || name != "Default" {
+				t.Errorf("%s: expected layout Default, got %q %v", c.name, name, err)
+			}
+		}
+	}
+}
+
+func TestNewSlideFileName(t *testing.T) {
+	f := NewFile()
+	first := f.GetSlideList()[0]
+	second, err := f.NewSlide()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = f.DeleteSlide(first); err != nil {
+		t.Fatal(err)
+	}
+	third, err := f.NewSlide()
+	if err != nil {
+		t.Fatal(err)
+	}
+	paths := map[string]int{}
+	for _, slideID := range []int{second, third} {
+		slideXMLPath, ok := f.getSlideXMLPath(slideID)
+		if !ok {
+			t.Fatalf("the slide %d doesn't exist", slideID)
+		}
+		if other, ok := paths[slideXMLPath]; ok {
+			t.Errorf("the slides %d and %d share the part %s", other, slideID, slideXMLPath)
+		}
+		paths[slideXMLPath] = slideID
+	}
+	if _, ok := paths["ppt/slides/slide3.xml"]; !ok {
+		t.Errorf("expected the part ppt/slides/slide3.xml, got %v", paths)
+	}
+}
+
+func TestActiveSlide(t *testing.T) {
+	f := NewFile()
+	for i := 0; i < 2; i++ {
+		if _, err := f.NewSlide(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := f.SetActiveSlide(3); !errors.Is(err, ErrSlideIndex) {
+		t.Errorf("expected ErrSlideIndex, got %v", err)
+	}
+	reopen := func() {
+		buf, err := f.WriteToBuffer()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if f, err = OpenReader(buf); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, index := range []int{2, 0, 1} {
+		if err := f.SetActiveSlide(index); err != nil {
+			t.Fatal(err)
+		}
+		reopen()
+		if active := f.GetActiveSlideIndex(); active != index {
+			t.Errorf("expected the active slide %d, got %d", index, active)
+		}
+		presentation, err := f.presentationReader()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if first := presentation.FirstSlideNum; (index == 0) != (first == nil) || (first != nil && *first != index+1) {
+			t.Errorf("expected the first slide number %d, got %v", index+1, first)
+		}
+	}
+
+	// Delete the active slide, the next slide is activated
+	slideIDs := f.GetSlideList()
+	if err := f.DeleteSlide(slideIDs[1]); err != nil {
+		t.Fatal(err)
+	}
+	reopen()
+	if active := f.GetActiveSlideIndex(); active != 1 {
+		t.Errorf("expected the active slide 1, got %d", active)
+	}
+	if err := f.DeleteSlide(f.GetSlideList()[0]); err != nil {
+		t.Fatal(err)
+	}
+	if active := f.GetActiveSlideIndex(); active != 0 {
+		t.Errorf("expected the active slide 0, got %d", active)
+	}
+}
+
+func TestRenumberSlideIDs(t *testing.T) {
+	f := NewFile()
+	for i := 0; i < 2; i++ {
+		if _, err := f.NewSlide(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := f.DeleteSlide(f.GetSlideList()[0]); err != nil {
+		t.Fatal(err)
+	}
+	if err := f.SetActiveSlide(1); err != nil {
+		t.Fatal(err)
+	}
+	buf, err := f.WriteToBuffer()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f, err = OpenReader(buf); err != nil {
+		t.Fatal(err)
+	}
+	oldIDs := f.GetSlideList()
+	ids, err := f.RenumberSlideIDs()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for idx, slideID := range f.GetSlideList() {
+		if slideID != defaultXMLSlideID+idx || ids[oldIDs[idx]] != slideID {
+			t.Errorf("expected the slide ID %d at %d, got %d", defaultXMLSlideID+idx, idx, slideID)
+		}
+	}
+	if active := f.GetActiveSlideIndex(); active != 1 {
+		t.Errorf("expected the active slide 1, got %d", active)
+	}
+	if buf, err = f.WriteToBuffer(); err != nil {
+		t.Fatal(err)
+	}
+	if f, err = OpenReader(buf); err != nil {
+		t.Fatal(err)
+	}
+	if active := f.GetActiveSlideIndex(); active != 1 {
+		t.Errorf("expected the active slide 1 after saving, got %d", active)
+	}
+}
+
+func TestDuplicateSlideTo(t *testing.T) {
+	const (
+		background = `<p:bg><p:bgPr><a:solidFill><a:srgbClr val="FF0000"/></a:solidFill><a:effectLst/></p:bgPr></p:bg>`
+		connector  = `<p:cxnSp><p:nvCxnSpPr><p:cNvPr id="9" name="Connector"/><p:cNvCxnSpPr/><p:nvPr/></p:nvCxnSpPr>` +
+			`<p:spPr><a:xfrm><a:off x="0" y="0"/><a:ext cx="914400" cy="0"/></a:xfrm><a:prstGeom prst="line"><a:avLst/></a:prstGeom></p:spPr></p:cxnSp>`
+	)
+	for _, index := range []int{0, 1, 2} {
+		f := NewFile()
+		if _, err := f.NewSlide(); err != nil {
+			t.Fatal(err)
+		}
+		slideID := f.GetSlideList()[0]
+		content, err := f.GetSlideXML(slideID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		content = bytes.Replace(content, []byte("<p:cSld>"), []byte("<p:cSld>"+background), 1)
+		content = bytes.Replace(content, []byte("</p:spTree>"), []byte(connector+"</p:spTree>"), 1)
+		if err = f.SetSlideXML(slideID, content); err != nil {
+			t.Fatal(err)
+		}
+		slideXMLPath, _ := f.getSlideXMLPath(slideID)
+		f.addRels(getPartRelsPath(slideXMLPath), SourceRelationshipNotesSlide, "../notesSlides/notesSlide1.xml", "")
+
+		newSlideID, err := f.DuplicateSlideTo(slideID, index)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if idx, _ := f.GetSlideIndex(newSlideID); idx != index {
+			t.Errorf("expected the duplicated slide at %d, got %d", index, idx)
+		}
+		duplicated, err := f.GetSlideXML(newSlideID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, element := range []string{background, connector} {
+			if !bytes.Contains(duplicated, []byte(element)) {
+				t.Errorf("expected the element %s in the duplicated slide, got %s", element, duplicated)
+			}
+		}
+		newSlideXMLPath, _ := f.getSlideXMLPath(newSlideID)
+		if _, ok := f.getRelTargetByType(newSlideXMLPath, SourceRelationshipNotesSlide); ok {
+			t.Error("the speaker notes are duplicated")
+		}
+		if _, ok := f.getRelTargetByType(newSlideXMLPath, SourceRelationshipSlideLayout); !ok {
+			t.Error("the duplicated slide has no layout")
+		}
+	}
+}
+
+func TestSetSlideShowMasterShapes(t *testing.T) {
+	f := NewFile()
+	slideID := f.GetSlideList()[0]
+	for _, c := range []struct {
+		show     bool
+		expected string
+	}{
+		{show: false, expected: `showMasterSp="false" showMasterPhAnim="false"`},
+		{show: true},
+	} {
+		if err := f.SetSlideShowMasterShapes(slideID, c.show); err != nil {
+			t.Fatal(err)
+		}
+		buf, err := f.WriteToBuffer()
+		if err != nil {
+			t.Fatal(err)
+		}
+		saved, err := OpenReader(buf)
+		if err != nil {
+			t.Fatal(err)
+		}
+		content := saved.readXML("ppt/slides/slide1.xml")
+		if hidden := bytes.Contains(content, []byte("showMasterSp")); c.expected == "" && hidden || c.expected != "" && !bytes.Contains(content, []byte(c.expected)) {
+			t.Errorf("expected %q in the slide, got %s", c.expected, content)
+		}
+		if show, err := saved.GetSlideShowMasterShapes(slideID); err != nil || show != c.show {
+			t.Errorf("expected the master shapes shown %t, got %t %v", c.show, show, err)
+		}
+	}
+	if err := f.SetSlideShowMasterShapes(300, false); !errors.Is(err, ErrSlideNotExist{300}) {
+		t.Errorf("expected ErrSlideNotExist, got %v", err)
+	}
+	if _, err := f.GetSlideShowMasterShapes(300); !errors.Is(err, ErrSlideNotExist{300}) {
+		t.Errorf("expected ErrSlideNotExist, got %v", err)
+	}
+}
+
+func TestSlideTitle(t *testing.T) {
+	f := NewFile()
+	slideID := f.GetSlideList()[0]
+	slide, err := f.slideReader(slideID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	bold := 1
+	slide.getTitleShape().TextBody = &DecodeTextBody{Paragraph: []DecodeParagraph{
+		{Runs: []DecodeRuns{{RunProperties: &DecodeRunProperties{Bold: &bold, Lang: "en-US"}, Text: "Draft"}}},
+	}}
+	if err = f.SetSlideTitle(slideID, "Quarterly\nReview"); err != nil {
+		t.Fatal(err)
+	}
+	buf, err := f.WriteToBuffer()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f, err = OpenReader(buf); err != nil {
+		t.Fatal(err)
+	}
+	if title, err := f.GetSlideTitle(slideID); err != nil || title != "Quarterly\nReview" {
+		t.Errorf("expected the title %q, got %q %v", "Quarterly\nReview", title, err)
+	}
+	if slide, err = f.slideReader(slideID); err != nil {
+		t.Fatal(err)
+	}
+	for _, p := range slide.getTitleShape().TextBody.Paragraph {
+		if rPr := p.Runs[0].RunProperties; rPr == nil || rPr.Bold == nil || *rPr.Bold != 1 || rPr.Lang != "en-US" {
+			t.Errorf("expected the formatting of the title is kept, got %+v", rPr)
+		}
+	}
+
+	slide.CommonSlideData.ShapeTree.Shape = slide.CommonSlideData.ShapeTree.Shape[1:]
+	if title, err := f.GetSlideTitle(slideID); err != nil || title != "" {
+		t.Errorf("expected the empty title, got %q %v", title, err)
+	}
+	for _, c := range []struct {
+		slideID  int
+		expected error
+	}{
+		{slideID: slideID, expected: ErrPlaceholderNotExist},
+		{slideID: 300, expected: ErrSlideNotExist{300}},
+	} {
+		if err = f.SetSlideTitle(c.slideID, "Title"); !errors.Is(err, c.expected) {
+			t.Errorf("expected %v, got %v", c.expected, err)
+		}
+	}
+	if _, err = f.GetSlideTitle(300); !errors.Is(err, ErrSlideNotExist{300}) {
+		t.Errorf("expected ErrSlideNotExist, got %v", err)
+	}
+}
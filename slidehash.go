@@ -0,0 +1,83 @@
+// Copyright 2026 kenny-not-dead. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+//
+// Package gopptx provides functionality to create and manipulate PowerPoint
+// (.pptx) files in Go, using the Office Open XML (ECMA-376) format.
+
+package gopptx
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"hash"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// SlideHash provides a function to get the stable content hash of the slide
+// by given slide ID, which is the hex encoded SHA-256 of the slide XML and
+// the relationships of the slide, including the content of the referenced
+// media parts, so the downstream caches can detect which slides changed
+// between the generations of the presentation. The XML is hashed in the
+// canonical form, the order of the attributes and the indentation between
+// the elements don't change the hash. For example:
+//
+//	sum, err := f.SlideHash(256)
+//	if err != nil {
+//	    fmt.Println(err)
+//	    return
+//	}
+//	if sum != cached[256] {
+//	    fmt.Println("slide 256 changed")
+//	}
+func (f *File) SlideHash(slideID int) (string, error) {
+	slide, err := f.slideNodeReader(slideID)
+	if err != nil {
+		return "", err
+	}
+	slideXMLPath, _ := f.getSlideXMLPath(slideID)
+	h := sha256.New()
+	writeCanonicalXML(h, slide)
+	rels := getRelationships(f.getRels(getPartRelsPath(slideXMLPath)))
+	sort.Slice(rels, func(i, j int) bool { return rels[i].ID < rels[j].ID })
+	for _, rel := range rels {
+		h.Write([]byte("\x00" + rel.ID + "\x00" + rel.Type + "\x00" + rel.TargetMode + "\x00"))
+		if rel.TargetMode == "External" {
+			h.Write([]byte(rel.Target))
+			continue
+		}
+		target := resolveRelTarget(slideXMLPath, rel.Target)
+		if !isMediaPart(target) {
+			h.Write([]byte(target))
+			continue
+		}
+		sum := sha256.Sum256(f.readPart(target))
+		h.Write(sum[:])
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// writeCanonicalXML writes the element in the canonical form into the hash,
+// the attributes are sorted by the names, and the whitespace between the
+// elements is skipped except for the text of the text runs.
+func writeCanonicalXML(h hash.Hash, n *xmlNode) {
+	h.Write([]byte("<" + n.Name))
+	attrs := slices.Clone(n.Attr)
+	sort.Slice(attrs, func(i, j int) bool { return attrs[i].Name.Local < attrs[j].Name.Local })
+	for _, attr := range attrs {
+		h.Write([]byte(" " + attr.Name.Local + "=" + attr.Value + "\x00"))
+	}
+	h.Write([]byte(">"))
+	for _, child := range n.Children {
+		if child.Name != "" {
+			writeCanonicalXML(h, child)
+			continue
+		}
+		if localName(n.Name) == "t" || strings.TrimSpace(child.Text) != "" {
+			h.Write([]byte(child.Text + "\x00"))
+		}
+	}
+	h.Write([]byte("</>"))
+}
@@ -0,0 +1,79 @@
+package gopptx
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+func TestSlideHash(t *testing.T) {
+	getHash := func(f *File, slideID int) string {
+		t.Helper()
+		sum, err := f.SlideHash(slideID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(sum) != 64 {
+			t.Errorf("expected the hex encoded SHA-256, got %s", sum)
+		}
+		return sum
+	}
+	f := NewFile()
+	slideID := f.GetSlideList()[0]
+	original := getHash(f, slideID)
+	buf, err := f.WriteToBuffer()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f, err = OpenReader(buf); err != nil {
+		t.Fatal(err)
+	}
+	if sum := getHash(f, slideID); sum != original {
+		t.Errorf("expected the stable hash %s after saving, got %s", original, sum)
+	}
+
+	var replaced bool
+	if f, err = openTestFile(t, func(name string, content []byte) []byte {
+		if name != defaultXMLPathSlide {
+			return content
+		}
+		canonical := content
+		content = bytes.Replace(content, []byte(`<p:cNvPr id="7" name="PlaceHolder 1">`), []byte(`<p:cNvPr name="PlaceHolder 1" id="7">`), 1)
+		content = bytes.ReplaceAll(content, []byte("><p:"), []byte(">\n  <p:"))
+		replaced = !bytes.Equal(content, canonical)
+		return content
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if sum := getHash(f, slideID); !replaced || sum != original {
+		t.Errorf("expected the hash %s is independent of the attribute order and the indentation, got %s", original, sum)
+	}
+
+	if _, err = f.AddPictureFromBytes(slideID, ".png", newTestPNG(t, 8, 8), nil); err != nil {
+		t.Fatal(err)
+	}
+	picture := getHash(f, slideID)
+	if picture == original {
+		t.Error("expected the hash changes on adding the picture")
+	}
+	slide, err := f.slideReader(slideID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	slideXMLPath, _ := f.getSlideXMLPath(slideID)
+	mediaPath, _ := f.getRelTarget(slideXMLPath, slide.CommonSlideData.ShapeTree.Picture[0].BlipFill.Blip.Embed)
+	f.Pkg.Store(mediaPath, newTestPNG(t, 8, 9))
+	if sum := getHash(f, slideID); sum == picture {
+		t.Error("expected the hash changes on changing the content of the image")
+	}
+	nextSlideID, err := f.NewSlide()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sum := getHash(f, nextSlideID); sum != original {
+		t.Errorf("expected the hash %s of the slide with the same content, got %s", original, sum)
+	}
+	if _, err = f.SlideHash(300); !errors.Is(err, ErrSlideNotExist{300}) {
+		t.Errorf("expected ErrSlideNotExist, got %v", err)
+	}
+}
@@ -0,0 +1,145 @@
+// Copyright 2026 kenny-not-dead. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+//
+// Package gopptx provides functionality to create and manipulate PowerPoint
+// (.pptx) files in Go, using the Office Open XML (ECMA-376) format.
+
+package gopptx
+
+import (
+	"archive/zip"
+	"encoding/xml"
+	"path"
+	"sort"
+	"strings"
+)
+
+// statsLargestParts is the number of the largest parts in the statistics.
+const statsLargestParts = 10
+
+// Stats directly maps the statistics of the presentation. The shapes count
+// excludes the pictures and the graphic frames, the words are counted in the
+// text of the shapes and the table cells. The media bytes is the total size of
+// the media parts, and the largest parts are the package parts in descending
+// order of the size, up to 10 parts.
+type Stats struct {
+	Slides       int
+	Shapes       int
+	Pictures     int
+	Tables       int
+	Charts       int
+	Words        int
+	MediaBytes   int64
+	LargestParts []PartSize
+}
+
+// PartSize directly maps the part name and the uncompressed size in bytes of
+// the package part.
+type PartSize struct {
+	Name string
+	Size int64
+}
+
+// Stats provides a function to get the statistics of the presentation, for
+// monitoring the size of the generated presentations. The part sizes are the
+// sizes as stored in the package, which don't include the unsaved changes of
+// the slides. For example:
+//
+//	stats, err := f.Stats()
+//	if err != nil {
+//	    fmt.Println(err)
+//	    return
+//	}
+//	fmt.Println(stats.Slides, stats.Words, stats.MediaBytes)
+func (f *File) Stats() (Stats, error) {
+	var stats Stats
+	for _, slideID := range f.GetSlideList() {
+		slide, err := f.slideReader(slideID)
+		if err != nil {
+			return stats, err
+		}
+		stats.Slides++
+		stats.Shapes += len(slide.getShapes())
+		stats.Pictures += len(slide.CommonSlideData.ShapeTree.Picture)
+		for _, shape := range slide.getShapes() {
+			stats.Words += len(strings.Fields(shape.TextBody.text()))
+		}
+		for _, gf := range slide.CommonSlideData.ShapeTree.GraphicFrame {
+			if gf.Graphic == nil || gf.Graphic.GraphicData == nil {
+				continue
+			}
+			switch gf.Graphic.GraphicData.URI {
+			case NameSpaceDrawingMLTable:
+				stats.Tables++
+				stats.Words += countTextWords(gf.Graphic.GraphicData.Content)
+			case NameSpaceDrawingMLChart.Value:
+				stats.Charts++
+			}
+		}
+	}
+	parts := f.getPartSizes()
+	for _, part := range parts {
+		if path.Dir(part.Name) == "ppt/media" {
+			stats.MediaBytes += part.Size
+		}
+	}
+	sort.SliceStable(parts, func(i, j int) bool { return parts[i].Size > parts[j].Size })
+	stats.LargestParts = parts[:min(len(parts), statsLargestParts)]
+	return stats, nil
+}
+
+// getPartSizes provides a function to get the sizes of the parts in the
+// package and the temporary files, in the order of the part names.
+func (f *File) getPartSizes() []PartSize {
+	sizes := map[string]int64{}
+	f.Pkg.Range(func(k, v interface{}) bool {
+		if content, ok := v.([]byte); ok {
+			sizes[k.(string)] = int64(len(content))
+		}
+		return true
+	})
+	f.tempFiles.Range(func(k, v interface{}) bool {
+		if _, ok := sizes[k.(string)]; ok {
+			return true
+		}
+		if zipFile, ok := v.(*zip.File); ok {
+			sizes[k.(string)] = int64(zipFile.UncompressedSize64)
+		} else if size, err := f.getTempFileSize(v.(string)); err == nil {
+			sizes[k.(string)] = size
+		}
+		return true
+	})
+	parts := make([]PartSize, 0, len(sizes))
+	for name, size := range sizes {
+		parts = append(parts, PartSize{Name: name, Size: size})
+	}
+	sort.Slice(parts, func(i, j int) bool { return parts[i].Name < parts[j].Name })
+	return parts
+}
+
+// countTextWords returns the number of the words in the text elements of the
+// DrawingML content.
+func countTextWords(content string) int {
+	var (
+		count  int
+		inText bool
+		d      = xml.NewDecoder(strings.NewReader(content))
+	)
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return count
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			inText = t.Name.Local == "t"
+		case xml.EndElement:
+			inText = false
+		case xml.CharData:
+			if inText {
+				count += len(strings.Fields(string(t)))
+			}
+		}
+	}
+}
@@ -0,0 +1,41 @@
+package gopptx
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestStats(t *testing.T) {
+	img := newTestPNG(t, 64, 64)
+	f := NewFile()
+	slideID := f.GetSlideList()[0]
+	addTestShape(t, f, slideID, "", "Hello big", "world")
+	if _, err := f.AddPictureFromBytes(slideID, ".png", img, nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := f.AddTableFromCSV(slideID, strings.NewReader("Region,Revenue\nNorth America,12\n"), nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := f.addChart(slideID, "pie", "", Offset{}, Extents{CX: 914400, CY: 914400}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := f.NewSlide(); err != nil {
+		t.Fatal(err)
+	}
+	stats, err := f.Stats()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stats.Slides != 2 || stats.Shapes != 5 || stats.Pictures != 1 || stats.Tables != 1 || stats.Charts != 1 ||
+		stats.Words != 8 || stats.MediaBytes != int64(len(img)) {
+		t.Errorf("unexpected statistics %+v", stats)
+	}
+	if len(stats.LargestParts) != statsLargestParts {
+		t.Fatalf("expected %d largest parts, got %d", statsLargestParts, len(stats.LargestParts))
+	}
+	for i := 1; i < len(stats.LargestParts); i++ {
+		if stats.LargestParts[i].Size > stats.LargestParts[i-1].Size {
+			t.Errorf("the largest parts are not in descending order of the size: %v", stats.LargestParts)
+		}
+	}
+}
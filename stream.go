@@ -10,7 +10,6 @@ package gopptx
 import (
 	"bytes"
 	"io"
-	"os"
 )
 
 type StreamWriter struct {
@@ -25,11 +24,44 @@ type StreamWriter struct {
 // bufferedWriter uses a temp file to store an extended buffer. Writes are
 // always made to an in-memory buffer, which will always succeed. The buffer
 // is written to the temp file with Sync, which may return an error.
-// Therefore, Sync should be periodically called and the error checked.
+// Therefore, Sync should be periodically called and the error checked. The
+// temp file is created, read and removed by the temporary file provider,
+// which is the file system if it isn't specified.
 type bufferedWriter struct {
-	tmpDir string
-	tmp    *os.File
-	buf    bytes.Buffer
+	tmpDir   string
+	provider TempFileProvider
+	tmp      TempFile
+	reader   io.ReadCloser
+	buf      bytes.Buffer
+}
+
+// tempFileProvider returns the provider of the temp file, which is the file
+// system if it isn't specified.
+func (bw *bufferedWriter) tempFileProvider() TempFileProvider {
+	if bw.provider != nil {
+		return bw.provider
+	}
+	return osTempFileProvider{}
+}
+
+// Write to the in-memory buffer. The error is always nil.
+func (bw *bufferedWriter) Write(p []byte) (n int, err error) {
+	return bw.buf.Write(p)
+}
+
+// Sync tries to write all data to the temp file if the in-memory buffer
+// exceeds the StreamChunkSize, the temp file is created by the temporary
+// file provider on the first time.
+func (bw *bufferedWriter) Sync() (err error) {
+	if bw.buf.Len() < StreamChunkSize {
+		return nil
+	}
+	if bw.tmp == nil {
+		if bw.tmp, err = bw.tempFileProvider().Create(bw.tmpDir, "gopptx-"); err != nil {
+			return err
+		}
+	}
+	return bw.Flush()
 }
 
 // Close the underlying temp file and reset the in-memory buffer.
@@ -38,7 +70,11 @@ func (bw *bufferedWriter) Close() error {
 	if bw.tmp == nil {
 		return nil
 	}
-	defer os.Remove(bw.tmp.Name())
+	if bw.reader != nil {
+		_ = bw.reader.Close()
+		bw.reader = nil
+	}
+	defer bw.tempFileProvider().Remove(bw.tmp.Name())
 	return bw.tmp.Close()
 }
 
@@ -50,12 +86,12 @@ func (bw *bufferedWriter) Reader() (io.Reader, error) {
 	if err := bw.Flush(); err != nil {
 		return nil, err
 	}
-	fi, err := bw.tmp.Stat()
-	if err != nil {
-		return nil, err
+	if bw.reader != nil {
+		_ = bw.reader.Close()
 	}
-	// os.File.ReadAt does not affect the cursor position and is safe to use here
-	return io.NewSectionReader(bw.tmp, 0, fi.Size()), nil
+	var err error
+	bw.reader, err = bw.tempFileProvider().Open(bw.tmp.Name())
+	return bw.reader, err
 }
 
 // Flush the entire in-memory buffer to the temp file, if a temp file is being
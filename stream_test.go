@@ -0,0 +1,91 @@
+package gopptx
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"strconv"
+	"testing"
+)
+
+// memTempFileProvider keeps the scratch files in the memory.
+type memTempFileProvider struct {
+	files map[string]*memTempFile
+}
+
+// memTempFile is the scratch file kept in the memory.
+type memTempFile struct {
+	bytes.Buffer
+	name string
+}
+
+func (f *memTempFile) Name() string { return f.name }
+
+func (f *memTempFile) Close() error { return nil }
+
+func (p *memTempFileProvider) Create(dir, pattern string) (TempFile, error) {
+	file := &memTempFile{name: pattern + strconv.Itoa(len(p.files))}
+	p.files[file.name] = file
+	return file, nil
+}
+
+func (p *memTempFileProvider) Open(name string) (io.ReadCloser, error) {
+	file, ok := p.files[name]
+	if !ok {
+		return nil, os.ErrNotExist
+	}
+	return io.NopCloser(bytes.NewReader(file.Bytes())), nil
+}
+
+func (p *memTempFileProvider) Remove(name string) error {
+	delete(p.files, name)
+	return nil
+}
+
+func TestBufferedWriter(t *testing.T) {
+	data := bytes.Repeat([]byte("a"), StreamChunkSize)
+	for _, c := range []struct {
+		name     string
+		provider *memTempFileProvider
+		data     []byte
+		temp     bool
+	}{
+		{name: "in-memory buffer", data: []byte("data")},
+		{name: "default provider", data: data, temp: true},
+		{name: "custom provider", provider: &memTempFileProvider{files: map[string]*memTempFile{}}, data: data, temp: true},
+	} {
+		var bw bufferedWriter
+		if c.provider != nil {
+			bw.provider = c.provider
+		}
+		if _, err := bw.Write(c.data); err != nil {
+			t.Fatal(err)
+		}
+		if err := bw.Sync(); err != nil {
+			t.Fatalf("%s: %v", c.name, err)
+		}
+		if (bw.tmp != nil) != c.temp {
+			t.Errorf("%s: expected the temp file %v", c.name, c.temp)
+		}
+		if _, err := bw.Write([]byte("end")); err != nil {
+			t.Fatal(err)
+		}
+		r, err := bw.Reader()
+		if err != nil {
+			t.Fatalf("%s: %v", c.name, err)
+		}
+		content, err := io.ReadAll(r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(content, append(c.data, "end"...)) {
+			t.Errorf("%s: the content of the buffer is changed", c.name)
+		}
+		if err = bw.Close(); err != nil {
+			t.Errorf("%s: %v", c.name, err)
+		}
+		if c.provider != nil && len(c.provider.files) != 0 {
+			t.Errorf("%s: the temp file isn't removed", c.name)
+		}
+	}
+}
@@ -0,0 +1,158 @@
+// Copyright 2026 kenny-not-dead. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+//
+// Package gopptx provides functionality to create and manipulate PowerPoint
+// (.pptx) files in Go, using the Office Open XML (ECMA-376) format.
+
+package gopptx
+
+import (
+	"path"
+	"strings"
+)
+
+// StripMedia provides a function to remove or replace the media parts of the
+// presentation by given predicate function, which receives the media file
+// and its size in bytes, and returns the replacement content and whether to
+// strip the media, so the lightweight versions of the heavy presentations
+// can be produced for sending by email. The content of the media part is
+// replaced if the replacement content is not nil, such as a smaller image of
+// the same format. Otherwise the media is removed: the videos and the audios
+// are removed from the pictures, which keep their poster frame images, the
+// pictures showing the removed images are deleted, and the other fills with
+// the removed images are replaced with no fill. It returns the number of the
+// stripped media parts. For example, remove the videos and the media larger
+// than 5 MB:
+//
+//	count, err := f.StripMedia(func(file gopptx.MediaFile, size int64) ([]byte, bool) {
+//	    return nil, size > 5<<20 || strings.HasSuffix(file.Name, ".mp4")
+//	})
+func (f *File) StripMedia(predicate func(file MediaFile, size int64) ([]byte, bool)) (int, error) {
+	if err := f.checkReadOnly(); err != nil {
+		return 0, err
+	}
+	sizes := map[string]int64{}
+	for _, part := range f.getPartSizes() {
+		sizes[part.Name] = part.Size
+	}
+	var count int
+	refs, removed := f.getMediaSlideIDs(), map[string]bool{}
+	for _, name := range f.getMediaPartNames() {
+		replacement, strip := predicate(MediaFile{Name: path.Base(name), Path: name, SlideIDs: refs[name]}, sizes[name])
+		if !strip {
+			continue
+		}
+		if count++; replacement != nil {
+			f.deleteTempFile(name)
+			f.Pkg.Store(name, replacement)
+			continue
+		}
+		removed[name] = true
+	}
+	if len(removed) == 0 {
+		return count, nil
+	}
+	for _, relPath := range f.getPartNames() {
+		if !strings.HasSuffix(relPath, ".rels") {
+			continue
+		}
+		source := strings.TrimSuffix(strings.Replace(relPath, "_rels/", "", 1), ".rels")
+		rIDs := map[string]bool{}
+		for _, rel := range getRelationships(f.getRels(relPath)) {
+			if rel.TargetMode != "External" && removed[resolveRelTarget(source, rel.Target)] {
+				rIDs[rel.ID] = true
+			}
+		}
+		if len(rIDs) == 0 {
+			continue
+		}
+		if err := f.stripPartMedia(source, rIDs); err != nil {
+			return count, err
+		}
+		for rID := range rIDs {
+			f.deleteRels(relPath, rID)
+		}
+	}
+	for name := range removed {
+		if !f.isPartReferenced(name) {
+			f.Pkg.Delete(name)
+			f.deleteTempFile(name)
+		}
+	}
+	return count, nil
+}
+
+// stripPartMedia provides a function to remove the references to the
+// removed media from the part by given part name and the relationship IDs of
+// the removed media.
+func (f *File) stripPartMedia(partName string, rIDs map[string]bool) error {
+	content := f.readBytes(partName)
+	if slide, ok := f.Slide.Load(partName); ok && slide != nil {
+		content = marshalSlide(slide.(*decodeSlide))
+	}
+	if len(content) == 0 {
+		return nil
+	}
+	root, err := f.xmlNodeReader(content)
+	if err != nil {
+		return err
+	}
+	stripMediaReferences(root, rIDs)
+	f.Slide.Delete(partName)
+	f.xmlAttr.Delete(partName)
+	f.deleteTempFile(partName)
+	f.saveFileList(partName, root.bytes())
+	return nil
+}
+
+// stripMediaReferences removes the elements referring to the removed media
+// from the children of the element by given element and the relationship IDs
+// of the removed media. The picture elements are removed with their images,
+// the extensions with their media, and the image fills outside of the
+// pictures are replaced with no fill.
+func stripMediaReferences(n *xmlNode, rIDs map[string]bool) {
+	refers := func(node *xmlNode) bool {
+		if node == nil {
+			return false
+		}
+		for _, attr := range node.Attr {
+			if name := attr.Name.Local; strings.Contains(name, ":") && rIDs[attr.Value] &&
+				(localName(name) == "embed" || localName(name) == "link" || localName(name) == "id") {
+				return true
+			}
+		}
+		return false
+	}
+	children := n.Children[:0]
+	for _, child := range n.Children {
+		if child.Name == "" {
+			children = append(children, child)
+			continue
+		}
+		switch localName(child.Name) {
+		case "pic":
+			if refers(child.find("blipFill", "blip")) {
+				continue
+			}
+		case "blipFill":
+			if refers(child.find("blip")) {
+				children = append(children, newXMLNode(strings.TrimSuffix(child.Name, "blipFill")+"noFill"))
+				continue
+			}
+		case "buBlip", "ext":
+			if refers(child.find("blip")) || refers(child.find("media")) {
+				continue
+			}
+		case "blip", "videoFile", "audioFile", "quickTimeFile", "wavAudioFile":
+			if refers(child) {
+				continue
+			}
+		}
+		if stripMediaReferences(child, rIDs); localName(child.Name) == "extLst" && child.find("ext") == nil {
+			continue
+		}
+		children = append(children, child)
+	}
+	n.Children = children
+}
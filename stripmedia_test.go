@@ -0,0 +1,97 @@
+package gopptx
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestStripMedia(t *testing.T) {
+	const (
+		video = `<p:pic><p:nvPicPr><p:cNvPr id="10" name="Video 9"/><p:cNvPicPr/><p:nvPr><a:videoFile r:link="rId91"/>` +
+			`<p:extLst><p:ext uri="{DAA4B4D4-6D71-4841-9C94-3DE7FCFB9230}"><p14:media xmlns:p14="http://schemas.microsoft.com/office/powerpoint/2010/main" r:embed="rId92"/></p:ext></p:extLst></p:nvPr></p:nvPicPr>` +
+			`<p:blipFill><a:blip r:embed="rId93"/><a:stretch><a:fillRect/></a:stretch></p:blipFill><p:spPr><a:xfrm><a:off x="0" y="0"/><a:ext cx="914400" cy="914400"/></a:xfrm><a:prstGeom prst="rect"><a:avLst/></a:prstGeom></p:spPr></p:pic>`
+		filled = `<p:sp><p:nvSpPr><p:cNvPr id="11" name="Filled 10"/><p:cNvSpPr/><p:nvPr/></p:nvSpPr>` +
+			`<p:spPr><a:xfrm><a:off x="0" y="0"/><a:ext cx="914400" cy="914400"/></a:xfrm><a:prstGeom prst="rect"><a:avLst/></a:prstGeom>` +
+			`<a:blipFill><a:blip r:embed="rId94"/><a:stretch><a:fillRect/></a:stretch></a:blipFill></p:spPr></p:sp>`
+	)
+	f, err := openTestFile(t, func(name string, content []byte) []byte {
+		switch name {
+		case defaultXMLPathSlide:
+			return bytes.Replace(content, []byte("</p:spTree>"), []byte(video+filled+"</p:spTree>"), 1)
+		case getPartRelsPath(defaultXMLPathSlide):
+			return bytes.Replace(content, []byte("</Relationships>"), []byte(
+				`<Relationship Id="rId91" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/video" Target="../media/media1.mp4"/>`+
+					`<Relationship Id="rId92" Type="http://schemas.microsoft.com/office/2007/relationships/media" Target="../media/media1.mp4"/>`+
+					`<Relationship Id="rId93" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/image" Target="../media/image1.png"/>`+
+					`<Relationship Id="rId94" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/image" Target="../media/image2.png"/>`+
+					`</Relationships>`), 1)
+		case defaultXMLPathContentTypes:
+			return bytes.Replace(content, []byte("</Types>"), []byte(
+				`<Default Extension="mp4" ContentType="video/mp4"/><Default Extension="png" ContentType="image/png"/></Types>`), 1)
+		}
+		return content
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, content := range map[string][]byte{
+		"ppt/media/media1.mp4": bytes.Repeat([]byte("video"), 1024),
+		"ppt/media/image1.png": newTestPNG(t, 8, 8),
+		"ppt/media/image2.png": newTestPNG(t, 16, 16),
+	} {
+		f.Pkg.Store(name, content)
+	}
+	slideID := f.GetSlideList()[0]
+	if _, err = f.AddPictureFromBytes(slideID, ".png", newTestPNG(t, 64, 64), nil); err != nil {
+		t.Fatal(err)
+	}
+	small := newTestPNG(t, 4, 4)
+	sizes := map[string]int64{}
+	count, err := f.StripMedia(func(file MediaFile, size int64) ([]byte, bool) {
+		sizes[file.Name] = size
+		switch file.Name {
+		case "media1.mp4", "image2.png":
+			return nil, true
+		case "image3.png":
+			return small, true
+		}
+		return nil, false
+	})
+	if err != nil || count != 3 {
+		t.Fatalf("expected 3 stripped media, got %d %v", count, err)
+	}
+	if sizes["media1.mp4"] != 5120 || len(sizes) != 4 {
+		t.Errorf("expected the sizes of the media, got %v", sizes)
+	}
+	buf, err := f.WriteToBuffer()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f, err = OpenReader(buf); err != nil {
+		t.Fatal(err)
+	}
+	var media []string
+	for _, name := range f.getPartNames() {
+		if strings.HasPrefix(name, "ppt/media/") {
+			media = append(media, name)
+		}
+	}
+	if strings.Join(media, " ") != "ppt/media/image1.png ppt/media/image3.png" || !bytes.Equal(f.readBytes("ppt/media/image3.png"), small) {
+		t.Errorf("expected the poster frame and the replaced image, got %v", media)
+	}
+	content := string(f.readXML(defaultXMLPathSlide))
+	for _, element := range []string{"videoFile", "p14:media", "rId91", "rId92", "rId94"} {
+		if strings.Contains(content, element) {
+			t.Errorf("expected the reference %s to the removed media is removed, got %s", element, content)
+		}
+	}
+	for _, element := range []string{`name="Video 9"`, `<a:blip r:embed="rId93"`, `name="Filled 10"`, "<a:noFill"} {
+		if !strings.Contains(content, element) {
+			t.Errorf("expected %s is kept, got %s", element, content)
+		}
+	}
+	if err = f.Validate(); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,648 @@
+// Copyright 2026 kenny-not-dead. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+//
+// Package gopptx provides functionality to create and manipulate PowerPoint
+// (.pptx) files in Go, using the Office Open XML (ECMA-376) format.
+
+package gopptx
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+// EffectiveShapeStyle directly maps the resolved formatting of the shape. The
+// fill type is one of "none", "solid", "gradient", "pattern", "picture" and
+// "group", the fill color is the hex RGB color of the solid fill, the first
+// gradient stop or the pattern foreground. The line type is "none" or the
+// fill type of the outline, and the line width is specified in EMUs. The font
+// is the resolved font of the first text run of the shape.
+type EffectiveShapeStyle struct {
+	FillType  string
+	FillColor string
+	LineType  string
+	LineColor string
+	LineWidth int
+	Font      EffectiveFont
+}
+
+// EffectiveFont directly maps the resolved font of the text. The family is
+// the Latin typeface with the theme fonts resolved, the size is specified in
+// points and the color is the hex RGB color.
+type EffectiveFont struct {
+	Family string
+	Size   float64
+	Color  string
+	Bold   bool
+	Italic bool
+}
+
+// styleResolver defines the inheritance chain for resolving the formatting of
+// a slide shape. The shapes are the slide shape followed by the matching
+// placeholders of the layout and the master.
+type styleResolver struct {
+	shapes    []*xmlNode
+	textStyle *xmlNode
+	theme     *xmlNode
+	colorMap  map[string]string
+	title     bool
+}
+
+// GetEffectiveShapeStyle provides a function to get the resolved fill, line
+// and font of the shape by given slide id and shape name. The properties are
+// inherited from the shape to the placeholders of the slide layout and the
+// slide master, then the shape style and the theme, the theme colors and
+// fonts are resolved to the actual values. For example:
+//
+//	style, err := f.GetEffectiveShapeStyle(256, "Title 1")
+//	if err != nil {
+//	    fmt.Println(err)
+//	    return
+//	}
+//	fmt.Println(style.FillColor, style.Font.Family, style.Font.Size)
+func (f *File) GetEffectiveShapeStyle(slideID int, shapeName string) (EffectiveShapeStyle, error) {
+	var style EffectiveShapeStyle
+	r, err := f.newStyleResolver(slideID, shapeName)
+	if err != nil {
+		return style, err
+	}
+	style.FillType, style.FillColor = r.shapeFill()
+	style.LineType, style.LineColor, style.LineWidth = r.shapeLine()
+	paragraph := r.shapes[0].find("txBody", "p")
+	style.Font = r.font(paragraph.find("r", "rPr"), getParagraphLevel(paragraph))
+	return style, nil
+}
+
+// GetEffectiveRunProperties provides a function to get the resolved font of
+// the text run by given slide id, shape name, zero-based paragraph index and
+// zero-based run index in the paragraph, the text fields are counted as runs.
+// The properties are inherited from the run to the list styles of the
+// shape, the placeholders of the slide layout and the slide master at the
+// outline level of the paragraph, then the text styles of the slide master,
+// the shape style and the theme fonts. For example, get the font of the
+// first run in the second paragraph:
+//
+//	font, err := f.GetEffectiveRunProperties(256, "Content Placeholder 2", 1, 0)
+//	if err != nil {
+//	    fmt.Println(err)
+//	    return
+//	}
+//	fmt.Println(font.Family, font.Size, font.Color, font.Bold, font.Italic)
+func (f *File) GetEffectiveRunProperties(slideID int, shapeName string, paragraphIdx, runIdx int) (EffectiveFont, error) {
+	r, err := f.newStyleResolver(slideID, shapeName)
+	if err != nil {
+		return EffectiveFont{}, err
+	}
+	paragraphs := r.shapes[0].find("txBody").findAll("p")
+	if paragraphIdx < 0 || paragraphIdx >= len(paragraphs) {
+		return EffectiveFont{}, ErrTextRunNotExist
+	}
+	var runs []*xmlNode
+	for _, child := range paragraphs[paragraphIdx].Children {
+		if name := localName(child.Name); name == "r" || name == "fld" {
+			runs = append(runs, child)
+		}
+	}
+	if runIdx < 0 || runIdx >= len(runs) {
+		return EffectiveFont{}, ErrTextRunNotExist
+	}
+	return r.font(runs[runIdx].find("rPr"), getParagraphLevel(paragraphs[paragraphIdx])), nil
+}
+
+// This section defines the indexes of the styles in the style matrix of the
+// theme, which are referenced by the line, fill and effect references of the
+// shape style.
+const (
+	ShapeStyleNone = iota
+	ShapeStyleSubtle
+	ShapeStyleModerate
+	ShapeStyleIntense
+)
+
+// NewShapeStyle provides a function to create the shape style which
+// references the line, fill and effect styles of the theme by given index in
+// the style matrix and the theme color such as "accent1". The outline uses the
+// darker shade of the color and the text uses the minor font of the theme with
+// the light color of the color scheme. For example, create the rectangle with
+// the moderate style of the second accent color:
+//
+//	shapeID, err := f.CreateShape(256, gopptx.DecodeShapeProperties{
+//	    Xfrm: &gopptx.DecodeXfrm{
+//	        Offset:  &gopptx.Offset{X: 914400, Y: 914400},
+//	        Extents: &gopptx.Extents{CX: 2743200, CY: 1371600},
+//	    },
+//	    PresetGeometry: &gopptx.DecodePresetGeometry{Preset: "rect", AdjustValueList: &gopptx.AdjustValueList{}},
+//	}, gopptx.DecodeTextBody{BodyProperties: &gopptx.DecodeBodyProperties{}})
+//	if err != nil {
+//	    fmt.Println(err)
+//	    return
+//	}
+//	err = f.SetShapeStyle(256, shapeID, gopptx.NewShapeStyle(gopptx.ShapeStyleModerate, "accent2"))
+func NewShapeStyle(idx int, color string) *DecodeShapeStyle {
+	return &DecodeShapeStyle{
+		LineReference: &DecodeStyleMatrixReference{
+			Index:       idx,
+			SchemeColor: &DecodeSchemeColor{Val: color, Shade: &ColorModifier{Val: 50000}},
+		},
+		FillReference:   &DecodeStyleMatrixReference{Index: idx, SchemeColor: &DecodeSchemeColor{Val: color}},
+		EffectReference: &DecodeStyleMatrixReference{Index: idx, SchemeColor: &DecodeSchemeColor{Val: color}},
+		FontReference:   &DecodeFontReference{Index: "minor", SchemeColor: &DecodeSchemeColor{Val: "lt1"}},
+	}
+}
+
+// SetShapeStyle provides a function to set the style of the shape by given
+// slide id, shape id and the shape style, which references the styles of the
+// theme. The style of the shape will be removed if the style is nil.
+func (f *File) SetShapeStyle(slideID, shapeID int, style *DecodeShapeStyle) error {
+	if err := f.checkReadOnly(); err != nil {
+		return err
+	}
+	slide, err := f.slideReader(slideID)
+	if err != nil {
+		return err
+	}
+	if style != nil {
+		if idx := style.FontReference; idx != nil && idx.Index != "major" && idx.Index != "minor" && idx.Index != "none" {
+			return ErrShapeStyleInvalid
+		}
+		for _, ref := range []*DecodeStyleMatrixReference{style.LineReference, style.FillReference, style.EffectReference} {
+			if ref != nil && ref.Index < 0 {
+				return ErrShapeStyleInvalid
+			}
+		}
+	}
+	shapes := slide.CommonSlideData.ShapeTree.Shape
+	for i := range shapes {
+		if shapes[i].NonVisualShapeProperties != nil && shapes[i].NonVisualShapeProperties.CommonNonVisualProperties != nil &&
+			shapes[i].NonVisualShapeProperties.CommonNonVisualProperties.ID == shapeID {
+			shapes[i].Style = style
+			return nil
+		}
+	}
+	return ErrShapeNotExist{shapeID}
+}
+
+// slideNodeReader provides a function to get the element tree of the slide by
+// given slide id, the unsaved changes of the slide are included.
+func (f *File) slideNodeReader(slideID int) (*xmlNode, error) {
+	slideXMLPath, ok := f.getSlideXMLPath(slideID)
+	if !ok {
+		return nil, ErrSlideNotExist{slideID}
+	}
+	if slide, ok := f.Slide.Load(slideXMLPath); ok && slide != nil {
+		return f.xmlNodeReader(marshalSlide(slide.(*decodeSlide)))
+	}
+	return f.xmlNodeReader(f.readBytes(slideXMLPath))
+}
+
+// newStyleResolver provides a function to create the style resolver of the
+// shape by given slide id and shape name.
+func (f *File) newStyleResolver(slideID int, shapeName string) (*styleResolver, error) {
+	slide, err := f.slideNodeReader(slideID)
+	if err != nil {
+		return nil, err
+	}
+	shape := findShapeNode(slide.find("cSld", "spTree"), shapeName)
+	if shape == nil {
+		return nil, ErrShapeNameNotExist{SlideID: slideID, Name: shapeName}
+	}
+	return f.newShapeStyleResolver(slideID, slide, shape)
+}
+
+// newShapeStyleResolver provides a function to create the style resolver by
+// given slide id, slide element and shape element of the slide.
+func (f *File) newShapeStyleResolver(slideID int, slide, shape *xmlNode) (*styleResolver, error) {
+	var err error
+	r := &styleResolver{shapes: []*xmlNode{shape}}
+	slideXMLPath, _ := f.getSlideXMLPath(slideID)
+	layoutXMLPath, _ := f.getRelTargetByType(slideXMLPath, SourceRelationshipSlideLayout)
+	masterXMLPath, _ := f.getRelTargetByType(layoutXMLPath, SourceRelationshipSlideMaster)
+	themeXMLPath, ok := f.getRelTargetByType(masterXMLPath, SourceRelationshipTheme)
+	if !ok {
+		themeXMLPath = defaultXMLPathTheme
+	}
+	var layout, master *xmlNode
+	if layoutXMLPath != "" {
+		if layout, err = f.xmlNodeReader(f.readXML(layoutXMLPath)); err != nil {
+			return nil, err
+		}
+	}
+	if masterXMLPath != "" {
+		if master, err = f.xmlNodeReader(f.readXML(masterXMLPath)); err != nil {
+			return nil, err
+		}
+	}
+	if content := f.readXML(themeXMLPath); len(content) > 0 {
+		if r.theme, err = f.xmlNodeReader(content); err != nil {
+			return nil, err
+		}
+	}
+	r.colorMap = getColorMap(master, layout, slide)
+	phType, idx, isPlaceholder := getPlaceholderNode(shape)
+	if !isPlaceholder {
+		if content := f.readXML(defaultXMLPathPresentation); len(content) > 0 {
+			if presentation, err := f.xmlNodeReader(content); err == nil {
+				r.textStyle = presentation.find("defaultTextStyle")
+			}
+		}
+		if r.textStyle == nil {
+			r.textStyle = master.find("txStyles", "otherStyle")
+		}
+		return r, nil
+	}
+	r.title = phType == "title" || phType == "ctrTitle"
+	if r.title {
+		r.textStyle = master.find("txStyles", "titleStyle")
+	} else {
+		r.textStyle = master.find("txStyles", "bodyStyle")
+	}
+	if ph := findPlaceholderNode(layout, phType, idx); ph != nil {
+		r.shapes = append(r.shapes, ph)
+		phType, _, _ = getPlaceholderNode(ph)
+	}
+	switch phType {
+	case "title", "ctrTitle":
+		phType = "title"
+	case "dt", "ftr", "sldNum":
+	default:
+		phType = "body"
+	}
+	if ph := findPlaceholderNode(master, phType, ""); ph != nil {
+		r.shapes = append(r.shapes, ph)
+	}
+	return r, nil
+}
+
+// findShapeNode returns the shape element by given shape tree element and
+// shape name, the shapes in the groups are included.
+func findShapeNode(tree *xmlNode, name string) *xmlNode {
+	if tree == nil {
+		return nil
+	}
+	for _, child := range tree.Children {
+		switch localName(child.Name) {
+		case "sp":
+			if child.find("nvSpPr", "cNvPr").attr("name") == name {
+				return child
+			}
+		case "grpSp":
+			if shape := findShapeNode(child, name); shape != nil {
+				return shape
+			}
+		}
+	}
+	return nil
+}
+
+// getPlaceholderNode returns the placeholder type and index of the shape
+// element, the type defaults to "obj".
+func getPlaceholderNode(shape *xmlNode) (string, string, bool) {
+	ph := shape.find("nvSpPr", "nvPr", "ph")
+	if ph == nil {
+		return "", "", false
+	}
+	phType := ph.attr("type")
+	if phType == "" {
+		phType = "obj"
+	}
+	return phType, ph.attr("idx"), true
+}
+
+// findPlaceholderNode returns the placeholder shape element of the layout or
+// master by given placeholder type and index. The placeholder with the same
+// index is preferred, then the same type.
+func findPlaceholderNode(root *xmlNode, phType, idx string) *xmlNode {
+	var byType *xmlNode
+	for _, shape := range root.find("cSld", "spTree").findAll("sp") {
+		t, i, ok := getPlaceholderNode(shape)
+		if !ok {
+			continue
+		}
+		if idx != "" && i == idx {
+			return shape
+		}
+		if t == phType && byType == nil {
+			byType = shape
+		}
+	}
+	return byType
+}
+
+// getParagraphLevel returns the zero-based outline level of the paragraph
+// element.
+func getParagraphLevel(paragraph *xmlNode) int {
+	level, _ := strconv.Atoi(paragraph.find("pPr").attr("lvl"))
+	return min(max(level, 0), 8)
+}
+
+// shapeFill returns the resolved fill type and color of the shape.
+func (r *styleResolver) shapeFill() (string, string) {
+	for _, shape := range r.shapes {
+		if fillType, clr, ok := r.fill(shape.find("spPr"), ""); ok {
+			return fillType, clr
+		}
+	}
+	if ref := r.shapes[0].find("style", "fillRef"); ref != nil {
+		idx, _ := strconv.Atoi(ref.attr("idx"))
+		list, refColor := "fillStyleLst", r.color(ref, "")
+		if idx > 1000 {
+			list, idx = "bgFillStyleLst", idx-1000
+		}
+		if styles := r.theme.find("themeElements", "fmtScheme", list); styles != nil && idx > 0 {
+			fills := elementChildren(styles)
+			if idx <= len(fills) {
+				if fillType, clr, ok := r.fill(&xmlNode{Children: fills[idx-1 : idx]}, refColor); ok {
+					return fillType, clr
+				}
+			}
+		}
+	}
+	return "none", ""
+}
+
+// shapeLine returns the resolved line type, color and width of the shape.
+func (r *styleResolver) shapeLine() (string, string, int) {
+	lineType, clr, width := "", "", -1
+	for _, shape := range r.shapes {
+		ln := shape.find("spPr", "ln")
+		if w, err := strconv.Atoi(ln.attr("w")); err == nil && width == -1 {
+			width = w
+		}
+		if lineType == "" {
+			lineType, clr, _ = r.fill(ln, "")
+		}
+	}
+	if ref := r.shapes[0].find("style", "lnRef"); ref != nil {
+		idx, _ := strconv.Atoi(ref.attr("idx"))
+		lines := r.theme.find("themeElements", "fmtScheme", "lnStyleLst").findAll("ln")
+		if idx > 0 && idx <= len(lines) {
+			if w, err := strconv.Atoi(lines[idx-1].attr("w")); err == nil && width == -1 {
+				width = w
+			}
+			if lineType == "" {
+				lineType, clr, _ = r.fill(lines[idx-1], r.color(ref, ""))
+			}
+		}
+	}
+	if lineType == "" || lineType == "none" {
+		return "none", "", 0
+	}
+	return lineType, clr, max(width, 0)
+}
+
+// fill returns the fill type and color of the fill in the properties element
+// by given placeholder color of the theme styles.
+func (r *styleResolver) fill(props *xmlNode, phClr string) (string, string, bool) {
+	if props == nil {
+		return "", "", false
+	}
+	for _, child := range props.Children {
+		switch localName(child.Name) {
+		case "noFill":
+			return "none", "", true
+		case "solidFill":
+			return "solid", r.color(child, phClr), true
+		case "gradFill":
+			return "gradient", r.color(child.find("gsLst", "gs"), phClr), true
+		case "pattFill":
+			return "pattern", r.color(child.find("fgClr"), phClr), true
+		case "blipFill":
+			return "picture", "", true
+		case "grpFill":
+			return "group", "", true
+		}
+	}
+	return "", "", false
+}
+
+// font returns the resolved font by given run properties element and the
+// zero-based outline level of the paragraph.
+func (r *styleResolver) font(rPr *xmlNode, level int) EffectiveFont {
+	props := []*xmlNode{rPr}
+	lvl := "lvl" + strconv.Itoa(level+1) + "pPr"
+	for _, shape := range r.shapes {
+		props = append(props, shape.find("txBody", "lstStyle", lvl, "defRPr"))
+	}
+	props = append(props, r.textStyle.find(lvl, "defRPr"))
+	var (
+		font               EffectiveFont
+		family, clr        string
+		size, bold, italic string
+	)
+	for _, p := range props {
+		if p == nil {
+			continue
+		}
+		if family == "" {
+			family = p.find("latin").attr("typeface")
+		}
+		if clr == "" {
+			if _, c, ok := r.fill(p, ""); ok {
+				clr = c
+			}
+		}
+		if size == "" {
+			size = p.attr("sz")
+		}
+		if bold == "" {
+			bold = p.attr("b")
+		}
+		if italic == "" {
+			italic = p.attr("i")
+		}
+	}
+	if ref := r.shapes[0].find("style", "fontRef"); ref != nil {
+		if family == "" && ref.attr("idx") == "major" {
+			family = "+mj-lt"
+		}
+		if family == "" && ref.attr("idx") == "minor" {
+			family = "+mn-lt"
+		}
+		if clr == "" {
+			clr = r.color(ref, "")
+		}
+	}
+	if family == "" {
+		family = "+mn-lt"
+		if r.title {
+			family = "+mj-lt"
+		}
+	}
+	if clr == "" {
+		clr = r.schemeColor("tx1")
+	}
+	font.Family, font.Color = r.themeFont(family), clr
+	font.Size = float64(defaultFontSize) / 100
+	if sz, err := strconv.Atoi(size); err == nil {
+		font.Size = float64(sz) / 100
+	}
+	font.Bold, font.Italic = bold == "1" || bold == "true", italic == "1" || italic == "true"
+	return font
+}
+
+// themeFont returns the typeface by given font name, the theme font names
+// such as "+mj-lt" and "+mn-ea" are resolved by the font scheme of the theme.
+func (r *styleResolver) themeFont(name string) string {
+	if !strings.HasPrefix(name, "+") || len(name) != 6 {
+		return name
+	}
+	collection := map[string]string{"mj": "majorFont", "mn": "minorFont"}[name[1:3]]
+	script := map[string]string{"lt": "latin", "ea": "ea", "cs": "cs"}[name[4:]]
+	if typeface := r.theme.find("themeElements", "fontScheme", collection, script).attr("typeface"); typeface != "" {
+		return typeface
+	}
+	return name
+}
+
+// color returns the hex RGB color of the first color element in the given
+// element with the color transforms applied, the placeholder color is used
+// for the "phClr" scheme color.
+func (r *styleResolver) color(node *xmlNode, phClr string) string {
+	if node == nil {
+		return ""
+	}
+	for _, child := range node.Children {
+		var clr string
+		switch localName(child.Name) {
+		case "srgbClr":
+			clr = child.attr("val")
+		case "sysClr":
+			clr = child.attr("lastClr")
+		case "schemeClr":
+			if clr = phClr; child.attr("val") != "phClr" {
+				clr = r.schemeColor(child.attr("val"))
+			}
+		case "prstClr":
+			clr = presetColors[child.attr("val")]
+		default:
+			continue
+		}
+		if clr == "" {
+			return ""
+		}
+		return applyColorTransforms(clr, child)
+	}
+	return ""
+}
+
+// schemeColor returns the hex RGB color of the theme by given scheme color
+// name, the names such as "tx1" and "bg1" are mapped by the color map of the
+// slide master.
+func (r *styleResolver) schemeColor(name string) string {
+	if mapped, ok := r.colorMap[name]; ok {
+		name = mapped
+	} else if mapped, ok = map[string]string{"tx1": "dk1", "bg1": "lt1", "tx2": "dk2", "bg2": "lt2"}[name]; ok {
+		name = mapped
+	}
+	slot := r.theme.find("themeElements", "clrScheme", name)
+	if clr := slot.find("srgbClr").attr("val"); clr != "" {
+		return strings.ToUpper(clr)
+	}
+	return strings.ToUpper(slot.find("sysClr").attr("lastClr"))
+}
+
+// presetColors defined the hex RGB colors of the common preset colors.
+var presetColors = map[string]string{
+	"black": "000000", "white": "FFFFFF", "red": "FF0000", "green": "008000",
+	"blue": "0000FF", "yellow": "FFFF00", "gray": "808080", "orange": "FFA500",
+}
+
+// elementChildren returns the child elements of the element except the
+// character data.
+func elementChildren(node *xmlNode) []*xmlNode {
+	var list []*xmlNode
+	for _, child := range node.Children {
+		if child.Name != "" {
+			list = append(list, child)
+		}
+	}
+	return list
+}
+
+// applyColorTransforms returns the hex RGB color by given hex RGB color and
+// the color element, the luminance, tint and shade transforms are applied.
+func applyColorTransforms(clr string, node *xmlNode) string {
+	rgb, err := strconv.ParseUint(clr, 16, 32)
+	if err != nil || len(clr) != 6 {
+		return strings.ToUpper(clr)
+	}
+	red, green, blue := float64(rgb>>16&0xFF)/255, float64(rgb>>8&0xFF)/255, float64(rgb&0xFF)/255
+	for _, child := range node.Children {
+		val, err := strconv.Atoi(child.attr("val"))
+		if err != nil {
+			continue
+		}
+		v := float64(val) / 100000
+		switch localName(child.Name) {
+		case "lumMod", "lumOff":
+			h, s, l := rgbToHSL(red, green, blue)
+			if localName(child.Name) == "lumMod" {
+				l *= v
+			} else {
+				l += v
+			}
+			red, green, blue = hslToRGB(h, s, math.Min(math.Max(l, 0), 1))
+		case "tint":
+			red, green, blue = 1-(1-red)*v, 1-(1-green)*v, 1-(1-blue)*v
+		case "shade":
+			red, green, blue = red*v, green*v, blue*v
+		}
+	}
+	return rgbToHex(red, green, blue)
+}
+
+// rgbToHSL converts the RGB color to the HSL color, all the components are
+// between 0 and 1.
+func rgbToHSL(r, g, b float64) (float64, float64, float64) {
+	maxC, minC := math.Max(r, math.Max(g, b)), math.Min(r, math.Min(g, b))
+	l := (maxC + minC) / 2
+	if maxC == minC {
+		return 0, 0, l
+	}
+	d := maxC - minC
+	s := d / (maxC + minC)
+	if l > 0.5 {
+		s = d / (2 - maxC - minC)
+	}
+	var h float64
+	switch maxC {
+	case r:
+		h = (g - b) / d
+		if g < b {
+			h += 6
+		}
+	case g:
+		h = (b-r)/d + 2
+	default:
+		h = (r-g)/d + 4
+	}
+	return h / 6, s, l
+}
+
+// hslToRGB converts the HSL color to the RGB color, all the components are
+// between 0 and 1.
+func hslToRGB(h, s, l float64) (float64, float64, float64) {
+	if s == 0 {
+		return l, l, l
+	}
+	q := l * (1 + s)
+	if l >= 0.5 {
+		q = l + s - l*s
+	}
+	p := 2*l - q
+	hue := func(t float64) float64 {
+		t -= math.Floor(t)
+		switch {
+		case t < 1.0/6:
+			return p + (q-p)*6*t
+		case t < 0.5:
+			return q
+		case t < 2.0/3:
+			return p + (q-p)*(2.0/3-t)*6
+		}
+		return p
+	}
+	return hue(h + 1.0/3), hue(h), hue(h - 1.0/3)
+}
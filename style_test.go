@@ -0,0 +1,148 @@
+package gopptx
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"regexp"
+	"testing"
+)
+
+func TestGetEffectiveShapeStyle(t *testing.T) {
+	f := NewFile()
+	slideID := f.GetSlideList()[0]
+	layoutXMLPath := "ppt/slideLayouts/slideLayout1.xml"
+	f.Pkg.Store(layoutXMLPath, regexp.MustCompile(`<a:ln w="0">\s*<a:noFill/>`).ReplaceAll(f.readXML(layoutXMLPath),
+		[]byte(`<a:ln w="12700"><a:solidFill><a:schemeClr val="accent1"/></a:solidFill>`)))
+	if _, err := f.CreateShape(slideID, DecodeShapeProperties{
+		Xfrm:      &DecodeXfrm{Offset: &Offset{}, Extents: &Extents{CX: 914400, CY: 914400}},
+		SolidFill: &DecodeSolidFill{SchemeColor: &DecodeSchemeColor{Val: "accent2", LumMod: &ColorModifier{Val: 50000}}},
+	}, DecodeTextBody{}); err != nil {
+		t.Fatal(err)
+	}
+	slide, err := f.slideReader(slideID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The title without the outline inherits the outline of the layout
+	slide.getTitleShape().ShapeProperties.Ln = nil
+	slide.CommonSlideData.ShapeTree.Shape[2].NonVisualShapeProperties.CommonNonVisualProperties.Name = "Box"
+	for _, c := range []struct {
+		shapeName string
+		expected  EffectiveShapeStyle
+		err       error
+	}{
+		{
+			shapeName: "PlaceHolder 1",
+			expected: EffectiveShapeStyle{
+				FillType: "none", LineType: "solid", LineColor: "18A303", LineWidth: 12700,
+				Font: EffectiveFont{Family: "Arial", Size: 18, Color: "000000"},
+			},
+		},
+		{
+			shapeName: "Box",
+			expected: EffectiveShapeStyle{
+				FillType: "solid", FillColor: "013452", LineType: "none",
+				Font: EffectiveFont{Family: "Arial", Size: 18, Color: "000000"},
+			},
+		},
+		{shapeName: "Picture 1", err: ErrShapeNameNotExist{SlideID: slideID, Name: "Picture 1"}},
+	} {
+		style, err := f.GetEffectiveShapeStyle(slideID, c.shapeName)
+		if err != c.err {
+			t.Fatalf("%s: expected error %v, got %v", c.shapeName, c.err, err)
+		}
+		if style != c.expected {
+			t.Errorf("%s: expected the style %+v, got %+v", c.shapeName, c.expected, style)
+		}
+	}
+}
+
+func TestGetEffectiveRunProperties(t *testing.T) {
+	f := NewFile()
+	slideID := f.GetSlideList()[0]
+	masterXMLPath, themeXMLPath := "ppt/slideMasters/slideMaster1.xml", "ppt/theme/theme1.xml"
+	f.Pkg.Store(masterXMLPath, bytes.Replace(f.readXML(masterXMLPath), []byte("</p:sldMaster>"), []byte(`<p:txStyles><p:titleStyle>`+
+		`<a:lvl1pPr><a:defRPr sz="4000" b="1"><a:solidFill><a:schemeClr val="accent1"/></a:solidFill></a:defRPr></a:lvl1pPr></p:titleStyle>`+
+		`<p:bodyStyle><a:lvl1pPr><a:defRPr sz="2800"/></a:lvl1pPr><a:lvl2pPr><a:defRPr sz="2400" i="1"/></a:lvl2pPr></p:bodyStyle>`+
+		`</p:txStyles></p:sldMaster>`), 1))
+	f.Pkg.Store(themeXMLPath, bytes.Replace(f.readXML(themeXMLPath), []byte(`<a:majorFont>
+                <a:latin typeface="Arial"`), []byte(`<a:majorFont><a:latin typeface="Georgia"`), 1))
+	slide, err := f.slideReader(slideID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	size, level := 2000, 1
+	slide.getTitleShape().TextBody.Paragraph = []DecodeParagraph{{Runs: []DecodeRuns{
+		{Text: "Quarterly"}, {RunProperties: &DecodeRunProperties{Size: &size, Latin: &Latin{Typeface: "+mn-lt"}}, Text: " Review"},
+	}}}
+	slide.CommonSlideData.ShapeTree.Shape[1].TextBody.Paragraph = []DecodeParagraph{
+		{Runs: []DecodeRuns{{Text: "Agenda"}}},
+		{ParagraphProperties: &ParagraphProperties{Level: &level}, Runs: []DecodeRuns{{Text: "Intro"}}},
+	}
+	for _, c := range []struct {
+		shapeName            string
+		paragraphIdx, runIdx int
+		expected             EffectiveFont
+		err                  error
+	}{
+		{shapeName: "PlaceHolder 1", expected: EffectiveFont{Family: "Georgia", Size: 40, Color: "18A303", Bold: true}},
+		{shapeName: "PlaceHolder 1", runIdx: 1, expected: EffectiveFont{Family: "Arial", Size: 20, Color: "18A303", Bold: true}},
+		{shapeName: "PlaceHolder 2", expected: EffectiveFont{Family: "Arial", Size: 28, Color: "000000"}},
+		{shapeName: "PlaceHolder 2", paragraphIdx: 1, expected: EffectiveFont{Family: "Arial", Size: 24, Color: "000000", Italic: true}},
+		{shapeName: "PlaceHolder 2", paragraphIdx: 2, err: ErrTextRunNotExist},
+		{shapeName: "PlaceHolder 2", runIdx: 1, err: ErrTextRunNotExist},
+		{shapeName: "Picture 1", err: ErrShapeNameNotExist{SlideID: slideID, Name: "Picture 1"}},
+	} {
+		font, err := f.GetEffectiveRunProperties(slideID, c.shapeName, c.paragraphIdx, c.runIdx)
+		if err != c.err {
+			t.Fatalf("%s: expected error %v, got %v", c.shapeName, c.err, err)
+		}
+		if font != c.expected {
+			t.Errorf("%s: expected the font %+v of the run %d in the paragraph %d, got %+v", c.shapeName, c.expected, c.runIdx, c.paragraphIdx, font)
+		}
+	}
+}
+
+func TestSetShapeStyle(t *testing.T) {
+	f := NewFile()
+	slideID := f.GetSlideList()[0]
+	shapeID := addTestShape(t, f, slideID, "", "Styled").NonVisualShapeProperties.CommonNonVisualProperties.ID
+	for _, style := range []*DecodeShapeStyle{NewShapeStyle(ShapeStyleModerate, "accent2"), nil} {
+		if err := f.SetShapeStyle(slideID, shapeID, style); err != nil {
+			t.Fatal(err)
+		}
+		buf, err := f.WriteToBuffer()
+		if err != nil {
+			t.Fatal(err)
+		}
+		saved, err := OpenReader(buf)
+		if err != nil {
+			t.Fatal(err)
+		}
+		slide, err := saved.slideReader(slideID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if shapes := slide.CommonSlideData.ShapeTree.Shape; !reflect.DeepEqual(shapes[len(shapes)-1].Style, style) {
+			t.Errorf("expected the shape style %v, got %v", style, shapes[len(shapes)-1].Style)
+		}
+		if content := saved.readXML("ppt/slides/slide1.xml"); bytes.Contains(content, []byte("<p:style>")) != (style != nil) {
+			t.Errorf("unexpected shape style in the slide, got %s", content)
+		}
+	}
+
+	for _, c := range []struct {
+		shapeID  int
+		style    *DecodeShapeStyle
+		expected error
+	}{
+		{shapeID: shapeID, style: &DecodeShapeStyle{FontReference: &DecodeFontReference{Index: "body"}}, expected: ErrShapeStyleInvalid},
+		{shapeID: shapeID, style: &DecodeShapeStyle{FillReference: &DecodeStyleMatrixReference{Index: -1}}, expected: ErrShapeStyleInvalid},
+		{shapeID: 99, style: NewShapeStyle(ShapeStyleSubtle, "accent1"), expected: ErrShapeNotExist{99}},
+	} {
+		if err := f.SetShapeStyle(slideID, c.shapeID, c.style); !errors.Is(err, c.expected) {
+			t.Errorf("expected %v, got %v", c.expected, err)
+		}
+	}
+}
@@ -0,0 +1,437 @@
+// Copyright 2026 kenny-not-dead. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+//
+// Package gopptx provides functionality to create and manipulate PowerPoint
+// (.pptx) files in Go, using the Office Open XML (ECMA-376) format.
+
+package gopptx
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	defaultTableStyleID   = "{5C22544A-7EE6-4342-B048-85BDC9FD1C3A}"
+	defaultTableRowHeight = 370840
+	defaultTableMargin    = 457200
+)
+
+// TableOptions directly maps the settings of the table. The offset and width
+// are specified in EMUs, the table is placed below the title area and spans
+// the slide width with margins by default. The row height is specified in
+// EMUs with default 370840. The style ID is the GUID of the table style with
+// default "{5C22544A-7EE6-4342-B048-85BDC9FD1C3A}", the medium style 2 with
+// accent 1. The font size is specified in points, the size of the table style
+// is used if it is zero. The header fill is the hex RGB background color of
+// the header row, the color of the table style is used if it is empty.
+type TableOptions struct {
+	Name       string
+	OffsetX    int
+	OffsetY    int
+	Width      int
+	RowHeight  int
+	StyleID    string
+	FontSize   int
+	HeaderFill string
+}
+
+// tableData defines the text of the table to be created, the table has no
+// header row if the header is nil. The weights are the relative widths of the
+// columns.
+type tableData struct {
+	header  []string
+	rows    [][]string
+	align   []string
+	weights []int
+}
+
+// AddTableFromStructs provides a function to add a table onto the slide by
+// given slide id, slice of structs or pointers to structs and the table
+// settings, and returns the shape id of the table. Each exported field is a
+// column, and the header is the field name. The field tag "pptx" specifies
+// the header, the alignment "left", "center" or "right", and the format of
+// the values. The format is a number format code such as "#,##0.00" or "0.0%"
+// for the numbers, see FormatNumber for the format code, the layout for the
+// time values, or a fmt verb such as "%08d". The format should be the last
+// option of the tag because it may contain commas, and the field with tag "-"
+// is skipped. The numbers are right aligned by default. For example:
+//
+//	type Sale struct {
+//	    Region  string    `pptx:"Region"`
+//	    Revenue float64   `pptx:"Revenue,format=#,##0.00"`
+//	    Growth  float64   `pptx:"Growth,align=center,format=0.0%"`
+//	    Updated time.Time `pptx:"Updated,format=Jan 2"`
+//	    Notes   string    `pptx:"-"`
+//	}
+//
+//	shapeID, err := f.AddTableFromStructs(256, []Sale{
+//	    {Region: "North", Revenue: 12500, Growth: 0.12, Updated: time.Now()},
+//	    {Region: "South", Revenue: 9800.5, Growth: -0.03, Updated: time.Now()},
+//	}, nil)
+func (f *File) AddTableFromStructs(slideID int, rows interface{}, opts *TableOptions) (int, error) {
+	if err := f.checkReadOnly(); err != nil {
+		return 0, err
+	}
+	val := reflect.ValueOf(rows)
+	if val.Kind() != reflect.Slice {
+		return 0, ErrTableStructs
+	}
+	typ := val.Type().Elem()
+	if typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+	if typ.Kind() != reflect.Struct {
+		return 0, ErrTableStructs
+	}
+	type column struct {
+		index  []int
+		format string
+	}
+	var (
+		columns []column
+		data    tableData
+	)
+	for _, field := range reflect.VisibleFields(typ) {
+		tag := field.Tag.Get("pptx")
+		if !field.IsExported() || field.Anonymous || tag == "-" {
+			continue
+		}
+		header, align, format := field.Name, "", ""
+		name, options, _ := strings.Cut(tag, ",")
+		if name != "" {
+			header = name
+		}
+		for options != "" {
+			var option string
+			if strings.HasPrefix(options, "format=") {
+				format, options = strings.TrimPrefix(options, "format="), ""
+				continue
+			}
+			option, options, _ = strings.Cut(options, ",")
+			if value, ok := strings.CutPrefix(option, "align="); ok {
+				align = value
+			}
+		}
+		if align == "" {
+			switch field.Type.Kind() {
+			case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+				reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+				reflect.Float32, reflect.Float64:
+				align = "right"
+			}
+		}
+		columns = append(columns, column{index: field.Index, format: format})
+		data.header = append(data.header, header)
+		data.align = append(data.align, align)
+	}
+	if len(columns) == 0 {
+		return 0, ErrTableStructs
+	}
+	symbols := f.numberSymbols()
+	for i := 0; i < val.Len(); i++ {
+		item := reflect.Indirect(val.Index(i))
+		row := make([]string, len(columns))
+		if item.IsValid() {
+			for j, col := range columns {
+				if field, err := item.FieldByIndexErr(col.index); err == nil {
+					row[j] = formatTableValue(field.Interface(), col.format, symbols)
+				}
+			}
+		}
+		data.rows = append(data.rows, row)
+	}
+	return f.addTable(slideID, data, opts)
+}
+
+// CSVTableOptions directly maps the settings of the table imported from the
+// CSV data. The delimiter is detected from the comma, semicolon, tab and
+// vertical bar if it is zero. The first record is the header row unless
+// NoHeader is true.
+type CSVTableOptions struct {
+	TableOptions
+	Delimiter rune
+	NoHeader  bool
+}
+
+// AddTableFromCSV provides a function to add a table onto the slide by given
+// slide id, the reader of the CSV data and the table settings, and returns
+// the shape id of the table. The header row is styled by the table style, the
+// width of the columns are sized by the length of their content, and the
+// numeric columns are right aligned. For example:
+//
+//	file, err := os.Open("sales.csv")
+//	if err != nil {
+//	    fmt.Println(err)
+//	    return
+//	}
+//	defer file.Close()
+//	shapeID, err := f.AddTableFromCSV(256, file, &gopptx.CSVTableOptions{
+//	    TableOptions: gopptx.TableOptions{HeaderFill: "203864", FontSize: 12},
+//	})
+func (f *File) AddTableFromCSV(slideID int, r io.Reader, opts *CSVTableOptions) (int, error) {
+	if err := f.checkReadOnly(); err != nil {
+		return 0, err
+	}
+	if opts == nil {
+		opts = &CSVTableOptions{}
+	}
+	content, err := io.ReadAll(r)
+	if err != nil {
+		return 0, err
+	}
+	content = bytes.TrimPrefix(content, []byte("\ufeff"))
+	reader := csv.NewReader(bytes.NewReader(content))
+	reader.Comma, reader.FieldsPerRecord, reader.LazyQuotes = opts.Delimiter, -1, true
+	if reader.Comma == 0 {
+		reader.Comma = detectCSVDelimiter(content)
+	}
+	records, err := reader.ReadAll()
+	if err != nil {
+		return 0, err
+	}
+	if len(records) == 0 {
+		return 0, ErrTableEmpty
+	}
+	data := tableData{rows: records}
+	if !opts.NoHeader {
+		data.header, data.rows = records[0], records[1:]
+	}
+	var cols int
+	for _, record := range records {
+		cols = max(cols, len(record))
+	}
+	data.align, data.weights = make([]string, cols), make([]int, cols)
+	for c := 0; c < cols; c++ {
+		numeric := false
+		for r, record := range records {
+			if c >= len(record) {
+				continue
+			}
+			// Clamp the content length to avoid too narrow or wide columns.
+			data.weights[c] = max(data.weights[c], min(max(utf8.RuneCountInString(record[c]), 4), 40))
+			if r == 0 && !opts.NoHeader || strings.TrimSpace(record[c]) == "" {
+				continue
+			}
+			_, err := strconv.ParseFloat(strings.NewReplacer(",", "", "%", "", "$", "").Replace(strings.TrimSpace(record[c])), 64)
+			if numeric = err == nil; !numeric {
+				break
+			}
+		}
+		if numeric {
+			data.align[c] = "right"
+		}
+	}
+	return f.addTable(slideID, data, &opts.TableOptions)
+}
+
+// detectCSVDelimiter returns the delimiter of the CSV data, which is the
+// candidate with the most occurrences outside the quotes in each of the first
+// lines. The comma will be returned if none of the candidates occurs.
+func detectCSVDelimiter(content []byte) rune {
+	delimiter, best := ',', 0
+	lines := strings.SplitN(string(content), "\n", 11)
+	if len(lines) > 10 {
+		lines = lines[:10]
+	}
+	for _, candidate := range []rune{',', ';', '\t', '|'} {
+		least := -1
+		for _, line := range lines {
+			if strings.TrimSpace(line) == "" {
+				continue
+			}
+			var count int
+			quoted := false
+			for _, char := range line {
+				if char == '"' {
+					quoted = !quoted
+				} else if char == candidate && !quoted {
+					count++
+				}
+			}
+			if least == -1 || count < least {
+				least = count
+			}
+		}
+		if least > best {
+			delimiter, best = candidate, least
+		}
+	}
+	return delimiter
+}
+
+// addTable provides a function to add a table onto the slide by given slide
+// id, the text of the table and the table settings, and returns the shape id
+// of the table.
+func (f *File) addTable(slideID int, data tableData, opts *TableOptions) (int, error) {
+	if opts == nil {
+		opts = &TableOptions{}
+	}
+	slideWidth, _, err := f.getSlideSize()
+	if err != nil {
+		return 0, err
+	}
+	slide, err := f.slideReader(slideID)
+	if err != nil {
+		return 0, err
+	}
+	offsetX, offsetY, width, rowHeight, styleID := opts.OffsetX, opts.OffsetY, opts.Width, opts.RowHeight, opts.StyleID
+	if offsetX == 0 && offsetY == 0 {
+		offsetX, offsetY = defaultTableMargin, defaultTableMargin*7/2
+	}
+	if width <= 0 {
+		width = slideWidth - 2*offsetX
+	}
+	if rowHeight <= 0 {
+		rowHeight = defaultTableRowHeight
+	}
+	if styleID == "" {
+		styleID = defaultTableStyleID
+	}
+	rows, cols := data.rows, len(data.header)
+	for _, row := range rows {
+		cols = max(cols, len(row))
+	}
+	if cols == 0 {
+		return 0, ErrTableEmpty
+	}
+	firstRow, bandRow := 1, 1
+	if data.header == nil {
+		firstRow = 0
+	} else {
+		rows = append([][]string{data.header}, rows...)
+	}
+	tbl := table{TableProperties: &tableProperties{FirstRow: &firstRow, BandRow: &bandRow, TableStyleID: styleID}}
+	weights, totalWeight := make([]int, cols), 0
+	for i := range weights {
+		weights[i] = 1
+		if i < len(data.weights) {
+			weights[i] = max(data.weights[i], 1)
+		}
+		totalWeight += weights[i]
+	}
+	for i, weight := range weights {
+		colWidth := width * weight / totalWeight
+		if i == len(weights)-1 {
+			colWidth = width - width*(totalWeight-weight)/totalWeight
+		}
+		tbl.TableGrid.GridCol = append(tbl.TableGrid.GridCol, tableGridCol{Width: colWidth})
+	}
+	for r, cells := range rows {
+		row := tableRow{Height: rowHeight}
+		for c := 0; c < cols; c++ {
+			var text, align string
+			if c < len(cells) {
+				text = cells[c]
+			}
+			if c < len(data.align) {
+				align = data.align[c]
+			}
+			row.Cells = append(row.Cells, newTableCell(text, align, r == 0 && firstRow == 1, opts))
+		}
+		tbl.Rows = append(tbl.Rows, row)
+	}
+	output, err := xml.Marshal(tbl)
+	if err != nil {
+		return 0, err
+	}
+	shapeID := slide.nextShapeID()
+	name := opts.Name
+	if name == "" {
+		name = fmt.Sprintf("Table %d", shapeID-1)
+	}
+	slide.CommonSlideData.ShapeTree.GraphicFrame = append(slide.CommonSlideData.ShapeTree.GraphicFrame, decodeGraphicFrame{
+		NonVisualGraphicFrameProperties: &decodeNonVisualGraphicFrameProperties{
+			CommonNonVisualProperties:             &CommonNonVisualProperties{ID: shapeID, Name: name},
+			CommonNonVisualGraphicFrameProperties: &innerXML{Content: `<a:graphicFrameLocks noGrp="1"/>`},
+			NonVisualProperties:                   &decodeNonVisualProperties{},
+		},
+		Xfrm: &DecodeXfrm{
+			Offset:  &Offset{X: offsetX, Y: offsetY},
+			Extents: &Extents{CX: width, CY: rowHeight * len(rows)},
+		},
+		Graphic: &decodeGraphic{GraphicData: &GraphicData{URI: NameSpaceDrawingMLTable, Content: string(output)}},
+	})
+	return shapeID, nil
+}
+
+// newTableCell returns the table cell by given text, alignment, whether the
+// cell is in the header row and the table settings.
+func newTableCell(text, align string, header bool, opts *TableOptions) tableCell {
+	switch align {
+	case "left":
+		align = "l"
+	case "center":
+		align = "ctr"
+	case "right":
+		align = "r"
+	}
+	rPr := &DecodeRunProperties{Lang: "en-US"}
+	if opts.FontSize > 0 {
+		sz := opts.FontSize * 100
+		rPr.Size = &sz
+	}
+	if header {
+		bold := 1
+		rPr.Bold = &bold
+	}
+	paragraph := DecodeParagraph{EndParagraphRunProperties: rPr}
+	if align != "" {
+		paragraph.ParagraphProperties = &ParagraphProperties{Align: &align}
+	}
+	if text != "" {
+		paragraph.Runs = []DecodeRuns{{RunProperties: rPr, Text: text}}
+	}
+	cell := tableCell{
+		TextBody:       newTextBody(&DecodeTextBody{BodyProperties: &DecodeBodyProperties{}, Paragraph: []DecodeParagraph{paragraph}}),
+		CellProperties: &tableCellProperties{},
+	}
+	if header && opts.HeaderFill != "" {
+		cell.CellProperties.SolidFill = &SolidFill{SolidRGBColor: &solidRGBColor{Val: strings.TrimPrefix(opts.HeaderFill, "#")}}
+	}
+	return cell
+}
+
+// formatTableValue returns the text of the table cell by given value, format
+// and symbols of the numbers, see AddTableFromStructs for the format.
+func formatTableValue(value interface{}, format string, symbols numberSymbols) string {
+	if strings.HasPrefix(format, "%") {
+		return fmt.Sprintf(format, value)
+	}
+	switch v := value.(type) {
+	case time.Time:
+		if v.IsZero() {
+			return ""
+		}
+		if format == "" {
+			format = time.DateOnly
+		}
+		return v.Format(format)
+	case fmt.Stringer:
+		return v.String()
+	}
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return formatNumber(float64(rv.Int()), format, symbols)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return formatNumber(float64(rv.Uint()), format, symbols)
+	case reflect.Float32, reflect.Float64:
+		return formatNumber(rv.Float(), format, symbols)
+	case reflect.Ptr, reflect.Interface:
+		if rv.IsNil() {
+			return ""
+		}
+		return formatTableValue(rv.Elem().Interface(), format, symbols)
+	}
+	return fmt.Sprint(value)
+}
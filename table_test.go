@@ -0,0 +1,141 @@
+package gopptx
+
+import (
+	"encoding/xml"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+// getTestTableCells returns the text and alignment of the cells of the table
+// by given slide id and the shape id of the table, in "text|align" format.
+func getTestTableCells(t *testing.T, f *File, slideID, shapeID int) [][]string {
+	t.Helper()
+	slide, err := f.slideReader(slideID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, gf := range slide.CommonSlideData.ShapeTree.GraphicFrame {
+		if gf.NonVisualGraphicFrameProperties.CommonNonVisualProperties.ID != shapeID {
+			continue
+		}
+		var table struct {
+			Rows []struct {
+				Cells []struct {
+					Paragraph struct {
+						Properties struct {
+							Align string `xml:"algn,attr"`
+						} `xml:"pPr"`
+						Text string `xml:"r>t"`
+					} `xml:"txBody>p"`
+				} `xml:"tc"`
+			} `xml:"tr"`
+		}
+		if err = xml.Unmarshal([]byte(gf.Graphic.GraphicData.Content), &table); err != nil {
+			t.Fatal(err)
+		}
+		var cells [][]string
+		for _, row := range table.Rows {
+			var texts []string
+			for _, cell := range row.Cells {
+				texts = append(texts, cell.Paragraph.Text+"|"+cell.Paragraph.Properties.Align)
+			}
+			cells = append(cells, texts)
+		}
+		return cells
+	}
+	t.Fatalf("the table %d does not exist", shapeID)
+	return nil
+}
+
+func TestAddTableFromStructs(t *testing.T) {
+	type sale struct {
+		Region  string    `pptx:"Region"`
+		Revenue float64   `pptx:"Revenue,format=#,##0.00"`
+		Growth  float64   `pptx:"Growth,align=center,format=0.0%"`
+		Updated time.Time `pptx:",format=Jan 2"`
+		Units   int
+		Notes   string `pptx:"-"`
+		secret  string
+	}
+	updated := time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC)
+	for _, c := range []struct {
+		name     string
+		rows     interface{}
+		err      error
+		expected [][]string
+	}{
+		{
+			name: "structs", rows: []sale{{Region: "North", Revenue: 12500, Growth: 0.125, Updated: updated, Units: 8, Notes: "-", secret: "-"}},
+			expected: [][]string{
+				{"Region|", "Revenue|r", "Growth|ctr", "Updated|", "Units|r"},
+				{"North|", "12,500.00|r", "12.5%|ctr", "Oct 17|", "8|r"},
+			},
+		},
+		{
+			name: "pointers", rows: []*sale{nil, {Region: "South"}},
+			expected: [][]string{
+				{"Region|", "Revenue|r", "Growth|ctr", "Updated|", "Units|r"},
+				{"|", "|r", "|ctr", "|", "|r"},
+				{"South|", "0.00|r", "0.0%|ctr", "|", "0|r"},
+			},
+		},
+		{name: "slice", rows: sale{}, err: ErrTableStructs},
+		{name: "struct", rows: []string{"North"}, err: ErrTableStructs},
+		{name: "fields", rows: []struct{ secret string }{{}}, err: ErrTableStructs},
+	} {
+		f := NewFile()
+		slideID := f.GetSlideList()[0]
+		shapeID, err := f.AddTableFromStructs(slideID, c.rows, nil)
+		if err != c.err {
+			t.Fatalf("%s: expected error %v, got %v", c.name, c.err, err)
+		}
+		if err != nil {
+			continue
+		}
+		if cells := getTestTableCells(t, f, slideID, shapeID); !reflect.DeepEqual(cells, c.expected) {
+			t.Errorf("%s: expected the cells %v, got %v", c.name, c.expected, cells)
+		}
+	}
+}
+
+func TestAddTableFromCSV(t *testing.T) {
+	for _, c := range []struct {
+		name, csv string
+		opts      *CSVTableOptions
+		err       error
+		expected  [][]string
+	}{
+		{
+			name: "comma", csv: "Region,Revenue\nNorth,\"12,500\"\nSouth,9800.5\n",
+			expected: [][]string{{"Region|", "Revenue|r"}, {"North|", "12,500|r"}, {"South|", "9800.5|r"}},
+		},
+		{
+			name: "semicolon", csv: "\ufeffRegion;Note\n\"North;East\";12%\nSouth;n/a\n",
+			expected: [][]string{{"Region|", "Note|"}, {"North;East|", "12%|"}, {"South|", "n/a|"}},
+		},
+		{
+			name: "no header", csv: "1\t2\n3\t4\t5\n", opts: &CSVTableOptions{NoHeader: true},
+			expected: [][]string{{"1|r", "2|r", "|r"}, {"3|r", "4|r", "5|r"}},
+		},
+		{
+			name: "delimiter", csv: "a|b,c\n", opts: &CSVTableOptions{Delimiter: ','},
+			expected: [][]string{{"a|b|", "c|"}},
+		},
+		{name: "empty", csv: "", err: ErrTableEmpty},
+	} {
+		f := NewFile()
+		slideID := f.GetSlideList()[0]
+		shapeID, err := f.AddTableFromCSV(slideID, strings.NewReader(c.csv), c.opts)
+		if err != c.err {
+			t.Fatalf("%s: expected error %v, got %v", c.name, c.err, err)
+		}
+		if err != nil {
+			continue
+		}
+		if cells := getTestTableCells(t, f, slideID, shapeID); !reflect.DeepEqual(cells, c.expected) {
+			t.Errorf("%s: expected the cells %v, got %v", c.name, c.expected, cells)
+		}
+	}
+}
@@ -0,0 +1,79 @@
+// Copyright 2026 kenny-not-dead. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+//
+// Package gopptx provides functionality to create and manipulate PowerPoint
+// (.pptx) files in Go, using the Office Open XML (ECMA-376) format.
+
+package gopptx
+
+import (
+	"io"
+	"io/fs"
+	"os"
+)
+
+// TempFile defines the scratch file created by the TempFileProvider, the
+// name identifies the file on opening and removing it by the provider.
+type TempFile interface {
+	io.Writer
+	io.Closer
+	Name() string
+}
+
+// TempFileProvider defines the interface creating, opening and removing the
+// scratch files, which are used to extract the large slides on reading and
+// to buffer the large streamed parts. The services running in the read-only
+// or diskless containers can implement it to keep the scratch files in the
+// memory or the object storage. The Create function creates a new file in
+// the directory by the pattern which has the same meaning as the
+// os.CreateTemp function, and the directory is the TmpDir of the options.
+type TempFileProvider interface {
+	Create(dir, pattern string) (TempFile, error)
+	Open(name string) (io.ReadCloser, error)
+	Remove(name string) error
+}
+
+// osTempFileProvider defines the default provider which keeps the scratch
+// files in the file system.
+type osTempFileProvider struct{}
+
+// Create implements the TempFileProvider interface by the os.CreateTemp.
+func (osTempFileProvider) Create(dir, pattern string) (TempFile, error) {
+	return os.CreateTemp(dir, pattern)
+}
+
+// Open implements the TempFileProvider interface by the os.Open.
+func (osTempFileProvider) Open(name string) (io.ReadCloser, error) {
+	return os.Open(name)
+}
+
+// Remove implements the TempFileProvider interface by the os.Remove.
+func (osTempFileProvider) Remove(name string) error {
+	return os.Remove(name)
+}
+
+// tempFileProvider returns the provider of the scratch files of the
+// presentation, which is the file system if it isn't specified.
+func (f *File) tempFileProvider() TempFileProvider {
+	if f.options.TempFileProvider != nil {
+		return f.options.TempFileProvider
+	}
+	return osTempFileProvider{}
+}
+
+// getTempFileSize returns the size of the scratch file by given name, the
+// file is read through if the provider doesn't return the file information.
+func (f *File) getTempFileSize(name string) (int64, error) {
+	file, err := f.tempFileProvider().Open(name)
+	if err != nil {
+		return 0, err
+	}
+	defer file.Close()
+	if stat, ok := file.(interface{ Stat() (fs.FileInfo, error) }); ok {
+		if fi, err := stat.Stat(); err == nil {
+			return fi.Size(), nil
+		}
+	}
+	return io.Copy(io.Discard, file)
+}
@@ -15,9 +15,6 @@ var (
 	//go:embed templates/\[Content_Types].xml
 	templateContentTypes string
 
-	//go:embed templates/\app.xml
-	templateDocpropsApp string
-
 	//go:embed templates/core.xml
 	templateDocpropsCore string
 
@@ -39,6 +36,9 @@ var (
 	//go:embed templates/slideMaster1-rels.xml
 	templateSlideMasterRels string
 
+	//go:embed templates/notesMaster1.xml
+	templateNotesMaster string
+
 	//go:embed templates/presentation.xml
 	templatePresentation string
 
@@ -0,0 +1,136 @@
+// Copyright 2026 kenny-not-dead. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+//
+// Package gopptx provides functionality to create and manipulate PowerPoint
+// (.pptx) files in Go, using the Office Open XML (ECMA-376) format.
+
+package gopptx
+
+import (
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// TextBoxOptions directly maps the settings of the text box. The text is
+// split into the paragraphs by the line feeds. The offset and size of the
+// text box are specified in EMUs, the text box is sized to fit the text if
+// the width or height is zero. The font size is specified in points, the
+// color is the hex RGB color of the text, and the alignment is one of "l",
+// "ctr", "r" and "just". The font, font size, color and alignment are
+// inherited from the slide master if they are empty.
+type TextBoxOptions struct {
+	Name     string
+	Text     string
+	OffsetX  int
+	OffsetY  int
+	Width    int
+	Height   int
+	Font     string
+	FontSize int
+	Bold     bool
+	Color    string
+	Align    string
+}
+
+// AddTextBox provides a function to add the text box with the text onto the
+// slide by given slide id and the text box settings, it returns the shape ID
+// of the text box. For example, add the bold text box at 1 inch from the top
+// left corner of the slide:
+//
+//	shapeID, err := f.AddTextBox(256, gopptx.TextBoxOptions{
+//	    Text:     "Hello, world",
+//	    OffsetX:  914400,
+//	    OffsetY:  914400,
+//	    Width:    3657600,
+//	    Height:   457200,
+//	    FontSize: 24,
+//	    Bold:     true,
+//	})
+func (f *File) AddTextBox(slideID int, opts TextBoxOptions) (int, error) {
+	if err := f.checkReadOnly(); err != nil {
+		return -1, err
+	}
+	slide, err := f.slideReader(slideID)
+	if err != nil {
+		return -1, err
+	}
+	shapeID := slide.nextShapeID()
+	slide.CommonSlideData.ShapeTree.Shape = append(slide.CommonSlideData.ShapeTree.Shape, newTextBox(shapeID, opts))
+	return shapeID, nil
+}
+
+// newTextBox provides a function to create the text box by given shape ID
+// and the text box settings.
+func newTextBox(shapeID int, opts TextBoxOptions) decodeShape {
+	name, txBox, wrap := opts.Name, true, "square"
+	if name == "" {
+		name = "TextBox " + strconv.Itoa(shapeID-1)
+	}
+	lines := strings.Split(opts.Text, "\n")
+	width, height := opts.Width, opts.Height
+	bodyPr := &DecodeBodyProperties{Wrap: &wrap}
+	if width <= 0 || height <= 0 {
+		// Approximate the average character width as 0.6 of the font size,
+		// and the line height as 1.2 of the font size.
+		size := 18
+		if opts.FontSize > 0 {
+			size = opts.FontSize
+		}
+		var chars int
+		for _, line := range lines {
+			chars = max(chars, utf8.RuneCountInString(line))
+		}
+		if width <= 0 {
+			wrap = "none"
+			width = max(chars, 1)*size*EMUPerPoint*3/5 + 2*defaultTextInsetX
+		}
+		if height <= 0 {
+			height = len(lines)*size*EMUPerPoint*6/5 + 2*defaultTextInsetY
+		}
+		bodyPr.SpAutoFit = &SpAutoFit{}
+	}
+	rPr := &DecodeRunProperties{}
+	if opts.Bold {
+		bold := 1
+		rPr.Bold = &bold
+	}
+	if opts.FontSize > 0 {
+		sz := opts.FontSize * 100
+		rPr.Size = &sz
+	}
+	if opts.Color != "" {
+		rPr.SolidFill = &DecodeSolidFill{SolidRGBColor: &SolidRGBColor{Val: strings.TrimPrefix(opts.Color, "#")}}
+	}
+	if opts.Font != "" {
+		rPr.Latin = &Latin{Typeface: opts.Font}
+	}
+	paragraphs := make([]DecodeParagraph, len(lines))
+	for i, line := range lines {
+		runProps := *rPr
+		if opts.Align != "" {
+			align := opts.Align
+			paragraphs[i].ParagraphProperties = &ParagraphProperties{Align: &align}
+		}
+		if line == "" {
+			paragraphs[i].EndParagraphRunProperties = &runProps
+			continue
+		}
+		paragraphs[i].Runs = []DecodeRuns{{RunProperties: &runProps, Text: line}}
+	}
+	offset, extents := Offset{X: opts.OffsetX, Y: opts.OffsetY}, Extents{CX: width, CY: height}
+	return decodeShape{
+		NonVisualShapeProperties: &decodeNonVisualShapeProperties{
+			CommonNonVisualProperties:      &CommonNonVisualProperties{ID: shapeID, Name: name},
+			CommonNonVisualShapeProperties: &decodeCommonNonVisualShapeProperties{TxBox: &txBox},
+			NonVisualProperties:            &decodeNonVisualProperties{},
+		},
+		ShapeProperties: &DecodeShapeProperties{
+			Xfrm:           &DecodeXfrm{Offset: &offset, Extents: &extents},
+			PresetGeometry: &DecodePresetGeometry{Preset: "rect", AdjustValueList: &AdjustValueList{}},
+			NoFill:         &noFill{},
+		},
+		TextBody: &DecodeTextBody{BodyProperties: bodyPr, Paragraph: paragraphs},
+	}
+}
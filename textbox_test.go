@@ -0,0 +1,40 @@
+package gopptx
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestAddTextBox(t *testing.T) {
+	f := NewFile()
+	slideID := f.GetSlideList()[0]
+	for _, c := range []struct {
+		opts     TextBoxOptions
+		expected []string
+	}{
+		{opts: TextBoxOptions{}, expected: []string{`<p:cNvSpPr txBox="true"`, `<a:spAutoFit`}},
+		{
+			opts:     TextBoxOptions{Text: "Hello\nworld", OffsetX: 914400, OffsetY: 457200, Width: 3657600, Height: 457200, Bold: true},
+			expected: []string{`<a:off x="914400" y="457200"`, `<a:ext cx="3657600" cy="457200"`, `<a:t>Hello</a:t>`, `<a:t>world</a:t>`, `b="1"`},
+		},
+	} {
+		shapeID, err := f.AddTextBox(slideID, c.opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		content, err := f.GetSlideXML(slideID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		shape := string(content)[strings.Index(string(content), `<p:cNvPr id="`+strconv.Itoa(shapeID)+`"`):]
+		for _, expected := range c.expected {
+			if !strings.Contains(shape, expected) {
+				t.Errorf("text box %d: expected %s in %s", shapeID, expected, shape)
+			}
+		}
+	}
+	if _, err := f.AddTextBox(0, TextBoxOptions{}); err == nil {
+		t.Error("expected the error on adding the text box onto the missing slide")
+	}
+}
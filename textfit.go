@@ -0,0 +1,235 @@
+// Copyright 2026 kenny-not-dead. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+//
+// Package gopptx provides functionality to create and manipulate PowerPoint
+// (.pptx) files in Go, using the Office Open XML (ECMA-376) format.
+
+package gopptx
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// TextMetrics is the interface measuring the width of the text, which is
+// used to lay out the text on checking and fitting the text in the shapes.
+// The TextWidth returns the width in points of the text by given text, Latin
+// font name and font size in points, the font name is empty if the run
+// doesn't specify it. The implementations can measure the text with the
+// actual font files for the exact results.
+type TextMetrics interface {
+	TextWidth(text, font string, size float64) float64
+}
+
+// defaultTextMetrics defines the default metrics measuring the width of the
+// text by the classes of the characters.
+type defaultTextMetrics struct{}
+
+// TextOverflow directly maps the shape which text likely overflows its frame.
+// The height is the height of the text area of the shape, and the text
+// height is the estimated height of the text at the current font sizes, both
+// are specified in EMUs.
+type TextOverflow struct {
+	SlideID    int
+	ShapeID    int
+	ShapeName  string
+	Height     int
+	TextHeight int
+}
+
+// CheckTextOverflow provides a function to find the shapes which text likely
+// overflows their frames at the current font sizes, so the generated
+// presentations can be adjusted before delivery. The text is laid out with
+// the TextMetrics option, which are the approximate widths of the characters
+// of the common sans-serif fonts by default, and the font scale of the
+// shrinking text is applied. The shapes resized to fit the text and the
+// placeholders without their own positions are skipped. For example:
+//
+//	overflows, err := f.CheckTextOverflow()
+//	if err != nil {
+//	    fmt.Println(err)
+//	    return
+//	}
+//	for _, overflow := range overflows {
+//	    fmt.Println(overflow.SlideID, overflow.ShapeName, overflow.TextHeight-overflow.Height)
+//	}
+func (f *File) CheckTextOverflow() ([]TextOverflow, error) {
+	var overflows []TextOverflow
+	metrics := f.textMetrics()
+	for _, slideID := range f.GetSlideList() {
+		slide, err := f.slideReader(slideID)
+		if err != nil {
+			return overflows, err
+		}
+		for _, shape := range slide.getShapes() {
+			if shape.TextBody == nil || shape.ShapeProperties == nil || shape.ShapeProperties.Xfrm == nil ||
+				shape.ShapeProperties.Xfrm.Extents == nil {
+				continue
+			}
+			if bodyPr := shape.TextBody.BodyProperties; bodyPr != nil && bodyPr.SpAutoFit != nil {
+				continue
+			}
+			width, height := getTextArea(shape.TextBody, shape.ShapeProperties.Xfrm.Extents)
+			textHeight := measureTextHeight(shape.TextBody, width, metrics)
+			if textHeight <= height {
+				continue
+			}
+			overflow := TextOverflow{SlideID: slideID, Height: height, TextHeight: textHeight}
+			if nvSpPr := shape.NonVisualShapeProperties; nvSpPr != nil && nvSpPr.CommonNonVisualProperties != nil {
+				overflow.ShapeID, overflow.ShapeName = nvSpPr.CommonNonVisualProperties.ID, nvSpPr.CommonNonVisualProperties.Name
+			}
+			overflows = append(overflows, overflow)
+		}
+	}
+	return overflows, nil
+}
+
+// FitShapeToText provides a function to resize the shape to fit its text by
+// given slide id and shape name, which avoids the clipped text in the
+// generated slides. The text is measured with the TextMetrics option, the
+// height of the shape is grown to the height of the text and the shape is
+// set to be resized to fit the text on editing in PowerPoint, which replaces
+// the shrinking of the text. The shape isn't shrunk if the text fits in it,
+// and only the autofit is set on the placeholders inheriting the positions
+// from the layout. For example:
+//
+//	err := f.FitShapeToText(256, "TextBox 1")
+func (f *File) FitShapeToText(slideID int, shapeName string) error {
+	if err := f.checkReadOnly(); err != nil {
+		return err
+	}
+	slide, err := f.slideReader(slideID)
+	if err != nil {
+		return err
+	}
+	var shape *decodeShape
+	for i, s := range slide.CommonSlideData.ShapeTree.Shape {
+		if s.NonVisualShapeProperties != nil && s.NonVisualShapeProperties.CommonNonVisualProperties != nil &&
+			s.NonVisualShapeProperties.CommonNonVisualProperties.Name == shapeName {
+			shape = &slide.CommonSlideData.ShapeTree.Shape[i]
+			break
+		}
+	}
+	if shape == nil {
+		return ErrShapeNameNotExist{SlideID: slideID, Name: shapeName}
+	}
+	if shape.TextBody == nil {
+		shape.TextBody = &DecodeTextBody{Paragraph: []DecodeParagraph{{}}}
+	}
+	if shape.TextBody.BodyProperties == nil {
+		shape.TextBody.BodyProperties = &DecodeBodyProperties{}
+	}
+	bodyPr := shape.TextBody.BodyProperties
+	if shape.ShapeProperties != nil && shape.ShapeProperties.Xfrm != nil && shape.ShapeProperties.Xfrm.Extents != nil {
+		ext := shape.ShapeProperties.Xfrm.Extents
+		bodyPr.NormAutofit = nil
+		width, height := getTextArea(shape.TextBody, ext)
+		if textHeight := measureTextHeight(shape.TextBody, width, f.textMetrics()); textHeight > height {
+			ext.CY += textHeight - height
+		}
+	}
+	bodyPr.NoAutofit, bodyPr.NormAutofit, bodyPr.SpAutoFit = nil, nil, &SpAutoFit{}
+	return nil
+}
+
+// getTextArea returns the width and height of the text area in EMUs by given
+// text body and the extents of the shape, the insets of the text body are
+// excluded.
+func getTextArea(dt *DecodeTextBody, ext *Extents) (int, int) {
+	insets := [4]int{defaultTextInsetX, defaultTextInsetY, defaultTextInsetX, defaultTextInsetY}
+	if bodyPr := dt.BodyProperties; bodyPr != nil {
+		for i, inset := range []*int{bodyPr.LIns, bodyPr.TIns, bodyPr.RIns, bodyPr.BIns} {
+			if inset != nil {
+				insets[i] = *inset
+			}
+		}
+	}
+	return max(ext.CX-insets[0]-insets[2], 0), max(ext.CY-insets[1]-insets[3], 0)
+}
+
+// measureTextHeight returns the estimated height in EMUs of the text body
+// laid out in the text area by given width in EMUs and text metrics. The
+// words are wrapped into the lines unless the wrapping is disabled, and the
+// height of the line is 1.2 times of the largest font size in the line.
+func measureTextHeight(dt *DecodeTextBody, width int, metrics TextMetrics) int {
+	scale, wrap := 1.0, true
+	if bodyPr := dt.BodyProperties; bodyPr != nil {
+		if bodyPr.NormAutofit != nil {
+			if fontScale, err := strconv.Atoi(bodyPr.NormAutofit.FontScale); err == nil {
+				scale = float64(fontScale) / 100000
+			}
+		}
+		wrap = bodyPr.Wrap == nil || *bodyPr.Wrap != "none"
+	}
+	var height float64
+	for _, p := range dt.Paragraph {
+		defSize := defaultFontSize
+		if p.EndParagraphRunProperties != nil && p.EndParagraphRunProperties.Size != nil {
+			defSize = *p.EndParagraphRunProperties.Size
+		}
+		lineSpacing := 1.2
+		if pPr := p.ParagraphProperties; pPr != nil && pPr.LineSpacing != nil && pPr.LineSpacing.SpacingPercent != nil {
+			lineSpacing *= float64(pPr.LineSpacing.SpacingPercent.Val) / 100000
+		}
+		available := float64(width-p.level()*457200) / EMUPerPoint
+		var lines, lineWidth, lineSize float64
+		newLine := func() {
+			lines += lineSize
+			lineWidth, lineSize = 0, 0
+		}
+		for _, r := range p.Runs {
+			size, font := float64(defSize)/100*scale, ""
+			if rPr := r.RunProperties; rPr != nil {
+				if rPr.Size != nil {
+					size = float64(*rPr.Size) / 100 * scale
+				}
+				if rPr.Latin != nil {
+					font = rPr.Latin.Typeface
+				}
+			}
+			for _, word := range strings.SplitAfter(r.Text, " ") {
+				w := metrics.TextWidth(word, font, size)
+				if wrap && lineWidth > 0 && lineWidth+w > available {
+					newLine()
+				}
+				for wrap && w > available && available > 0 {
+					lineSize = max(lineSize, size)
+					newLine()
+					w -= available
+				}
+				lineWidth, lineSize = lineWidth+w, max(lineSize, size)
+			}
+		}
+		if lineSize == 0 && lines == 0 {
+			lineSize = float64(defSize) / 100 * scale
+		}
+		newLine()
+		height += lines * lineSpacing
+	}
+	return int(height * EMUPerPoint)
+}
+
+// TextWidth returns the approximate width in points of the text by given
+// text, font name and font size in points, the widths of the characters are
+// estimated by the classes of the characters in the common sans-serif fonts.
+func (defaultTextMetrics) TextWidth(text, font string, size float64) float64 {
+	var width float64
+	for _, r := range text {
+		switch {
+		case strings.ContainsRune("iljtfI.,;:'|!() ", r):
+			width += 0.3
+		case strings.ContainsRune("mwMW@", r):
+			width += 0.85
+		case unicode.Is(unicode.Han, r) || unicode.Is(unicode.Hangul, r) ||
+			unicode.Is(unicode.Hiragana, r) || unicode.Is(unicode.Katakana, r):
+			width += 1
+		case unicode.IsUpper(r):
+			width += 0.67
+		default:
+			width += 0.52
+		}
+	}
+	return width * size
+}
@@ -0,0 +1,93 @@
+package gopptx
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestCheckTextOverflow(t *testing.T) {
+	f := NewFile()
+	slideID := f.GetSlideList()[0]
+	lines := strings.Split(strings.Repeat("Line\n", 10), "\n")
+	lines = lines[:len(lines)-1]
+	words := strings.TrimSpace(strings.Repeat("word ", 8))
+	noWrap := "none"
+	for _, c := range []struct {
+		name       string
+		paragraphs []string
+		bodyPr     *DecodeBodyProperties
+	}{
+		{name: "Fit", paragraphs: []string{"Hello"}},
+		{name: "Paragraphs", paragraphs: lines},
+		{name: "Resized", paragraphs: lines, bodyPr: &DecodeBodyProperties{SpAutoFit: &SpAutoFit{}}},
+		{name: "Shrunk", paragraphs: lines, bodyPr: &DecodeBodyProperties{NormAutofit: &NormAutofit{FontScale: "25000"}}},
+		{name: "Wrapped", paragraphs: []string{words}},
+		{name: "Unwrapped", paragraphs: []string{words}, bodyPr: &DecodeBodyProperties{Wrap: &noWrap}},
+	} {
+		shape := addTestShape(t, f, slideID, "", c.paragraphs...)
+		shape.NonVisualShapeProperties.CommonNonVisualProperties.Name = c.name
+		shape.TextBody.BodyProperties = c.bodyPr
+	}
+	overflows, err := f.CheckTextOverflow()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []TextOverflow{
+		{SlideID: slideID, ShapeID: 10, ShapeName: "Paragraphs", Height: 822960, TextHeight: 2743199},
+		{SlideID: slideID, ShapeID: 13, ShapeName: "Wrapped", Height: 822960, TextHeight: 1097280},
+	}
+	if !reflect.DeepEqual(overflows, expected) {
+		t.Errorf("expected the overflows %+v, got %+v", expected, overflows)
+	}
+}
+
+// testTextMetrics defines the text metrics which records the measured runs and
+// returns the zero width, so the paragraphs are never wrapped.
+type testTextMetrics struct {
+	runs []string
+}
+
+func (m *testTextMetrics) TextWidth(text, font string, size float64) float64 {
+	m.runs = append(m.runs, fmt.Sprintf("%s|%s|%g", text, font, size))
+	return 0
+}
+
+func TestFitShapeToText(t *testing.T) {
+	metrics := &testTextMetrics{}
+	for _, c := range []struct {
+		opts     []Option
+		expected int
+	}{
+		{expected: 14356080},
+		{opts: []Option{WithTextMetrics(metrics)}, expected: 914400},
+	} {
+		f := NewFile(c.opts...)
+		slideID := f.GetSlideList()[0]
+		shape := addTestShape(t, f, slideID, "", strings.TrimSpace(strings.Repeat("word ", 40)))
+		shape.NonVisualShapeProperties.CommonNonVisualProperties.Name = "Notes"
+		shape.TextBody.BodyProperties = &DecodeBodyProperties{NormAutofit: &NormAutofit{FontScale: "50000"}}
+		size := 2400
+		shape.TextBody.Paragraph[0].Runs[0].RunProperties = &DecodeRunProperties{Size: &size, Latin: &Latin{Typeface: "Georgia"}}
+		if err := f.FitShapeToText(slideID, "Notes"); err != nil {
+			t.Fatal(err)
+		}
+		if shape.ShapeProperties.Xfrm.Extents.CY != c.expected {
+			t.Errorf("expected the height %d of the shape, got %d", c.expected, shape.ShapeProperties.Xfrm.Extents.CY)
+		}
+		if bodyPr := shape.TextBody.BodyProperties; bodyPr.SpAutoFit == nil || bodyPr.NormAutofit != nil || bodyPr.NoAutofit != nil {
+			t.Errorf("expected the shape is resized to fit the text, got %+v", bodyPr)
+		}
+		if overflows, err := f.CheckTextOverflow(); err != nil || len(overflows) != 0 {
+			t.Errorf("expected no overflows, got %v %v", overflows, err)
+		}
+		if err := f.FitShapeToText(slideID, "Title"); !errors.Is(err, ErrShapeNameNotExist{SlideID: slideID, Name: "Title"}) {
+			t.Errorf("expected ErrShapeNameNotExist, got %v", err)
+		}
+	}
+	if len(metrics.runs) != 40 || metrics.runs[0] != "word |Georgia|24" || metrics.runs[39] != "word|Georgia|24" {
+		t.Errorf("expected the words are measured by the text metrics, got %v", metrics.runs)
+	}
+}
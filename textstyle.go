@@ -0,0 +1,260 @@
+// Copyright 2026 kenny-not-dead. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+//
+// Package gopptx provides functionality to create and manipulate PowerPoint
+// (.pptx) files in Go, using the Office Open XML (ECMA-376) format.
+
+package gopptx
+
+import (
+	"strconv"
+	"strings"
+)
+
+// SlideMasterProperties directly maps the properties of the slide master. The
+// title, body and other styles are the default text styles of the title
+// placeholders, the other placeholders and the other text of the slides using
+// the slide master, the styles hold the outline levels defined in the slide
+// master.
+type SlideMasterProperties struct {
+	Name       string
+	TitleStyle []TextLevelStyle
+	BodyStyle  []TextLevelStyle
+	OtherStyle []TextLevelStyle
+}
+
+// TextLevelStyle directly maps the paragraph and the text run properties of
+// the outline level of the text style, the level is the zero-based outline
+// level. The align is one of "l", "ctr", "r" or "just", the left margin and
+// the indent of the first line are specified in EMUs. The bullet is the
+// bullet character, or "none" for no bullet. The font size is specified in
+// points, the font is the Latin typeface, the color is the hex RGB color, and
+// the scheme color is the name of the theme color, such as "tx1" or
+// "accent1". The empty or nil values are inherited or kept as is.
+type TextLevelStyle struct {
+	Level       int
+	Align       string
+	MarginLeft  *int
+	Indent      *int
+	Bullet      string
+	BulletFont  string
+	FontSize    float64
+	Font        string
+	Color       string
+	SchemeColor string
+	Bold        *bool
+	Italic      *bool
+}
+
+// GetSlideMasterProperties provides a function to get the properties of the
+// slide master by given zero-based index of the master in the order of the
+// master parts, including the default text styles of the slides using the
+// slide master. For example, print the bullets of the body text:
+//
+//	props, err := f.GetSlideMasterProperties(0)
+//	if err != nil {
+//	    fmt.Println(err)
+//	    return
+//	}
+//	for _, style := range props.BodyStyle {
+//	    fmt.Println(style.Level, style.Bullet, style.FontSize)
+//	}
+func (f *File) GetSlideMasterProperties(index int) (SlideMasterProperties, error) {
+	var props SlideMasterProperties
+	paths := f.getSlideMasterPaths()
+	if index < 0 || index >= len(paths) {
+		return props, ErrSlideMasterNotExist{index}
+	}
+	master, err := f.xmlNodeReader(f.readXML(paths[index]))
+	if err != nil {
+		return props, err
+	}
+	props.Name = master.find("cSld").attr("name")
+	props.TitleStyle = getTextLevelStyles(master.find("txStyles", "titleStyle"))
+	props.BodyStyle = getTextLevelStyles(master.find("txStyles", "bodyStyle"))
+	props.OtherStyle = getTextLevelStyles(master.find("txStyles", "otherStyle"))
+	return props, nil
+}
+
+// SetSlideMasterProperties provides a function to set the properties of the
+// slide master by given zero-based index of the master in the order of the
+// master parts and the properties. The name is kept if it is empty, and only
+// the outline levels in the styles are changed, so the default bullets,
+// sizes and colors of the text can be adjusted for the whole presentation
+// without editing the slides. For example, use the dash bullets in the
+// accent color for the first level of the body text:
+//
+//	bold := true
+//	err := f.SetSlideMasterProperties(0, &gopptx.SlideMasterProperties{
+//	    BodyStyle: []gopptx.TextLevelStyle{
+//	        {Level: 0, Bullet: "–", FontSize: 24, SchemeColor: "accent1", Bold: &bold},
+//	    },
+//	})
+func (f *File) SetSlideMasterProperties(index int, props *SlideMasterProperties) error {
+	if err := f.checkReadOnly(); err != nil {
+		return err
+	}
+	paths := f.getSlideMasterPaths()
+	if index < 0 || index >= len(paths) {
+		return ErrSlideMasterNotExist{index}
+	}
+	if props == nil {
+		return nil
+	}
+	for _, styles := range [][]TextLevelStyle{props.TitleStyle, props.BodyStyle, props.OtherStyle} {
+		for _, style := range styles {
+			if style.Level < 0 || style.Level > 8 {
+				return ErrTextLevelStyle
+			}
+			if style.Color != "" && !hexColorExp.MatchString(strings.TrimPrefix(style.Color, "#")) {
+				return ErrColorInvalid
+			}
+		}
+	}
+	master, err := f.xmlNodeReader(f.readXML(paths[index]))
+	if err != nil {
+		return err
+	}
+	if cSld := master.find("cSld"); cSld != nil && props.Name != "" {
+		cSld.setAttr("name", props.Name)
+	}
+	p, a := master.prefix(NameSpacePresentationML.Value), master.prefix(NameSpaceDrawingML.Value)
+	if a == "" {
+		a = "a:"
+		master.setAttr("xmlns:a", NameSpaceDrawingML.Value)
+	}
+	for i, styles := range [][]TextLevelStyle{props.TitleStyle, props.BodyStyle, props.OtherStyle} {
+		if len(styles) == 0 {
+			continue
+		}
+		txStyles := master.find("txStyles")
+		if txStyles == nil {
+			txStyles = newXMLNode(p + "txStyles")
+			master.insert(txStyles, p+"extLst")
+		}
+		name := []string{"titleStyle", "bodyStyle", "otherStyle"}[i]
+		style := txStyles.find(name)
+		if style == nil {
+			style = newXMLNode(p + name)
+			txStyles.insert(style, []string{p + "bodyStyle", p + "otherStyle", p + "extLst"}[i:]...)
+		}
+		for _, ts := range styles {
+			setTextLevelStyle(style, a, ts)
+		}
+	}
+	f.saveFileList(paths[index], master.bytes())
+	return nil
+}
+
+// getTextLevelStyles returns the outline levels of the text style by given
+// text style element.
+func getTextLevelStyles(style *xmlNode) []TextLevelStyle {
+	var styles []TextLevelStyle
+	for level := 0; level < 9 && style != nil; level++ {
+		pPr := style.find("lvl" + strconv.Itoa(level+1) + "pPr")
+		if pPr == nil {
+			continue
+		}
+		ts := TextLevelStyle{Level: level, Align: pPr.attr("algn"), BulletFont: pPr.find("buFont").attr("typeface")}
+		if marL, err := strconv.Atoi(pPr.attr("marL")); err == nil {
+			ts.MarginLeft = &marL
+		}
+		if indent, err := strconv.Atoi(pPr.attr("indent")); err == nil {
+			ts.Indent = &indent
+		}
+		if pPr.find("buNone") != nil {
+			ts.Bullet = "none"
+		} else {
+			ts.Bullet = pPr.find("buChar").attr("char")
+		}
+		defRPr := pPr.find("defRPr")
+		if size, err := strconv.Atoi(defRPr.attr("sz")); err == nil {
+			ts.FontSize = float64(size) / 100
+		}
+		if b := defRPr.attr("b"); b != "" {
+			bold := b == "1" || b == "true"
+			ts.Bold = &bold
+		}
+		if i := defRPr.attr("i"); i != "" {
+			italic := i == "1" || i == "true"
+			ts.Italic = &italic
+		}
+		ts.Font = defRPr.find("latin").attr("typeface")
+		ts.Color = defRPr.find("solidFill", "srgbClr").attr("val")
+		ts.SchemeColor = defRPr.find("solidFill", "schemeClr").attr("val")
+		styles = append(styles, ts)
+	}
+	return styles
+}
+
+// setTextLevelStyle sets the non-empty properties of the outline level of the
+// text style by given text style element, the prefix of the DrawingML
+// namespace and the outline level style. The elements are inserted in the
+// order of the schema.
+func setTextLevelStyle(style *xmlNode, a string, ts TextLevelStyle) {
+	var levels []string
+	for level := ts.Level + 2; level <= 9; level++ {
+		levels = append(levels, a+"lvl"+strconv.Itoa(level)+"pPr")
+	}
+	pPr := style.child(a + "lvl" + strconv.Itoa(ts.Level+1) + "pPr")
+	if pPr == nil {
+		pPr = newXMLNode(a + "lvl" + strconv.Itoa(ts.Level+1) + "pPr")
+		style.insert(pPr, append(levels, a+"extLst")...)
+	}
+	if ts.Align != "" {
+		pPr.setAttr("algn", ts.Align)
+	}
+	if ts.MarginLeft != nil {
+		pPr.setAttr("marL", strconv.Itoa(*ts.MarginLeft))
+	}
+	if ts.Indent != nil {
+		pPr.setAttr("indent", strconv.Itoa(*ts.Indent))
+	}
+	if ts.BulletFont != "" {
+		pPr.remove(a+"buFontTx", a+"buFont")
+		pPr.insert(newXMLNode(a+"buFont", "typeface", ts.BulletFont),
+			a+"buNone", a+"buAutoNum", a+"buChar", a+"buBlip", a+"tabLst", a+"defRPr", a+"extLst")
+	}
+	if ts.Bullet != "" {
+		pPr.remove(a+"buNone", a+"buAutoNum", a+"buChar", a+"buBlip")
+		bullet := newXMLNode(a+"buChar", "char", ts.Bullet)
+		if ts.Bullet == "none" {
+			bullet = newXMLNode(a + "buNone")
+		}
+		pPr.insert(bullet, a+"tabLst", a+"defRPr", a+"extLst")
+	}
+	if ts.FontSize <= 0 && ts.Bold == nil && ts.Italic == nil && ts.Color == "" && ts.SchemeColor == "" && ts.Font == "" {
+		return
+	}
+	defRPr := pPr.child(a + "defRPr")
+	if defRPr == nil {
+		defRPr = newXMLNode(a + "defRPr")
+		pPr.insert(defRPr, a+"extLst")
+	}
+	if ts.FontSize > 0 {
+		defRPr.setAttr("sz", strconv.Itoa(int(ts.FontSize*100)))
+	}
+	if ts.Bold != nil {
+		defRPr.setAttr("b", boolToVal(*ts.Bold))
+	}
+	if ts.Italic != nil {
+		defRPr.setAttr("i", boolToVal(*ts.Italic))
+	}
+	if ts.Color != "" || ts.SchemeColor != "" {
+		fill := newXMLNode(a + "solidFill")
+		if ts.Color != "" {
+			fill.Children = []*xmlNode{newXMLNode(a+"srgbClr", "val", strings.ToUpper(strings.TrimPrefix(ts.Color, "#")))}
+		} else {
+			fill.Children = []*xmlNode{newXMLNode(a+"schemeClr", "val", ts.SchemeColor)}
+		}
+		defRPr.remove(a+"noFill", a+"solidFill", a+"gradFill", a+"blipFill", a+"pattFill", a+"grpFill")
+		defRPr.insert(fill, a+"effectLst", a+"effectDag", a+"highlight", a+"uLnTx", a+"uLn", a+"uFillTx", a+"uFill",
+			a+"latin", a+"ea", a+"cs", a+"sym", a+"hlinkClick", a+"hlinkMouseOver", a+"rtl", a+"extLst")
+	}
+	if ts.Font != "" {
+		defRPr.remove(a + "latin")
+		defRPr.insert(newXMLNode(a+"latin", "typeface", ts.Font),
+			a+"ea", a+"cs", a+"sym", a+"hlinkClick", a+"hlinkMouseOver", a+"rtl", a+"extLst")
+	}
+}
@@ -0,0 +1,73 @@
+package gopptx
+
+import (
+	"errors"
+	"reflect"
+	"regexp"
+	"testing"
+)
+
+func TestSetSlideMasterProperties(t *testing.T) {
+	f := NewFile()
+	marL, indent, bold, italic := 342900, -342900, true, false
+	for _, props := range []*SlideMasterProperties{
+		nil,
+		{BodyStyle: []TextLevelStyle{
+			{Level: 8, Bullet: "none", Color: "#c00000", Italic: &italic},
+			{Level: 0, Bullet: "•", FontSize: 20, SchemeColor: "tx1"},
+		}},
+		{Name: "Corporate", TitleStyle: []TextLevelStyle{{Level: 0, Align: "ctr", FontSize: 44, Font: "Georgia"}}},
+		{BodyStyle: []TextLevelStyle{{Level: 0, Align: "l", MarginLeft: &marL, Indent: &indent, Bullet: "–", BulletFont: "Arial", SchemeColor: "accent1", Bold: &bold}}},
+	} {
+		if err := f.SetSlideMasterProperties(0, props); err != nil {
+			t.Fatal(err)
+		}
+	}
+	buf, err := f.WriteToBuffer()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f, err = OpenReader(buf); err != nil {
+		t.Fatal(err)
+	}
+	props, err := f.GetSlideMasterProperties(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := SlideMasterProperties{
+		Name:       "Corporate",
+		TitleStyle: []TextLevelStyle{{Level: 0, Align: "ctr", FontSize: 44, Font: "Georgia"}},
+		BodyStyle: []TextLevelStyle{
+			{Level: 0, Align: "l", MarginLeft: &marL, Indent: &indent, Bullet: "–", BulletFont: "Arial", FontSize: 20, SchemeColor: "accent1", Bold: &bold},
+			{Level: 8, Bullet: "none", Color: "C00000", Italic: &italic},
+		},
+	}
+	if !reflect.DeepEqual(props, expected) {
+		t.Errorf("expected the slide master properties %+v, got %+v", expected, props)
+	}
+	if !regexp.MustCompile(`<p:txStyles><p:titleStyle>.*</p:titleStyle><p:bodyStyle><a:lvl1pPr [^>]*><a:buFont typeface="Arial"/><a:buChar char="–"/><a:defRPr [^>]*><a:solidFill>.*</a:lvl1pPr><a:lvl9pPr><a:buNone/>.*</p:bodyStyle></p:txStyles>`).
+		Match(f.readXML("ppt/slideMasters/slideMaster1.xml")) {
+		t.Errorf("unexpected order of the text styles, got %s", f.readXML("ppt/slideMasters/slideMaster1.xml"))
+	}
+	if err = f.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	for _, c := range []struct {
+		index    int
+		props    *SlideMasterProperties
+		expected error
+	}{
+		{index: 1, props: &SlideMasterProperties{}, expected: ErrSlideMasterNotExist{1}},
+		{index: -1, expected: ErrSlideMasterNotExist{-1}},
+		{props: &SlideMasterProperties{OtherStyle: []TextLevelStyle{{Level: 9}}}, expected: ErrTextLevelStyle},
+		{props: &SlideMasterProperties{TitleStyle: []TextLevelStyle{{Color: "red"}}}, expected: ErrColorInvalid},
+	} {
+		if err = f.SetSlideMasterProperties(c.index, c.props); !errors.Is(err, c.expected) {
+			t.Errorf("expected %v, got %v", c.expected, err)
+		}
+	}
+	if _, err = f.GetSlideMasterProperties(1); !errors.Is(err, ErrSlideMasterNotExist{1}) {
+		t.Errorf("expected ErrSlideMasterNotExist, got %v", err)
+	}
+}
@@ -9,9 +9,17 @@ package gopptx
 
 import (
 	"bytes"
+	"fmt"
 	"io"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
 )
 
+// hexColorExp matches the hex RGB color.
+var hexColorExp = regexp.MustCompile(`^[0-9A-Fa-f]{6}$`)
+
 // themeReader provides a function to get the pointer to the xl/theme/theme1.xml
 // structure after deserialization.
 func (f *File) themeReader() (*decodeTheme, error) {
@@ -25,3 +33,275 @@ func (f *File) themeReader() (*decodeTheme, error) {
 	}
 	return &theme, nil
 }
+
+// ThemeColorScheme directly maps the color scheme of the theme, the colors
+// are the hex RGB colors such as "4472C4". The empty colors are kept as is
+// on setting the color scheme.
+type ThemeColorScheme struct {
+	Dark1             string
+	Light1            string
+	Dark2             string
+	Light2            string
+	Accent1           string
+	Accent2           string
+	Accent3           string
+	Accent4           string
+	Accent5           string
+	Accent6           string
+	Hyperlink         string
+	FollowedHyperlink string
+}
+
+// ThemeFonts directly maps the Latin typefaces of the font scheme of the
+// theme, the major font is used by the headings and the minor font is used by
+// the body text. The empty typefaces are kept as is on setting the fonts.
+type ThemeFonts struct {
+	Major string
+	Minor string
+}
+
+// colors returns the pointers to the colors of the color scheme in the
+// order of the color slots of the theme.
+func (cs *ThemeColorScheme) colors() []*string {
+	return []*string{
+		&cs.Dark1, &cs.Light1, &cs.Dark2, &cs.Light2, &cs.Accent1, &cs.Accent2, &cs.Accent3,
+		&cs.Accent4, &cs.Accent5, &cs.Accent6, &cs.Hyperlink, &cs.FollowedHyperlink,
+	}
+}
+
+// colors returns the pointers to the color slots of the theme color scheme.
+func (dcs *decodeColorScheme) colors() []*decodeComplexTypeColor {
+	return []*decodeComplexTypeColor{
+		&dcs.Dk1, &dcs.Lt1, &dcs.Dk2, &dcs.Lt2, &dcs.Accent1, &dcs.Accent2, &dcs.Accent3,
+		&dcs.Accent4, &dcs.Accent5, &dcs.Accent6, &dcs.Hlink, &dcs.FolHlink,
+	}
+}
+
+// GetThemeColorScheme provides a function to get the color scheme of the
+// theme, the system colors are returned by the last computed colors. For
+// example:
+//
+//	scheme, err := f.GetThemeColorScheme()
+//	if err != nil {
+//	    fmt.Println(err)
+//	    return
+//	}
+//	fmt.Println(scheme.Accent1)
+func (f *File) GetThemeColorScheme() (ThemeColorScheme, error) {
+	var scheme ThemeColorScheme
+	if f.Theme == nil {
+		return scheme, nil
+	}
+	slots := f.Theme.ThemeElements.ColorScheme.colors()
+	for i, clr := range scheme.colors() {
+		switch slot := slots[i]; {
+		case slot.SrgbColor != nil && slot.SrgbColor.Val != nil:
+			*clr = strings.ToUpper(*slot.SrgbColor.Val)
+		case slot.SystemColor != nil:
+			*clr = strings.ToUpper(slot.SystemColor.LastClr)
+		}
+	}
+	return scheme, nil
+}
+
+// SetThemeColorScheme provides a function to set the colors of the color
+// scheme of the theme, the empty colors are kept as is. For example:
+//
+//	err := f.SetThemeColorScheme(&gopptx.ThemeColorScheme{
+//	    Accent1: "C00000",
+//	    Accent2: "FFC000",
+//	})
+func (f *File) SetThemeColorScheme(scheme *ThemeColorScheme) error {
+	if err := f.checkReadOnly(); err != nil {
+		return err
+	}
+	if f.Theme == nil {
+		return ErrThemeNotExist
+	}
+	colors := scheme.colors()
+	for _, clr := range colors {
+		if *clr != "" && !hexColorExp.MatchString(strings.TrimPrefix(*clr, "#")) {
+			return ErrColorInvalid
+		}
+	}
+	for i, slot := range f.Theme.ThemeElements.ColorScheme.colors() {
+		if val := strings.ToUpper(strings.TrimPrefix(*colors[i], "#")); val != "" {
+			*slot = decodeComplexTypeColor{SrgbColor: &srgbColor{Val: &val}}
+		}
+	}
+	return nil
+}
+
+// SetThemeFonts provides a function to set the Latin typefaces of the major
+// and minor fonts of the theme, the empty typefaces are kept as is. For
+// example:
+//
+//	err := f.SetThemeFonts(&gopptx.ThemeFonts{Major: "Georgia", Minor: "Verdana"})
+func (f *File) SetThemeFonts(fonts *ThemeFonts) error {
+	if err := f.checkReadOnly(); err != nil {
+		return err
+	}
+	if f.Theme == nil {
+		return ErrThemeNotExist
+	}
+	fontScheme := &f.Theme.ThemeElements.FontScheme
+	for _, font := range []struct {
+		collection *decodeFontCollection
+		typeface   string
+	}{{&fontScheme.MajorFont, fonts.Major}, {&fontScheme.MinorFont, fonts.Minor}} {
+		if font.typeface == "" {
+			continue
+		}
+		font.collection.Latin = &complexTypeTextFont{Typeface: font.typeface}
+	}
+	return nil
+}
+
+// This section defines the modes of the theme variant.
+const (
+	// ThemeVariantKeep keeps the light or dark background of the color
+	// scheme.
+	ThemeVariantKeep ThemeVariantMode = iota
+	// ThemeVariantLight makes the light colors the background colors.
+	ThemeVariantLight
+	// ThemeVariantDark makes the dark colors the background colors.
+	ThemeVariantDark
+)
+
+// ThemeVariantMode defined the type of the mode of the theme variant.
+type ThemeVariantMode byte
+
+// ThemeVariantTransform directly maps the settings of the theme variant. The
+// mode swaps the dark and light colors of the color scheme for the light or
+// dark background. The hue rotation is specified in degrees and is applied to
+// all colors of the color scheme. The minimum contrast is the WCAG contrast
+// ratio between the text colors and the background colors with default 4.5,
+// the accents use the ratio 3 for the graphical objects.
+type ThemeVariantTransform struct {
+	Mode        ThemeVariantMode
+	HueRotation float64
+	MinContrast float64
+}
+
+// GenerateThemeVariant provides a function to generate the variant of the
+// color scheme of the theme by given transform, the theme isn't changed. The
+// lightness of the text colors, the hyperlinks and the accents is adjusted to
+// meet the WCAG contrast ratio against the background. For example, create
+// the dark variant of the template with the hue rotated by 180 degrees:
+//
+//	scheme, err := f.GenerateThemeVariant(gopptx.ThemeVariantTransform{
+//	    Mode:        gopptx.ThemeVariantDark,
+//	    HueRotation: 180,
+//	})
+//	if err != nil {
+//	    fmt.Println(err)
+//	    return
+//	}
+//	if err := f.SetThemeColorScheme(&scheme); err != nil {
+//	    fmt.Println(err)
+//	    return
+//	}
+//	if err := f.SaveAs("dark.pptx"); err != nil {
+//	    fmt.Println(err)
+//	}
+func (f *File) GenerateThemeVariant(transform ThemeVariantTransform) (ThemeColorScheme, error) {
+	if err := f.checkReadOnly(); err != nil {
+		return ThemeColorScheme{}, err
+	}
+	if f.Theme == nil {
+		return ThemeColorScheme{}, ErrThemeNotExist
+	}
+	scheme, err := f.GetThemeColorScheme()
+	if err != nil {
+		return scheme, err
+	}
+	for _, clr := range scheme.colors() {
+		if !hexColorExp.MatchString(*clr) {
+			return scheme, ErrColorInvalid
+		}
+		if transform.HueRotation != 0 {
+			*clr = rotateHue(*clr, transform.HueRotation)
+		}
+	}
+	dark := getRelativeLuminance(scheme.Light1) < getRelativeLuminance(scheme.Dark1)
+	if transform.Mode == ThemeVariantLight && dark || transform.Mode == ThemeVariantDark && !dark {
+		scheme.Dark1, scheme.Light1 = scheme.Light1, scheme.Dark1
+		scheme.Dark2, scheme.Light2 = scheme.Light2, scheme.Dark2
+	}
+	minContrast := transform.MinContrast
+	if minContrast <= 0 {
+		minContrast = 4.5
+	}
+	scheme.Dark1 = ensureContrast(scheme.Dark1, scheme.Light1, minContrast)
+	scheme.Dark2 = ensureContrast(scheme.Dark2, scheme.Light2, minContrast)
+	scheme.Hyperlink = ensureContrast(scheme.Hyperlink, scheme.Light1, minContrast)
+	scheme.FollowedHyperlink = ensureContrast(scheme.FollowedHyperlink, scheme.Light1, minContrast)
+	for _, clr := range []*string{
+		&scheme.Accent1, &scheme.Accent2, &scheme.Accent3, &scheme.Accent4, &scheme.Accent5, &scheme.Accent6,
+	} {
+		*clr = ensureContrast(*clr, scheme.Light1, min(minContrast, 3))
+	}
+	return scheme, nil
+}
+
+// hexToRGB returns the red, green and blue components between 0 and 1 by
+// given hex RGB color.
+func hexToRGB(clr string) (float64, float64, float64) {
+	rgb, _ := strconv.ParseUint(clr, 16, 32)
+	return float64(rgb>>16&0xFF) / 255, float64(rgb>>8&0xFF) / 255, float64(rgb&0xFF) / 255
+}
+
+// rgbToHex returns the hex RGB color by given red, green and blue components
+// between 0 and 1.
+func rgbToHex(r, g, b float64) string {
+	to := func(c float64) int { return int(math.Round(math.Min(math.Max(c, 0), 1) * 255)) }
+	return fmt.Sprintf("%02X%02X%02X", to(r), to(g), to(b))
+}
+
+// rotateHue returns the hex RGB color with the hue rotated by given hex RGB
+// color and the rotation in degrees.
+func rotateHue(clr string, degrees float64) string {
+	h, s, l := rgbToHSL(hexToRGB(clr))
+	return rgbToHex(hslToRGB(h+degrees/360, s, l))
+}
+
+// getRelativeLuminance returns the relative luminance of the hex RGB color
+// defined by WCAG 2.
+func getRelativeLuminance(clr string) float64 {
+	linear := func(c float64) float64 {
+		if c <= 0.03928 {
+			return c / 12.92
+		}
+		return math.Pow((c+0.055)/1.055, 2.4)
+	}
+	r, g, b := hexToRGB(clr)
+	return 0.2126*linear(r) + 0.7152*linear(g) + 0.0722*linear(b)
+}
+
+// getContrastRatio returns the WCAG contrast ratio between two hex RGB
+// colors, which is between 1 and 21.
+func getContrastRatio(clr1, clr2 string) float64 {
+	l1, l2 := getRelativeLuminance(clr1), getRelativeLuminance(clr2)
+	return (math.Max(l1, l2) + 0.05) / (math.Min(l1, l2) + 0.05)
+}
+
+// ensureContrast returns the hex RGB color with the lightness adjusted to
+// meet the contrast ratio against the background by given hex RGB color, the
+// background color and the contrast ratio. The color is lightened on the
+// dark background and darkened on the light background.
+func ensureContrast(clr, background string, ratio float64) string {
+	if getContrastRatio(clr, background) >= ratio {
+		return clr
+	}
+	h, s, l := rgbToHSL(hexToRGB(clr))
+	step := -0.01
+	if getContrastRatio("FFFFFF", background) > getContrastRatio("000000", background) {
+		step = 0.01
+	}
+	for l = l + step; l >= 0 && l <= 1; l += step {
+		if clr = rgbToHex(hslToRGB(h, s, l)); getContrastRatio(clr, background) >= ratio {
+			return clr
+		}
+	}
+	return rgbToHex(hslToRGB(h, s, math.Min(math.Max(l, 0), 1)))
+}
@@ -0,0 +1,49 @@
+package gopptx
+
+import "testing"
+
+func TestGenerateThemeVariant(t *testing.T) {
+	f := NewFile()
+	original, err := f.GetThemeColorScheme()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, c := range []struct {
+		transform  ThemeVariantTransform
+		background string
+		accent2    string
+	}{
+		{transform: ThemeVariantTransform{}, background: "FFFFFF", accent2: "0369A3"},
+		{transform: ThemeVariantTransform{Mode: ThemeVariantLight}, background: "FFFFFF", accent2: "0369A3"},
+		{transform: ThemeVariantTransform{Mode: ThemeVariantDark}, background: "000000", accent2: "0369A3"},
+		{transform: ThemeVariantTransform{Mode: ThemeVariantDark, HueRotation: 180, MinContrast: 7}, background: "000000", accent2: "A33D03"},
+	} {
+		scheme, err := f.GenerateThemeVariant(c.transform)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if scheme.Light1 != c.background || scheme.Accent2 != c.accent2 {
+			t.Errorf("expected the background %s and the accent %s, got %s %s", c.background, c.accent2, scheme.Light1, scheme.Accent2)
+		}
+		minContrast := c.transform.MinContrast
+		if minContrast == 0 {
+			minContrast = 4.5
+		}
+		for _, pair := range [][2]string{
+			{scheme.Dark1, scheme.Light1}, {scheme.Dark2, scheme.Light2},
+			{scheme.Hyperlink, scheme.Light1}, {scheme.FollowedHyperlink, scheme.Light1},
+		} {
+			if ratio := getContrastRatio(pair[0], pair[1]); ratio < minContrast {
+				t.Errorf("expected the contrast ratio of %s on %s at least %g, got %g", pair[0], pair[1], minContrast, ratio)
+			}
+		}
+		for _, accent := range []string{scheme.Accent1, scheme.Accent2, scheme.Accent3, scheme.Accent4, scheme.Accent5, scheme.Accent6} {
+			if ratio := getContrastRatio(accent, scheme.Light1); ratio < 3 {
+				t.Errorf("expected the contrast ratio of %s on %s at least 3, got %g", accent, scheme.Light1, ratio)
+			}
+		}
+	}
+	if scheme, err := f.GetThemeColorScheme(); err != nil || scheme != original {
+		t.Errorf("expected the theme isn't changed, got %v %v", scheme, err)
+	}
+}
@@ -0,0 +1,167 @@
+// Copyright 2026 kenny-not-dead. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+//
+// Package gopptx provides functionality to create and manipulate PowerPoint
+// (.pptx) files in Go, using the Office Open XML (ECMA-376) format.
+
+package gopptx
+
+import (
+	"sort"
+	"strings"
+	"time"
+)
+
+// TimelineEvent directly maps the event of the timeline. The milestone
+// events are marked by the diamonds and the other events by the circles.
+type TimelineEvent struct {
+	Date      time.Time
+	Label     string
+	Milestone bool
+}
+
+// TimelineOptions directly maps the settings of the timeline. The offset and
+// size of the bounding box of the timeline are specified in EMUs, the
+// bounding box is the middle half of the slide inside the 0.5 inch margins if
+// the width or height is zero. The color is the hex RGB color of the axis and
+// the markers with default "4472C4". The date format is the layout of the
+// dates on the axis used by the time package with default "Jan 2, 2006", and
+// the font size of the labels is in points with default 12.
+type TimelineOptions struct {
+	OffsetX    int
+	OffsetY    int
+	Width      int
+	Height     int
+	Color      string
+	DateFormat string
+	FontSize   int
+}
+
+// AddTimeline provides a function to add the horizontal timeline of the
+// events as the group shape by given slide id, events and the timeline
+// settings, it returns the shape id of the group. The events are placed on
+// the date axis in proportion to the dates, the labels of the events are
+// placed above the axis in two alternate rows and the dates below the axis.
+// For example:
+//
+//	shapeID, err := f.AddTimeline(256, []gopptx.TimelineEvent{
+//	    {Date: time.Date(2026, 1, 15, 0, 0, 0, 0, time.UTC), Label: "Kickoff"},
+//	    {Date: time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC), Label: "Design review"},
+//	    {Date: time.Date(2026, 6, 30, 0, 0, 0, 0, time.UTC), Label: "Launch", Milestone: true},
+//	}, &gopptx.TimelineOptions{Color: "C00000", DateFormat: "Jan 2006"})
+func (f *File) AddTimeline(slideID int, events []TimelineEvent, opts *TimelineOptions) (int, error) {
+	if err := f.checkReadOnly(); err != nil {
+		return 0, err
+	}
+	slide, err := f.slideReader(slideID)
+	if err != nil {
+		return 0, err
+	}
+	if len(events) == 0 {
+		return 0, ErrTimelineEventsEmpty
+	}
+	if opts == nil {
+		opts = &TimelineOptions{}
+	}
+	box, clr, format, fontSize := *opts, strings.TrimPrefix(opts.Color, "#"), opts.DateFormat, opts.FontSize
+	if clr == "" {
+		clr = "4472C4"
+	}
+	if !hexColorExp.MatchString(clr) {
+		return 0, ErrColorInvalid
+	}
+	if format == "" {
+		format = "Jan 2, 2006"
+	}
+	if fontSize <= 0 {
+		fontSize = 12
+	}
+	if box.Width <= 0 || box.Height <= 0 {
+		slideWidth, slideHeight, err := f.getSlideSize()
+		if err != nil {
+			return 0, err
+		}
+		box.OffsetX, box.OffsetY, box.Width, box.Height = 457200, slideHeight/4, slideWidth-2*457200, slideHeight/2
+	}
+	sorted := append([]TimelineEvent(nil), events...)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Date.Before(sorted[j].Date) })
+	var (
+		count       = len(sorted)
+		labelWidth  = min(box.Width*2/(count+1), box.Width/2)
+		lineHeight  = fontSize * EMUPerPoint * 3 / 2
+		labelHeight = 2*lineHeight + 2*defaultTextInsetY
+		dateHeight  = lineHeight + 2*defaultTextInsetY
+		markerSize  = fontSize * EMUPerPoint * 4 / 3
+		axisHeight  = 2 * EMUPerPoint
+		axisY       = box.OffsetY + box.Height*2/3
+		left, right = box.OffsetX + labelWidth/2, box.OffsetX + box.Width - labelWidth/2
+		span        = sorted[count-1].Date.Sub(sorted[0].Date)
+	)
+	shapeID := slide.nextShapeID()
+	group := decodeGroupShape{
+		NonVisualGroupShapeProperties: &decodeNonVisualGroupShapeProperties{
+			CommonNonVisualProperties:           &CommonNonVisualProperties{ID: shapeID, Name: "Timeline"},
+			CommonNonVisualGroupShapeProperties: &CommonNonVisualGroupShapeProperties{},
+			NonVisualProperties:                 &decodeNonVisualProperties{},
+		},
+		GroupShapeProperties: &decodeGroupShapeProperties{Xfrm: &DecodeXfrm{
+			Offset:       &Offset{X: box.OffsetX, Y: box.OffsetY},
+			Extents:      &Extents{CX: box.Width, CY: box.Height},
+			ChildOffset:  &Offset{X: box.OffsetX, Y: box.OffsetY},
+			ChildExtents: &Extents{CX: box.Width, CY: box.Height},
+		}},
+	}
+	addShape := func(shape decodeShape) {
+		shapeID++
+		shape.NonVisualShapeProperties.CommonNonVisualProperties.ID = shapeID
+		group.Shape = append(group.Shape, shape)
+	}
+	addShape(newTimelineShape("Timeline Axis", "rect", clr,
+		Offset{X: box.OffsetX, Y: axisY - axisHeight/2}, Extents{CX: box.Width, CY: axisHeight}))
+	for i, event := range sorted {
+		x := left + (right-left)*i/max(count-1, 1)
+		if span > 0 {
+			x = left + int(float64(right-left)*float64(event.Date.Sub(sorted[0].Date))/float64(span))
+		}
+		labelY := axisY - markerSize/2 - labelHeight*(i%2+1)
+		preset := "ellipse"
+		if event.Milestone {
+			preset = "diamond"
+		}
+		addShape(newTimelineShape("Timeline Stem "+event.Label, "rect", clr,
+			Offset{X: x - EMUPerPoint/2, Y: labelY + labelHeight}, Extents{CX: EMUPerPoint, CY: axisY - labelY - labelHeight}))
+		addShape(newTimelineShape("Timeline Marker "+event.Label, preset, clr,
+			Offset{X: x - markerSize/2, Y: axisY - markerSize/2}, Extents{CX: markerSize, CY: markerSize}))
+		label := newCaptionShape(0, event.Label, fontSize, Offset{X: x - labelWidth/2, Y: labelY}, Extents{CX: labelWidth, CY: labelHeight})
+		label.NonVisualShapeProperties.CommonNonVisualProperties.Name = "Timeline Label " + event.Label
+		anchor := "b"
+		label.TextBody.BodyProperties.Anchor = &anchor
+		addShape(label)
+		date := newCaptionShape(0, event.Date.Format(format), max(fontSize-2, 8),
+			Offset{X: x - labelWidth/2, Y: axisY + markerSize/2}, Extents{CX: labelWidth, CY: dateHeight})
+		date.NonVisualShapeProperties.CommonNonVisualProperties.Name = "Timeline Date " + event.Label
+		addShape(date)
+	}
+	slide.CommonSlideData.ShapeTree.GroupShape = append(slide.CommonSlideData.ShapeTree.GroupShape, group)
+	return group.NonVisualGroupShapeProperties.CommonNonVisualProperties.ID, nil
+}
+
+// newTimelineShape provides a function to create the filled shape without
+// outline of the timeline by given shape name, preset geometry, hex RGB
+// color, offset and size of the shape.
+func newTimelineShape(name, preset, clr string, offset Offset, extents Extents) decodeShape {
+	return decodeShape{
+		NonVisualShapeProperties: &decodeNonVisualShapeProperties{
+			CommonNonVisualProperties:      &CommonNonVisualProperties{Name: name},
+			CommonNonVisualShapeProperties: &decodeCommonNonVisualShapeProperties{},
+			NonVisualProperties:            &decodeNonVisualProperties{},
+		},
+		ShapeProperties: &DecodeShapeProperties{
+			Xfrm:           &DecodeXfrm{Offset: &offset, Extents: &extents},
+			PresetGeometry: &DecodePresetGeometry{Preset: preset, AdjustValueList: &AdjustValueList{}},
+			SolidFill:      &DecodeSolidFill{SolidRGBColor: &SolidRGBColor{Val: clr}},
+			Ln:             &decodeLine{NoFill: &noFill{}},
+		},
+	}
+}
@@ -0,0 +1,98 @@
+package gopptx
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestAddTimeline(t *testing.T) {
+	f := NewFile()
+	slideID := f.GetSlideList()[0]
+	events := []TimelineEvent{
+		{Date: time.Date(2026, 6, 30, 0, 0, 0, 0, time.UTC), Label: "Launch", Milestone: true},
+		{Date: time.Date(2026, 1, 15, 0, 0, 0, 0, time.UTC), Label: "Kickoff"},
+		{Date: time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC), Label: "Design"},
+	}
+	shapeID, err := f.AddTimeline(slideID, events, &TimelineOptions{
+		Width: 3600000, Height: 1200000, Color: "#C00000", DateFormat: "Jan 2006", FontSize: 10,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if shapeID != 9 {
+		t.Errorf("expected the group shape id 9, got %d", shapeID)
+	}
+	buf, err := f.WriteToBuffer()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f, err = OpenReader(buf); err != nil {
+		t.Fatal(err)
+	}
+	slide, err := f.slideReader(slideID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(slide.CommonSlideData.ShapeTree.GroupShape) != 1 {
+		t.Fatalf("expected the timeline group, got %d groups", len(slide.CommonSlideData.ShapeTree.GroupShape))
+	}
+	type timelineShape struct {
+		ID      int
+		Name    string
+		Preset  string
+		CenterX int
+		Text    string
+	}
+	var shapes []timelineShape
+	for _, shape := range slide.CommonSlideData.ShapeTree.GroupShape[0].Shape {
+		cNvPr, spPr := shape.NonVisualShapeProperties.CommonNonVisualProperties, shape.ShapeProperties
+		if spPr.SolidFill != nil && spPr.SolidFill.SolidRGBColor.Val != "C00000" {
+			t.Errorf("expected the color C00000 of the shape %s, got %s", cNvPr.Name, spPr.SolidFill.SolidRGBColor.Val)
+		}
+		m := timelineShape{ID: cNvPr.ID, Name: cNvPr.Name, Preset: spPr.PresetGeometry.Preset, CenterX: spPr.Xfrm.Offset.X + spPr.Xfrm.Extents.CX/2}
+		if shape.TextBody != nil {
+			m.Text = shape.TextBody.text()
+		}
+		shapes = append(shapes, m)
+	}
+	expected := []timelineShape{{ID: 10, Name: "Timeline Axis", Preset: "rect", CenterX: 1800000}}
+	for i, event := range []struct {
+		label, date, preset string
+		x                   int
+	}{
+		{"Kickoff", "Jan 2026", "ellipse", 900000},
+		{"Design", "Mar 2026", "ellipse", 1387951},
+		{"Launch", "Jun 2026", "diamond", 2700000},
+	} {
+		id := 11 + i*4
+		expected = append(expected,
+			timelineShape{ID: id, Name: "Timeline Stem " + event.label, Preset: "rect", CenterX: event.x},
+			timelineShape{ID: id + 1, Name: "Timeline Marker " + event.label, Preset: event.preset, CenterX: event.x},
+			timelineShape{ID: id + 2, Name: "Timeline Label " + event.label, Preset: "rect", CenterX: event.x, Text: event.label},
+			timelineShape{ID: id + 3, Name: "Timeline Date " + event.label, Preset: "rect", CenterX: event.x, Text: event.date},
+		)
+	}
+	if !reflect.DeepEqual(shapes, expected) {
+		t.Errorf("expected the timeline shapes %v, got %v", expected, shapes)
+	}
+	if nextShapeID := slide.nextShapeID(); nextShapeID != 23 {
+		t.Errorf("expected the next shape id 23, got %d", nextShapeID)
+	}
+
+	for _, c := range []struct {
+		slideID  int
+		events   []TimelineEvent
+		opts     *TimelineOptions
+		expected error
+	}{
+		{slideID: slideID, expected: ErrTimelineEventsEmpty},
+		{slideID: slideID, events: events, opts: &TimelineOptions{Color: "red"}, expected: ErrColorInvalid},
+		{slideID: 300, events: events, expected: ErrSlideNotExist{300}},
+	} {
+		if _, err = f.AddTimeline(c.slideID, c.events, c.opts); !errors.Is(err, c.expected) {
+			t.Errorf("expected %v, got %v", c.expected, err)
+		}
+	}
+}
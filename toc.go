@@ -0,0 +1,176 @@
+// Copyright 2026 kenny-not-dead. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+//
+// Package gopptx provides functionality to create and manipulate PowerPoint
+// (.pptx) files in Go, using the Office Open XML (ECMA-376) format.
+
+package gopptx
+
+import (
+	"path"
+	"slices"
+	"strings"
+)
+
+// tocSlideName is the name of the common slide data of the table of contents
+// slide, which is used to find the slide for refreshing.
+const tocSlideName = "Table of Contents"
+
+// TOCOptions directly maps the settings of the table of contents slide. The
+// title defaults to "Agenda", and the index is the zero-based index of the
+// slide in the presentation with default 1, which is used only when the slide
+// is created. The slide lists the section names if the presentation has
+// sections, or the slide titles if it has no sections or SlideTitles is true.
+type TOCOptions struct {
+	Title       string
+	Index       *int
+	SlideTitles bool
+}
+
+// tocItem defines an entry of the table of contents, which links to the slide
+// by given slide id.
+type tocItem struct {
+	text    string
+	slideID int
+}
+
+// GenerateTOC provides a function to create an agenda slide listing the
+// section names or slide titles of the presentation, each entry is a hyperlink
+// jumping to the first slide of the section or the slide. If the presentation
+// already has the table of contents slide which created by this function, the
+// slide will be refreshed in place, so call it again after the sections or
+// slides changed. It returns the slide id of the table of contents slide. For
+// example:
+//
+//	slideID, err := f.GenerateTOC(&gopptx.TOCOptions{Title: "Contents"})
+func (f *File) GenerateTOC(opts *TOCOptions) (int, error) {
+	if err := f.checkReadOnly(); err != nil {
+		return 0, err
+	}
+	if opts == nil {
+		opts = &TOCOptions{}
+	}
+	title, index := opts.Title, 1
+	if title == "" {
+		title = "Agenda"
+	}
+	if opts.Index != nil {
+		index = *opts.Index
+	}
+	slideID, err := f.getTOCSlideID()
+	if err != nil {
+		return 0, err
+	}
+	if slideID == 0 {
+		if slideID, err = f.NewSlide(); err != nil {
+			return 0, err
+		}
+		if err = f.moveSlide(slideID, index); err != nil {
+			return 0, err
+		}
+	}
+	slide, err := f.slideReader(slideID)
+	if err != nil {
+		return 0, err
+	}
+	slide.CommonSlideData.Name = tocSlideName
+	titleShape, bodyShape := slide.getTitleShape(), slide.getPlaceholder("body", nil, nil)
+	if titleShape == nil || bodyShape == nil {
+		return 0, ErrPlaceholderNotExist
+	}
+	items, err := f.getTOCItems(slideID, opts.SlideTitles)
+	if err != nil {
+		return 0, err
+	}
+	slideXMLPath, _ := f.getSlideXMLPath(slideID)
+	f.deleteSlideJumpRels(slideXMLPath)
+	setPlaceholderParagraphs(titleShape, []JSONParagraph{{Runs: []JSONRun{{Text: title}}}})
+	paragraphs := []JSONParagraph{{}}
+	if len(items) > 0 {
+		paragraphs = make([]JSONParagraph, len(items))
+	}
+	for i, item := range items {
+		paragraphs[i] = JSONParagraph{Runs: []JSONRun{{Text: item.text}}}
+	}
+	setPlaceholderParagraphs(bodyShape, paragraphs)
+	for i, item := range items {
+		target, _ := f.getSlideXMLPath(item.slideID)
+		rID := f.addRels(getPartRelsPath(slideXMLPath), SourceRelationshipSlide, path.Base(target), "")
+		run := &bodyShape.TextBody.Paragraph[i].Runs[0]
+		if run.RunProperties == nil {
+			run.RunProperties = &DecodeRunProperties{}
+		}
+		run.RunProperties.HyperlinkClick = &DecodeHyperlink{
+			RID:    rID,
+			Action: "ppaction://hlinksldjump",
+		}
+	}
+	return slideID, nil
+}
+
+// getTOCSlideID provides a function to get the slide id of the table of
+// contents slide, zero will be returned if the presentation has no such slide.
+func (f *File) getTOCSlideID() (int, error) {
+	for _, slideID := range f.GetSlideList() {
+		slide, err := f.slideReader(slideID)
+		if err != nil {
+			return 0, err
+		}
+		if slide.CommonSlideData.Name == tocSlideName {
+			return slideID, nil
+		}
+	}
+	return 0, nil
+}
+
+// getTOCItems provides a function to get the entries of the table of contents
+// except the table of contents slide by given slide id. The empty sections
+// and the slides without title are skipped.
+func (f *File) getTOCItems(tocSlideID int, slideTitles bool) ([]tocItem, error) {
+	var items []tocItem
+	if !slideTitles {
+		sections, err := f.GetSections()
+		if err != nil {
+			return nil, err
+		}
+		for _, s := range sections {
+			slideIDs := slices.DeleteFunc(s.SlideIDs, func(id int) bool { return id == tocSlideID })
+			if len(slideIDs) > 0 {
+				items = append(items, tocItem{text: s.Name, slideID: slideIDs[0]})
+			}
+		}
+		if len(sections) > 0 {
+			return items, nil
+		}
+	}
+	for _, slideID := range f.GetSlideList() {
+		if slideID == tocSlideID {
+			continue
+		}
+		slide, err := f.slideReader(slideID)
+		if err != nil {
+			return nil, err
+		}
+		if shape := slide.getTitleShape(); shape != nil {
+			if text := strings.Join(strings.Fields(shape.TextBody.text()), " "); text != "" {
+				items = append(items, tocItem{text: text, slideID: slideID})
+			}
+		}
+	}
+	return items, nil
+}
+
+// deleteSlideJumpRels provides a function to remove the relationships to the
+// other slides of the slide by given slide XML path.
+func (f *File) deleteSlideJumpRels(slideXMLPath string) {
+	rels, _ := f.relsReader(getPartRelsPath(slideXMLPath))
+	if rels == nil {
+		return
+	}
+	rels.mu.Lock()
+	defer rels.mu.Unlock()
+	rels.Relationships = slices.DeleteFunc(rels.Relationships, func(rel relationship) bool {
+		return rel.Type == SourceRelationshipSlide
+	})
+}
@@ -0,0 +1,67 @@
+package gopptx
+
+import "testing"
+
+func TestGenerateTOC(t *testing.T) {
+	f := NewFile()
+	for i := 0; i < 2; i++ {
+		if _, err := f.NewSlide(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	s := f.GetSlideList()
+	if err := f.SetSections([]Section{{Name: "Intro", SlideIDs: s[:1]}, {Name: "Details", SlideIDs: s[1:]}}); err != nil {
+		t.Fatal(err)
+	}
+	var tocSlideID int
+	for _, c := range []struct {
+		sections []Section
+		items    []tocItem
+	}{
+		{items: []tocItem{{text: "Intro", slideID: s[0]}, {text: "Details", slideID: s[1]}}},
+		{
+			sections: []Section{{Name: "Intro", SlideIDs: []int{s[0]}}, {Name: "Body", SlideIDs: s[1:2]}, {Name: "End", SlideIDs: s[2:]}},
+			items:    []tocItem{{text: "Intro", slideID: s[0]}, {text: "Body", slideID: s[1]}, {text: "End", slideID: s[2]}},
+		},
+	} {
+		if c.sections != nil {
+			// The table of contents slide is the second slide in the first section
+			c.sections[0].SlideIDs = append(c.sections[0].SlideIDs, tocSlideID)
+			if err := f.SetSections(c.sections); err != nil {
+				t.Fatal(err)
+			}
+		}
+		slideID, err := f.GenerateTOC(nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if tocSlideID != 0 && slideID != tocSlideID {
+			t.Errorf("expected the table of contents slide %d is refreshed, got %d", tocSlideID, slideID)
+		}
+		tocSlideID = slideID
+		if idx, _ := f.GetSlideIndex(slideID); idx != 1 {
+			t.Errorf("expected the table of contents slide at 1, got %d", idx)
+		}
+		slide, err := f.slideReader(slideID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		body := slide.getPlaceholder("body", nil, nil)
+		if body == nil || len(body.TextBody.Paragraph) != len(c.items) {
+			t.Fatalf("expected %d entries of the table of contents", len(c.items))
+		}
+		slideXMLPath, _ := f.getSlideXMLPath(slideID)
+		for i, item := range c.items {
+			p := body.TextBody.Paragraph[i]
+			if p.text() != item.text {
+				t.Errorf("expected the entry %s, got %s", item.text, p.text())
+			}
+			target, _ := f.getSlideXMLPath(item.slideID)
+			if link := p.Runs[0].RunProperties.HyperlinkClick; link == nil {
+				t.Errorf("the entry %s has no hyperlink", item.text)
+			} else if linked, _ := f.getRelTarget(slideXMLPath, link.RID); linked != target {
+				t.Errorf("expected the entry %s links to %s, got %s", item.text, target, linked)
+			}
+		}
+	}
+}
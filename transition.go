@@ -0,0 +1,189 @@
+// Copyright 2026 kenny-not-dead. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+//
+// Package gopptx provides functionality to create and manipulate PowerPoint
+// (.pptx) files in Go, using the Office Open XML (ECMA-376) format.
+
+package gopptx
+
+import (
+	"bytes"
+	"encoding/xml"
+	"strings"
+)
+
+// transitionTypes defined the supported types of the slide transitions, the
+// values are whether the transition accepts the direction.
+var transitionTypes = map[string]bool{
+	"blinds": true, "checker": true, "circle": false, "comb": true, "cover": true,
+	"cut": false, "diamond": false, "dissolve": false, "fade": false,
+	"newsflash": false, "plus": false, "pull": true, "push": true, "random": false,
+	"randomBar": true, "split": true, "strips": true, "wedge": false,
+	"wheel": false, "wipe": true, "zoom": true,
+}
+
+// SlideTransition directly maps the transition of the slide. The type is the
+// name of the transition effect, such as "fade", "push" or "wipe", and the
+// empty type sets the advance settings without the effect. The direction is
+// used by the transitions accepting it, such as "l", "r", "u" or "d" for the
+// push and wipe transitions, "horz" or "vert" for the blinds transition, and
+// "in" or "out" for the split and zoom transitions. The speed is one of
+// "slow", "med" or "fast", the advance after is the time in milliseconds
+// after which the slide advances automatically, and zero for no automatic
+// advance.
+type SlideTransition struct {
+	Type           string
+	Direction      string
+	Speed          string
+	AdvanceOnClick *bool
+	AdvanceAfter   int
+}
+
+// SetSlideTransition provides a function to set the transition of the slide
+// by given slide id and the transition, the transition is removed if it is
+// nil. For example, set the push transition from the bottom with automatic
+// advance after 5 seconds:
+//
+//	err := f.SetSlideTransition(256, &gopptx.SlideTransition{
+//	    Type: "push", Direction: "u", Speed: "med", AdvanceAfter: 5000,
+//	})
+func (f *File) SetSlideTransition(slideID int, opts *SlideTransition) error {
+	if err := f.checkReadOnly(); err != nil {
+		return err
+	}
+	transition, err := newDecodeSlideTransition(opts)
+	if err != nil {
+		return err
+	}
+	return f.setSlideTransition(slideID, transition)
+}
+
+// SetAllSlideTransitions provides a function to set the same transition of
+// all slides in the presentation by given transition, the transitions are
+// removed if it is nil. For example, set the fade transition for all slides:
+//
+//	err := f.SetAllSlideTransitions(&gopptx.SlideTransition{Type: "fade"})
+func (f *File) SetAllSlideTransitions(opts *SlideTransition) error {
+	if err := f.checkReadOnly(); err != nil {
+		return err
+	}
+	transition, err := newDecodeSlideTransition(opts)
+	if err != nil {
+		return err
+	}
+	for _, slideID := range f.GetSlideList() {
+		if err = f.setSlideTransition(slideID, transition); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SetSectionSlideTransitions provides a function to set the same transition
+// of the slides in the section by given section name and the transition, the
+// transitions are removed if it is nil. For example, set the wipe transition
+// for the slides in the section "Details":
+//
+//	err := f.SetSectionSlideTransitions("Details", &gopptx.SlideTransition{
+//	    Type: "wipe", Direction: "r",
+//	})
+func (f *File) SetSectionSlideTransitions(name string, opts *SlideTransition) error {
+	if err := f.checkReadOnly(); err != nil {
+		return err
+	}
+	transition, err := newDecodeSlideTransition(opts)
+	if err != nil {
+		return err
+	}
+	sections, err := f.GetSections()
+	if err != nil {
+		return err
+	}
+	for _, section := range sections {
+		if section.Name != name {
+			continue
+		}
+		for _, slideID := range section.SlideIDs {
+			if err = f.setSlideTransition(slideID, transition); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return ErrSectionNotExist{name}
+}
+
+// RemoveTransitions provides a function to remove the transitions of the
+// slides by given slide ids, the transitions of all slides are removed if no
+// slide id is given. For example, remove the transitions of two slides:
+//
+//	err := f.RemoveTransitions(256, 257)
+func (f *File) RemoveTransitions(slideIDs ...int) error {
+	if err := f.checkReadOnly(); err != nil {
+		return err
+	}
+	if len(slideIDs) == 0 {
+		slideIDs = f.GetSlideList()
+	}
+	for _, slideID := range slideIDs {
+		if err := f.setSlideTransition(slideID, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// setSlideTransition provides a function to set the parsed transition of the
+// slide by given slide id and the transition. The mc:AlternateContent block
+// holding the transition is removed, since the slide can only have one
+// transition.
+func (f *File) setSlideTransition(slideID int, transition *decodeSlideTransition) error {
+	slide, err := f.slideReader(slideID)
+	if err != nil {
+		return err
+	}
+	slide.mu.Lock()
+	defer slide.mu.Unlock()
+	if slide.DecodeAlternateContent != nil && strings.Contains(slide.DecodeAlternateContent.Content, "transition") {
+		slide.AlternateContent, slide.DecodeAlternateContent = nil, nil
+	}
+	slide.Transition = nil
+	if transition != nil {
+		t := *transition
+		slide.Transition = &t
+	}
+	return nil
+}
+
+// newDecodeSlideTransition returns the parsed transition by given transition
+// settings, nil will be returned if the settings are nil.
+func newDecodeSlideTransition(opts *SlideTransition) (*decodeSlideTransition, error) {
+	if opts == nil {
+		return nil, nil
+	}
+	hasDir, ok := transitionTypes[opts.Type]
+	if !ok && opts.Type != "" {
+		return nil, ErrTransitionType
+	}
+	if opts.Speed != "" && opts.Speed != "slow" && opts.Speed != "med" && opts.Speed != "fast" {
+		return nil, ErrTransitionSpeed
+	}
+	transition := &decodeSlideTransition{Speed: opts.Speed, AdvanceOnClick: opts.AdvanceOnClick}
+	if opts.AdvanceAfter > 0 {
+		advanceAfter := opts.AdvanceAfter
+		transition.AdvanceAfter = &advanceAfter
+	}
+	if opts.Type != "" {
+		var buf bytes.Buffer
+		buf.WriteString("<p:" + opts.Type)
+		if hasDir && opts.Direction != "" {
+			buf.WriteString(` dir="`)
+			_ = xml.EscapeText(&buf, []byte(opts.Direction))
+			buf.WriteString(`"`)
+		}
+		buf.WriteString("/>")
+		transition.Content = buf.String()
+	}
+	return transition, nil
+}
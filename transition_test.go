@@ -0,0 +1,83 @@
+package gopptx
+
+import (
+	"errors"
+	"regexp"
+	"testing"
+)
+
+func TestSetSlideTransition(t *testing.T) {
+	f := NewFile()
+	first := f.GetSlideList()[0]
+	second, err := f.NewSlide()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = f.SetSections([]Section{{Name: "Intro", SlideIDs: []int{first}}, {Name: "Details", SlideIDs: []int{second}}}); err != nil {
+		t.Fatal(err)
+	}
+	advanceOnClick := false
+	transitionExp := regexp.MustCompile(`(?s)<p:transition.*?</p:transition>|<mc:AlternateContent`)
+	for _, c := range []struct {
+		set      func() error
+		expected [2]string
+	}{
+		{
+			set:      func() error { return nil },
+			expected: [2]string{"<mc:AlternateContent", "<mc:AlternateContent"},
+		},
+		{
+			set: func() error {
+				return f.SetSlideTransition(first, &SlideTransition{Type: "push", Direction: "u", Speed: "med", AdvanceOnClick: &advanceOnClick, AdvanceAfter: 5000})
+			},
+			expected: [2]string{`<p:transition spd="med" advClick="false" advTm="5000"><p:push dir="u"/></p:transition>`, "<mc:AlternateContent"},
+		},
+		{
+			set:      func() error { return f.SetAllSlideTransitions(&SlideTransition{Type: "fade", Direction: "l"}) },
+			expected: [2]string{`<p:transition><p:fade/></p:transition>`, `<p:transition><p:fade/></p:transition>`},
+		},
+		{
+			set:      func() error { return f.SetSectionSlideTransitions("Details", &SlideTransition{AdvanceAfter: 3000}) },
+			expected: [2]string{`<p:transition><p:fade/></p:transition>`, `<p:transition advTm="3000"></p:transition>`},
+		},
+		{
+			set:      func() error { return f.SetSlideTransition(second, &SlideTransition{Type: "wipe", Direction: `"r"`}) },
+			expected: [2]string{`<p:transition><p:fade/></p:transition>`, `<p:transition><p:wipe dir="&#34;r&#34;"/></p:transition>`},
+		},
+		{
+			set:      func() error { return f.SetAllSlideTransitions(nil) },
+			expected: [2]string{},
+		},
+	} {
+		if err = c.set(); err != nil {
+			t.Fatal(err)
+		}
+		buf, err := f.WriteToBuffer()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if f, err = OpenReader(buf); err != nil {
+			t.Fatal(err)
+		}
+		for i, slideID := range []int{first, second} {
+			slideXMLPath, _ := f.getSlideXMLPath(slideID)
+			if transition := transitionExp.FindString(string(f.readXML(slideXMLPath))); transition != c.expected[i] {
+				t.Errorf("expected the transition %s of the slide %d, got %s", c.expected[i], slideID, transition)
+			}
+		}
+	}
+
+	for _, c := range []struct {
+		set      func() error
+		expected error
+	}{
+		{set: func() error { return f.SetSlideTransition(first, &SlideTransition{Type: "spin"}) }, expected: ErrTransitionType},
+		{set: func() error { return f.SetAllSlideTransitions(&SlideTransition{Speed: "medium"}) }, expected: ErrTransitionSpeed},
+		{set: func() error { return f.SetSectionSlideTransitions("Summary", &SlideTransition{}) }, expected: ErrSectionNotExist{"Summary"}},
+		{set: func() error { return f.SetSlideTransition(300, &SlideTransition{}) }, expected: ErrSlideNotExist{300}},
+	} {
+		if err = c.set(); !errors.Is(err, c.expected) {
+			t.Errorf("expected %v, got %v", c.expected, err)
+		}
+	}
+}
@@ -0,0 +1,179 @@
+// Copyright 2026 kenny-not-dead. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+//
+// Package gopptx provides functionality to create and manipulate PowerPoint
+// (.pptx) files in Go, using the Office Open XML (ECMA-376) format.
+
+package gopptx
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// TranslationUnit directly maps the translatable text of the text run in the
+// translation bundle. The ID is the stable identifier of the run in the
+// format "slideID/shapeID/paragraph/run", the source is the text of the run,
+// and the target is the translated text.
+type TranslationUnit struct {
+	ID     string `json:"id"`
+	Source string `json:"source"`
+	Target string `json:"target,omitempty"`
+}
+
+// translatableRun defines the text run of the slide with the stable
+// identifier.
+type translatableRun struct {
+	id  string
+	run *DecodeRuns
+}
+
+// ExportTranslatableText provides a function to write the text of all text
+// runs in the shapes of the slides as the translation bundle to the given
+// io.Writer by given format, which is "xliff" for the XLIFF 1.2 document or
+// "json" for the list of the translation units. The runs without text and the
+// fields are skipped, and the text in the tables isn't exported. For example:
+//
+//	var buf bytes.Buffer
+//	if err := f.ExportTranslatableText(&buf, "xliff"); err != nil {
+//	    fmt.Println(err)
+//	}
+func (f *File) ExportTranslatableText(w io.Writer, format string) error {
+	if format != "xliff" && format != "json" {
+		return ErrTranslationFormat
+	}
+	runs, err := f.getTranslatableRuns()
+	if err != nil {
+		return err
+	}
+	var buf bytes.Buffer
+	if format == "json" {
+		units := []TranslationUnit{}
+		for _, r := range runs {
+			units = append(units, TranslationUnit{ID: r.id, Source: r.run.Text})
+		}
+		enc := json.NewEncoder(&buf)
+		enc.SetIndent("", "  ")
+		if err = enc.Encode(units); err != nil {
+			return err
+		}
+	} else {
+		doc := xliff{Version: "1.2", File: xliffFile{Original: "presentation", SourceLanguage: "und", DataType: "plaintext"}}
+		for _, r := range runs {
+			if r.run.RunProperties != nil && r.run.RunProperties.Lang != "" && doc.File.SourceLanguage == "und" {
+				doc.File.SourceLanguage = r.run.RunProperties.Lang
+			}
+			doc.File.TransUnits = append(doc.File.TransUnits, xliffTransUnit{ID: r.id, Source: r.run.Text})
+		}
+		output, err := xml.MarshalIndent(doc, "", "  ")
+		if err != nil {
+			return err
+		}
+		buf.WriteString(xml.Header)
+		buf.Write(output)
+		buf.WriteString("\n")
+	}
+	_, err = buf.WriteTo(w)
+	return err
+}
+
+// ImportTranslations provides a function to write the translated text of the
+// translation bundle read from the given io.Reader back into the text runs of
+// the slides by given format, which is "xliff" or "json" as the format of the
+// ExportTranslatableText function. The formatting of the runs is kept, and
+// the units without the target text are skipped. For example:
+//
+//	file, err := os.Open("Presentation.de.xlf")
+//	if err != nil {
+//	    fmt.Println(err)
+//	    return
+//	}
+//	defer file.Close()
+//	if err := f.ImportTranslations(file, "xliff"); err != nil {
+//	    fmt.Println(err)
+//	}
+func (f *File) ImportTranslations(r io.Reader, format string) error {
+	if err := f.checkReadOnly(); err != nil {
+		return err
+	}
+	var units []TranslationUnit
+	switch format {
+	case "json":
+		if err := json.NewDecoder(r).Decode(&units); err != nil {
+			return err
+		}
+	case "xliff":
+		var doc xliff
+		if err := xml.NewDecoder(r).Decode(&doc); err != nil {
+			return err
+		}
+		for _, u := range doc.File.TransUnits {
+			if u.Target != nil {
+				units = append(units, TranslationUnit{ID: u.ID, Source: u.Source, Target: *u.Target})
+			}
+		}
+	default:
+		return ErrTranslationFormat
+	}
+	runs, err := f.getTranslatableRuns()
+	if err != nil {
+		return err
+	}
+	index := make(map[string]*DecodeRuns, len(runs))
+	for _, r := range runs {
+		index[r.id] = r.run
+	}
+	for _, u := range units {
+		if u.Target == "" {
+			continue
+		}
+		run, ok := index[u.ID]
+		if !ok {
+			return ErrTranslationUnitNotExist{u.ID}
+		}
+		run.Text = u.Target
+	}
+	return nil
+}
+
+// getTranslatableRuns provides a function to get the text runs with text in
+// the shapes and the group shapes of all slides in the presentation order.
+func (f *File) getTranslatableRuns() ([]translatableRun, error) {
+	var runs []translatableRun
+	var walk func(slideID int, shapes []decodeShape, groups []decodeGroupShape)
+	walk = func(slideID int, shapes []decodeShape, groups []decodeGroupShape) {
+		for i := range shapes {
+			shape := &shapes[i]
+			if shape.TextBody == nil || shape.NonVisualShapeProperties == nil ||
+				shape.NonVisualShapeProperties.CommonNonVisualProperties == nil {
+				continue
+			}
+			shapeID := shape.NonVisualShapeProperties.CommonNonVisualProperties.ID
+			for p := range shape.TextBody.Paragraph {
+				for r := range shape.TextBody.Paragraph[p].Runs {
+					run := &shape.TextBody.Paragraph[p].Runs[r]
+					if run.FieldID != "" || strings.TrimSpace(run.Text) == "" {
+						continue
+					}
+					runs = append(runs, translatableRun{id: fmt.Sprintf("%d/%d/%d/%d", slideID, shapeID, p, r), run: run})
+				}
+			}
+		}
+		for i := range groups {
+			walk(slideID, groups[i].Shape, groups[i].GroupShape)
+		}
+	}
+	for _, slideID := range f.GetSlideList() {
+		slide, err := f.slideReader(slideID)
+		if err != nil {
+			return nil, err
+		}
+		walk(slideID, slide.CommonSlideData.ShapeTree.Shape, slide.CommonSlideData.ShapeTree.GroupShape)
+	}
+	return runs, nil
+}
@@ -0,0 +1,81 @@
+package gopptx
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestTranslations(t *testing.T) {
+	f := NewFile()
+	slideID := f.GetSlideList()[0]
+	shape := addTestShape(t, f, slideID, "", "Hello", " ", "World")
+	shape.TextBody.Paragraph[0].Runs[0].RunProperties = &DecodeRunProperties{Lang: "en-US"}
+	shape.TextBody.Paragraph[0].Runs = append(shape.TextBody.Paragraph[0].Runs,
+		DecodeRuns{FieldID: "{B6F15528-21DE-4FAA-801E-634DDDAF4B2B}", FieldType: "slidenum", Text: "1"})
+	var buf bytes.Buffer
+	if err := f.ExportTranslatableText(&buf, "json"); err != nil {
+		t.Fatal(err)
+	}
+	var units []TranslationUnit
+	if err := json.Unmarshal(buf.Bytes(), &units); err != nil {
+		t.Fatal(err)
+	}
+	if expected := []TranslationUnit{{ID: "256/9/0/0", Source: "Hello"}, {ID: "256/9/2/0", Source: "World"}}; !reflect.DeepEqual(units, expected) {
+		t.Errorf("expected the translation units %v, got %v", expected, units)
+	}
+	buf.Reset()
+	if err := f.ExportTranslatableText(&buf, "xliff"); err != nil {
+		t.Fatal(err)
+	}
+	for _, expected := range []string{
+		`<xliff xmlns="urn:oasis:names:tc:xliff:document:1.2" version="1.2">`,
+		`<file original="presentation" source-language="en-US" datatype="plaintext">`,
+		`<trans-unit id="256/9/0/0">`, "<source>Hello</source>", `<trans-unit id="256/9/2/0">`, "<source>World</source>",
+	} {
+		if !strings.Contains(buf.String(), expected) {
+			t.Errorf("expected %s in the XLIFF document, got %s", expected, buf.String())
+		}
+	}
+	xliffDoc := strings.Replace(buf.String(), "<source>Hello</source>", "<source>Hello</source><target>Hallo &amp; willkommen</target>", 1)
+	if err := f.ImportTranslations(strings.NewReader(xliffDoc), "xliff"); err != nil {
+		t.Fatal(err)
+	}
+	if err := f.ImportTranslations(strings.NewReader(`[{"id":"256/9/0/0","source":"Hello"},{"id":"256/9/2/0","source":"World","target":"Welt"}]`), "json"); err != nil {
+		t.Fatal(err)
+	}
+	buf.Reset()
+	if err := f.ExportTranslatableText(&buf, "json"); err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal(buf.Bytes(), &units); err != nil {
+		t.Fatal(err)
+	}
+	if expected := []TranslationUnit{{ID: "256/9/0/0", Source: "Hallo & willkommen"}, {ID: "256/9/2/0", Source: "Welt"}}; !reflect.DeepEqual(units, expected) {
+		t.Errorf("expected the translated units %v, got %v", expected, units)
+	}
+	if rPr := shape.TextBody.Paragraph[0].Runs[0].RunProperties; rPr == nil || rPr.Lang != "en-US" {
+		t.Errorf("expected the formatting of the run is kept, got %+v", rPr)
+	}
+	if field := shape.TextBody.Paragraph[0].Runs[1]; field.Text != "1" {
+		t.Errorf("expected the field isn't translated, got %s", field.Text)
+	}
+
+	for _, c := range []struct {
+		input, format string
+		expected      error
+	}{
+		{input: `[{"id":"256/99/0/0","target":"Hallo"}]`, format: "json", expected: ErrTranslationUnitNotExist{"256/99/0/0"}},
+		{input: "id,target", format: "csv", expected: ErrTranslationFormat},
+	} {
+		if err := f.ImportTranslations(strings.NewReader(c.input), c.format); !errors.Is(err, c.expected) {
+			t.Errorf("expected %v, got %v", c.expected, err)
+		}
+	}
+	if err := f.ExportTranslatableText(&buf, "csv"); err != ErrTranslationFormat {
+		t.Errorf("expected ErrTranslationFormat, got %v", err)
+	}
+}
@@ -0,0 +1,127 @@
+// Copyright 2026 kenny-not-dead. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+//
+// Package gopptx provides functionality to create and manipulate PowerPoint
+// (.pptx) files in Go, using the Office Open XML (ECMA-376) format.
+
+package gopptx
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+)
+
+// Validate provides a function to check the package structure of the
+// presentation, and returns all found problems joined into one error, or nil
+// if the presentation is valid. It checks that every part has a content type,
+// every content type override and internal relationship refers to an existing
+// part, the slide IDs are unique and in the valid range, and each master
+// refers to its own theme and maps all theme colors. For example:
+//
+//	if err := f.Validate(); err != nil {
+//	    fmt.Println(err)
+//	}
+func (f *File) Validate() error {
+	var errs []error
+	parts := f.getPartNames()
+	exists := make(map[string]bool, len(parts))
+	for _, name := range parts {
+		exists[name] = true
+	}
+	content, err := f.contentTypesReader()
+	if err != nil {
+		return err
+	}
+	content.mu.Lock()
+	defaults, overrides := map[string]bool{}, map[string]bool{}
+	for _, d := range content.Defaults {
+		defaults[strings.ToLower(d.Extension)] = true
+	}
+	for _, o := range content.Overrides {
+		partName := strings.TrimPrefix(o.PartName, "/")
+		overrides[partName] = true
+		if !exists[partName] {
+			errs = append(errs, fmt.Errorf("content type override refers to missing part %s", o.PartName))
+		}
+	}
+	content.mu.Unlock()
+	for _, name := range parts {
+		if name == defaultXMLPathContentTypes || strings.HasSuffix(name, "/") {
+			continue
+		}
+		if !overrides[name] && !defaults[strings.ToLower(strings.TrimPrefix(path.Ext(name), "."))] {
+			errs = append(errs, fmt.Errorf("part %s has no content type", name))
+		}
+		if !strings.HasSuffix(name, ".rels") {
+			continue
+		}
+		rels, err := f.relsReader(name)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("part %s: %w", name, err))
+			continue
+		}
+		if rels == nil {
+			continue
+		}
+		source := strings.TrimSuffix(strings.Replace(name, "_rels/", "", 1), ".rels")
+		rels.mu.Lock()
+		for _, rel := range rels.Relationships {
+			if rel.TargetMode == "External" {
+				continue
+			}
+			if target := resolveRelTarget(source, rel.Target); !exists[target] {
+				errs = append(errs, fmt.Errorf("relationship %s in %s refers to missing part %s", rel.ID, name, target))
+			}
+		}
+		rels.mu.Unlock()
+	}
+	presentation, err := f.presentationReader()
+	if err != nil {
+		return err
+	}
+	slideIDs := map[int]bool{}
+	for _, s := range presentation.Slides.Slide {
+		if s.SlideID < defaultXMLSlideID || s.SlideID > maxXMLSlideID {
+			errs = append(errs, fmt.Errorf("slide id %d is out of range", s.SlideID))
+		}
+		if slideIDs[s.SlideID] {
+			errs = append(errs, fmt.Errorf("slide id %d is duplicated", s.SlideID))
+		}
+		slideIDs[s.SlideID] = true
+		if _, ok := f.getRelTarget(f.getPresentationPath(), s.RelationshipID); !ok {
+			errs = append(errs, fmt.Errorf("slide id %d refers to missing relationship %s", s.SlideID, s.RelationshipID))
+		}
+	}
+	problems, err := f.checkMasterThemes(false)
+	if err != nil {
+		return err
+	}
+	return errors.Join(append(errs, problems...)...)
+}
+
+// getPartNames provides a function to get the sorted names of all parts in the
+// package, including the parts which have not been written back yet.
+func (f *File) getPartNames() []string {
+	names := map[string]bool{}
+	collect := func(k, v interface{}) bool {
+		names[k.(string)] = true
+		return true
+	}
+	f.Pkg.Range(collect)
+	f.tempFiles.Range(collect)
+	f.Slide.Range(collect)
+	f.Relationships.Range(collect)
+	for name := range f.streams {
+		names[name] = true
+	}
+	list := make([]string, 0, len(names))
+	for name := range names {
+		list = append(list, name)
+	}
+	sort.Strings(list)
+	return list
+}
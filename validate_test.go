@@ -0,0 +1,42 @@
+package gopptx
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestValidate(t *testing.T) {
+	for _, c := range []struct {
+		name, part, old, new string
+		expected             string
+	}{
+		{name: "valid"},
+		{
+			name: "content type", part: defaultXMLPathContentTypes,
+			old: "</Types>", new: `<Override PartName="/ppt/missing.xml" ContentType="application/xml"/></Types>`,
+			expected: "content type override refers to missing part /ppt/missing.xml",
+		},
+		{
+			name: "relationship", part: "ppt/_rels/presentation.xml.rels", old: `Target="presProps.xml"`, new: `Target="missing.xml"`,
+			expected: "relationship rId4 in ppt/_rels/presentation.xml.rels refers to missing part ppt/missing.xml",
+		},
+		{
+			name: "slide id", part: "ppt/presentation.xml", old: `id="256"`, new: `id="255"`,
+			expected: "slide id 255 is out of range",
+		},
+	} {
+		f, err := openTestFile(t, func(name string, content []byte) []byte {
+			if name == c.part {
+				return bytes.Replace(content, []byte(c.old), []byte(c.new), 1)
+			}
+			return content
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = f.Validate(); c.expected == "" && err != nil || c.expected != "" && (err == nil || !strings.Contains(err.Error(), c.expected)) {
+			t.Errorf("%s: expected the problem %q, got %v", c.name, c.expected, err)
+		}
+	}
+}
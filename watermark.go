@@ -0,0 +1,259 @@
+// Copyright 2026 kenny-not-dead. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+//
+// Package gopptx provides functionality to create and manipulate PowerPoint
+// (.pptx) files in Go, using the Office Open XML (ECMA-376) format.
+
+package gopptx
+
+import (
+	"bytes"
+	"encoding/xml"
+	"path"
+	"strings"
+	"unicode/utf8"
+)
+
+// Watermark directly maps the content of the watermark, which is either the
+// text, or the image data with the image extension such as ".png".
+type Watermark struct {
+	Text      string
+	Extension string
+	Image     []byte
+}
+
+// WatermarkOptions directly maps the format settings of the watermark. The
+// font size is specified in points with default 72, the color defaults to
+// "808080", the transparency is a percentage with default 50, and the
+// rotation is specified in degrees clockwise with default -45 for the text
+// and 0 for the image. The width and height of the image watermark are
+// specified in EMUs, if both are zero, the image will be fitted into the half
+// of the slide. The watermark will be added onto every slide master instead
+// of the slides if OnMaster is true, so it appears behind the slide content.
+type WatermarkOptions struct {
+	Font         string
+	FontSize     int
+	Color        string
+	Transparency *int
+	Rotation     *int
+	Width        int
+	Height       int
+	OnMaster     bool
+}
+
+// AddWatermark provides a function to stamp a semi-transparent rotated text
+// box or image at the center of every slide by given watermark and format
+// settings. For example, add the text "DRAFT" onto every slide:
+//
+//	err := f.AddWatermark(gopptx.Watermark{Text: "DRAFT"}, nil)
+//
+// Add an image onto the slide masters with 80 percent transparency:
+//
+//	logo, err := os.ReadFile("logo.png")
+//	if err != nil {
+//	    fmt.Println(err)
+//	    return
+//	}
+//	transparency := 80
+//	err = f.AddWatermark(gopptx.Watermark{Extension: ".png", Image: logo},
+//	    &gopptx.WatermarkOptions{Transparency: &transparency, OnMaster: true})
+func (f *File) AddWatermark(watermark Watermark, opts *WatermarkOptions) error {
+	if err := f.checkReadOnly(); err != nil {
+		return err
+	}
+	if opts == nil {
+		opts = &WatermarkOptions{}
+	}
+	slideWidth, slideHeight, err := f.getSlideSize()
+	if err != nil {
+		return err
+	}
+	transparency := 50
+	if opts.Transparency != nil {
+		transparency = min(max(*opts.Transparency, 0), 100)
+	}
+	rotation := -45
+	if len(watermark.Image) > 0 {
+		rotation = 0
+	}
+	if opts.Rotation != nil {
+		rotation = *opts.Rotation
+	}
+	wm := &watermarkFormat{
+		opts:         opts,
+		rotation:     (rotation%360 + 360) % 360 * 60000,
+		transparency: transparency,
+	}
+	if len(watermark.Image) == 0 {
+		wm.text = watermark.Text
+		wm.setTextFrame(slideWidth, slideHeight)
+	} else {
+		contentType, ok := supportedImageTypes[strings.ToLower(watermark.Extension)]
+		if !ok {
+			return ErrImgExt
+		}
+		width, height, err := getPictureSize(watermark.Image, &PictureOptions{Width: opts.Width, Height: opts.Height})
+		if err != nil {
+			return err
+		}
+		if opts.Width == 0 && opts.Height == 0 {
+			// Fit the image into the half of the slide with keeping the aspect ratio.
+			width, height = slideWidth/2, slideWidth/2*height/width
+			if height > slideHeight/2 {
+				width, height = slideHeight/2*width/height, slideHeight/2
+			}
+		}
+		wm.offset = Offset{X: (slideWidth - width) / 2, Y: (slideHeight - height) / 2}
+		wm.extents = Extents{CX: width, CY: height}
+		wm.media = "../media/" + path.Base(f.addMedia(watermark.Image, watermark.Extension))
+		if err = f.setContentTypeDefault(watermark.Extension, contentType); err != nil {
+			return err
+		}
+	}
+	if opts.OnMaster {
+		return f.addMasterWatermark(wm)
+	}
+	for _, slideID := range f.GetSlideList() {
+		slide, err := f.slideReader(slideID)
+		if err != nil {
+			return err
+		}
+		if wm.media == "" {
+			slide.CommonSlideData.ShapeTree.Shape = append(slide.CommonSlideData.ShapeTree.Shape, wm.newShape(slide.nextShapeID()))
+			continue
+		}
+		slideXMLPath, _ := f.getSlideXMLPath(slideID)
+		rID := f.addRels(getPartRelsPath(slideXMLPath), SourceRelationshipImage, wm.media, "")
+		slide.CommonSlideData.ShapeTree.Picture = append(slide.CommonSlideData.ShapeTree.Picture, wm.newPicture(slide.nextShapeID(), rID))
+	}
+	return nil
+}
+
+// watermarkFormat defines the resolved format settings of the watermark, the
+// media is the relationship target of the image watermark.
+type watermarkFormat struct {
+	opts         *WatermarkOptions
+	text, media  string
+	rotation     int
+	transparency int
+	offset       Offset
+	extents      Extents
+}
+
+// setTextFrame provides a function to set the frame of the text watermark at
+// the center of the slide by given slide size.
+func (wm *watermarkFormat) setTextFrame(slideWidth, slideHeight int) {
+	size := 72
+	if wm.opts.FontSize > 0 {
+		size = wm.opts.FontSize
+	}
+	// Approximate the average character width as 0.6 of the font size.
+	width := min(utf8.RuneCountInString(wm.text)*size*EMUPerPoint*3/5+2*defaultTextInsetX, slideWidth)
+	height := size*EMUPerPoint*3/2 + 2*defaultTextInsetY
+	wm.offset = Offset{X: (slideWidth - width) / 2, Y: (slideHeight - height) / 2}
+	wm.extents = Extents{CX: width, CY: height}
+}
+
+// shapeProperties returns the shape properties of the watermark.
+func (wm *watermarkFormat) shapeProperties() *DecodeShapeProperties {
+	rot, offset, extents := wm.rotation, wm.offset, wm.extents
+	spPr := &DecodeShapeProperties{
+		Xfrm:           &DecodeXfrm{Offset: &offset, Extents: &extents},
+		PresetGeometry: &DecodePresetGeometry{Preset: "rect", AdjustValueList: &AdjustValueList{}},
+	}
+	if rot != 0 {
+		spPr.Xfrm.Rot = &rot
+	}
+	return spPr
+}
+
+// newPicture provides a function to create the picture of the image
+// watermark by given shape ID and relationship ID of the image.
+func (wm *watermarkFormat) newPicture(shapeID int, rID string) decodePicture {
+	return decodePicture{
+		NonVisualPictureProperties: &decodeNonVisualPictureProperties{
+			CommonNonVisualProperties:        &CommonNonVisualProperties{ID: shapeID, Name: "Watermark"},
+			CommonNonVisualPictureProperties: &decodeCommonNonVisualPictureProperties{},
+			NonVisualProperties:              &decodeNonVisualProperties{},
+		},
+		BlipFill: &decodeBlipFill{
+			Blip: &decodeBlip{
+				Embed:       rID,
+				AlphaModFix: &AlphaModFix{Amount: (100 - wm.transparency) * 1000},
+			},
+			Stretch: &decodeStretch{FillRect: &FillRect{}},
+		},
+		ShapeProperties: wm.shapeProperties(),
+	}
+}
+
+// newShape provides a function to create the text box of the text watermark
+// by given shape ID.
+func (wm *watermarkFormat) newShape(shapeID int) decodeShape {
+	size, clr, align, wrap, txBox := 72, "808080", "ctr", "none", true
+	if wm.opts.FontSize > 0 {
+		size = wm.opts.FontSize
+	}
+	if wm.opts.Color != "" {
+		clr = strings.TrimPrefix(wm.opts.Color, "#")
+	}
+	sz := size * 100
+	rPr := &DecodeRunProperties{
+		Size:      &sz,
+		SolidFill: &DecodeSolidFill{SolidRGBColor: &SolidRGBColor{Val: clr, Alpha: &ColorAlpha{Val: (100 - wm.transparency) * 1000}}},
+	}
+	if wm.opts.Font != "" {
+		rPr.Latin = &Latin{Typeface: wm.opts.Font}
+	}
+	spPr := wm.shapeProperties()
+	spPr.NoFill = &noFill{}
+	return decodeShape{
+		NonVisualShapeProperties: &decodeNonVisualShapeProperties{
+			CommonNonVisualProperties:      &CommonNonVisualProperties{ID: shapeID, Name: "Watermark"},
+			CommonNonVisualShapeProperties: &decodeCommonNonVisualShapeProperties{TxBox: &txBox},
+			NonVisualProperties:            &decodeNonVisualProperties{},
+		},
+		ShapeProperties: spPr,
+		TextBody: &DecodeTextBody{
+			BodyProperties: &DecodeBodyProperties{Wrap: &wrap},
+			Paragraph: []DecodeParagraph{{
+				ParagraphProperties: &ParagraphProperties{Align: &align},
+				Runs:                []DecodeRuns{{RunProperties: rPr, Text: wm.text}},
+			}},
+		},
+	}
+}
+
+// addMasterWatermark provides a function to append the watermark to the
+// shape tree of every slide master.
+func (f *File) addMasterWatermark(wm *watermarkFormat) error {
+	for _, masterXMLPath := range f.getSlideMasterPaths() {
+		master, err := f.xmlNodeReader(f.readXML(masterXMLPath))
+		if err != nil {
+			return err
+		}
+		shapeTree := master.find("cSld", "spTree")
+		if shapeTree == nil {
+			return newSlideMasterShapeTreeError(masterXMLPath)
+		}
+		var buf bytes.Buffer
+		enc, shapeID := xml.NewEncoder(&buf), getMaxShapeID(master)+1
+		if wm.media == "" {
+			err = enc.EncodeElement(newShape(wm.newShape(shapeID)), xml.StartElement{Name: xml.Name{Local: "p:sp"}})
+		} else {
+			rID := f.addRels(getPartRelsPath(masterXMLPath), SourceRelationshipImage, wm.media, "")
+			err = enc.EncodeElement(newPicture(wm.newPicture(shapeID, rID)), xml.StartElement{Name: xml.Name{Local: "p:pic"}})
+		}
+		if err != nil {
+			return err
+		}
+		node, err := f.xmlNodeReader(buf.Bytes())
+		if err != nil {
+			return err
+		}
+		shapeTree.insert(node, strings.TrimSuffix(shapeTree.Name, "spTree")+"extLst")
+		f.saveFileList(masterXMLPath, master.bytes())
+	}
+	return nil
+}
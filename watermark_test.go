@@ -0,0 +1,58 @@
+package gopptx
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestAddWatermark(t *testing.T) {
+	transparency, rotation := 80, 30
+	for _, c := range []struct {
+		name      string
+		watermark Watermark
+		opts      *WatermarkOptions
+		err       error
+		master    []string
+	}{
+		{name: "text", watermark: Watermark{Text: "DRAFT"}},
+		{
+			name: "image", watermark: Watermark{Extension: ".png", Image: newTestPNG(t, 40, 20)},
+			opts: &WatermarkOptions{Transparency: &transparency, Rotation: &rotation, OnMaster: true},
+			master: []string{`<p:cNvPr id="5" name="Watermark"`, `<a:alphaModFix amt="20000"`,
+				`<a:xfrm rot="1800000"><a:off x="2520156" y="1575197"`, `<a:ext cx="5040312" cy="2520156"`},
+		},
+		{name: "extension", watermark: Watermark{Extension: ".txt", Image: []byte{0}}, err: ErrImgExt},
+	} {
+		f := NewFile()
+		slideID := f.GetSlideList()[0]
+		if err := f.AddWatermark(c.watermark, c.opts); err != c.err {
+			t.Fatalf("%s: expected error %v, got %v", c.name, c.err, err)
+		}
+		slide, err := f.slideReader(slideID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		shapes := slide.getShapes()
+		if c.master != nil || c.err != nil {
+			if len(shapes) != 2 || len(slide.CommonSlideData.ShapeTree.Picture) != 0 {
+				t.Errorf("%s: expected no watermark on the slide", c.name)
+			}
+			master := string(f.readXML("ppt/slideMasters/slideMaster1.xml"))
+			for _, expected := range c.master {
+				if !strings.Contains(master, expected) {
+					t.Errorf("%s: expected %s in the slide master, got %s", c.name, expected, master)
+				}
+			}
+			continue
+		}
+		shape := shapes[len(shapes)-1]
+		if xfrm := shape.ShapeProperties.Xfrm; shape.NonVisualShapeProperties.CommonNonVisualProperties.Name != "Watermark" ||
+			*xfrm.Rot != 18900000 || xfrm.Offset.X+xfrm.Extents.CX/2 != 5040312 || xfrm.Offset.Y+xfrm.Extents.CY/2 != 2835275 {
+			t.Errorf("%s: expected the rotated watermark at the center of the slide, got %+v", c.name, xfrm)
+		}
+		if r := shape.TextBody.Paragraph[0].Runs[0]; r.Text != "DRAFT" || *r.RunProperties.Size != 7200 ||
+			r.RunProperties.SolidFill.SolidRGBColor.Val != "808080" || r.RunProperties.SolidFill.SolidRGBColor.Alpha.Val != 50000 {
+			t.Errorf("%s: expected the semi-transparent text, got %+v", c.name, r)
+		}
+	}
+}
@@ -33,14 +33,43 @@ const (
 const (
 	ContentTypePresentationML                     = "application/vnd.openxmlformats-officedocument.presentationml.presentation.main+xml"
 	ContentTypeSlideML                            = "application/vnd.openxmlformats-officedocument.presentationml.slide+xml"
+	ContentTypeChartColorStyle                    = "application/vnd.ms-office.chartcolorstyle+xml"
+	ContentTypeChartStyle                         = "application/vnd.ms-office.chartstyle+xml"
+	ContentTypeCoreProperties                     = "application/vnd.openxmlformats-package.core-properties+xml"
+	ContentTypeDrawingMLChart                     = "application/vnd.openxmlformats-officedocument.drawingml.chart+xml"
+	ContentTypeExtendedProperties                 = "application/vnd.openxmlformats-officedocument.extended-properties+xml"
+	ContentTypeNotesMaster                        = "application/vnd.openxmlformats-officedocument.presentationml.notesMaster+xml"
+	ContentTypeNotesSlide                         = "application/vnd.openxmlformats-officedocument.presentationml.notesSlide+xml"
+	ContentTypeOLEObject                          = "application/vnd.openxmlformats-officedocument.oleObject"
+	ContentTypePresProps                          = "application/vnd.openxmlformats-officedocument.presentationml.presProps+xml"
 	ContentTypeRelationships                      = "application/vnd.openxmlformats-package.relationships+xml"
+	ContentTypeTheme                              = "application/vnd.openxmlformats-officedocument.theme+xml"
+	ContentTypeSheetML                            = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
 	ContentTypeVBA                                = "application/vnd.ms-office.vbaProject"
 	NameSpaceDrawingMLMain                        = "http://schemas.openxmlformats.org/drawingml/2006/main"
+	NameSpaceDrawingMLTable                       = "http://schemas.openxmlformats.org/drawingml/2006/table"
 	NameSpaceExtendedProperties                   = "http://schemas.openxmlformats.org/officeDocument/2006/extended-properties"
+	NameSpaceSpreadSheetMLMain                    = "http://schemas.openxmlformats.org/spreadsheetml/2006/main"
 	NameSpaceXML                                  = "http://www.w3.org/XML/1998/namespace"
+	SourceRelationshipCalcChain                   = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/calcChain"
+	SourceRelationshipChart                       = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/chart"
+	SourceRelationshipChartColorStyle             = "http://schemas.microsoft.com/office/2011/relationships/chartColorStyle"
+	SourceRelationshipChartStyle                  = "http://schemas.microsoft.com/office/2011/relationships/chartStyle"
+	SourceRelationshipCoreProperties              = "http://schemas.openxmlformats.org/package/2006/relationships/metadata/core-properties"
 	SourceRelationshipCustomProperties            = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/custom-properties"
+	SourceRelationshipExtendedProperties          = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/extended-properties"
+	SourceRelationshipHyperlink                   = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/hyperlink"
+	SourceRelationshipImage                       = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/image"
+	SourceRelationshipOLEObject                   = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/oleObject"
+	SourceRelationshipPackage                     = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/package"
+	SourceRelationshipNotesMaster                 = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/notesMaster"
+	SourceRelationshipNotesSlide                  = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/notesSlide"
 	SourceRelationshipOfficeDocument              = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument"
+	SourceRelationshipPresProps                   = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/presProps"
 	SourceRelationshipSlide                       = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/slide"
+	SourceRelationshipSlideLayout                 = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/slideLayout"
+	SourceRelationshipSlideMaster                 = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/slideMaster"
+	SourceRelationshipTheme                       = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/theme"
 	StrictNameSpaceDocumentPropertiesVariantTypes = "http://purl.oclc.org/ooxml/officeDocument/docPropsVTypes"
 	StrictNameSpaceDrawingMLMain                  = "http://purl.oclc.org/ooxml/drawingml/main"
 	StrictNameSpaceExtendedProperties             = "http://purl.oclc.org/ooxml/officeDocument/extendedProperties"
@@ -49,6 +78,12 @@ const (
 	StrictNameSpacePresentationMLMain = "http://purl.oclc.org/ooxml/presentationml/main"
 )
 
+// Extension URIs of the presentation.
+const (
+	ExtURISectionList    = "{521415D9-36F7-43E2-AB2F-B90AF26B5E84}"
+	ExtURISlideGuideList = "{27BBF7A9-308A-43DC-89C8-2F10F3537804}"
+)
+
 const (
 	defaultXMLPathSlide           = "ppt/slides/slide1.xml"
 	defaultXMLPathSlideRels       = "ppt/slides/_rels/slide1.xml.rels"
@@ -74,6 +109,7 @@ const (
 	defaultXMLMasterSlideID = "rId2"
 	defaultXMLSlideRID      = "rId3"
 	defaultXMLSlideID       = 256
+	maxXMLSlideID           = 2147483647
 	defaultXMLShapeID       = 7
 )
 
@@ -81,11 +117,33 @@ const (
 	MaxFieldLength = 255
 )
 
+const (
+	EMUPerInch         = 914400
+	EMUPerPixel        = 9525
+	EMUPerPoint        = 12700
+	defaultSlideWidth  = 9144000
+	defaultSlideHeight = 6858000
+)
+
 // supportedContentTypes defined supported file format types.
 var supportedContentTypes = map[string]string{
 	".pptx": ContentTypePresentationML,
 }
 
+// supportedImageTypes defined supported image types.
+var supportedImageTypes = map[string]string{
+	".bmp":  "image/bmp",
+	".emf":  "image/x-emf",
+	".gif":  "image/gif",
+	".jpeg": "image/jpeg",
+	".jpg":  "image/jpeg",
+	".png":  "image/png",
+	".svg":  "image/svg+xml",
+	".tif":  "image/tiff",
+	".tiff": "image/tiff",
+	".wmf":  "image/x-wmf",
+}
+
 const (
 	templateNamespaceIDMap = ` xmlns:a="http://schemas.openxmlformats.org/drawingml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships" xmlns:p="http://schemas.openxmlformats.org/presentationml/2006/main" xmlns:mc="http://schemas.openxmlformats.org/markup-compatibility/2006" xmlns:p14="http://schemas.microsoft.com/office/powerpoint/2010/main" xmlns:p15="http://schemas.microsoft.com/office/powerpoint/2012/main"`
 	templatePPTXNamespace  = ` xmlns:p="http://schemas.openxmlformats.org/presentationml/2006/main" xmlns:a="http://schemas.openxmlformats.org/drawingml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships" xmlns:mc="http://schemas.openxmlformats.org/markup-compatibility/2006" mc:Ignorable="p14" xmlns:p14="http://schemas.microsoft.com/office/powerpoint/2010/main"`
@@ -103,4 +161,5 @@ type innerXML struct {
 type alternateContent struct {
 	XMLNSMC string `xml:"xmlns:mc,attr,omitempty"`
 	Content string `xml:",innerxml"`
+	order   int
 }
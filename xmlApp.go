@@ -0,0 +1,91 @@
+// Copyright 2026 kenny-not-dead. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+//
+// Package gopptx provides functionality to create and manipulate PowerPoint
+// (.pptx) files in Go, using the Office Open XML (ECMA-376) format.
+
+package gopptx
+
+import "encoding/xml"
+
+// appProperties directly maps the Properties element of the extended
+// properties part docProps/app.xml in the namespace
+// http://schemas.openxmlformats.org/officeDocument/2006/extended-properties
+type appProperties struct {
+	XMLName              xml.Name   `xml:"Properties"`
+	XMLNS                string     `xml:"xmlns,attr"`
+	XMLNSVT              string     `xml:"xmlns:vt,attr"`
+	Template             string     `xml:"Template"`
+	TotalTime            int        `xml:"TotalTime"`
+	Words                *int       `xml:"Words,omitempty"`
+	Application          string     `xml:"Application,omitempty"`
+	PresentationFormat   string     `xml:"PresentationFormat,omitempty"`
+	Paragraphs           *int       `xml:"Paragraphs,omitempty"`
+	Slides               int        `xml:"Slides"`
+	Notes                int        `xml:"Notes"`
+	HiddenSlides         int        `xml:"HiddenSlides"`
+	MMClips              int        `xml:"MMClips"`
+	ScaleCrop            bool       `xml:"ScaleCrop"`
+	HeadingPairs         *appVector `xml:"HeadingPairs>vt:vector,omitempty"`
+	TitlesOfParts        *appVector `xml:"TitlesOfParts>vt:vector,omitempty"`
+	Manager              string     `xml:"Manager,omitempty"`
+	Company              string     `xml:"Company,omitempty"`
+	LinksUpToDate        bool       `xml:"LinksUpToDate"`
+	SharedDoc            bool       `xml:"SharedDoc"`
+	HyperlinkBase        string     `xml:"HyperlinkBase,omitempty"`
+	HLinks               *innerXML  `xml:"HLinks,omitempty"`
+	HyperlinksChanged    bool       `xml:"HyperlinksChanged"`
+	DigSig               *innerXML  `xml:"DigSig,omitempty"`
+	AppVersion           string     `xml:"AppVersion,omitempty"`
+	DocSecurity          *int       `xml:"DocSecurity,omitempty"`
+	Characters           *int       `xml:"Characters,omitempty"`
+	CharactersWithSpaces *int       `xml:"CharactersWithSpaces,omitempty"`
+	Lines                *int       `xml:"Lines,omitempty"`
+	Pages                *int       `xml:"Pages,omitempty"`
+}
+
+// appVector directly maps the vt:vector element of the heading pairs and the
+// titles of parts, the variants are the pairs of the heading names and the
+// counts of the parts.
+type appVector struct {
+	Size     int          `xml:"size,attr"`
+	BaseType string       `xml:"baseType,attr"`
+	Variant  []appVariant `xml:"vt:variant"`
+	Lpstr    []string     `xml:"vt:lpstr"`
+}
+
+// appVariant directly maps the vt:variant element of the heading pairs.
+type appVariant struct {
+	Lpstr string `xml:"vt:lpstr,omitempty"`
+	I4    *int   `xml:"vt:i4,omitempty"`
+}
+
+// decodeAppProperties defines the structure used to parse the Properties
+// element of the extended properties part docProps/app.xml. The heading pairs
+// and the titles of parts are rebuilt on saving, so they are not parsed.
+type decodeAppProperties struct {
+	XMLName              xml.Name  `xml:"http://schemas.openxmlformats.org/officeDocument/2006/extended-properties Properties"`
+	Template             string    `xml:"Template"`
+	TotalTime            int       `xml:"TotalTime"`
+	Words                *int      `xml:"Words"`
+	Application          string    `xml:"Application"`
+	PresentationFormat   string    `xml:"PresentationFormat"`
+	Paragraphs           *int      `xml:"Paragraphs"`
+	MMClips              int       `xml:"MMClips"`
+	ScaleCrop            bool      `xml:"ScaleCrop"`
+	Manager              string    `xml:"Manager"`
+	Company              string    `xml:"Company"`
+	LinksUpToDate        bool      `xml:"LinksUpToDate"`
+	SharedDoc            bool      `xml:"SharedDoc"`
+	HyperlinkBase        string    `xml:"HyperlinkBase"`
+	HLinks               *innerXML `xml:"HLinks"`
+	HyperlinksChanged    bool      `xml:"HyperlinksChanged"`
+	DigSig               *innerXML `xml:"DigSig"`
+	AppVersion           string    `xml:"AppVersion"`
+	DocSecurity          *int      `xml:"DocSecurity"`
+	Characters           *int      `xml:"Characters"`
+	CharactersWithSpaces *int      `xml:"CharactersWithSpaces"`
+	Lines                *int      `xml:"Lines"`
+	Pages                *int      `xml:"Pages"`
+}
@@ -0,0 +1,17 @@
+// Copyright 2026 kenny-not-dead. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+//
+// Package gopptx provides functionality to create and manipulate PowerPoint
+// (.pptx) files in Go, using the Office Open XML (ECMA-376) format.
+
+package gopptx
+
+import "encoding/xml"
+
+// decodeNotesSlide defines the structure used to parse the notes element of
+// the notes slide part, which holds the speaker notes of a slide.
+type decodeNotesSlide struct {
+	XMLName         xml.Name        `xml:"http://schemas.openxmlformats.org/presentationml/2006/main notes"`
+	CommonSlideData decodeSlideData `xml:"cSld"`
+}
@@ -37,17 +37,33 @@ type presentation struct {
 	XMLNSP14               string            `xml:"xmlns:p14,attr"`
 	XMLNSP15               string            `xml:"xmlns:p15,attr"`
 	XMLNSMC                string            `xml:"xmlns:mc,attr"`
+	FirstSlideNum          *int              `xml:"firstSlideNum,attr,omitempty"`
+	ShowSpecialPlsOnTitle  *bool             `xml:"showSpecialPlsOnTitleSld,attr,omitempty"`
 	AlternateContent       *alternateContent `xml:"mc:AlternateContent"`
 	DecodeAlternateContent *innerXML         `xml:"http://schemas.openxmlformats.org/markup-compatibility/2006 AlternateContent"`
 	MasterSlide            masterSlideList   `xml:"p:sldMasterIdLst"`
+	NotesMaster            *notesMasterList  `xml:"p:notesMasterIdLst,omitempty"`
 	Slides                 *slideList        `xml:"p:sldIdLst,omitempty"`
 	SlideSize              *slideSize        `xml:"p:sldSz,omitempty"`
 	NotesSize              *slideSize        `xml:"p:notesSz,omitempty"`
+	ModifyVerifier         *modifyVerifier   `xml:"p:modifyVerifier,omitempty"`
+	ExtensionList          *extensionList    `xml:"p:extLst,omitempty"`
 }
 
 // TODO
 type masterSlideList struct {
-	MasterSlide slideID `xml:"p:sldMasterId"`
+	MasterSlide []slideID `xml:"p:sldMasterId"`
+}
+
+// notesMasterList directly maps the notesMasterIdLst element of the
+// presentation, which refers to the notes master by the relationship ID.
+type notesMasterList struct {
+	NotesMaster []notesMasterID `xml:"p:notesMasterId"`
+}
+
+// notesMasterID directly maps the notesMasterId element of the presentation.
+type notesMasterID struct {
+	RelationshipID string `xml:"r:id,attr"`
 }
 
 // TODO
@@ -64,31 +80,154 @@ type slideID struct {
 // decodePresentation contains elements and attributes that encompass the data
 // content of the presentation.
 type decodePresentation struct {
-	XMLName                xml.Name              `xml:"http://schemas.openxmlformats.org/presentationml/2006/main presentation"`
-	AlternateContent       *alternateContent     `xml:"mc:AlternateContent"`
-	DecodeAlternateContent *innerXML             `xml:"http://schemas.openxmlformats.org/markup-compatibility/2006 AlternateContent"`
-	MasterSlide            decodeMasterSlideList `xml:"sldMasterIdLst"`
-	Slides                 *decodeSlideList      `xml:"sldIdLst,omitempty"`
-	SlideSize              *slideSize            `xml:"sldSz,omitempty"`
-	NotesSize              *slideSize            `xml:"notesSz,omitempty"`
+	XMLName                xml.Name               `xml:"http://schemas.openxmlformats.org/presentationml/2006/main presentation"`
+	FirstSlideNum          *int                   `xml:"firstSlideNum,attr,omitempty"`
+	ShowSpecialPlsOnTitle  *bool                  `xml:"showSpecialPlsOnTitleSld,attr,omitempty"`
+	AlternateContent       *alternateContent      `xml:"mc:AlternateContent"`
+	DecodeAlternateContent *innerXML              `xml:"http://schemas.openxmlformats.org/markup-compatibility/2006 AlternateContent"`
+	MasterSlide            decodeMasterSlideList  `xml:"sldMasterIdLst"`
+	NotesMaster            *decodeNotesMasterList `xml:"notesMasterIdLst"`
+	Slides                 *decodeSlideList       `xml:"sldIdLst,omitempty"`
+	SlideSize              *slideSize             `xml:"sldSz,omitempty"`
+	NotesSize              *slideSize             `xml:"notesSz,omitempty"`
+	ModifyVerifier         *modifyVerifier        `xml:"modifyVerifier,omitempty"`
+	ExtensionList          *decodeExtensionList   `xml:"extLst,omitempty"`
 }
 
 type decodeMasterSlideList struct {
-	MasterSlide decodeSlideID `xml:"sldMasterId"`
+	MasterSlide []decodeSlideID `xml:"sldMasterId"`
+}
+
+// decodeNotesMasterList defines the structure used to parse the
+// notesMasterIdLst element of the presentation.
+type decodeNotesMasterList struct {
+	NotesMaster []decodeNotesMasterID `xml:"notesMasterId"`
+}
+
+// decodeNotesMasterID defines the structure used to parse the notesMasterId
+// element of the presentation.
+type decodeNotesMasterID struct {
+	RelationshipID string `xml:"http://schemas.openxmlformats.org/officeDocument/2006/relationships id,attr"`
 }
 
 type decodeSlideList struct {
 	Slide []decodeSlideID `xml:"sldId"`
 }
 
-// decodeSlideID defines a slide in this presentation. Slide data is stored in a
-// separate part.
+// decodeSlideID defines the structure used to parse the sldId and
+// sldMasterId elements, the namespaces of the id attributes which are
+// neither the relationships nor empty are kept for reporting.
 type decodeSlideID struct {
-	RelationshipID string `xml:"http://schemas.openxmlformats.org/officeDocument/2006/relationships id,attr"`
-	SlideID        int    `xml:"id,attr"`
+	RelationshipID      string   `xml:"http://schemas.openxmlformats.org/officeDocument/2006/relationships id,attr"`
+	SlideID             int      `xml:"id,attr"`
+	UnexpectedNamespace []string `xml:"-"`
+}
+
+// modifyVerifier directly maps the modifyVerifier element, it specifies the
+// hash of the password to modify the presentation by either the algorithm
+// name or the legacy cryptographic attributes, it's used for both parsing
+// and serialization.
+type modifyVerifier struct {
+	AlgorithmName              string `xml:"algorithmName,attr,omitempty"`
+	HashValue                  string `xml:"hashValue,attr,omitempty"`
+	SaltValue                  string `xml:"saltValue,attr,omitempty"`
+	SpinValue                  string `xml:"spinValue,attr,omitempty"`
+	CryptProviderType          string `xml:"cryptProviderType,attr,omitempty"`
+	CryptAlgorithmClass        string `xml:"cryptAlgorithmClass,attr,omitempty"`
+	CryptAlgorithmType         string `xml:"cryptAlgorithmType,attr,omitempty"`
+	CryptAlgorithmSid          string `xml:"cryptAlgorithmSid,attr,omitempty"`
+	SpinCount                  string `xml:"spinCount,attr,omitempty"`
+	SaltData                   string `xml:"saltData,attr,omitempty"`
+	HashData                   string `xml:"hashData,attr,omitempty"`
+	CryptProvider              string `xml:"cryptProvider,attr,omitempty"`
+	AlgIDExt                   string `xml:"algIdExt,attr,omitempty"`
+	AlgIDExtSource             string `xml:"algIdExtSource,attr,omitempty"`
+	CryptProviderTypeExt       string `xml:"cryptProviderTypeExt,attr,omitempty"`
+	CryptProviderTypeExtSource string `xml:"cryptProviderTypeExtSource,attr,omitempty"`
 }
 
 type slideSize struct {
 	CX int `xml:"cx,attr"`
 	CY int `xml:"cy,attr"`
 }
+
+// extensionList directly maps the extLst element, it contains the extensions
+// of the future versions of the format.
+type extensionList struct {
+	Ext []extension `xml:"p:ext"`
+}
+
+// extension directly maps the ext element, the content of the extension is
+// kept as is.
+type extension struct {
+	URI     string `xml:"uri,attr"`
+	Content string `xml:",innerxml"`
+}
+
+// drawingExtensionList directly maps the extLst element in the DrawingML
+// namespace, it's used for both parsing and serialization, the content of
+// the extensions is kept as is.
+type drawingExtensionList struct {
+	Ext []decodeExtension `xml:"ext"`
+}
+
+// MarshalXML serializes the extension list with the prefix of the DrawingML
+// namespace.
+func (l drawingExtensionList) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	list := struct {
+		Ext []extension `xml:"a:ext"`
+	}{}
+	for _, ext := range l.Ext {
+		list.Ext = append(list.Ext, extension(ext))
+	}
+	return e.EncodeElement(list, xml.StartElement{Name: xml.Name{Local: "a:extLst"}})
+}
+
+// decodeExtensionList defines the structure used to parse the extLst element.
+type decodeExtensionList struct {
+	Ext []decodeExtension `xml:"ext"`
+}
+
+// decodeExtension defines the structure used to parse the ext element.
+type decodeExtension struct {
+	URI     string `xml:"uri,attr"`
+	Content string `xml:",innerxml"`
+}
+
+// sectionList directly maps the sectionLst element in the namespace
+// http://schemas.microsoft.com/office/powerpoint/2010/main, it specifies the
+// sections of the presentation.
+type sectionList struct {
+	XMLName  xml.Name  `xml:"p14:sectionLst"`
+	XMLNSP14 string    `xml:"xmlns:p14,attr"`
+	Section  []section `xml:"p14:section"`
+}
+
+// section directly maps the section element, it groups the slides by given
+// slide IDs.
+type section struct {
+	Name      string             `xml:"name,attr"`
+	ID        string             `xml:"id,attr"`
+	SlideList sectionSlideIDList `xml:"p14:sldIdLst"`
+}
+
+type sectionSlideIDList struct {
+	SlideID []sectionSlideID `xml:"p14:sldId"`
+}
+
+type sectionSlideID struct {
+	ID int `xml:"id,attr"`
+}
+
+// decodeSectionList defines the structure used to parse the sectionLst
+// element.
+type decodeSectionList struct {
+	XMLName xml.Name        `xml:"http://schemas.microsoft.com/office/powerpoint/2010/main sectionLst"`
+	Section []decodeSection `xml:"section"`
+}
+
+type decodeSection struct {
+	Name     string           `xml:"name,attr"`
+	ID       string           `xml:"id,attr"`
+	SlideIDs []sectionSlideID `xml:"sldIdLst>sldId"`
+}
@@ -21,19 +21,88 @@ type Slide struct {
 	XMLNSP14               string            `xml:"xmlns:p14,attr"`
 	XMLNSP15               string            `xml:"xmlns:p15,attr"`
 	XMLNSMC                string            `xml:"xmlns:mc,attr"`
+	ShowMasterShapes       *bool             `xml:"showMasterSp,attr,omitempty"`
+	ShowMasterPhAnim       *bool             `xml:"showMasterPhAnim,attr,omitempty"`
+	Show                   *bool             `xml:"show,attr,omitempty"`
 	CommonSlideData        SlideData         `xml:"p:cSld"`
+	ColorMapOverride       *colorMapOverride `xml:"p:clrMapOvr,omitempty"`
 	AlternateContent       *alternateContent `xml:"mc:AlternateContent"`
 	DecodeAlternateContent *innerXML         `xml:"http://schemas.openxmlformats.org/markup-compatibility/2006 AlternateContent"`
+	Transition             *slideTransition  `xml:"p:transition"`
+	Timing                 *slideTiming      `xml:"p:timing"`
+	ExtensionList          *extensionList    `xml:"p:extLst,omitempty"`
+}
+
+// colorMapOverride directly maps the clrMapOvr element, it specifies the
+// color mapping of the slide, which is either the mapping of the slide master
+// or the overriding mapping.
+type colorMapOverride struct {
+	MasterColorMapping   *struct{}     `xml:"a:masterClrMapping,omitempty"`
+	OverrideColorMapping *colorMapping `xml:"a:overrideClrMapping,omitempty"`
+}
+
+// colorMapping directly maps the clrMap and the overrideClrMapping elements,
+// it maps the background and text colors, the accents and the hyperlinks to
+// the theme colors.
+type colorMapping struct {
+	Background1       string `xml:"bg1,attr"`
+	Text1             string `xml:"tx1,attr"`
+	Background2       string `xml:"bg2,attr"`
+	Text2             string `xml:"tx2,attr"`
+	Accent1           string `xml:"accent1,attr"`
+	Accent2           string `xml:"accent2,attr"`
+	Accent3           string `xml:"accent3,attr"`
+	Accent4           string `xml:"accent4,attr"`
+	Accent5           string `xml:"accent5,attr"`
+	Accent6           string `xml:"accent6,attr"`
+	Hyperlink         string `xml:"hlink,attr"`
+	FollowedHyperlink string `xml:"folHlink,attr"`
 }
 
 type SlideData struct {
-	ShapeTree ShapeTree `xml:"p:spTree"`
+	Name          string         `xml:"name,attr,omitempty"`
+	ShapeTree     ShapeTree      `xml:"p:spTree"`
+	ExtensionList *extensionList `xml:"p:extLst,omitempty"`
 }
 
 type ShapeTree struct {
 	NonVisualGroupShapeProperties *NonVisualGroupShapeProperties `xml:"p:nvGrpSpPr,omitempty"`
 	GroupShapeProperties          *GroupShapeProperties          `xml:"p:grpSpPr,omitempty"`
 	Shape                         []Shape                        `xml:"p:sp"`
+	Picture                       []Picture                      `xml:"p:pic"`
+	GraphicFrame                  []GraphicFrame                 `xml:"p:graphicFrame"`
+	GroupShape                    []GroupShape                   `xml:"p:grpSp"`
+	ContentPart                   []contentPart                  `xml:"p:contentPart"`
+	AlternateContent              []alternateContent             `xml:"mc:AlternateContent"`
+	ExtensionList                 *extensionList                 `xml:"p:extLst,omitempty"`
+}
+
+// contentPart directly maps the p:contentPart element, which refers to the
+// part of the content in the other format, such as the ink annotations in
+// the InkML format. The properties of the content part are kept as the inner
+// XML content.
+type contentPart struct {
+	BwMode  string `xml:"p14:bwMode,attr,omitempty"`
+	RID     string `xml:"r:id,attr"`
+	Content string `xml:",innerxml"`
+	order   int
+}
+
+// GroupShape directly maps the grpSp element. This element specifies the
+// group of the shapes, which are transformed together by the transform of
+// the group, the child offset and extents map the coordinates of the shapes
+// in the group to the group.
+type GroupShape struct {
+	NonVisualGroupShapeProperties *NonVisualGroupShapeProperties `xml:"p:nvGrpSpPr"`
+	GroupShapeProperties          *GroupShapeProperties          `xml:"p:grpSpPr"`
+	Shape                         []Shape                        `xml:"p:sp"`
+	Picture                       []Picture                      `xml:"p:pic"`
+	GraphicFrame                  []GraphicFrame                 `xml:"p:graphicFrame"`
+	GroupShape                    []GroupShape                   `xml:"p:grpSp"`
+	ContentPart                   []contentPart                  `xml:"p:contentPart"`
+	AlternateContent              []alternateContent             `xml:"mc:AlternateContent"`
+	ExtensionList                 *extensionList                 `xml:"p:extLst,omitempty"`
+	order                         int
 }
 
 type NonVisualGroupShapeProperties struct {
@@ -47,6 +116,9 @@ type GroupShapeProperties struct {
 }
 
 type Xfrm struct {
+	Rot          *int     `xml:"rot,attr,omitempty"`
+	FlipH        *bool    `xml:"flipH,attr,omitempty"`
+	FlipV        *bool    `xml:"flipV,attr,omitempty"`
 	Offset       *Offset  `xml:"a:off"`
 	Extents      *Extents `xml:"a:ext"`
 	ChildOffset  *Offset  `xml:"a:chOff"`
@@ -56,7 +128,47 @@ type Xfrm struct {
 type Shape struct {
 	NonVisualShapeProperties *NonVisualShapeProperties `xml:"p:nvSpPr"`
 	ShapeProperties          *ShapeProperties          `xml:"p:spPr"`
+	Style                    *shapeStyle               `xml:"p:style,omitempty"`
 	TextBody                 *TextBody                 `xml:"p:txBody,omitempty"`
+	ExtensionList            *extensionList            `xml:"p:extLst,omitempty"`
+	order                    int
+}
+
+// shapeStyle directly maps the style element of the shape, it references the
+// line, fill and effect styles of the style matrix and the font of the theme.
+type shapeStyle struct {
+	LineReference   *styleMatrixReference `xml:"a:lnRef"`
+	FillReference   *styleMatrixReference `xml:"a:fillRef"`
+	EffectReference *styleMatrixReference `xml:"a:effectRef"`
+	FontReference   *fontReference        `xml:"a:fontRef"`
+}
+
+// styleMatrixReference directly maps the lnRef, fillRef and effectRef
+// elements, it specifies the index of the style in the style matrix of the
+// theme and the color used by the style.
+type styleMatrixReference struct {
+	Index         int            `xml:"idx,attr"`
+	SchemeColor   *schemeColor   `xml:"a:schemeClr,omitempty"`
+	SolidRGBColor *solidRGBColor `xml:"a:srgbClr,omitempty"`
+}
+
+// fontReference directly maps the fontRef element, it specifies the major or
+// minor font of the theme and the color used by the text.
+type fontReference struct {
+	Index         string         `xml:"idx,attr"`
+	SchemeColor   *schemeColor   `xml:"a:schemeClr,omitempty"`
+	SolidRGBColor *solidRGBColor `xml:"a:srgbClr,omitempty"`
+}
+
+// schemeColor directly maps the schemeClr element, it specifies the color
+// bound to the color scheme of the theme with the color transforms.
+type schemeColor struct {
+	Val    string         `xml:"val,attr"`
+	Tint   *ColorModifier `xml:"a:tint,omitempty"`
+	Shade  *ColorModifier `xml:"a:shade,omitempty"`
+	Alpha  *ColorAlpha    `xml:"a:alpha,omitempty"`
+	LumMod *ColorModifier `xml:"a:lumMod,omitempty"`
+	LumOff *ColorModifier `xml:"a:lumOff,omitempty"`
 }
 
 type NonVisualShapeProperties struct {
@@ -66,7 +178,9 @@ type NonVisualShapeProperties struct {
 }
 
 type NonVisualProperties struct {
-	Ph *Ph `xml:"p:ph,omitempty"`
+	UserDrawn     *bool          `xml:"userDrawn,attr,omitempty"`
+	Ph            *Ph            `xml:"p:ph,omitempty"`
+	ExtensionList *extensionList `xml:"p:extLst,omitempty"`
 }
 
 type CommonNonVisualShapeProperties struct {
@@ -75,15 +189,23 @@ type CommonNonVisualShapeProperties struct {
 }
 
 type ShapeProperties struct {
-	Xfrm           *Xfrm           `xml:"a:xfrm"`
-	PresetGeometry *PresetGeometry `xml:"a:prstGeom,omitempty"`
-	NoFill         *noFill         `xml:"a:noFill,omitempty"`
-	Ln             *Line           `xml:"a:ln,omitempty"`
+	Xfrm           *Xfrm                 `xml:"a:xfrm"`
+	PresetGeometry *PresetGeometry       `xml:"a:prstGeom,omitempty"`
+	SolidFill      *SolidFill            `xml:"a:solidFill,omitempty"`
+	NoFill         *noFill               `xml:"a:noFill,omitempty"`
+	Ln             *Line                 `xml:"a:ln,omitempty"`
+	ExtensionList  *drawingExtensionList `xml:"extLst,omitempty"`
 }
 
 type PresetGeometry struct {
 	Preset          string           `xml:"prst,attr"`
-	AdjustValueList *AdjustValueList `xml:"a:avLst"`
+	AdjustValueList *adjustValueList `xml:"a:avLst"`
+}
+
+// adjustValueList directly maps the avLst element, it specifies the adjust
+// values of the preset geometry, such as the inset of the trapezoid.
+type adjustValueList struct {
+	AdjustValue []AdjustValue `xml:"a:gd"`
 }
 
 type Line struct {
@@ -97,54 +219,237 @@ type TextBody struct {
 }
 
 type BodyProperties struct {
-	LIns      *int       `xml:"lIns,attr,omitempty"`
-	RIns      *int       `xml:"rIns,attr,omitempty"`
-	TIns      *int       `xml:"tIns,attr,omitempty"`
-	BIns      *int       `xml:"bIns,attr,omitempty"`
-	Anchor    *string    `xml:"anchor,attr,omitempty"`
-	NoAutofit *NoAutofit `xml:"a:noAutofit,omitempty"`
+	LIns        *int         `xml:"lIns,attr,omitempty"`
+	RIns        *int         `xml:"rIns,attr,omitempty"`
+	TIns        *int         `xml:"tIns,attr,omitempty"`
+	BIns        *int         `xml:"bIns,attr,omitempty"`
+	Anchor      *string      `xml:"anchor,attr,omitempty"`
+	Wrap        *string      `xml:"wrap,attr,omitempty"`
+	NoAutofit   *NoAutofit   `xml:"a:noAutofit,omitempty"`
+	NormAutofit *NormAutofit `xml:"a:normAutofit,omitempty"`
+	SpAutoFit   *SpAutoFit   `xml:"a:spAutoFit,omitempty"`
 }
 
 type Paragraph struct {
-	ParagraphProperties       *ParagraphProperties `xml:"a:pPr,omitempty"`
+	ParagraphProperties       *paragraphProperties `xml:"a:pPr,omitempty"`
 	Runs                      []Runs               `xml:"a:r"`
 	EndParagraphRunProperties *RunProperties       `xml:"a:endParaRPr,omitempty"`
 }
 
+// paragraphProperties directly maps the pPr element, it contains all
+// paragraph level text properties.
+type paragraphProperties struct {
+	Level       *int         `xml:"lvl,attr,omitempty"`
+	Indent      *int         `xml:"indent,attr,omitempty"`
+	Align       *string      `xml:"algn,attr,omitempty"`
+	LineSpacing *lineSpacing `xml:"a:lnSpc,omitempty"`
+	BuNone      *struct{}    `xml:"a:buNone,omitempty"`
+}
+
+type lineSpacing struct {
+	SpacingPercent *SpacingPercent `xml:"a:spcPct"`
+}
+
+// Runs directly maps the r element, or the fld element of the field if the
+// field ID isn't empty, such as the slide number and the date.
 type Runs struct {
+	FieldID       string         `xml:"id,attr,omitempty"`
+	FieldType     string         `xml:"type,attr,omitempty"`
 	RunProperties *RunProperties `xml:"a:rPr,omitempty"`
 	Text          string         `xml:"a:t"`
 }
 
 type RunProperties struct {
-	Bold      *int       `xml:"b,attr,omitempty"`
-	Lang      string     `xml:"lang,attr,omitempty"`
-	Size      *int       `xml:"sz,attr,omitempty"`
-	Space     *int       `xml:"spc,attr,omitempty"`
-	Strike    string     `xml:"strike,attr,omitempty"`
-	SolidFill *SolidFill `xml:"a:solidFill,omitempty"`
-	Latin     *Latin     `xml:"a:latin,omitempty"`
+	Bold           *int       `xml:"b,attr,omitempty"`
+	Lang           string     `xml:"lang,attr,omitempty"`
+	Size           *int       `xml:"sz,attr,omitempty"`
+	Space          *int       `xml:"spc,attr,omitempty"`
+	Strike         string     `xml:"strike,attr,omitempty"`
+	SolidFill      *SolidFill `xml:"a:solidFill,omitempty"`
+	Latin          *Latin     `xml:"a:latin,omitempty"`
+	HyperlinkClick *hyperlink `xml:"a:hlinkClick,omitempty"`
+}
+
+// hyperlink directly maps the hlinkClick element, it specifies the on-click
+// hyperlink information to be applied to a run of text.
+type hyperlink struct {
+	RID     string `xml:"r:id,attr"`
+	Action  string `xml:"action,attr,omitempty"`
+	Tooltip string `xml:"tooltip,attr,omitempty"`
 }
 type SolidFill struct {
-	SolidRGBColor *SolidRGBColor `xml:"a:srgbClr"`
+	SolidRGBColor *solidRGBColor `xml:"a:srgbClr"`
+	SchemeColor   *schemeColor   `xml:"a:schemeClr,omitempty"`
+}
+
+// solidRGBColor directly maps the srgbClr element, it specifies a color using
+// the red, green, blue RGB color model.
+type solidRGBColor struct {
+	Val   string      `xml:"val,attr"`
+	Alpha *ColorAlpha `xml:"a:alpha,omitempty"`
+}
+
+// Picture directly maps the pic element. This element specifies the existence
+// of a picture object within the slide.
+type Picture struct {
+	NonVisualPictureProperties *NonVisualPictureProperties `xml:"p:nvPicPr"`
+	BlipFill                   *BlipFill                   `xml:"p:blipFill"`
+	ShapeProperties            *ShapeProperties            `xml:"p:spPr"`
+	ExtensionList              *extensionList              `xml:"p:extLst,omitempty"`
+	order                      int
+}
+
+type NonVisualPictureProperties struct {
+	CommonNonVisualProperties        *CommonNonVisualProperties        `xml:"p:cNvPr"`
+	CommonNonVisualPictureProperties *CommonNonVisualPictureProperties `xml:"p:cNvPicPr"`
+	NonVisualProperties              *NonVisualProperties              `xml:"p:nvPr"`
+}
+
+type CommonNonVisualPictureProperties struct {
+	PictureLocks *PictureLocks `xml:"a:picLocks,omitempty"`
+}
+
+type BlipFill struct {
+	Blip    *Blip            `xml:"a:blip"`
+	SrcRect *SourceRectangle `xml:"a:srcRect,omitempty"`
+	Stretch *Stretch         `xml:"a:stretch,omitempty"`
+}
+
+type Blip struct {
+	Embed         string       `xml:"r:embed,attr,omitempty"`
+	Link          string       `xml:"r:link,attr,omitempty"`
+	AlphaModFix   *AlphaModFix `xml:"a:alphaModFix,omitempty"`
+	Duotone       *innerXML    `xml:"a:duotone,omitempty"`
+	Grayscale     *struct{}    `xml:"a:grayscl,omitempty"`
+	Luminance     *Luminance   `xml:"a:lum,omitempty"`
+	ExtensionList *innerXML    `xml:"a:extLst,omitempty"`
+}
+
+type Stretch struct {
+	FillRect *FillRect `xml:"a:fillRect"`
+}
+
+// GraphicFrame directly maps the graphicFrame element. This element specifies
+// the existence of a graphics frame, which holds the chart, table or other
+// graphical object within the slide.
+type GraphicFrame struct {
+	NonVisualGraphicFrameProperties *NonVisualGraphicFrameProperties `xml:"p:nvGraphicFramePr"`
+	Xfrm                            *Xfrm                            `xml:"p:xfrm"`
+	Graphic                         *Graphic                         `xml:"a:graphic"`
+	ExtensionList                   *extensionList                   `xml:"p:extLst,omitempty"`
+	order                           int
+}
+
+type NonVisualGraphicFrameProperties struct {
+	CommonNonVisualProperties             *CommonNonVisualProperties `xml:"p:cNvPr"`
+	CommonNonVisualGraphicFrameProperties *innerXML                  `xml:"p:cNvGraphicFramePr"`
+	NonVisualProperties                   *NonVisualProperties       `xml:"p:nvPr"`
+}
+
+type Graphic struct {
+	GraphicData *GraphicData `xml:"a:graphicData"`
+}
+
+// GraphicData directly maps the graphicData element, the URI specifies the
+// type of the graphical object, and the content holds the object XML such as
+// the c:chart or a:tbl element.
+type GraphicData struct {
+	URI     string `xml:"uri,attr"`
+	Content string `xml:",innerxml"`
 }
 
 type decodeSlide struct {
 	mu                     sync.Mutex
-	XMLName                xml.Name          `xml:"sld"`
-	CommonSlideData        decodeSlideData   `xml:"cSld"`
-	AlternateContent       *alternateContent `xml:"mc:AlternateContent"`
-	DecodeAlternateContent *innerXML         `xml:"http://schemas.openxmlformats.org/markup-compatibility/2006 AlternateContent"`
+	XMLName                xml.Name                `xml:"sld"`
+	ShowMasterShapes       *bool                   `xml:"showMasterSp,attr"`
+	ShowMasterPhAnim       *bool                   `xml:"showMasterPhAnim,attr"`
+	Show                   *bool                   `xml:"show,attr"`
+	CommonSlideData        decodeSlideData         `xml:"cSld"`
+	ColorMapOverride       *decodeColorMapOverride `xml:"clrMapOvr"`
+	AlternateContent       *alternateContent       `xml:"mc:AlternateContent"`
+	DecodeAlternateContent *innerXML               `xml:"http://schemas.openxmlformats.org/markup-compatibility/2006 AlternateContent"`
+	Transition             *decodeSlideTransition  `xml:"transition"`
+	Timing                 *innerXML               `xml:"timing"`
+	ExtensionList          *decodeExtensionList    `xml:"extLst"`
+}
+
+// slideTransition directly maps the p:transition element of the slide, the
+// transition effect element is kept as the inner XML content.
+type slideTransition struct {
+	Speed          string `xml:"spd,attr,omitempty"`
+	AdvanceOnClick *bool  `xml:"advClick,attr"`
+	AdvanceAfter   *int   `xml:"advTm,attr"`
+	Content        string `xml:",innerxml"`
+}
+
+// decodeSlideTransition defines the structure used to parse the transition
+// element of the slide.
+type decodeSlideTransition struct {
+	Speed          string `xml:"spd,attr"`
+	AdvanceOnClick *bool  `xml:"advClick,attr"`
+	AdvanceAfter   *int   `xml:"advTm,attr"`
+	Content        string `xml:",innerxml"`
+}
+
+// slideTiming directly maps the p:timing element of the slide, which holds
+// the animations of the slide as the inner XML content.
+type slideTiming struct {
+	Content string `xml:",innerxml"`
+}
+
+// decodeColorMapOverride directly maps the clrMapOvr element of the slide.
+type decodeColorMapOverride struct {
+	MasterColorMapping   *struct{}     `xml:"masterClrMapping"`
+	OverrideColorMapping *colorMapping `xml:"overrideClrMapping"`
 }
 
 type decodeSlideData struct {
-	ShapeTree decodeShapeTree `xml:"spTree"`
+	Name          string               `xml:"name,attr,omitempty"`
+	ShapeTree     decodeShapeTree      `xml:"spTree"`
+	ExtensionList *decodeExtensionList `xml:"extLst"`
 }
 
 type decodeShapeTree struct {
 	NonVisualGroupShapeProperties *decodeNonVisualGroupShapeProperties `xml:"nvGrpSpPr,omitempty"`
 	GroupShapeProperties          *decodeGroupShapeProperties          `xml:"grpSpPr,omitempty"`
 	Shape                         []decodeShape                        `xml:"sp"`
+	Picture                       []decodePicture                      `xml:"pic"`
+	GraphicFrame                  []decodeGraphicFrame                 `xml:"graphicFrame"`
+	GroupShape                    []decodeGroupShape                   `xml:"grpSp"`
+	ContentPart                   []decodeContentPart                  `xml:"contentPart"`
+	AlternateContent              []decodeAlternateContent             `xml:"http://schemas.openxmlformats.org/markup-compatibility/2006 AlternateContent"`
+	ExtensionList                 *decodeExtensionList                 `xml:"extLst"`
+}
+
+// decodeContentPart defines the structure used to parse the contentPart
+// element of the shape tree or the group shape.
+type decodeContentPart struct {
+	BwMode  string `xml:"http://schemas.microsoft.com/office/powerpoint/2010/main bwMode,attr"`
+	RID     string `xml:"http://schemas.openxmlformats.org/officeDocument/2006/relationships id,attr"`
+	Content string `xml:",innerxml"`
+	order   int
+}
+
+// decodeAlternateContent defines the structure used to parse the
+// AlternateContent element of the shape tree or the group shape.
+type decodeAlternateContent struct {
+	Content string `xml:",innerxml"`
+	order   int
+}
+
+// decodeGroupShape defines the structure used to parse the grpSp element of
+// the shape tree.
+type decodeGroupShape struct {
+	NonVisualGroupShapeProperties *decodeNonVisualGroupShapeProperties `xml:"nvGrpSpPr"`
+	GroupShapeProperties          *decodeGroupShapeProperties          `xml:"grpSpPr"`
+	Shape                         []decodeShape                        `xml:"sp"`
+	Picture                       []decodePicture                      `xml:"pic"`
+	GraphicFrame                  []decodeGraphicFrame                 `xml:"graphicFrame"`
+	GroupShape                    []decodeGroupShape                   `xml:"grpSp"`
+	ContentPart                   []decodeContentPart                  `xml:"contentPart"`
+	AlternateContent              []decodeAlternateContent             `xml:"http://schemas.openxmlformats.org/markup-compatibility/2006 AlternateContent"`
+	ExtensionList                 *decodeExtensionList                 `xml:"extLst"`
+	order                         int
 }
 
 type decodeNonVisualGroupShapeProperties struct {
@@ -154,18 +459,33 @@ type decodeNonVisualGroupShapeProperties struct {
 }
 
 type CommonNonVisualProperties struct {
-	ID   int    `xml:"id,attr"`
-	Name string `xml:"name,attr"`
+	ID            int                   `xml:"id,attr"`
+	Name          string                `xml:"name,attr"`
+	Descr         string                `xml:"descr,attr,omitempty"`
+	ExtensionList *drawingExtensionList `xml:"extLst,omitempty"`
 }
 
 type CommonNonVisualGroupShapeProperties struct{}
 
 type decodeNonVisualProperties struct {
-	Ph *Ph `xml:"ph,omitempty"`
-}
-
+	UserDrawn     *bool                `xml:"userDrawn,attr,omitempty"`
+	Ph            *Ph                  `xml:"ph,omitempty"`
+	ExtensionList *decodeExtensionList `xml:"extLst"`
+}
+
+// Ph directly maps the ph element of the non-visual properties, which makes
+// the shape a placeholder. The type is the placeholder type such as "title",
+// "body" or "pic" with default "obj", the index links the placeholder to the
+// one with the same index on the slide layout and the slide master, the
+// orientation is "horz" or "vert", the size is "full", "half" or "quarter",
+// and the custom prompt indicates the placeholder has the custom prompt
+// text.
 type Ph struct {
-	Type *string `xml:"type,attr,omitempty"`
+	Type            *string `xml:"type,attr,omitempty"`
+	Orient          *string `xml:"orient,attr,omitempty"`
+	Size            *string `xml:"sz,attr,omitempty"`
+	Idx             *int    `xml:"idx,attr,omitempty"`
+	HasCustomPrompt *bool   `xml:"hasCustomPrompt,attr,omitempty"`
 }
 
 type decodeGroupShapeProperties struct {
@@ -173,6 +493,9 @@ type decodeGroupShapeProperties struct {
 }
 
 type DecodeXfrm struct {
+	Rot          *int     `xml:"rot,attr,omitempty"`
+	FlipH        *bool    `xml:"flipH,attr,omitempty"`
+	FlipV        *bool    `xml:"flipV,attr,omitempty"`
 	Offset       *Offset  `xml:"off"`
 	Extents      *Extents `xml:"ext"`
 	ChildOffset  *Offset  `xml:"chOff"`
@@ -192,7 +515,56 @@ type Extents struct {
 type decodeShape struct {
 	NonVisualShapeProperties *decodeNonVisualShapeProperties `xml:"nvSpPr"`
 	ShapeProperties          *DecodeShapeProperties          `xml:"spPr"`
+	Style                    *DecodeShapeStyle               `xml:"style,omitempty"`
 	TextBody                 *DecodeTextBody                 `xml:"txBody,omitempty"`
+	ExtensionList            *decodeExtensionList            `xml:"extLst"`
+	order                    int
+}
+
+// DecodeShapeStyle defines the structure used to parse the style element of
+// the shape. The index of the line, fill and effect references is the style
+// in the style matrix of the theme, 0 for no style and 1 to 3 for the subtle,
+// moderate and intense styles. The index of the font reference is "major",
+// "minor" or "none".
+type DecodeShapeStyle struct {
+	LineReference   *DecodeStyleMatrixReference `xml:"lnRef"`
+	FillReference   *DecodeStyleMatrixReference `xml:"fillRef"`
+	EffectReference *DecodeStyleMatrixReference `xml:"effectRef"`
+	FontReference   *DecodeFontReference        `xml:"fontRef"`
+}
+
+// DecodeStyleMatrixReference defines the structure used to parse the lnRef,
+// fillRef and effectRef elements of the shape style.
+type DecodeStyleMatrixReference struct {
+	Index         int                `xml:"idx,attr"`
+	SchemeColor   *DecodeSchemeColor `xml:"schemeClr,omitempty"`
+	SolidRGBColor *SolidRGBColor     `xml:"srgbClr,omitempty"`
+}
+
+// DecodeFontReference defines the structure used to parse the fontRef
+// element of the shape style.
+type DecodeFontReference struct {
+	Index         string             `xml:"idx,attr"`
+	SchemeColor   *DecodeSchemeColor `xml:"schemeClr,omitempty"`
+	SolidRGBColor *SolidRGBColor     `xml:"srgbClr,omitempty"`
+}
+
+// DecodeSchemeColor defines the structure used to parse the schemeClr
+// element, the value is the color of the color scheme such as "accent1", and
+// the transforms are specified in thousandths of a percent.
+type DecodeSchemeColor struct {
+	Val    string         `xml:"val,attr"`
+	Tint   *ColorModifier `xml:"tint,omitempty"`
+	Shade  *ColorModifier `xml:"shade,omitempty"`
+	Alpha  *ColorAlpha    `xml:"alpha,omitempty"`
+	LumMod *ColorModifier `xml:"lumMod,omitempty"`
+	LumOff *ColorModifier `xml:"lumOff,omitempty"`
+}
+
+// ColorModifier specifies the tint, shade or luminance transform of the color
+// in thousandths of a percent.
+type ColorModifier struct {
+	Val int `xml:"val,attr"`
 }
 
 type decodeNonVisualShapeProperties struct {
@@ -213,8 +585,10 @@ type ShapeLocks struct {
 type DecodeShapeProperties struct {
 	Xfrm           *DecodeXfrm           `xml:"xfrm"`
 	PresetGeometry *DecodePresetGeometry `xml:"prstGeom,omitempty"`
+	SolidFill      *DecodeSolidFill      `xml:"solidFill,omitempty"`
 	NoFill         *noFill               `xml:"noFill,omitempty"`
 	Ln             *decodeLine           `xml:"ln,omitempty"`
+	ExtensionList  *drawingExtensionList `xml:"extLst,omitempty"`
 }
 
 type noFill struct{}
@@ -225,6 +599,7 @@ type DecodePresetGeometry struct {
 }
 
 type AdjustValueList struct {
+	AdjustValue []AdjustValue `xml:"gd"`
 }
 
 type AdjustValue struct {
@@ -243,16 +618,31 @@ type DecodeTextBody struct {
 }
 
 type DecodeBodyProperties struct {
-	LIns      *int       `xml:"lIns,attr,omitempty"`
-	RIns      *int       `xml:"rIns,attr,omitempty"`
-	TIns      *int       `xml:"tIns,attr,omitempty"`
-	BIns      *int       `xml:"bIns,attr,omitempty"`
-	Anchor    *string    `xml:"anchor,attr,omitempty"`
-	NoAutofit *NoAutofit `xml:"noAutofit,omitempty"`
+	LIns        *int         `xml:"lIns,attr,omitempty"`
+	RIns        *int         `xml:"rIns,attr,omitempty"`
+	TIns        *int         `xml:"tIns,attr,omitempty"`
+	BIns        *int         `xml:"bIns,attr,omitempty"`
+	Anchor      *string      `xml:"anchor,attr,omitempty"`
+	Wrap        *string      `xml:"wrap,attr,omitempty"`
+	NoAutofit   *NoAutofit   `xml:"noAutofit,omitempty"`
+	NormAutofit *NormAutofit `xml:"normAutofit,omitempty"`
+	SpAutoFit   *SpAutoFit   `xml:"spAutoFit,omitempty"`
 }
 
 type NoAutofit struct{}
 
+// NormAutofit directly maps the normAutofit element, the text is shrunk to
+// fit the shape by the font scale and the line spacing reduction, which are
+// specified in thousandths of a percent.
+type NormAutofit struct {
+	FontScale      string `xml:"fontScale,attr,omitempty"`
+	LnSpcReduction string `xml:"lnSpcReduction,attr,omitempty"`
+}
+
+// SpAutoFit directly maps the spAutoFit element, the shape is resized to fit
+// the text.
+type SpAutoFit struct{}
+
 type DecodeParagraph struct {
 	ParagraphProperties       *ParagraphProperties `xml:"pPr,omitempty"`
 	Runs                      []DecodeRuns         `xml:"r"`
@@ -260,6 +650,7 @@ type DecodeParagraph struct {
 }
 
 type ParagraphProperties struct {
+	Level       *int         `xml:"lvl,attr,omitempty"`
 	Indent      *int         `xml:"indent,attr,omitempty"`
 	Align       *string      `xml:"algn,attr,omitempty"`
 	LineSpacing *LineSpacing `xml:"lnSpc,omitempty"`
@@ -274,29 +665,136 @@ type SpacingPercent struct {
 	Val int `xml:"val,attr"`
 }
 
+// DecodeRuns defines the structure used to parse the r and fld elements of
+// the paragraph. The field ID and type are empty for the text runs, the text
+// of the field is the last computed value of the field.
 type DecodeRuns struct {
+	FieldID       string               `xml:"id,attr,omitempty"`
+	FieldType     string               `xml:"type,attr,omitempty"`
 	RunProperties *DecodeRunProperties `xml:"rPr,omitempty"`
 	Text          string               `xml:"t"`
 }
 
 type DecodeRunProperties struct {
-	Bold      *int             `xml:"b,attr,omitempty"`
-	Lang      string           `xml:"lang,attr,omitempty"`
-	Size      *int             `xml:"sz,attr,omitempty"`
-	Space     *int             `xml:"spc,attr,omitempty"`
-	Strike    string           `xml:"strike,attr,omitempty"`
-	SolidFill *DecodeSolidFill `xml:"solidFill,omitempty"`
-	Latin     *Latin           `xml:"latin,omitempty"`
+	Bold           *int             `xml:"b,attr,omitempty"`
+	Lang           string           `xml:"lang,attr,omitempty"`
+	Size           *int             `xml:"sz,attr,omitempty"`
+	Space          *int             `xml:"spc,attr,omitempty"`
+	Strike         string           `xml:"strike,attr,omitempty"`
+	SolidFill      *DecodeSolidFill `xml:"solidFill,omitempty"`
+	Latin          *Latin           `xml:"latin,omitempty"`
+	HyperlinkClick *DecodeHyperlink `xml:"hlinkClick,omitempty"`
+}
+
+// DecodeHyperlink defines the structure used to parse the hlinkClick element,
+// the action "ppaction://hlinksldjump" specifies the jump to the slide of the
+// relationship.
+type DecodeHyperlink struct {
+	RID     string `xml:"http://schemas.openxmlformats.org/officeDocument/2006/relationships id,attr"`
+	Action  string `xml:"action,attr,omitempty"`
+	Tooltip string `xml:"tooltip,attr,omitempty"`
 }
 
 type DecodeSolidFill struct {
-	SolidRGBColor *SolidRGBColor `xml:"srgbClr"`
+	SolidRGBColor *SolidRGBColor     `xml:"srgbClr"`
+	SchemeColor   *DecodeSchemeColor `xml:"schemeClr"`
 }
 
 type SolidRGBColor struct {
-	Val string `xml:"val,attr"`
+	Val   string      `xml:"val,attr"`
+	Alpha *ColorAlpha `xml:"alpha,omitempty"`
+}
+
+// ColorAlpha specifies the opacity of the color in thousandths of a percent.
+type ColorAlpha struct {
+	Val int `xml:"val,attr"`
 }
 
 type Latin struct {
 	Typeface string `xml:"typeface,attr"`
 }
+
+// decodePicture defines the structure used to parse the pic element of the
+// shape tree.
+type decodePicture struct {
+	NonVisualPictureProperties *decodeNonVisualPictureProperties `xml:"nvPicPr"`
+	BlipFill                   *decodeBlipFill                   `xml:"blipFill"`
+	ShapeProperties            *DecodeShapeProperties            `xml:"spPr"`
+	ExtensionList              *decodeExtensionList              `xml:"extLst"`
+	order                      int
+}
+
+type decodeGraphicFrame struct {
+	NonVisualGraphicFrameProperties *decodeNonVisualGraphicFrameProperties `xml:"nvGraphicFramePr"`
+	Xfrm                            *DecodeXfrm                            `xml:"xfrm"`
+	Graphic                         *decodeGraphic                         `xml:"graphic"`
+	ExtensionList                   *decodeExtensionList                   `xml:"extLst"`
+	order                           int
+}
+
+type decodeNonVisualGraphicFrameProperties struct {
+	CommonNonVisualProperties             *CommonNonVisualProperties `xml:"cNvPr"`
+	CommonNonVisualGraphicFrameProperties *innerXML                  `xml:"cNvGraphicFramePr"`
+	NonVisualProperties                   *decodeNonVisualProperties `xml:"nvPr"`
+}
+
+type decodeGraphic struct {
+	GraphicData *GraphicData `xml:"graphicData"`
+}
+
+type decodeNonVisualPictureProperties struct {
+	CommonNonVisualProperties        *CommonNonVisualProperties              `xml:"cNvPr"`
+	CommonNonVisualPictureProperties *decodeCommonNonVisualPictureProperties `xml:"cNvPicPr"`
+	NonVisualProperties              *decodeNonVisualProperties              `xml:"nvPr"`
+}
+
+type decodeCommonNonVisualPictureProperties struct {
+	PictureLocks *PictureLocks `xml:"picLocks,omitempty"`
+}
+
+type PictureLocks struct {
+	NoChangeAspect *int `xml:"noChangeAspect,attr,omitempty"`
+}
+
+type decodeBlipFill struct {
+	Blip    *decodeBlip      `xml:"blip"`
+	SrcRect *SourceRectangle `xml:"srcRect,omitempty"`
+	Stretch *decodeStretch   `xml:"stretch,omitempty"`
+}
+
+type decodeBlip struct {
+	Embed         string       `xml:"http://schemas.openxmlformats.org/officeDocument/2006/relationships embed,attr,omitempty"`
+	Link          string       `xml:"http://schemas.openxmlformats.org/officeDocument/2006/relationships link,attr,omitempty"`
+	AlphaModFix   *AlphaModFix `xml:"alphaModFix,omitempty"`
+	Duotone       *innerXML    `xml:"duotone,omitempty"`
+	Grayscale     *struct{}    `xml:"grayscl,omitempty"`
+	Luminance     *Luminance   `xml:"lum,omitempty"`
+	ExtensionList *innerXML    `xml:"extLst,omitempty"`
+}
+
+// AlphaModFix specifies the opacity of the blip in thousandths of a percent.
+type AlphaModFix struct {
+	Amount int `xml:"amt,attr"`
+}
+
+// Luminance specifies the brightness and contrast change of the blip in
+// thousandths of a percent.
+type Luminance struct {
+	Bright   int `xml:"bright,attr,omitempty"`
+	Contrast int `xml:"contrast,attr,omitempty"`
+}
+
+// SourceRectangle specifies the portion of the blip used for the fill, each
+// edge given as an inset in thousandths of a percent.
+type SourceRectangle struct {
+	L *int `xml:"l,attr,omitempty"`
+	T *int `xml:"t,attr,omitempty"`
+	R *int `xml:"r,attr,omitempty"`
+	B *int `xml:"b,attr,omitempty"`
+}
+
+type decodeStretch struct {
+	FillRect *FillRect `xml:"fillRect"`
+}
+
+type FillRect struct{}
@@ -0,0 +1,18 @@
+// Copyright 2026 kenny-not-dead. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+//
+// Package gopptx provides functionality to create and manipulate PowerPoint
+// (.pptx) files in Go, using the Office Open XML (ECMA-376) format.
+
+package gopptx
+
+import "encoding/xml"
+
+// decodeSlideLayout defines the structure used to parse the sldLayout element
+// of the slide layout part.
+type decodeSlideLayout struct {
+	XMLName         xml.Name        `xml:"http://schemas.openxmlformats.org/presentationml/2006/main sldLayout"`
+	Type            string          `xml:"type,attr,omitempty"`
+	CommonSlideData decodeSlideData `xml:"cSld"`
+}
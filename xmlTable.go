@@ -0,0 +1,50 @@
+// Copyright 2026 kenny-not-dead. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+//
+// Package gopptx provides functionality to create and manipulate PowerPoint
+// (.pptx) files in Go, using the Office Open XML (ECMA-376) format.
+
+package gopptx
+
+import "encoding/xml"
+
+// table directly maps the tbl element. This element is the root element for
+// a table within the graphic frame.
+type table struct {
+	XMLName         xml.Name         `xml:"a:tbl"`
+	TableProperties *tableProperties `xml:"a:tblPr"`
+	TableGrid       tableGrid        `xml:"a:tblGrid"`
+	Rows            []tableRow       `xml:"a:tr"`
+}
+
+// tableProperties directly maps the tblPr element, the table style ID refers
+// to a built-in table style or the table style in the tableStyles.xml part.
+type tableProperties struct {
+	FirstRow     *int   `xml:"firstRow,attr,omitempty"`
+	BandRow      *int   `xml:"bandRow,attr,omitempty"`
+	TableStyleID string `xml:"a:tableStyleId,omitempty"`
+}
+
+type tableGrid struct {
+	GridCol []tableGridCol `xml:"a:gridCol"`
+}
+
+type tableGridCol struct {
+	Width int `xml:"w,attr"`
+}
+
+type tableRow struct {
+	Height int         `xml:"h,attr"`
+	Cells  []tableCell `xml:"a:tc"`
+}
+
+type tableCell struct {
+	TextBody       *TextBody            `xml:"a:txBody"`
+	CellProperties *tableCellProperties `xml:"a:tcPr"`
+}
+
+type tableCellProperties struct {
+	Anchor    string     `xml:"anchor,attr,omitempty"`
+	SolidFill *SolidFill `xml:"a:solidFill,omitempty"`
+}
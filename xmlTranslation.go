@@ -0,0 +1,33 @@
+// Copyright 2026 kenny-not-dead. All rights reserved.
+// Use of this source code is governed by a BSD-style license
+// that can be found in the LICENSE file.
+//
+// Package gopptx provides functionality to create and manipulate PowerPoint
+// (.pptx) files in Go, using the Office Open XML (ECMA-376) format.
+
+package gopptx
+
+import "encoding/xml"
+
+// xliff directly maps the root element of the XLIFF 1.2 document in the
+// namespace urn:oasis:names:tc:xliff:document:1.2
+type xliff struct {
+	XMLName xml.Name  `xml:"urn:oasis:names:tc:xliff:document:1.2 xliff"`
+	Version string    `xml:"version,attr"`
+	File    xliffFile `xml:"file"`
+}
+
+// xliffFile directly maps the file element of the XLIFF document.
+type xliffFile struct {
+	Original       string           `xml:"original,attr"`
+	SourceLanguage string           `xml:"source-language,attr"`
+	DataType       string           `xml:"datatype,attr"`
+	TransUnits     []xliffTransUnit `xml:"body>trans-unit"`
+}
+
+// xliffTransUnit directly maps the trans-unit element of the XLIFF document.
+type xliffTransUnit struct {
+	ID     string  `xml:"id,attr"`
+	Source string  `xml:"source"`
+	Target *string `xml:"target"`
+}